@@ -0,0 +1,2212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.17.3
+// source: edge-cluster-helm-messages.proto
+
+package edgecluster
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+//*
+// Declares the details of a revision of a helm release installed on an existing edge cluster
+type HelmRelease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the helm release
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The namespace the helm release is installed to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The revision of the helm release
+	Revision int32 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The status of the helm release revision, e.g. deployed, failed or superseded
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// The human-friendly description of the helm release revision
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// The name of the chart the helm release revision is installed from
+	ChartName string `protobuf:"bytes,6,opt,name=chartName,proto3" json:"chartName,omitempty"`
+	// The version of the chart the helm release revision is installed from
+	ChartVersion string `protobuf:"bytes,7,opt,name=chartVersion,proto3" json:"chartVersion,omitempty"`
+	// The version of the application the chart contains
+	AppVersion string `protobuf:"bytes,8,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	// The time the helm release was first deployed
+	FirstDeployed *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=firstDeployed,proto3" json:"firstDeployed,omitempty"`
+	// The time the helm release revision was deployed
+	LastDeployed *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=lastDeployed,proto3" json:"lastDeployed,omitempty"`
+}
+
+func (x *HelmRelease) Reset() {
+	*x = HelmRelease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelmRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelmRelease) ProtoMessage() {}
+
+func (x *HelmRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelmRelease.ProtoReflect.Descriptor instead.
+func (*HelmRelease) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HelmRelease) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *HelmRelease) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetChartName() string {
+	if x != nil {
+		return x.ChartName
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetFirstDeployed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstDeployed
+	}
+	return nil
+}
+
+func (x *HelmRelease) GetLastDeployed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDeployed
+	}
+	return nil
+}
+
+//*
+// Request to install a new helm release on an existing edge cluster
+type InstallHelmReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique edge cluster identifier
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The namespace the helm release should be installed to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the helm release
+	ReleaseName string `protobuf:"bytes,3,opt,name=releaseName,proto3" json:"releaseName,omitempty"`
+	// The name of the helm repository the chart is installed from
+	RepositoryName string `protobuf:"bytes,4,opt,name=repositoryName,proto3" json:"repositoryName,omitempty"`
+	// Optional, if provided, the chart is only installed if the helm repository is registered with the given URL
+	RepositoryURL string `protobuf:"bytes,5,opt,name=repositoryURL,proto3" json:"repositoryURL,omitempty"`
+	// The name of the chart to install
+	Chart string `protobuf:"bytes,6,opt,name=chart,proto3" json:"chart,omitempty"`
+	// Optional, if provided, pins the version of the chart to install
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Optional, the YAML values documents to install the chart with, later documents override the earlier ones
+	Values []string `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty"`
+	// Optional, the set expressions (e.g. key=value) applied on top of the values documents
+	Set []string `protobuf:"bytes,9,rep,name=set,proto3" json:"set,omitempty"`
+	// Optional, the set expressions whose values are always treated as strings
+	SetString []string `protobuf:"bytes,10,rep,name=setString,proto3" json:"setString,omitempty"`
+	// Optional, maps the value keys to the file contents they are set to
+	SetFile map[string]string `protobuf:"bytes,11,rep,name=setFile,proto3" json:"setFile,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional, if set, a failed install is uninstalled. Atomic implies wait.
+	Atomic bool `protobuf:"varint,12,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// Optional, if set, waits until all the resources of the release are ready
+	Wait bool `protobuf:"varint,13,opt,name=wait,proto3" json:"wait,omitempty"`
+	// Optional, the time to wait for the kubernetes operations and the hooks, 5 minutes if not provided
+	Timeout *durationpb.Duration `protobuf:"bytes,14,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional, if set, the missing chart dependencies are downloaded before the chart is installed
+	DependencyUpdate bool `protobuf:"varint,15,opt,name=dependencyUpdate,proto3" json:"dependencyUpdate,omitempty"`
+}
+
+func (x *InstallHelmReleaseRequest) Reset() {
+	*x = InstallHelmReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallHelmReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallHelmReleaseRequest) ProtoMessage() {}
+
+func (x *InstallHelmReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallHelmReleaseRequest.ProtoReflect.Descriptor instead.
+func (*InstallHelmReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InstallHelmReleaseRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetRepositoryName() string {
+	if x != nil {
+		return x.RepositoryName
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetRepositoryURL() string {
+	if x != nil {
+		return x.RepositoryURL
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *InstallHelmReleaseRequest) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *InstallHelmReleaseRequest) GetSetString() []string {
+	if x != nil {
+		return x.SetString
+	}
+	return nil
+}
+
+func (x *InstallHelmReleaseRequest) GetSetFile() map[string]string {
+	if x != nil {
+		return x.SetFile
+	}
+	return nil
+}
+
+func (x *InstallHelmReleaseRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *InstallHelmReleaseRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *InstallHelmReleaseRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *InstallHelmReleaseRequest) GetDependencyUpdate() bool {
+	if x != nil {
+		return x.DependencyUpdate
+	}
+	return false
+}
+
+//*
+// Response contains the result of installing a new helm release on an existing edge cluster
+type InstallHelmReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The latest revision of the helm release
+	Release *HelmRelease `protobuf:"bytes,3,opt,name=release,proto3" json:"release,omitempty"`
+	// The revisions of the helm release ordered from the oldest to the latest
+	History []*HelmRelease `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *InstallHelmReleaseResponse) Reset() {
+	*x = InstallHelmReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallHelmReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallHelmReleaseResponse) ProtoMessage() {}
+
+func (x *InstallHelmReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallHelmReleaseResponse.ProtoReflect.Descriptor instead.
+func (*InstallHelmReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InstallHelmReleaseResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *InstallHelmReleaseResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *InstallHelmReleaseResponse) GetRelease() *HelmRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *InstallHelmReleaseResponse) GetHistory() []*HelmRelease {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+//*
+// Request to upgrade an existing helm release on an existing edge cluster
+type UpgradeHelmReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique edge cluster identifier
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The namespace the helm release is installed to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the helm release
+	ReleaseName string `protobuf:"bytes,3,opt,name=releaseName,proto3" json:"releaseName,omitempty"`
+	// The name of the helm repository the chart is upgraded from
+	RepositoryName string `protobuf:"bytes,4,opt,name=repositoryName,proto3" json:"repositoryName,omitempty"`
+	// Optional, if provided, the chart is only upgraded if the helm repository is registered with the given URL
+	RepositoryURL string `protobuf:"bytes,5,opt,name=repositoryURL,proto3" json:"repositoryURL,omitempty"`
+	// The name of the chart to upgrade to
+	Chart string `protobuf:"bytes,6,opt,name=chart,proto3" json:"chart,omitempty"`
+	// Optional, if provided, pins the version of the chart to upgrade to
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Optional, the YAML values documents to upgrade the chart with, later documents override the earlier ones
+	Values []string `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty"`
+	// Optional, the set expressions (e.g. key=value) applied on top of the values documents
+	Set []string `protobuf:"bytes,9,rep,name=set,proto3" json:"set,omitempty"`
+	// Optional, the set expressions whose values are always treated as strings
+	SetString []string `protobuf:"bytes,10,rep,name=setString,proto3" json:"setString,omitempty"`
+	// Optional, maps the value keys to the file contents they are set to
+	SetFile map[string]string `protobuf:"bytes,11,rep,name=setFile,proto3" json:"setFile,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Optional, if set, a failed upgrade is rolled back. Atomic implies wait.
+	Atomic bool `protobuf:"varint,12,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// Optional, if set, waits until all the resources of the release are ready
+	Wait bool `protobuf:"varint,13,opt,name=wait,proto3" json:"wait,omitempty"`
+	// Optional, the time to wait for the kubernetes operations and the hooks, 5 minutes if not provided
+	Timeout *durationpb.Duration `protobuf:"bytes,14,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Optional, if set, the missing chart dependencies are downloaded before the chart is upgraded
+	DependencyUpdate bool `protobuf:"varint,15,opt,name=dependencyUpdate,proto3" json:"dependencyUpdate,omitempty"`
+}
+
+func (x *UpgradeHelmReleaseRequest) Reset() {
+	*x = UpgradeHelmReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeHelmReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeHelmReleaseRequest) ProtoMessage() {}
+
+func (x *UpgradeHelmReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeHelmReleaseRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeHelmReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpgradeHelmReleaseRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetRepositoryName() string {
+	if x != nil {
+		return x.RepositoryName
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetRepositoryURL() string {
+	if x != nil {
+		return x.RepositoryURL
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetChart() string {
+	if x != nil {
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *UpgradeHelmReleaseRequest) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *UpgradeHelmReleaseRequest) GetSetString() []string {
+	if x != nil {
+		return x.SetString
+	}
+	return nil
+}
+
+func (x *UpgradeHelmReleaseRequest) GetSetFile() map[string]string {
+	if x != nil {
+		return x.SetFile
+	}
+	return nil
+}
+
+func (x *UpgradeHelmReleaseRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *UpgradeHelmReleaseRequest) GetWait() bool {
+	if x != nil {
+		return x.Wait
+	}
+	return false
+}
+
+func (x *UpgradeHelmReleaseRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *UpgradeHelmReleaseRequest) GetDependencyUpdate() bool {
+	if x != nil {
+		return x.DependencyUpdate
+	}
+	return false
+}
+
+//*
+// Response contains the result of upgrading an existing helm release on an existing edge cluster
+type UpgradeHelmReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The latest revision of the helm release
+	Release *HelmRelease `protobuf:"bytes,3,opt,name=release,proto3" json:"release,omitempty"`
+	// The revisions of the helm release ordered from the oldest to the latest
+	History []*HelmRelease `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *UpgradeHelmReleaseResponse) Reset() {
+	*x = UpgradeHelmReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeHelmReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeHelmReleaseResponse) ProtoMessage() {}
+
+func (x *UpgradeHelmReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeHelmReleaseResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeHelmReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpgradeHelmReleaseResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *UpgradeHelmReleaseResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *UpgradeHelmReleaseResponse) GetRelease() *HelmRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *UpgradeHelmReleaseResponse) GetHistory() []*HelmRelease {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+//*
+// Request to roll an existing helm release on an existing edge cluster back to one of its previous revisions
+type RollbackHelmReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique edge cluster identifier
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The namespace the helm release is installed to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the helm release
+	ReleaseName string `protobuf:"bytes,3,opt,name=releaseName,proto3" json:"releaseName,omitempty"`
+	// Optional, the revision to roll back to, the previous revision if not provided
+	Revision int32 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RollbackHelmReleaseRequest) Reset() {
+	*x = RollbackHelmReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackHelmReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackHelmReleaseRequest) ProtoMessage() {}
+
+func (x *RollbackHelmReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackHelmReleaseRequest.ProtoReflect.Descriptor instead.
+func (*RollbackHelmReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RollbackHelmReleaseRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+//*
+// Response contains the result of rolling back an existing helm release on an existing edge cluster
+type RollbackHelmReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The latest revision of the helm release
+	Release *HelmRelease `protobuf:"bytes,3,opt,name=release,proto3" json:"release,omitempty"`
+	// The revisions of the helm release ordered from the oldest to the latest
+	History []*HelmRelease `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *RollbackHelmReleaseResponse) Reset() {
+	*x = RollbackHelmReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollbackHelmReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackHelmReleaseResponse) ProtoMessage() {}
+
+func (x *RollbackHelmReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackHelmReleaseResponse.ProtoReflect.Descriptor instead.
+func (*RollbackHelmReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RollbackHelmReleaseResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *RollbackHelmReleaseResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RollbackHelmReleaseResponse) GetRelease() *HelmRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *RollbackHelmReleaseResponse) GetHistory() []*HelmRelease {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+//*
+// Request to uninstall an existing helm release from an existing edge cluster
+type UninstallHelmReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique edge cluster identifier
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The namespace the helm release is installed to
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the helm release
+	ReleaseName string `protobuf:"bytes,3,opt,name=releaseName,proto3" json:"releaseName,omitempty"`
+	// Optional, prevents the pre-delete and post-delete hooks of the helm release from running
+	DisableHooks bool `protobuf:"varint,4,opt,name=disableHooks,proto3" json:"disableHooks,omitempty"`
+	// Optional, the time to wait for the kubernetes operations and the hooks, 5 minutes if not provided
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *UninstallHelmReleaseRequest) Reset() {
+	*x = UninstallHelmReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallHelmReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallHelmReleaseRequest) ProtoMessage() {}
+
+func (x *UninstallHelmReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallHelmReleaseRequest.ProtoReflect.Descriptor instead.
+func (*UninstallHelmReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UninstallHelmReleaseRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *UninstallHelmReleaseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UninstallHelmReleaseRequest) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *UninstallHelmReleaseRequest) GetDisableHooks() bool {
+	if x != nil {
+		return x.DisableHooks
+	}
+	return false
+}
+
+func (x *UninstallHelmReleaseRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+//*
+// Response contains the result of uninstalling an existing helm release from an existing edge cluster
+type UninstallHelmReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *UninstallHelmReleaseResponse) Reset() {
+	*x = UninstallHelmReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallHelmReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallHelmReleaseResponse) ProtoMessage() {}
+
+func (x *UninstallHelmReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallHelmReleaseResponse.ProtoReflect.Descriptor instead.
+func (*UninstallHelmReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UninstallHelmReleaseResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *UninstallHelmReleaseResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+//*
+// Request to list the helm releases installed on an existing edge cluster
+type ListHelmReleasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique edge cluster identifier
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// Optional, if provided, will be used to filter helm releases installed to the given namespace
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *ListHelmReleasesRequest) Reset() {
+	*x = ListHelmReleasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHelmReleasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHelmReleasesRequest) ProtoMessage() {}
+
+func (x *ListHelmReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHelmReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListHelmReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListHelmReleasesRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *ListHelmReleasesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+//*
+// Response contains the result of listing the helm releases installed on an existing edge cluster
+type ListHelmReleasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The latest revision of the helm releases installed on the edge cluster
+	Releases []*HelmRelease `protobuf:"bytes,3,rep,name=releases,proto3" json:"releases,omitempty"`
+}
+
+func (x *ListHelmReleasesResponse) Reset() {
+	*x = ListHelmReleasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHelmReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHelmReleasesResponse) ProtoMessage() {}
+
+func (x *ListHelmReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHelmReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListHelmReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListHelmReleasesResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *ListHelmReleasesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ListHelmReleasesResponse) GetReleases() []*HelmRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+//*
+// Declares the details of a helm chart repository or an OCI registry the charts are installed from
+type HelmRepository struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name the helm repository is registered with
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL of the chart repository or the oci:// reference of the OCI registry
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The time the index of the chart repository was last downloaded, not set if the index has never been downloaded
+	// or the repository has no index, e.g. an OCI registry
+	LastIndexRefreshTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lastIndexRefreshTime,proto3" json:"lastIndexRefreshTime,omitempty"`
+}
+
+func (x *HelmRepository) Reset() {
+	*x = HelmRepository{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelmRepository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelmRepository) ProtoMessage() {}
+
+func (x *HelmRepository) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelmRepository.ProtoReflect.Descriptor instead.
+func (*HelmRepository) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HelmRepository) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HelmRepository) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HelmRepository) GetLastIndexRefreshTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastIndexRefreshTime
+	}
+	return nil
+}
+
+//*
+// Request to add a helm chart repository or an OCI registry
+type AddHelmRepositoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name to register the helm repository with
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The URL of the chart repository or the oci:// reference of the OCI registry, e.g. oci://registry.example.com/charts
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *AddHelmRepositoryRequest) Reset() {
+	*x = AddHelmRepositoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddHelmRepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddHelmRepositoryRequest) ProtoMessage() {}
+
+func (x *AddHelmRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddHelmRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*AddHelmRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddHelmRepositoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddHelmRepositoryRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+//*
+// Response contains the result of adding a helm chart repository or an OCI registry
+type AddHelmRepositoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The added helm repository
+	Repository *HelmRepository `protobuf:"bytes,3,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *AddHelmRepositoryResponse) Reset() {
+	*x = AddHelmRepositoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddHelmRepositoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddHelmRepositoryResponse) ProtoMessage() {}
+
+func (x *AddHelmRepositoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddHelmRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*AddHelmRepositoryResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AddHelmRepositoryResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *AddHelmRepositoryResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AddHelmRepositoryResponse) GetRepository() *HelmRepository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+//*
+// Request to remove a registered helm chart repository or OCI registry
+type RemoveHelmRepositoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name the helm repository is registered with
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RemoveHelmRepositoryRequest) Reset() {
+	*x = RemoveHelmRepositoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveHelmRepositoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHelmRepositoryRequest) ProtoMessage() {}
+
+func (x *RemoveHelmRepositoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHelmRepositoryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHelmRepositoryRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveHelmRepositoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//*
+// Response contains the result of removing a registered helm chart repository or OCI registry
+type RemoveHelmRepositoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *RemoveHelmRepositoryResponse) Reset() {
+	*x = RemoveHelmRepositoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveHelmRepositoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHelmRepositoryResponse) ProtoMessage() {}
+
+func (x *RemoveHelmRepositoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHelmRepositoryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHelmRepositoryResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveHelmRepositoryResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *RemoveHelmRepositoryResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+//*
+// Request to list the registered helm chart repositories and OCI registries
+type ListHelmRepositoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListHelmRepositoriesRequest) Reset() {
+	*x = ListHelmRepositoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHelmRepositoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHelmRepositoriesRequest) ProtoMessage() {}
+
+func (x *ListHelmRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHelmRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListHelmRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{16}
+}
+
+//*
+// Response contains the result of listing the registered helm chart repositories and OCI registries
+type ListHelmRepositoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The registered helm repositories ordered by their names
+	Repositories []*HelmRepository `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
+}
+
+func (x *ListHelmRepositoriesResponse) Reset() {
+	*x = ListHelmRepositoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHelmRepositoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHelmRepositoriesResponse) ProtoMessage() {}
+
+func (x *ListHelmRepositoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHelmRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListHelmRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListHelmRepositoriesResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *ListHelmRepositoriesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ListHelmRepositoriesResponse) GetRepositories() []*HelmRepository {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+//*
+// Request to download the latest index of the registered helm chart repositories
+type UpdateHelmRepositoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateHelmRepositoriesRequest) Reset() {
+	*x = UpdateHelmRepositoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHelmRepositoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHelmRepositoriesRequest) ProtoMessage() {}
+
+func (x *UpdateHelmRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHelmRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHelmRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{18}
+}
+
+//*
+// Response contains the result of downloading the latest index of the registered helm chart repositories
+type UpdateHelmRepositoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The registered helm repositories ordered by their names
+	Repositories []*HelmRepository `protobuf:"bytes,3,rep,name=repositories,proto3" json:"repositories,omitempty"`
+}
+
+func (x *UpdateHelmRepositoriesResponse) Reset() {
+	*x = UpdateHelmRepositoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_helm_messages_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHelmRepositoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHelmRepositoriesResponse) ProtoMessage() {}
+
+func (x *UpdateHelmRepositoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_helm_messages_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHelmRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHelmRepositoriesResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_helm_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateHelmRepositoriesResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *UpdateHelmRepositoriesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *UpdateHelmRepositoriesResponse) GetRepositories() []*HelmRepository {
+	if x != nil {
+		return x.Repositories
+	}
+	return nil
+}
+
+var File_edge_cluster_helm_messages_proto protoreflect.FileDescriptor
+
+var file_edge_cluster_helm_messages_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x68,
+	0x65, 0x6c, 0x6d, 0x2d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x02, 0x0a,
+	0x0b, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44,
+	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x22, 0xdf, 0x04, 0x0a, 0x19, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x77, 0x61, 0x69, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x1a, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22,
+	0xdf, 0x04, 0x0a, 0x19, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x52,
+	0x4c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x74,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x07, 0x73, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x64, 0x67,
+	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x73, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d,
+	0x69, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x77, 0x61, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xd2, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x48, 0x65, 0x6c,
+	0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65,
+	0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x1a, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64,
+	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a, 0x1b, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67,
+	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xdc, 0x01,
+	0x0a, 0x1b, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x6c, 0x0a, 0x1c,
+	0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5d, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64,
+	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x48,
+	0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x4e, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xa6, 0x01, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x48, 0x65, 0x6c,
+	0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x31,
+	0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x6c, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xad,
+	0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a,
+	0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1f,
+	0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xaf, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x3f, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x42, 0x0d, 0x5a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_edge_cluster_helm_messages_proto_rawDescOnce sync.Once
+	file_edge_cluster_helm_messages_proto_rawDescData = file_edge_cluster_helm_messages_proto_rawDesc
+)
+
+func file_edge_cluster_helm_messages_proto_rawDescGZIP() []byte {
+	file_edge_cluster_helm_messages_proto_rawDescOnce.Do(func() {
+		file_edge_cluster_helm_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_edge_cluster_helm_messages_proto_rawDescData)
+	})
+	return file_edge_cluster_helm_messages_proto_rawDescData
+}
+
+var file_edge_cluster_helm_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_edge_cluster_helm_messages_proto_goTypes = []interface{}{
+	(*HelmRelease)(nil),                    // 0: edgecluster.HelmRelease
+	(*InstallHelmReleaseRequest)(nil),      // 1: edgecluster.InstallHelmReleaseRequest
+	(*InstallHelmReleaseResponse)(nil),     // 2: edgecluster.InstallHelmReleaseResponse
+	(*UpgradeHelmReleaseRequest)(nil),      // 3: edgecluster.UpgradeHelmReleaseRequest
+	(*UpgradeHelmReleaseResponse)(nil),     // 4: edgecluster.UpgradeHelmReleaseResponse
+	(*RollbackHelmReleaseRequest)(nil),     // 5: edgecluster.RollbackHelmReleaseRequest
+	(*RollbackHelmReleaseResponse)(nil),    // 6: edgecluster.RollbackHelmReleaseResponse
+	(*UninstallHelmReleaseRequest)(nil),    // 7: edgecluster.UninstallHelmReleaseRequest
+	(*UninstallHelmReleaseResponse)(nil),   // 8: edgecluster.UninstallHelmReleaseResponse
+	(*ListHelmReleasesRequest)(nil),        // 9: edgecluster.ListHelmReleasesRequest
+	(*ListHelmReleasesResponse)(nil),       // 10: edgecluster.ListHelmReleasesResponse
+	(*HelmRepository)(nil),                 // 11: edgecluster.HelmRepository
+	(*AddHelmRepositoryRequest)(nil),       // 12: edgecluster.AddHelmRepositoryRequest
+	(*AddHelmRepositoryResponse)(nil),      // 13: edgecluster.AddHelmRepositoryResponse
+	(*RemoveHelmRepositoryRequest)(nil),    // 14: edgecluster.RemoveHelmRepositoryRequest
+	(*RemoveHelmRepositoryResponse)(nil),   // 15: edgecluster.RemoveHelmRepositoryResponse
+	(*ListHelmRepositoriesRequest)(nil),    // 16: edgecluster.ListHelmRepositoriesRequest
+	(*ListHelmRepositoriesResponse)(nil),   // 17: edgecluster.ListHelmRepositoriesResponse
+	(*UpdateHelmRepositoriesRequest)(nil),  // 18: edgecluster.UpdateHelmRepositoriesRequest
+	(*UpdateHelmRepositoriesResponse)(nil), // 19: edgecluster.UpdateHelmRepositoriesResponse
+	nil,                                    // 20: edgecluster.InstallHelmReleaseRequest.SetFileEntry
+	nil,                                    // 21: edgecluster.UpgradeHelmReleaseRequest.SetFileEntry
+	(*timestamppb.Timestamp)(nil),          // 22: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 23: google.protobuf.Duration
+	(Error)(0),                             // 24: edgecluster.Error
+}
+var file_edge_cluster_helm_messages_proto_depIdxs = []int32{
+	22, // 0: edgecluster.HelmRelease.firstDeployed:type_name -> google.protobuf.Timestamp
+	22, // 1: edgecluster.HelmRelease.lastDeployed:type_name -> google.protobuf.Timestamp
+	20, // 2: edgecluster.InstallHelmReleaseRequest.setFile:type_name -> edgecluster.InstallHelmReleaseRequest.SetFileEntry
+	23, // 3: edgecluster.InstallHelmReleaseRequest.timeout:type_name -> google.protobuf.Duration
+	24, // 4: edgecluster.InstallHelmReleaseResponse.error:type_name -> edgecluster.Error
+	0,  // 5: edgecluster.InstallHelmReleaseResponse.release:type_name -> edgecluster.HelmRelease
+	0,  // 6: edgecluster.InstallHelmReleaseResponse.history:type_name -> edgecluster.HelmRelease
+	21, // 7: edgecluster.UpgradeHelmReleaseRequest.setFile:type_name -> edgecluster.UpgradeHelmReleaseRequest.SetFileEntry
+	23, // 8: edgecluster.UpgradeHelmReleaseRequest.timeout:type_name -> google.protobuf.Duration
+	24, // 9: edgecluster.UpgradeHelmReleaseResponse.error:type_name -> edgecluster.Error
+	0,  // 10: edgecluster.UpgradeHelmReleaseResponse.release:type_name -> edgecluster.HelmRelease
+	0,  // 11: edgecluster.UpgradeHelmReleaseResponse.history:type_name -> edgecluster.HelmRelease
+	24, // 12: edgecluster.RollbackHelmReleaseResponse.error:type_name -> edgecluster.Error
+	0,  // 13: edgecluster.RollbackHelmReleaseResponse.release:type_name -> edgecluster.HelmRelease
+	0,  // 14: edgecluster.RollbackHelmReleaseResponse.history:type_name -> edgecluster.HelmRelease
+	23, // 15: edgecluster.UninstallHelmReleaseRequest.timeout:type_name -> google.protobuf.Duration
+	24, // 16: edgecluster.UninstallHelmReleaseResponse.error:type_name -> edgecluster.Error
+	24, // 17: edgecluster.ListHelmReleasesResponse.error:type_name -> edgecluster.Error
+	0,  // 18: edgecluster.ListHelmReleasesResponse.releases:type_name -> edgecluster.HelmRelease
+	22, // 19: edgecluster.HelmRepository.lastIndexRefreshTime:type_name -> google.protobuf.Timestamp
+	24, // 20: edgecluster.AddHelmRepositoryResponse.error:type_name -> edgecluster.Error
+	11, // 21: edgecluster.AddHelmRepositoryResponse.repository:type_name -> edgecluster.HelmRepository
+	24, // 22: edgecluster.RemoveHelmRepositoryResponse.error:type_name -> edgecluster.Error
+	24, // 23: edgecluster.ListHelmRepositoriesResponse.error:type_name -> edgecluster.Error
+	11, // 24: edgecluster.ListHelmRepositoriesResponse.repositories:type_name -> edgecluster.HelmRepository
+	24, // 25: edgecluster.UpdateHelmRepositoriesResponse.error:type_name -> edgecluster.Error
+	11, // 26: edgecluster.UpdateHelmRepositoriesResponse.repositories:type_name -> edgecluster.HelmRepository
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_edge_cluster_helm_messages_proto_init() }
+func file_edge_cluster_helm_messages_proto_init() {
+	if File_edge_cluster_helm_messages_proto != nil {
+		return
+	}
+	file_edge_cluster_commons_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_edge_cluster_helm_messages_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelmRelease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallHelmReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallHelmReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeHelmReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeHelmReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackHelmReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackHelmReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallHelmReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallHelmReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHelmReleasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHelmReleasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelmRepository); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddHelmRepositoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddHelmRepositoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveHelmRepositoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveHelmRepositoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHelmRepositoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHelmRepositoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHelmRepositoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_helm_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHelmRepositoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_edge_cluster_helm_messages_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_edge_cluster_helm_messages_proto_goTypes,
+		DependencyIndexes: file_edge_cluster_helm_messages_proto_depIdxs,
+		MessageInfos:      file_edge_cluster_helm_messages_proto_msgTypes,
+	}.Build()
+	File_edge_cluster_helm_messages_proto = out.File
+	file_edge_cluster_helm_messages_proto_rawDesc = nil
+	file_edge_cluster_helm_messages_proto_goTypes = nil
+	file_edge_cluster_helm_messages_proto_depIdxs = nil
+}
@@ -9,6 +9,8 @@ package edgecluster
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -27,15 +29,23 @@ type ClusterType int32
 const (
 	// K3S cluster
 	ClusterType_K3S ClusterType = 0
+	// K0S cluster
+	ClusterType_K0S ClusterType = 1
+	// Virtual cluster running inside the host namespace
+	ClusterType_VCLUSTER ClusterType = 2
 )
 
 // Enum value maps for ClusterType.
 var (
 	ClusterType_name = map[int32]string{
 		0: "K3S",
+		1: "K0S",
+		2: "VCLUSTER",
 	}
 	ClusterType_value = map[string]int32{
-		"K3S": 0,
+		"K3S":      0,
+		"K0S":      1,
+		"VCLUSTER": 2,
 	}
 )
 
@@ -66,6 +76,262 @@ func (ClusterType) EnumDescriptor() ([]byte, []int) {
 	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{0}
 }
 
+//*
+// The different provisioning statuses of an edge cluster
+type ProvisioningStatus int32
+
+const (
+	// The edge cluster is created and waiting for the provisioning to start
+	ProvisioningStatus_PENDING ProvisioningStatus = 0
+	// The edge cluster resources are being provisioned
+	ProvisioningStatus_PROVISIONING ProvisioningStatus = 1
+	// The edge cluster is provisioned and the helm charts are being installed
+	ProvisioningStatus_INSTALLING_CHARTS ProvisioningStatus = 2
+	// The edge cluster is provisioned and ready to be used
+	ProvisioningStatus_READY ProvisioningStatus = 3
+	// The edge cluster provisioning failed
+	ProvisioningStatus_FAILED ProvisioningStatus = 4
+	// The edge cluster provision is being deleted
+	ProvisioningStatus_DELETING ProvisioningStatus = 5
+)
+
+// Enum value maps for ProvisioningStatus.
+var (
+	ProvisioningStatus_name = map[int32]string{
+		0: "PENDING",
+		1: "PROVISIONING",
+		2: "INSTALLING_CHARTS",
+		3: "READY",
+		4: "FAILED",
+		5: "DELETING",
+	}
+	ProvisioningStatus_value = map[string]int32{
+		"PENDING":           0,
+		"PROVISIONING":      1,
+		"INSTALLING_CHARTS": 2,
+		"READY":             3,
+		"FAILED":            4,
+		"DELETING":          5,
+	}
+)
+
+func (x ProvisioningStatus) Enum() *ProvisioningStatus {
+	p := new(ProvisioningStatus)
+	*p = x
+	return p
+}
+
+func (x ProvisioningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProvisioningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_edge_cluster_messages_proto_enumTypes[1].Descriptor()
+}
+
+func (ProvisioningStatus) Type() protoreflect.EnumType {
+	return &file_edge_cluster_messages_proto_enumTypes[1]
+}
+
+func (x ProvisioningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProvisioningStatus.Descriptor instead.
+func (ProvisioningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{1}
+}
+
+//*
+// The different type of events emitted while an edge cluster is provisioned
+type EdgeClusterEventType int32
+
+const (
+	// The provisioning status of the edge cluster changed
+	EdgeClusterEventType_STATUS_CHANGED EdgeClusterEventType = 0
+	// The namespace that hosts the edge cluster is created
+	EdgeClusterEventType_NAMESPACE_CREATED EdgeClusterEventType = 1
+	// The load balancer address of the edge cluster is assigned
+	EdgeClusterEventType_LOAD_BALANCER_ADDRESS_ASSIGNED EdgeClusterEventType = 2
+	// The edge cluster server pod is ready
+	EdgeClusterEventType_SERVER_POD_READY EdgeClusterEventType = 3
+	// The edge cluster kubeconfig is fetched
+	EdgeClusterEventType_KUBECONFIG_FETCHED EdgeClusterEventType = 4
+	// A helm chart is installed on the edge cluster
+	EdgeClusterEventType_HELM_CHART_INSTALLED EdgeClusterEventType = 5
+	// A helm chart is uninstalled from the edge cluster
+	EdgeClusterEventType_HELM_CHART_UNINSTALLED EdgeClusterEventType = 6
+	// A resource of the edge cluster failed to clean up while the edge cluster was deleted
+	EdgeClusterEventType_CLEANUP_FAILED EdgeClusterEventType = 7
+	// A resource of the edge cluster that drifted from its desired state is repaired
+	EdgeClusterEventType_DRIFT_REPAIRED EdgeClusterEventType = 8
+)
+
+// Enum value maps for EdgeClusterEventType.
+var (
+	EdgeClusterEventType_name = map[int32]string{
+		0: "STATUS_CHANGED",
+		1: "NAMESPACE_CREATED",
+		2: "LOAD_BALANCER_ADDRESS_ASSIGNED",
+		3: "SERVER_POD_READY",
+		4: "KUBECONFIG_FETCHED",
+		5: "HELM_CHART_INSTALLED",
+		6: "HELM_CHART_UNINSTALLED",
+		7: "CLEANUP_FAILED",
+		8: "DRIFT_REPAIRED",
+	}
+	EdgeClusterEventType_value = map[string]int32{
+		"STATUS_CHANGED":                 0,
+		"NAMESPACE_CREATED":              1,
+		"LOAD_BALANCER_ADDRESS_ASSIGNED": 2,
+		"SERVER_POD_READY":               3,
+		"KUBECONFIG_FETCHED":             4,
+		"HELM_CHART_INSTALLED":           5,
+		"HELM_CHART_UNINSTALLED":         6,
+		"CLEANUP_FAILED":                 7,
+		"DRIFT_REPAIRED":                 8,
+	}
+)
+
+func (x EdgeClusterEventType) Enum() *EdgeClusterEventType {
+	p := new(EdgeClusterEventType)
+	*p = x
+	return p
+}
+
+func (x EdgeClusterEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EdgeClusterEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_edge_cluster_messages_proto_enumTypes[2].Descriptor()
+}
+
+func (EdgeClusterEventType) Type() protoreflect.EnumType {
+	return &file_edge_cluster_messages_proto_enumTypes[2]
+}
+
+func (x EdgeClusterEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EdgeClusterEventType.Descriptor instead.
+func (EdgeClusterEventType) EnumDescriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{2}
+}
+
+//*
+// The roles the kubeconfigs issued for an edge cluster can grant
+type KubeconfigRole int32
+
+const (
+	// Read only access to the resources, except the secrets
+	KubeconfigRole_VIEW KubeconfigRole = 0
+	// Read and write access to the resources, except the roles and role bindings
+	KubeconfigRole_EDIT KubeconfigRole = 1
+	// Full access to the resources, including the roles and role bindings
+	KubeconfigRole_ADMIN KubeconfigRole = 2
+)
+
+// Enum value maps for KubeconfigRole.
+var (
+	KubeconfigRole_name = map[int32]string{
+		0: "VIEW",
+		1: "EDIT",
+		2: "ADMIN",
+	}
+	KubeconfigRole_value = map[string]int32{
+		"VIEW":  0,
+		"EDIT":  1,
+		"ADMIN": 2,
+	}
+)
+
+func (x KubeconfigRole) Enum() *KubeconfigRole {
+	p := new(KubeconfigRole)
+	*p = x
+	return p
+}
+
+func (x KubeconfigRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KubeconfigRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_edge_cluster_messages_proto_enumTypes[3].Descriptor()
+}
+
+func (KubeconfigRole) Type() protoreflect.EnumType {
+	return &file_edge_cluster_messages_proto_enumTypes[3]
+}
+
+func (x KubeconfigRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KubeconfigRole.Descriptor instead.
+func (KubeconfigRole) EnumDescriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{3}
+}
+
+//*
+// The different ways the API server of an edge cluster can be exposed outside the host cluster
+type ServiceExposureMode int32
+
+const (
+	// A LoadBalancer service, the API server is advertised on the load balancer address
+	ServiceExposureMode_LOAD_BALANCER ServiceExposureMode = 0
+	// A NodePort service, the API server is advertised on the address of a host cluster node
+	ServiceExposureMode_NODE_PORT ServiceExposureMode = 1
+	// A ClusterIP service behind an ingress with TLS passthrough, the API server is advertised on the ingress host
+	ServiceExposureMode_INGRESS ServiceExposureMode = 2
+	// A ClusterIP service reached through an explicit external address
+	ServiceExposureMode_EXTERNAL_ADDRESS ServiceExposureMode = 3
+)
+
+// Enum value maps for ServiceExposureMode.
+var (
+	ServiceExposureMode_name = map[int32]string{
+		0: "LOAD_BALANCER",
+		1: "NODE_PORT",
+		2: "INGRESS",
+		3: "EXTERNAL_ADDRESS",
+	}
+	ServiceExposureMode_value = map[string]int32{
+		"LOAD_BALANCER":    0,
+		"NODE_PORT":        1,
+		"INGRESS":          2,
+		"EXTERNAL_ADDRESS": 3,
+	}
+)
+
+func (x ServiceExposureMode) Enum() *ServiceExposureMode {
+	p := new(ServiceExposureMode)
+	*p = x
+	return p
+}
+
+func (x ServiceExposureMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceExposureMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_edge_cluster_messages_proto_enumTypes[4].Descriptor()
+}
+
+func (ServiceExposureMode) Type() protoreflect.EnumType {
+	return &file_edge_cluster_messages_proto_enumTypes[4]
+}
+
+func (x ServiceExposureMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceExposureMode.Descriptor instead.
+func (ServiceExposureMode) EnumDescriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{4}
+}
+
 //*
 // The edge cluster object
 type EdgeCluster struct {
@@ -81,6 +347,8 @@ type EdgeCluster struct {
 	ClusterSecret string `protobuf:"bytes,3,opt,name=clusterSecret,proto3" json:"clusterSecret,omitempty"`
 	// Cluster type
 	ClusterType ClusterType `protobuf:"varint,4,opt,name=clusterType,proto3,enum=edgecluster.ClusterType" json:"clusterType,omitempty"`
+	// How the API server of the edge cluster is exposed outside the host cluster
+	ServiceExposure *ServiceExposure `protobuf:"bytes,5,opt,name=serviceExposure,proto3" json:"serviceExposure,omitempty"`
 }
 
 func (x *EdgeCluster) Reset() {
@@ -143,6 +411,13 @@ func (x *EdgeCluster) GetClusterType() ClusterType {
 	return ClusterType_K3S
 }
 
+func (x *EdgeCluster) GetServiceExposure() *ServiceExposure {
+	if x != nil {
+		return x.ServiceExposure
+	}
+	return nil
+}
+
 //*
 // The edge cluster provision details contains details such as current status of the edge cluster
 // as well as ingress address of the edge cluster to connect to
@@ -213,6 +488,83 @@ func (x *ProvisionDetail) GetPorts() []int32 {
 	return nil
 }
 
+//*
+// The provisioning state contains the current provisioning status of the edge cluster
+type ProvisioningState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The current provisioning status
+	Status ProvisioningStatus `protobuf:"varint,1,opt,name=status,proto3,enum=edgecluster.ProvisioningStatus" json:"status,omitempty"`
+	// Contains the error message of the last failed provisioning attempt
+	LastErrorMessage string `protobuf:"bytes,2,opt,name=lastErrorMessage,proto3" json:"lastErrorMessage,omitempty"`
+	// The time the edge cluster was created
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// The time the provisioning status was last updated
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (x *ProvisioningState) Reset() {
+	*x = ProvisioningState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvisioningState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisioningState) ProtoMessage() {}
+
+func (x *ProvisioningState) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisioningState.ProtoReflect.Descriptor instead.
+func (*ProvisioningState) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProvisioningState) GetStatus() ProvisioningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProvisioningStatus_PENDING
+}
+
+func (x *ProvisioningState) GetLastErrorMessage() string {
+	if x != nil {
+		return x.LastErrorMessage
+	}
+	return ""
+}
+
+func (x *ProvisioningState) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProvisioningState) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 //*
 // Request to create a new edge cluster
 type CreateEdgeClusterRequest struct {
@@ -227,7 +579,7 @@ type CreateEdgeClusterRequest struct {
 func (x *CreateEdgeClusterRequest) Reset() {
 	*x = CreateEdgeClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[2]
+		mi := &file_edge_cluster_messages_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -240,7 +592,7 @@ func (x *CreateEdgeClusterRequest) String() string {
 func (*CreateEdgeClusterRequest) ProtoMessage() {}
 
 func (x *CreateEdgeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[2]
+	mi := &file_edge_cluster_messages_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +605,7 @@ func (x *CreateEdgeClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEdgeClusterRequest.ProtoReflect.Descriptor instead.
 func (*CreateEdgeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{2}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateEdgeClusterRequest) GetEdgeCluster() *EdgeCluster {
@@ -286,7 +638,7 @@ type CreateEdgeClusterResponse struct {
 func (x *CreateEdgeClusterResponse) Reset() {
 	*x = CreateEdgeClusterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[3]
+		mi := &file_edge_cluster_messages_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -299,7 +651,7 @@ func (x *CreateEdgeClusterResponse) String() string {
 func (*CreateEdgeClusterResponse) ProtoMessage() {}
 
 func (x *CreateEdgeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[3]
+	mi := &file_edge_cluster_messages_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +664,7 @@ func (x *CreateEdgeClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEdgeClusterResponse.ProtoReflect.Descriptor instead.
 func (*CreateEdgeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{3}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateEdgeClusterResponse) GetError() Error {
@@ -363,7 +715,7 @@ type ReadEdgeClusterRequest struct {
 func (x *ReadEdgeClusterRequest) Reset() {
 	*x = ReadEdgeClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[4]
+		mi := &file_edge_cluster_messages_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -376,7 +728,7 @@ func (x *ReadEdgeClusterRequest) String() string {
 func (*ReadEdgeClusterRequest) ProtoMessage() {}
 
 func (x *ReadEdgeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[4]
+	mi := &file_edge_cluster_messages_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +741,7 @@ func (x *ReadEdgeClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadEdgeClusterRequest.ProtoReflect.Descriptor instead.
 func (*ReadEdgeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{4}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReadEdgeClusterRequest) GetEdgeClusterID() string {
@@ -414,12 +766,14 @@ type ReadEdgeClusterResponse struct {
 	EdgeCluster *EdgeCluster `protobuf:"bytes,3,opt,name=edgeCluster,proto3" json:"edgeCluster,omitempty"`
 	// The edge cluster provision details
 	ProvisionDetail *ProvisionDetail `protobuf:"bytes,6,opt,name=provisionDetail,proto3" json:"provisionDetail,omitempty"`
+	// The edge cluster provisioning state
+	ProvisioningState *ProvisioningState `protobuf:"bytes,7,opt,name=provisioningState,proto3" json:"provisioningState,omitempty"`
 }
 
 func (x *ReadEdgeClusterResponse) Reset() {
 	*x = ReadEdgeClusterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[5]
+		mi := &file_edge_cluster_messages_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -432,7 +786,7 @@ func (x *ReadEdgeClusterResponse) String() string {
 func (*ReadEdgeClusterResponse) ProtoMessage() {}
 
 func (x *ReadEdgeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[5]
+	mi := &file_edge_cluster_messages_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +799,7 @@ func (x *ReadEdgeClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadEdgeClusterResponse.ProtoReflect.Descriptor instead.
 func (*ReadEdgeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{5}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReadEdgeClusterResponse) GetError() Error {
@@ -476,6 +830,13 @@ func (x *ReadEdgeClusterResponse) GetProvisionDetail() *ProvisionDetail {
 	return nil
 }
 
+func (x *ReadEdgeClusterResponse) GetProvisioningState() *ProvisioningState {
+	if x != nil {
+		return x.ProvisioningState
+	}
+	return nil
+}
+
 //*
 // Request to update an existing edge cluster
 type UpdateEdgeClusterRequest struct {
@@ -492,7 +853,7 @@ type UpdateEdgeClusterRequest struct {
 func (x *UpdateEdgeClusterRequest) Reset() {
 	*x = UpdateEdgeClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[6]
+		mi := &file_edge_cluster_messages_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -505,7 +866,7 @@ func (x *UpdateEdgeClusterRequest) String() string {
 func (*UpdateEdgeClusterRequest) ProtoMessage() {}
 
 func (x *UpdateEdgeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[6]
+	mi := &file_edge_cluster_messages_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +879,7 @@ func (x *UpdateEdgeClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEdgeClusterRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEdgeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{6}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateEdgeClusterRequest) GetEdgeClusterID() string {
@@ -556,7 +917,7 @@ type UpdateEdgeClusterResponse struct {
 func (x *UpdateEdgeClusterResponse) Reset() {
 	*x = UpdateEdgeClusterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[7]
+		mi := &file_edge_cluster_messages_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +930,7 @@ func (x *UpdateEdgeClusterResponse) String() string {
 func (*UpdateEdgeClusterResponse) ProtoMessage() {}
 
 func (x *UpdateEdgeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[7]
+	mi := &file_edge_cluster_messages_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +943,7 @@ func (x *UpdateEdgeClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEdgeClusterResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEdgeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{7}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateEdgeClusterResponse) GetError() Error {
@@ -627,7 +988,7 @@ type DeleteEdgeClusterRequest struct {
 func (x *DeleteEdgeClusterRequest) Reset() {
 	*x = DeleteEdgeClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[8]
+		mi := &file_edge_cluster_messages_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -640,7 +1001,7 @@ func (x *DeleteEdgeClusterRequest) String() string {
 func (*DeleteEdgeClusterRequest) ProtoMessage() {}
 
 func (x *DeleteEdgeClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[8]
+	mi := &file_edge_cluster_messages_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +1014,7 @@ func (x *DeleteEdgeClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEdgeClusterRequest.ProtoReflect.Descriptor instead.
 func (*DeleteEdgeClusterRequest) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{8}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteEdgeClusterRequest) GetEdgeClusterID() string {
@@ -679,7 +1040,7 @@ type DeleteEdgeClusterResponse struct {
 func (x *DeleteEdgeClusterResponse) Reset() {
 	*x = DeleteEdgeClusterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[9]
+		mi := &file_edge_cluster_messages_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -692,7 +1053,7 @@ func (x *DeleteEdgeClusterResponse) String() string {
 func (*DeleteEdgeClusterResponse) ProtoMessage() {}
 
 func (x *DeleteEdgeClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[9]
+	mi := &file_edge_cluster_messages_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,7 +1066,7 @@ func (x *DeleteEdgeClusterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteEdgeClusterResponse.ProtoReflect.Descriptor instead.
 func (*DeleteEdgeClusterResponse) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{9}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteEdgeClusterResponse) GetError() Error {
@@ -742,7 +1103,7 @@ type ListEdgeClustersRequest struct {
 func (x *ListEdgeClustersRequest) Reset() {
 	*x = ListEdgeClustersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[10]
+		mi := &file_edge_cluster_messages_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -755,7 +1116,7 @@ func (x *ListEdgeClustersRequest) String() string {
 func (*ListEdgeClustersRequest) ProtoMessage() {}
 
 func (x *ListEdgeClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[10]
+	mi := &file_edge_cluster_messages_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +1129,7 @@ func (x *ListEdgeClustersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEdgeClustersRequest.ProtoReflect.Descriptor instead.
 func (*ListEdgeClustersRequest) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{10}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListEdgeClustersRequest) GetPagination() *Pagination {
@@ -816,12 +1177,14 @@ type EdgeClusterWithCursor struct {
 	EdgeCluster *EdgeCluster `protobuf:"bytes,3,opt,name=edgeCluster,proto3" json:"edgeCluster,omitempty"`
 	// The edge cluster provision details
 	ProvisionDetail *ProvisionDetail `protobuf:"bytes,4,opt,name=provisionDetail,proto3" json:"provisionDetail,omitempty"`
+	// The edge cluster provisioning state
+	ProvisioningState *ProvisioningState `protobuf:"bytes,5,opt,name=provisioningState,proto3" json:"provisioningState,omitempty"`
 }
 
 func (x *EdgeClusterWithCursor) Reset() {
 	*x = EdgeClusterWithCursor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[11]
+		mi := &file_edge_cluster_messages_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -834,7 +1197,7 @@ func (x *EdgeClusterWithCursor) String() string {
 func (*EdgeClusterWithCursor) ProtoMessage() {}
 
 func (x *EdgeClusterWithCursor) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[11]
+	mi := &file_edge_cluster_messages_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1210,7 @@ func (x *EdgeClusterWithCursor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EdgeClusterWithCursor.ProtoReflect.Descriptor instead.
 func (*EdgeClusterWithCursor) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{11}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EdgeClusterWithCursor) GetEdgeClusterID() string {
@@ -878,6 +1241,13 @@ func (x *EdgeClusterWithCursor) GetProvisionDetail() *ProvisionDetail {
 	return nil
 }
 
+func (x *EdgeClusterWithCursor) GetProvisioningState() *ProvisioningState {
+	if x != nil {
+		return x.ProvisioningState
+	}
+	return nil
+}
+
 //*
 // Response contains the result of searching for edge clusters
 type ListEdgeClustersResponse struct {
@@ -902,7 +1272,7 @@ type ListEdgeClustersResponse struct {
 func (x *ListEdgeClustersResponse) Reset() {
 	*x = ListEdgeClustersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_edge_cluster_messages_proto_msgTypes[12]
+		mi := &file_edge_cluster_messages_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -915,7 +1285,7 @@ func (x *ListEdgeClustersResponse) String() string {
 func (*ListEdgeClustersResponse) ProtoMessage() {}
 
 func (x *ListEdgeClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_edge_cluster_messages_proto_msgTypes[12]
+	mi := &file_edge_cluster_messages_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1298,7 @@ func (x *ListEdgeClustersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEdgeClustersResponse.ProtoReflect.Descriptor instead.
 func (*ListEdgeClustersResponse) Descriptor() ([]byte, []int) {
-	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{12}
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListEdgeClustersResponse) GetError() Error {
@@ -973,152 +1343,1816 @@ func (x *ListEdgeClustersResponse) GetEdgeClusters() []*EdgeClusterWithCursor {
 	return nil
 }
 
-var File_edge_cluster_messages_proto protoreflect.FileDescriptor
+//*
+// The event emitted while an edge cluster is provisioned
+type EdgeClusterEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_edge_cluster_messages_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x65,
-	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x1a, 0x65, 0x64, 0x67, 0x65,
-	0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x01, 0x0a, 0x0b, 0x45, 0x64, 0x67, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3a,
-	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x43,
-	0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22,
-	0xe3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65,
-	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65,
-	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0xeb, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64,
-	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b,
-	0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0f, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x22, 0x7c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67,
-	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x22, 0xbd, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
-	0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64,
-	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x22, 0x40, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe2,
-	0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x64,
-	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e,
-	0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0e, 0x73, 0x6f, 0x72,
-	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65,
-	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x49, 0x44, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x15, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x24, 0x0a,
-	0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x65,
-	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45,
+	// The event type
+	Type EdgeClusterEventType `protobuf:"varint,1,opt,name=type,proto3,enum=edgecluster.EdgeClusterEventType" json:"type,omitempty"`
+	// The provisioning status of the edge cluster at the time the event is emitted
+	Status ProvisioningStatus `protobuf:"varint,2,opt,name=status,proto3,enum=edgecluster.ProvisioningStatus" json:"status,omitempty"`
+	// Contains the details of the event, e.g. the assigned address or the installed helm chart name
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The time the event is emitted
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *EdgeClusterEvent) Reset() {
+	*x = EdgeClusterEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeClusterEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeClusterEvent) ProtoMessage() {}
+
+func (x *EdgeClusterEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeClusterEvent.ProtoReflect.Descriptor instead.
+func (*EdgeClusterEvent) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EdgeClusterEvent) GetType() EdgeClusterEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EdgeClusterEventType_STATUS_CHANGED
+}
+
+func (x *EdgeClusterEvent) GetStatus() ProvisioningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProvisioningStatus_PENDING
+}
+
+func (x *EdgeClusterEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EdgeClusterEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+//*
+// Request to watch the provisioning events of an existing edge cluster
+type WatchEdgeClusterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique edge cluster identifier
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+}
+
+func (x *WatchEdgeClusterRequest) Reset() {
+	*x = WatchEdgeClusterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEdgeClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEdgeClusterRequest) ProtoMessage() {}
+
+func (x *WatchEdgeClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEdgeClusterRequest.ProtoReflect.Descriptor instead.
+func (*WatchEdgeClusterRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchEdgeClusterRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+//*
+// Response contains a provisioning event of an existing edge cluster
+type WatchEdgeClusterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The provisioning event
+	Event *EdgeClusterEvent `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *WatchEdgeClusterResponse) Reset() {
+	*x = WatchEdgeClusterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEdgeClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEdgeClusterResponse) ProtoMessage() {}
+
+func (x *WatchEdgeClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEdgeClusterResponse.ProtoReflect.Descriptor instead.
+func (*WatchEdgeClusterResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchEdgeClusterResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *WatchEdgeClusterResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *WatchEdgeClusterResponse) GetEvent() *EdgeClusterEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+//*
+// Describes an edge cluster type supported by the service
+type ClusterTypeDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The edge cluster type
+	ClusterType ClusterType `protobuf:"varint,1,opt,name=clusterType,proto3,enum=edgecluster.ClusterType" json:"clusterType,omitempty"`
+	// The edge cluster type name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The features supported by the edge cluster type
+	SupportedFeatures []string `protobuf:"bytes,3,rep,name=supportedFeatures,proto3" json:"supportedFeatures,omitempty"`
+	// The helm charts installed on the edge cluster by default
+	DefaultCharts []string `protobuf:"bytes,4,rep,name=defaultCharts,proto3" json:"defaultCharts,omitempty"`
+}
+
+func (x *ClusterTypeDescriptor) Reset() {
+	*x = ClusterTypeDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterTypeDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterTypeDescriptor) ProtoMessage() {}
+
+func (x *ClusterTypeDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterTypeDescriptor.ProtoReflect.Descriptor instead.
+func (*ClusterTypeDescriptor) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ClusterTypeDescriptor) GetClusterType() ClusterType {
+	if x != nil {
+		return x.ClusterType
+	}
+	return ClusterType_K3S
+}
+
+func (x *ClusterTypeDescriptor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClusterTypeDescriptor) GetSupportedFeatures() []string {
+	if x != nil {
+		return x.SupportedFeatures
+	}
+	return nil
+}
+
+func (x *ClusterTypeDescriptor) GetDefaultCharts() []string {
+	if x != nil {
+		return x.DefaultCharts
+	}
+	return nil
+}
+
+//*
+// Request to list the edge cluster types supported by the service
+type ListSupportedClusterTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSupportedClusterTypesRequest) Reset() {
+	*x = ListSupportedClusterTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSupportedClusterTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedClusterTypesRequest) ProtoMessage() {}
+
+func (x *ListSupportedClusterTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedClusterTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListSupportedClusterTypesRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{18}
+}
+
+//*
+// Response contains the edge cluster types supported by the service
+type ListSupportedClusterTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The supported edge cluster types
+	ClusterTypes []*ClusterTypeDescriptor `protobuf:"bytes,3,rep,name=clusterTypes,proto3" json:"clusterTypes,omitempty"`
+}
+
+func (x *ListSupportedClusterTypesResponse) Reset() {
+	*x = ListSupportedClusterTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSupportedClusterTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportedClusterTypesResponse) ProtoMessage() {}
+
+func (x *ListSupportedClusterTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportedClusterTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListSupportedClusterTypesResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListSupportedClusterTypesResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *ListSupportedClusterTypesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ListSupportedClusterTypesResponse) GetClusterTypes() []*ClusterTypeDescriptor {
+	if x != nil {
+		return x.ClusterTypes
+	}
+	return nil
+}
+
+//*
+// Declares the details of a namespace that hosts the resources of an edge cluster that no longer exists
+type OrphanedNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the namespace
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The time the namespace was created
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// Indicates whether the namespace records the edge cluster it was provisioned for, so it can be adopted back
+	Adoptable bool `protobuf:"varint,3,opt,name=adoptable,proto3" json:"adoptable,omitempty"`
+	// The unique identifier of the edge cluster the namespace was provisioned for
+	EdgeClusterID string `protobuf:"bytes,4,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The edge cluster object the namespace was provisioned for, without its cluster secret
+	EdgeCluster *EdgeCluster `protobuf:"bytes,5,opt,name=edgeCluster,proto3" json:"edgeCluster,omitempty"`
+	// The email of the user that owned the edge cluster
+	UserEmail string `protobuf:"bytes,6,opt,name=userEmail,proto3" json:"userEmail,omitempty"`
+	// The version of the service that provisioned the namespace
+	ServiceVersion string `protobuf:"bytes,7,opt,name=serviceVersion,proto3" json:"serviceVersion,omitempty"`
+}
+
+func (x *OrphanedNamespace) Reset() {
+	*x = OrphanedNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrphanedNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanedNamespace) ProtoMessage() {}
+
+func (x *OrphanedNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanedNamespace.ProtoReflect.Descriptor instead.
+func (*OrphanedNamespace) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrphanedNamespace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrphanedNamespace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrphanedNamespace) GetAdoptable() bool {
+	if x != nil {
+		return x.Adoptable
+	}
+	return false
+}
+
+func (x *OrphanedNamespace) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *OrphanedNamespace) GetEdgeCluster() *EdgeCluster {
+	if x != nil {
+		return x.EdgeCluster
+	}
+	return nil
+}
+
+func (x *OrphanedNamespace) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *OrphanedNamespace) GetServiceVersion() string {
+	if x != nil {
+		return x.ServiceVersion
+	}
+	return ""
+}
+
+//*
+// Request to list the namespaces that host the resources of the edge clusters that no longer exist
+type ListOrphanedNamespacesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOrphanedNamespacesRequest) Reset() {
+	*x = ListOrphanedNamespacesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrphanedNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrphanedNamespacesRequest) ProtoMessage() {}
+
+func (x *ListOrphanedNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrphanedNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListOrphanedNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{21}
+}
+
+//*
+// Response contains the namespaces that host the resources of the edge clusters that no longer exist
+type ListOrphanedNamespacesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The orphaned namespaces ordered by their names
+	Namespaces []*OrphanedNamespace `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+}
+
+func (x *ListOrphanedNamespacesResponse) Reset() {
+	*x = ListOrphanedNamespacesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrphanedNamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrphanedNamespacesResponse) ProtoMessage() {}
+
+func (x *ListOrphanedNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrphanedNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListOrphanedNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListOrphanedNamespacesResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *ListOrphanedNamespacesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ListOrphanedNamespacesResponse) GetNamespaces() []*OrphanedNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+//*
+// Request to delete an orphaned namespace together with the resources it hosts
+type DeleteOrphanedNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the orphaned namespace
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteOrphanedNamespaceRequest) Reset() {
+	*x = DeleteOrphanedNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrphanedNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrphanedNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteOrphanedNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrphanedNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrphanedNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteOrphanedNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//*
+// Response contains the result of deleting an orphaned namespace
+type DeleteOrphanedNamespaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *DeleteOrphanedNamespaceResponse) Reset() {
+	*x = DeleteOrphanedNamespaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteOrphanedNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrphanedNamespaceResponse) ProtoMessage() {}
+
+func (x *DeleteOrphanedNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrphanedNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrphanedNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteOrphanedNamespaceResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *DeleteOrphanedNamespaceResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+//*
+// Request to adopt an orphaned namespace back into the repository as the edge cluster it was provisioned for
+type AdoptOrphanedNamespaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the orphaned namespace
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AdoptOrphanedNamespaceRequest) Reset() {
+	*x = AdoptOrphanedNamespaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptOrphanedNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptOrphanedNamespaceRequest) ProtoMessage() {}
+
+func (x *AdoptOrphanedNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptOrphanedNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*AdoptOrphanedNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AdoptOrphanedNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+//*
+// Response contains the result of adopting an orphaned namespace
+type AdoptOrphanedNamespaceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The unique identifier of the adopted edge cluster
+	EdgeClusterID string `protobuf:"bytes,3,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The adopted edge cluster object
+	EdgeCluster *EdgeCluster `protobuf:"bytes,4,opt,name=edgeCluster,proto3" json:"edgeCluster,omitempty"`
+}
+
+func (x *AdoptOrphanedNamespaceResponse) Reset() {
+	*x = AdoptOrphanedNamespaceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdoptOrphanedNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdoptOrphanedNamespaceResponse) ProtoMessage() {}
+
+func (x *AdoptOrphanedNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdoptOrphanedNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*AdoptOrphanedNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AdoptOrphanedNamespaceResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *AdoptOrphanedNamespaceResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *AdoptOrphanedNamespaceResponse) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *AdoptOrphanedNamespaceResponse) GetEdgeCluster() *EdgeCluster {
+	if x != nil {
+		return x.EdgeCluster
+	}
+	return nil
+}
+
+//*
+// Declares the details of a kubeconfig issued for an edge cluster
+type KubeconfigIssuance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the issuance
+	IssuanceID string `protobuf:"bytes,1,opt,name=issuanceID,proto3" json:"issuanceID,omitempty"`
+	// The unique identifier of the edge cluster the kubeconfig is issued for
+	EdgeClusterID string `protobuf:"bytes,2,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The role the kubeconfig grants
+	Role KubeconfigRole `protobuf:"varint,3,opt,name=role,proto3,enum=edgecluster.KubeconfigRole" json:"role,omitempty"`
+	// The namespace the role is granted in, the role is granted in all namespaces if empty
+	Namespace string `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The time the kubeconfig was issued
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	// The time the kubeconfig expires
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// Indicates whether the kubeconfig is revoked
+	Revoked bool `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// The time the kubeconfig was revoked, only set if the kubeconfig is revoked
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
+}
+
+func (x *KubeconfigIssuance) Reset() {
+	*x = KubeconfigIssuance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubeconfigIssuance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubeconfigIssuance) ProtoMessage() {}
+
+func (x *KubeconfigIssuance) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubeconfigIssuance.ProtoReflect.Descriptor instead.
+func (*KubeconfigIssuance) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *KubeconfigIssuance) GetIssuanceID() string {
+	if x != nil {
+		return x.IssuanceID
+	}
+	return ""
+}
+
+func (x *KubeconfigIssuance) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *KubeconfigIssuance) GetRole() KubeconfigRole {
+	if x != nil {
+		return x.Role
+	}
+	return KubeconfigRole_VIEW
+}
+
+func (x *KubeconfigIssuance) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *KubeconfigIssuance) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *KubeconfigIssuance) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *KubeconfigIssuance) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *KubeconfigIssuance) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+//*
+// Request to issue a kubeconfig granting a role on an existing edge cluster
+type IssueKubeconfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the edge cluster
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The role the kubeconfig grants
+	Role KubeconfigRole `protobuf:"varint,2,opt,name=role,proto3,enum=edgecluster.KubeconfigRole" json:"role,omitempty"`
+	// The namespace the role is granted in, the role is granted in all namespaces if empty
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// How long the kubeconfig is valid for
+	Ttl *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *IssueKubeconfigRequest) Reset() {
+	*x = IssueKubeconfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueKubeconfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueKubeconfigRequest) ProtoMessage() {}
+
+func (x *IssueKubeconfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueKubeconfigRequest.ProtoReflect.Descriptor instead.
+func (*IssueKubeconfigRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *IssueKubeconfigRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *IssueKubeconfigRequest) GetRole() KubeconfigRole {
+	if x != nil {
+		return x.Role
+	}
+	return KubeconfigRole_VIEW
+}
+
+func (x *IssueKubeconfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *IssueKubeconfigRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+//*
+// Response contains the result of issuing a kubeconfig
+type IssueKubeconfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The details of the issued kubeconfig
+	Issuance *KubeconfigIssuance `protobuf:"bytes,3,opt,name=issuance,proto3" json:"issuance,omitempty"`
+	// The content of the issued kubeconfig
+	KubeconfigContent string `protobuf:"bytes,4,opt,name=kubeconfigContent,proto3" json:"kubeconfigContent,omitempty"`
+}
+
+func (x *IssueKubeconfigResponse) Reset() {
+	*x = IssueKubeconfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueKubeconfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueKubeconfigResponse) ProtoMessage() {}
+
+func (x *IssueKubeconfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueKubeconfigResponse.ProtoReflect.Descriptor instead.
+func (*IssueKubeconfigResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IssueKubeconfigResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *IssueKubeconfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *IssueKubeconfigResponse) GetIssuance() *KubeconfigIssuance {
+	if x != nil {
+		return x.Issuance
+	}
+	return nil
+}
+
+func (x *IssueKubeconfigResponse) GetKubeconfigContent() string {
+	if x != nil {
+		return x.KubeconfigContent
+	}
+	return ""
+}
+
+//*
+// Request to revoke a kubeconfig issued for an existing edge cluster
+type RevokeKubeconfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the edge cluster
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+	// The unique identifier of the issuance
+	IssuanceID string `protobuf:"bytes,2,opt,name=issuanceID,proto3" json:"issuanceID,omitempty"`
+}
+
+func (x *RevokeKubeconfigRequest) Reset() {
+	*x = RevokeKubeconfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeKubeconfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKubeconfigRequest) ProtoMessage() {}
+
+func (x *RevokeKubeconfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKubeconfigRequest.ProtoReflect.Descriptor instead.
+func (*RevokeKubeconfigRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeKubeconfigRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+func (x *RevokeKubeconfigRequest) GetIssuanceID() string {
+	if x != nil {
+		return x.IssuanceID
+	}
+	return ""
+}
+
+//*
+// Response contains the result of revoking an issued kubeconfig
+type RevokeKubeconfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The details of the revoked kubeconfig
+	Issuance *KubeconfigIssuance `protobuf:"bytes,3,opt,name=issuance,proto3" json:"issuance,omitempty"`
+}
+
+func (x *RevokeKubeconfigResponse) Reset() {
+	*x = RevokeKubeconfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeKubeconfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeKubeconfigResponse) ProtoMessage() {}
+
+func (x *RevokeKubeconfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeKubeconfigResponse.ProtoReflect.Descriptor instead.
+func (*RevokeKubeconfigResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokeKubeconfigResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *RevokeKubeconfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RevokeKubeconfigResponse) GetIssuance() *KubeconfigIssuance {
+	if x != nil {
+		return x.Issuance
+	}
+	return nil
+}
+
+//*
+// Request to list the kubeconfigs issued for an existing edge cluster
+type ListKubeconfigIssuancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier of the edge cluster
+	EdgeClusterID string `protobuf:"bytes,1,opt,name=edgeClusterID,proto3" json:"edgeClusterID,omitempty"`
+}
+
+func (x *ListKubeconfigIssuancesRequest) Reset() {
+	*x = ListKubeconfigIssuancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKubeconfigIssuancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKubeconfigIssuancesRequest) ProtoMessage() {}
+
+func (x *ListKubeconfigIssuancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKubeconfigIssuancesRequest.ProtoReflect.Descriptor instead.
+func (*ListKubeconfigIssuancesRequest) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListKubeconfigIssuancesRequest) GetEdgeClusterID() string {
+	if x != nil {
+		return x.EdgeClusterID
+	}
+	return ""
+}
+
+//*
+// Response contains the kubeconfigs issued for an existing edge cluster
+type ListKubeconfigIssuancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicate whether the operation has any error
+	Error Error `protobuf:"varint,1,opt,name=error,proto3,enum=edgecluster.Error" json:"error,omitempty"`
+	// Contains error message if the operation was unsuccessful
+	ErrorMessage string `protobuf:"bytes,2,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+	// The issued kubeconfigs ordered by their issue time
+	Issuances []*KubeconfigIssuance `protobuf:"bytes,3,rep,name=issuances,proto3" json:"issuances,omitempty"`
+}
+
+func (x *ListKubeconfigIssuancesResponse) Reset() {
+	*x = ListKubeconfigIssuancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKubeconfigIssuancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKubeconfigIssuancesResponse) ProtoMessage() {}
+
+func (x *ListKubeconfigIssuancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKubeconfigIssuancesResponse.ProtoReflect.Descriptor instead.
+func (*ListKubeconfigIssuancesResponse) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListKubeconfigIssuancesResponse) GetError() Error {
+	if x != nil {
+		return x.Error
+	}
+	return Error_NO_ERROR
+}
+
+func (x *ListKubeconfigIssuancesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ListKubeconfigIssuancesResponse) GetIssuances() []*KubeconfigIssuance {
+	if x != nil {
+		return x.Issuances
+	}
+	return nil
+}
+
+//*
+// Declares how the API server of an edge cluster is exposed outside the host cluster
+type ServiceExposure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The exposure mode
+	Mode ServiceExposureMode `protobuf:"varint,1,opt,name=mode,proto3,enum=edgecluster.ServiceExposureMode" json:"mode,omitempty"`
+	// The ingress host for the INGRESS mode, or the external IP address or host name for the EXTERNAL_ADDRESS mode
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ServiceExposure) Reset() {
+	*x = ServiceExposure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_edge_cluster_messages_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceExposure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceExposure) ProtoMessage() {}
+
+func (x *ServiceExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_edge_cluster_messages_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceExposure.ProtoReflect.Descriptor instead.
+func (*ServiceExposure) Descriptor() ([]byte, []int) {
+	return file_edge_cluster_messages_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ServiceExposure) GetMode() ServiceExposureMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ServiceExposureMode_LOAD_BALANCER
+}
+
+func (x *ServiceExposure) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+var File_edge_cluster_messages_proto protoreflect.FileDescriptor
+
+var file_edge_cluster_messages_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x65, 0x64, 0x67,
+	0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x0b, 0x45, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x3a, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75,
+	0x72, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x43, 0x0a, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x61, 0x64,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11,
+	0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x22, 0xec, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x56, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x65,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45,
 	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0f,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22,
-	0x9c, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64,
-	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x61,
-	0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4e, 0x65,
-	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x0c, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0xe3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3e, 0x0a,
+	0x16, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0xb9, 0x02,
+	0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65,
 	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x52, 0x0c, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2a, 0x16,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x4c, 0x0a, 0x11, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7c, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64,
+	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x65,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x69, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x15, 0x45, 0x64,
+	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x46, 0x0a,
+	0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x4c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x9c, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28,
+	0x0a, 0x0f, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x4e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
+	0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x0c, 0x65, 0x64,
+	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x0c, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3f, 0x0a, 0x17, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0x9d, 0x01, 0x0a,
+	0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a,
+	0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x68, 0x61, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb9,
+	0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x11, 0x4f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x64, 0x6f, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x64, 0x67,
+	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x1f,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a,
+	0x1d, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x1e, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x4f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0xef, 0x02, 0x0a, 0x12, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4b,
+	0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x16, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x03, 0x74, 0x74, 0x6c, 0x22, 0xd2, 0x01, 0x0a, 0x17, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4b, 0x75,
+	0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b,
+	0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4b,
+	0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x6b,
+	0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x17, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73,
+	0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x22, 0xa5, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0x46, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x64, 0x67,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x22, 0xae, 0x01, 0x0a, 0x1f, 0x4c,
+	0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73,
+	0x75, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x09,
+	0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4b, 0x75,
+	0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x61, 0x0a, 0x0f, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x34,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2a, 0x2d,
 	0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a,
-	0x03, 0x4b, 0x33, 0x53, 0x10, 0x00, 0x42, 0x0d, 0x5a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x4b, 0x33, 0x53, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4b, 0x30, 0x53, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x56, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x6f, 0x0a,
+	0x12, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x47,
+	0x5f, 0x43, 0x48, 0x41, 0x52, 0x54, 0x53, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x2a, 0xf1,
+	0x01, 0x0a, 0x14, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4e,
+	0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e,
+	0x43, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52,
+	0x5f, 0x50, 0x4f, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12,
+	0x4b, 0x55, 0x42, 0x45, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x48, 0x41,
+	0x52, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1a,
+	0x0a, 0x16, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x49,
+	0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c,
+	0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x12,
+	0x0a, 0x0e, 0x44, 0x52, 0x49, 0x46, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x08, 0x2a, 0x2f, 0x0a, 0x0e, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x49, 0x45, 0x57, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x45, 0x44, 0x49, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49,
+	0x4e, 0x10, 0x02, 0x2a, 0x5a, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f,
+	0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x49, 0x4e, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x42,
+	0x0d, 0x5a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1133,52 +3167,114 @@ func file_edge_cluster_messages_proto_rawDescGZIP() []byte {
 	return file_edge_cluster_messages_proto_rawDescData
 }
 
-var file_edge_cluster_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_edge_cluster_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_edge_cluster_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_edge_cluster_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_edge_cluster_messages_proto_goTypes = []interface{}{
-	(ClusterType)(0),                  // 0: edgecluster.ClusterType
-	(*EdgeCluster)(nil),               // 1: edgecluster.EdgeCluster
-	(*ProvisionDetail)(nil),           // 2: edgecluster.ProvisionDetail
-	(*CreateEdgeClusterRequest)(nil),  // 3: edgecluster.CreateEdgeClusterRequest
-	(*CreateEdgeClusterResponse)(nil), // 4: edgecluster.CreateEdgeClusterResponse
-	(*ReadEdgeClusterRequest)(nil),    // 5: edgecluster.ReadEdgeClusterRequest
-	(*ReadEdgeClusterResponse)(nil),   // 6: edgecluster.ReadEdgeClusterResponse
-	(*UpdateEdgeClusterRequest)(nil),  // 7: edgecluster.UpdateEdgeClusterRequest
-	(*UpdateEdgeClusterResponse)(nil), // 8: edgecluster.UpdateEdgeClusterResponse
-	(*DeleteEdgeClusterRequest)(nil),  // 9: edgecluster.DeleteEdgeClusterRequest
-	(*DeleteEdgeClusterResponse)(nil), // 10: edgecluster.DeleteEdgeClusterResponse
-	(*ListEdgeClustersRequest)(nil),   // 11: edgecluster.ListEdgeClustersRequest
-	(*EdgeClusterWithCursor)(nil),     // 12: edgecluster.EdgeClusterWithCursor
-	(*ListEdgeClustersResponse)(nil),  // 13: edgecluster.ListEdgeClustersResponse
-	(*LoadBalancerStatus)(nil),        // 14: edgecluster.LoadBalancerStatus
-	(Error)(0),                        // 15: edgecluster.Error
-	(*Pagination)(nil),                // 16: edgecluster.Pagination
-	(*SortingOptionPair)(nil),         // 17: edgecluster.SortingOptionPair
+	(ClusterType)(0),                          // 0: edgecluster.ClusterType
+	(ProvisioningStatus)(0),                   // 1: edgecluster.ProvisioningStatus
+	(EdgeClusterEventType)(0),                 // 2: edgecluster.EdgeClusterEventType
+	(KubeconfigRole)(0),                       // 3: edgecluster.KubeconfigRole
+	(ServiceExposureMode)(0),                  // 4: edgecluster.ServiceExposureMode
+	(*EdgeCluster)(nil),                       // 5: edgecluster.EdgeCluster
+	(*ProvisionDetail)(nil),                   // 6: edgecluster.ProvisionDetail
+	(*ProvisioningState)(nil),                 // 7: edgecluster.ProvisioningState
+	(*CreateEdgeClusterRequest)(nil),          // 8: edgecluster.CreateEdgeClusterRequest
+	(*CreateEdgeClusterResponse)(nil),         // 9: edgecluster.CreateEdgeClusterResponse
+	(*ReadEdgeClusterRequest)(nil),            // 10: edgecluster.ReadEdgeClusterRequest
+	(*ReadEdgeClusterResponse)(nil),           // 11: edgecluster.ReadEdgeClusterResponse
+	(*UpdateEdgeClusterRequest)(nil),          // 12: edgecluster.UpdateEdgeClusterRequest
+	(*UpdateEdgeClusterResponse)(nil),         // 13: edgecluster.UpdateEdgeClusterResponse
+	(*DeleteEdgeClusterRequest)(nil),          // 14: edgecluster.DeleteEdgeClusterRequest
+	(*DeleteEdgeClusterResponse)(nil),         // 15: edgecluster.DeleteEdgeClusterResponse
+	(*ListEdgeClustersRequest)(nil),           // 16: edgecluster.ListEdgeClustersRequest
+	(*EdgeClusterWithCursor)(nil),             // 17: edgecluster.EdgeClusterWithCursor
+	(*ListEdgeClustersResponse)(nil),          // 18: edgecluster.ListEdgeClustersResponse
+	(*EdgeClusterEvent)(nil),                  // 19: edgecluster.EdgeClusterEvent
+	(*WatchEdgeClusterRequest)(nil),           // 20: edgecluster.WatchEdgeClusterRequest
+	(*WatchEdgeClusterResponse)(nil),          // 21: edgecluster.WatchEdgeClusterResponse
+	(*ClusterTypeDescriptor)(nil),             // 22: edgecluster.ClusterTypeDescriptor
+	(*ListSupportedClusterTypesRequest)(nil),  // 23: edgecluster.ListSupportedClusterTypesRequest
+	(*ListSupportedClusterTypesResponse)(nil), // 24: edgecluster.ListSupportedClusterTypesResponse
+	(*OrphanedNamespace)(nil),                 // 25: edgecluster.OrphanedNamespace
+	(*ListOrphanedNamespacesRequest)(nil),     // 26: edgecluster.ListOrphanedNamespacesRequest
+	(*ListOrphanedNamespacesResponse)(nil),    // 27: edgecluster.ListOrphanedNamespacesResponse
+	(*DeleteOrphanedNamespaceRequest)(nil),    // 28: edgecluster.DeleteOrphanedNamespaceRequest
+	(*DeleteOrphanedNamespaceResponse)(nil),   // 29: edgecluster.DeleteOrphanedNamespaceResponse
+	(*AdoptOrphanedNamespaceRequest)(nil),     // 30: edgecluster.AdoptOrphanedNamespaceRequest
+	(*AdoptOrphanedNamespaceResponse)(nil),    // 31: edgecluster.AdoptOrphanedNamespaceResponse
+	(*KubeconfigIssuance)(nil),                // 32: edgecluster.KubeconfigIssuance
+	(*IssueKubeconfigRequest)(nil),            // 33: edgecluster.IssueKubeconfigRequest
+	(*IssueKubeconfigResponse)(nil),           // 34: edgecluster.IssueKubeconfigResponse
+	(*RevokeKubeconfigRequest)(nil),           // 35: edgecluster.RevokeKubeconfigRequest
+	(*RevokeKubeconfigResponse)(nil),          // 36: edgecluster.RevokeKubeconfigResponse
+	(*ListKubeconfigIssuancesRequest)(nil),    // 37: edgecluster.ListKubeconfigIssuancesRequest
+	(*ListKubeconfigIssuancesResponse)(nil),   // 38: edgecluster.ListKubeconfigIssuancesResponse
+	(*ServiceExposure)(nil),                   // 39: edgecluster.ServiceExposure
+	(*LoadBalancerStatus)(nil),                // 40: edgecluster.LoadBalancerStatus
+	(*timestamppb.Timestamp)(nil),             // 41: google.protobuf.Timestamp
+	(Error)(0),                                // 42: edgecluster.Error
+	(*Pagination)(nil),                        // 43: edgecluster.Pagination
+	(*SortingOptionPair)(nil),                 // 44: edgecluster.SortingOptionPair
+	(*durationpb.Duration)(nil),               // 45: google.protobuf.Duration
 }
 var file_edge_cluster_messages_proto_depIdxs = []int32{
 	0,  // 0: edgecluster.EdgeCluster.clusterType:type_name -> edgecluster.ClusterType
-	14, // 1: edgecluster.ProvisionDetail.loadBalancer:type_name -> edgecluster.LoadBalancerStatus
-	1,  // 2: edgecluster.CreateEdgeClusterRequest.edgeCluster:type_name -> edgecluster.EdgeCluster
-	15, // 3: edgecluster.CreateEdgeClusterResponse.error:type_name -> edgecluster.Error
-	1,  // 4: edgecluster.CreateEdgeClusterResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
-	15, // 5: edgecluster.ReadEdgeClusterResponse.error:type_name -> edgecluster.Error
-	1,  // 6: edgecluster.ReadEdgeClusterResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
-	2,  // 7: edgecluster.ReadEdgeClusterResponse.provisionDetail:type_name -> edgecluster.ProvisionDetail
-	1,  // 8: edgecluster.UpdateEdgeClusterRequest.edgeCluster:type_name -> edgecluster.EdgeCluster
-	15, // 9: edgecluster.UpdateEdgeClusterResponse.error:type_name -> edgecluster.Error
-	1,  // 10: edgecluster.UpdateEdgeClusterResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
-	15, // 11: edgecluster.DeleteEdgeClusterResponse.error:type_name -> edgecluster.Error
-	16, // 12: edgecluster.ListEdgeClustersRequest.pagination:type_name -> edgecluster.Pagination
-	17, // 13: edgecluster.ListEdgeClustersRequest.sortingOptions:type_name -> edgecluster.SortingOptionPair
-	1,  // 14: edgecluster.EdgeClusterWithCursor.edgeCluster:type_name -> edgecluster.EdgeCluster
-	2,  // 15: edgecluster.EdgeClusterWithCursor.provisionDetail:type_name -> edgecluster.ProvisionDetail
-	15, // 16: edgecluster.ListEdgeClustersResponse.error:type_name -> edgecluster.Error
-	12, // 17: edgecluster.ListEdgeClustersResponse.edgeClusters:type_name -> edgecluster.EdgeClusterWithCursor
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	39, // 1: edgecluster.EdgeCluster.serviceExposure:type_name -> edgecluster.ServiceExposure
+	40, // 2: edgecluster.ProvisionDetail.loadBalancer:type_name -> edgecluster.LoadBalancerStatus
+	1,  // 3: edgecluster.ProvisioningState.status:type_name -> edgecluster.ProvisioningStatus
+	41, // 4: edgecluster.ProvisioningState.createdAt:type_name -> google.protobuf.Timestamp
+	41, // 5: edgecluster.ProvisioningState.updatedAt:type_name -> google.protobuf.Timestamp
+	5,  // 6: edgecluster.CreateEdgeClusterRequest.edgeCluster:type_name -> edgecluster.EdgeCluster
+	42, // 7: edgecluster.CreateEdgeClusterResponse.error:type_name -> edgecluster.Error
+	5,  // 8: edgecluster.CreateEdgeClusterResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
+	42, // 9: edgecluster.ReadEdgeClusterResponse.error:type_name -> edgecluster.Error
+	5,  // 10: edgecluster.ReadEdgeClusterResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
+	6,  // 11: edgecluster.ReadEdgeClusterResponse.provisionDetail:type_name -> edgecluster.ProvisionDetail
+	7,  // 12: edgecluster.ReadEdgeClusterResponse.provisioningState:type_name -> edgecluster.ProvisioningState
+	5,  // 13: edgecluster.UpdateEdgeClusterRequest.edgeCluster:type_name -> edgecluster.EdgeCluster
+	42, // 14: edgecluster.UpdateEdgeClusterResponse.error:type_name -> edgecluster.Error
+	5,  // 15: edgecluster.UpdateEdgeClusterResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
+	42, // 16: edgecluster.DeleteEdgeClusterResponse.error:type_name -> edgecluster.Error
+	43, // 17: edgecluster.ListEdgeClustersRequest.pagination:type_name -> edgecluster.Pagination
+	44, // 18: edgecluster.ListEdgeClustersRequest.sortingOptions:type_name -> edgecluster.SortingOptionPair
+	5,  // 19: edgecluster.EdgeClusterWithCursor.edgeCluster:type_name -> edgecluster.EdgeCluster
+	6,  // 20: edgecluster.EdgeClusterWithCursor.provisionDetail:type_name -> edgecluster.ProvisionDetail
+	7,  // 21: edgecluster.EdgeClusterWithCursor.provisioningState:type_name -> edgecluster.ProvisioningState
+	42, // 22: edgecluster.ListEdgeClustersResponse.error:type_name -> edgecluster.Error
+	17, // 23: edgecluster.ListEdgeClustersResponse.edgeClusters:type_name -> edgecluster.EdgeClusterWithCursor
+	2,  // 24: edgecluster.EdgeClusterEvent.type:type_name -> edgecluster.EdgeClusterEventType
+	1,  // 25: edgecluster.EdgeClusterEvent.status:type_name -> edgecluster.ProvisioningStatus
+	41, // 26: edgecluster.EdgeClusterEvent.timestamp:type_name -> google.protobuf.Timestamp
+	42, // 27: edgecluster.WatchEdgeClusterResponse.error:type_name -> edgecluster.Error
+	19, // 28: edgecluster.WatchEdgeClusterResponse.event:type_name -> edgecluster.EdgeClusterEvent
+	0,  // 29: edgecluster.ClusterTypeDescriptor.clusterType:type_name -> edgecluster.ClusterType
+	42, // 30: edgecluster.ListSupportedClusterTypesResponse.error:type_name -> edgecluster.Error
+	22, // 31: edgecluster.ListSupportedClusterTypesResponse.clusterTypes:type_name -> edgecluster.ClusterTypeDescriptor
+	41, // 32: edgecluster.OrphanedNamespace.createdAt:type_name -> google.protobuf.Timestamp
+	5,  // 33: edgecluster.OrphanedNamespace.edgeCluster:type_name -> edgecluster.EdgeCluster
+	42, // 34: edgecluster.ListOrphanedNamespacesResponse.error:type_name -> edgecluster.Error
+	25, // 35: edgecluster.ListOrphanedNamespacesResponse.namespaces:type_name -> edgecluster.OrphanedNamespace
+	42, // 36: edgecluster.DeleteOrphanedNamespaceResponse.error:type_name -> edgecluster.Error
+	42, // 37: edgecluster.AdoptOrphanedNamespaceResponse.error:type_name -> edgecluster.Error
+	5,  // 38: edgecluster.AdoptOrphanedNamespaceResponse.edgeCluster:type_name -> edgecluster.EdgeCluster
+	3,  // 39: edgecluster.KubeconfigIssuance.role:type_name -> edgecluster.KubeconfigRole
+	41, // 40: edgecluster.KubeconfigIssuance.issuedAt:type_name -> google.protobuf.Timestamp
+	41, // 41: edgecluster.KubeconfigIssuance.expiresAt:type_name -> google.protobuf.Timestamp
+	41, // 42: edgecluster.KubeconfigIssuance.revokedAt:type_name -> google.protobuf.Timestamp
+	3,  // 43: edgecluster.IssueKubeconfigRequest.role:type_name -> edgecluster.KubeconfigRole
+	45, // 44: edgecluster.IssueKubeconfigRequest.ttl:type_name -> google.protobuf.Duration
+	42, // 45: edgecluster.IssueKubeconfigResponse.error:type_name -> edgecluster.Error
+	32, // 46: edgecluster.IssueKubeconfigResponse.issuance:type_name -> edgecluster.KubeconfigIssuance
+	42, // 47: edgecluster.RevokeKubeconfigResponse.error:type_name -> edgecluster.Error
+	32, // 48: edgecluster.RevokeKubeconfigResponse.issuance:type_name -> edgecluster.KubeconfigIssuance
+	42, // 49: edgecluster.ListKubeconfigIssuancesResponse.error:type_name -> edgecluster.Error
+	32, // 50: edgecluster.ListKubeconfigIssuancesResponse.issuances:type_name -> edgecluster.KubeconfigIssuance
+	4,  // 51: edgecluster.ServiceExposure.mode:type_name -> edgecluster.ServiceExposureMode
+	52, // [52:52] is the sub-list for method output_type
+	52, // [52:52] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_edge_cluster_messages_proto_init() }
@@ -1213,7 +3309,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEdgeClusterRequest); i {
+			switch v := v.(*ProvisioningState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1225,7 +3321,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEdgeClusterResponse); i {
+			switch v := v.(*CreateEdgeClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1237,7 +3333,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadEdgeClusterRequest); i {
+			switch v := v.(*CreateEdgeClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1249,7 +3345,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadEdgeClusterResponse); i {
+			switch v := v.(*ReadEdgeClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1261,7 +3357,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEdgeClusterRequest); i {
+			switch v := v.(*ReadEdgeClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1273,7 +3369,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEdgeClusterResponse); i {
+			switch v := v.(*UpdateEdgeClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1285,7 +3381,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEdgeClusterRequest); i {
+			switch v := v.(*UpdateEdgeClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1297,7 +3393,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEdgeClusterResponse); i {
+			switch v := v.(*DeleteEdgeClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1309,7 +3405,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEdgeClustersRequest); i {
+			switch v := v.(*DeleteEdgeClusterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1321,7 +3417,7 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EdgeClusterWithCursor); i {
+			switch v := v.(*ListEdgeClustersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1333,6 +3429,18 @@ func file_edge_cluster_messages_proto_init() {
 			}
 		}
 		file_edge_cluster_messages_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgeClusterWithCursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEdgeClustersResponse); i {
 			case 0:
 				return &v.state
@@ -1344,14 +3452,266 @@ func file_edge_cluster_messages_proto_init() {
 				return nil
 			}
 		}
+		file_edge_cluster_messages_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgeClusterEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEdgeClusterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEdgeClusterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterTypeDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSupportedClusterTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSupportedClusterTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrphanedNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrphanedNamespacesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrphanedNamespacesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOrphanedNamespaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteOrphanedNamespaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptOrphanedNamespaceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdoptOrphanedNamespaceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubeconfigIssuance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueKubeconfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueKubeconfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeKubeconfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeKubeconfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKubeconfigIssuancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKubeconfigIssuancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_edge_cluster_messages_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceExposure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_edge_cluster_messages_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
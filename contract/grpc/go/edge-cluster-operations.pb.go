@@ -37,79 +37,230 @@ var file_edge_cluster_operations_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x65, 0x64,
 	0x67, 0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x2d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x32, 0xc1, 0x06, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x12, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x64, 0x67, 0x65,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64,
-	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x64, 0x67, 0x65,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x62, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x6f, 0x1a, 0x20, 0x65, 0x64, 0x67, 0x65, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2d,
+	0x68, 0x65, 0x6c, 0x6d, 0x2d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x32, 0xe8, 0x14, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x62, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x64,
-	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x65, 0x64,
-	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64,
-	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
-	0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x64, 0x67,
-	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x27, 0x2e, 0x65,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x45, 0x64,
+	0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x62, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x65, 0x64, 0x67,
+	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e,
+	0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65,
 	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x74, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x65, 0x64, 0x67,
-	0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x73, 0x12, 0x27,
+	0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x74, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x64, 0x67, 0x65,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x64, 0x67, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x7a, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a,
+	0x12, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x27, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x65, 0x6c, 0x6d, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b,
+	0x0a, 0x14, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65,
+	0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55,
+	0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x24, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x41, 0x64, 0x64, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6b, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c,
+	0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x16, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x65, 0x6c, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x74, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x70,
+	0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x16, 0x41, 0x64, 0x6f, 0x70, 0x74, 0x4f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x2a, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41,
+	0x64, 0x6f, 0x70, 0x74, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x6f, 0x70, 0x74,
+	0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x2e, 0x65,
+	0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x65, 0x64,
+	0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x73, 0x73,
+	0x75, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0d,
+	0x5a, 0x0b, 0x65, 0x64, 0x67, 0x65, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_edge_cluster_operations_proto_goTypes = []interface{}{
-	(*CreateEdgeClusterRequest)(nil),        // 0: edgecluster.CreateEdgeClusterRequest
-	(*ReadEdgeClusterRequest)(nil),          // 1: edgecluster.ReadEdgeClusterRequest
-	(*UpdateEdgeClusterRequest)(nil),        // 2: edgecluster.UpdateEdgeClusterRequest
-	(*DeleteEdgeClusterRequest)(nil),        // 3: edgecluster.DeleteEdgeClusterRequest
-	(*ListEdgeClustersRequest)(nil),         // 4: edgecluster.ListEdgeClustersRequest
-	(*ListEdgeClusterNodesRequest)(nil),     // 5: edgecluster.ListEdgeClusterNodesRequest
-	(*ListEdgeClusterPodsRequest)(nil),      // 6: edgecluster.ListEdgeClusterPodsRequest
-	(*ListEdgeClusterServicesRequest)(nil),  // 7: edgecluster.ListEdgeClusterServicesRequest
-	(*CreateEdgeClusterResponse)(nil),       // 8: edgecluster.CreateEdgeClusterResponse
-	(*ReadEdgeClusterResponse)(nil),         // 9: edgecluster.ReadEdgeClusterResponse
-	(*UpdateEdgeClusterResponse)(nil),       // 10: edgecluster.UpdateEdgeClusterResponse
-	(*DeleteEdgeClusterResponse)(nil),       // 11: edgecluster.DeleteEdgeClusterResponse
-	(*ListEdgeClustersResponse)(nil),        // 12: edgecluster.ListEdgeClustersResponse
-	(*ListEdgeClusterNodesResponse)(nil),    // 13: edgecluster.ListEdgeClusterNodesResponse
-	(*ListEdgeClusterPodsResponse)(nil),     // 14: edgecluster.ListEdgeClusterPodsResponse
-	(*ListEdgeClusterServicesResponse)(nil), // 15: edgecluster.ListEdgeClusterServicesResponse
+	(*CreateEdgeClusterRequest)(nil),          // 0: edgecluster.CreateEdgeClusterRequest
+	(*ReadEdgeClusterRequest)(nil),            // 1: edgecluster.ReadEdgeClusterRequest
+	(*UpdateEdgeClusterRequest)(nil),          // 2: edgecluster.UpdateEdgeClusterRequest
+	(*DeleteEdgeClusterRequest)(nil),          // 3: edgecluster.DeleteEdgeClusterRequest
+	(*ListEdgeClustersRequest)(nil),           // 4: edgecluster.ListEdgeClustersRequest
+	(*ListEdgeClusterNodesRequest)(nil),       // 5: edgecluster.ListEdgeClusterNodesRequest
+	(*ListEdgeClusterPodsRequest)(nil),        // 6: edgecluster.ListEdgeClusterPodsRequest
+	(*ListEdgeClusterServicesRequest)(nil),    // 7: edgecluster.ListEdgeClusterServicesRequest
+	(*WatchEdgeClusterRequest)(nil),           // 8: edgecluster.WatchEdgeClusterRequest
+	(*ListSupportedClusterTypesRequest)(nil),  // 9: edgecluster.ListSupportedClusterTypesRequest
+	(*InstallHelmReleaseRequest)(nil),         // 10: edgecluster.InstallHelmReleaseRequest
+	(*UpgradeHelmReleaseRequest)(nil),         // 11: edgecluster.UpgradeHelmReleaseRequest
+	(*RollbackHelmReleaseRequest)(nil),        // 12: edgecluster.RollbackHelmReleaseRequest
+	(*UninstallHelmReleaseRequest)(nil),       // 13: edgecluster.UninstallHelmReleaseRequest
+	(*ListHelmReleasesRequest)(nil),           // 14: edgecluster.ListHelmReleasesRequest
+	(*AddHelmRepositoryRequest)(nil),          // 15: edgecluster.AddHelmRepositoryRequest
+	(*RemoveHelmRepositoryRequest)(nil),       // 16: edgecluster.RemoveHelmRepositoryRequest
+	(*ListHelmRepositoriesRequest)(nil),       // 17: edgecluster.ListHelmRepositoriesRequest
+	(*UpdateHelmRepositoriesRequest)(nil),     // 18: edgecluster.UpdateHelmRepositoriesRequest
+	(*ListOrphanedNamespacesRequest)(nil),     // 19: edgecluster.ListOrphanedNamespacesRequest
+	(*DeleteOrphanedNamespaceRequest)(nil),    // 20: edgecluster.DeleteOrphanedNamespaceRequest
+	(*AdoptOrphanedNamespaceRequest)(nil),     // 21: edgecluster.AdoptOrphanedNamespaceRequest
+	(*IssueKubeconfigRequest)(nil),            // 22: edgecluster.IssueKubeconfigRequest
+	(*RevokeKubeconfigRequest)(nil),           // 23: edgecluster.RevokeKubeconfigRequest
+	(*ListKubeconfigIssuancesRequest)(nil),    // 24: edgecluster.ListKubeconfigIssuancesRequest
+	(*CreateEdgeClusterResponse)(nil),         // 25: edgecluster.CreateEdgeClusterResponse
+	(*ReadEdgeClusterResponse)(nil),           // 26: edgecluster.ReadEdgeClusterResponse
+	(*UpdateEdgeClusterResponse)(nil),         // 27: edgecluster.UpdateEdgeClusterResponse
+	(*DeleteEdgeClusterResponse)(nil),         // 28: edgecluster.DeleteEdgeClusterResponse
+	(*ListEdgeClustersResponse)(nil),          // 29: edgecluster.ListEdgeClustersResponse
+	(*ListEdgeClusterNodesResponse)(nil),      // 30: edgecluster.ListEdgeClusterNodesResponse
+	(*ListEdgeClusterPodsResponse)(nil),       // 31: edgecluster.ListEdgeClusterPodsResponse
+	(*ListEdgeClusterServicesResponse)(nil),   // 32: edgecluster.ListEdgeClusterServicesResponse
+	(*WatchEdgeClusterResponse)(nil),          // 33: edgecluster.WatchEdgeClusterResponse
+	(*ListSupportedClusterTypesResponse)(nil), // 34: edgecluster.ListSupportedClusterTypesResponse
+	(*InstallHelmReleaseResponse)(nil),        // 35: edgecluster.InstallHelmReleaseResponse
+	(*UpgradeHelmReleaseResponse)(nil),        // 36: edgecluster.UpgradeHelmReleaseResponse
+	(*RollbackHelmReleaseResponse)(nil),       // 37: edgecluster.RollbackHelmReleaseResponse
+	(*UninstallHelmReleaseResponse)(nil),      // 38: edgecluster.UninstallHelmReleaseResponse
+	(*ListHelmReleasesResponse)(nil),          // 39: edgecluster.ListHelmReleasesResponse
+	(*AddHelmRepositoryResponse)(nil),         // 40: edgecluster.AddHelmRepositoryResponse
+	(*RemoveHelmRepositoryResponse)(nil),      // 41: edgecluster.RemoveHelmRepositoryResponse
+	(*ListHelmRepositoriesResponse)(nil),      // 42: edgecluster.ListHelmRepositoriesResponse
+	(*UpdateHelmRepositoriesResponse)(nil),    // 43: edgecluster.UpdateHelmRepositoriesResponse
+	(*ListOrphanedNamespacesResponse)(nil),    // 44: edgecluster.ListOrphanedNamespacesResponse
+	(*DeleteOrphanedNamespaceResponse)(nil),   // 45: edgecluster.DeleteOrphanedNamespaceResponse
+	(*AdoptOrphanedNamespaceResponse)(nil),    // 46: edgecluster.AdoptOrphanedNamespaceResponse
+	(*IssueKubeconfigResponse)(nil),           // 47: edgecluster.IssueKubeconfigResponse
+	(*RevokeKubeconfigResponse)(nil),          // 48: edgecluster.RevokeKubeconfigResponse
+	(*ListKubeconfigIssuancesResponse)(nil),   // 49: edgecluster.ListKubeconfigIssuancesResponse
 }
 var file_edge_cluster_operations_proto_depIdxs = []int32{
 	0,  // 0: edgecluster.Service.CreateEdgeCluster:input_type -> edgecluster.CreateEdgeClusterRequest
@@ -120,16 +271,50 @@ var file_edge_cluster_operations_proto_depIdxs = []int32{
 	5,  // 5: edgecluster.Service.ListEdgeClusterNodes:input_type -> edgecluster.ListEdgeClusterNodesRequest
 	6,  // 6: edgecluster.Service.ListEdgeClusterPods:input_type -> edgecluster.ListEdgeClusterPodsRequest
 	7,  // 7: edgecluster.Service.ListEdgeClusterServices:input_type -> edgecluster.ListEdgeClusterServicesRequest
-	8,  // 8: edgecluster.Service.CreateEdgeCluster:output_type -> edgecluster.CreateEdgeClusterResponse
-	9,  // 9: edgecluster.Service.ReadEdgeCluster:output_type -> edgecluster.ReadEdgeClusterResponse
-	10, // 10: edgecluster.Service.UpdateEdgeCluster:output_type -> edgecluster.UpdateEdgeClusterResponse
-	11, // 11: edgecluster.Service.DeleteEdgeCluster:output_type -> edgecluster.DeleteEdgeClusterResponse
-	12, // 12: edgecluster.Service.ListEdgeClusters:output_type -> edgecluster.ListEdgeClustersResponse
-	13, // 13: edgecluster.Service.ListEdgeClusterNodes:output_type -> edgecluster.ListEdgeClusterNodesResponse
-	14, // 14: edgecluster.Service.ListEdgeClusterPods:output_type -> edgecluster.ListEdgeClusterPodsResponse
-	15, // 15: edgecluster.Service.ListEdgeClusterServices:output_type -> edgecluster.ListEdgeClusterServicesResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
+	8,  // 8: edgecluster.Service.WatchEdgeCluster:input_type -> edgecluster.WatchEdgeClusterRequest
+	9,  // 9: edgecluster.Service.ListSupportedClusterTypes:input_type -> edgecluster.ListSupportedClusterTypesRequest
+	10, // 10: edgecluster.Service.InstallHelmRelease:input_type -> edgecluster.InstallHelmReleaseRequest
+	11, // 11: edgecluster.Service.UpgradeHelmRelease:input_type -> edgecluster.UpgradeHelmReleaseRequest
+	12, // 12: edgecluster.Service.RollbackHelmRelease:input_type -> edgecluster.RollbackHelmReleaseRequest
+	13, // 13: edgecluster.Service.UninstallHelmRelease:input_type -> edgecluster.UninstallHelmReleaseRequest
+	14, // 14: edgecluster.Service.ListHelmReleases:input_type -> edgecluster.ListHelmReleasesRequest
+	15, // 15: edgecluster.Service.AddHelmRepository:input_type -> edgecluster.AddHelmRepositoryRequest
+	16, // 16: edgecluster.Service.RemoveHelmRepository:input_type -> edgecluster.RemoveHelmRepositoryRequest
+	17, // 17: edgecluster.Service.ListHelmRepositories:input_type -> edgecluster.ListHelmRepositoriesRequest
+	18, // 18: edgecluster.Service.UpdateHelmRepositories:input_type -> edgecluster.UpdateHelmRepositoriesRequest
+	19, // 19: edgecluster.Service.ListOrphanedNamespaces:input_type -> edgecluster.ListOrphanedNamespacesRequest
+	20, // 20: edgecluster.Service.DeleteOrphanedNamespace:input_type -> edgecluster.DeleteOrphanedNamespaceRequest
+	21, // 21: edgecluster.Service.AdoptOrphanedNamespace:input_type -> edgecluster.AdoptOrphanedNamespaceRequest
+	22, // 22: edgecluster.Service.IssueKubeconfig:input_type -> edgecluster.IssueKubeconfigRequest
+	23, // 23: edgecluster.Service.RevokeKubeconfig:input_type -> edgecluster.RevokeKubeconfigRequest
+	24, // 24: edgecluster.Service.ListKubeconfigIssuances:input_type -> edgecluster.ListKubeconfigIssuancesRequest
+	25, // 25: edgecluster.Service.CreateEdgeCluster:output_type -> edgecluster.CreateEdgeClusterResponse
+	26, // 26: edgecluster.Service.ReadEdgeCluster:output_type -> edgecluster.ReadEdgeClusterResponse
+	27, // 27: edgecluster.Service.UpdateEdgeCluster:output_type -> edgecluster.UpdateEdgeClusterResponse
+	28, // 28: edgecluster.Service.DeleteEdgeCluster:output_type -> edgecluster.DeleteEdgeClusterResponse
+	29, // 29: edgecluster.Service.ListEdgeClusters:output_type -> edgecluster.ListEdgeClustersResponse
+	30, // 30: edgecluster.Service.ListEdgeClusterNodes:output_type -> edgecluster.ListEdgeClusterNodesResponse
+	31, // 31: edgecluster.Service.ListEdgeClusterPods:output_type -> edgecluster.ListEdgeClusterPodsResponse
+	32, // 32: edgecluster.Service.ListEdgeClusterServices:output_type -> edgecluster.ListEdgeClusterServicesResponse
+	33, // 33: edgecluster.Service.WatchEdgeCluster:output_type -> edgecluster.WatchEdgeClusterResponse
+	34, // 34: edgecluster.Service.ListSupportedClusterTypes:output_type -> edgecluster.ListSupportedClusterTypesResponse
+	35, // 35: edgecluster.Service.InstallHelmRelease:output_type -> edgecluster.InstallHelmReleaseResponse
+	36, // 36: edgecluster.Service.UpgradeHelmRelease:output_type -> edgecluster.UpgradeHelmReleaseResponse
+	37, // 37: edgecluster.Service.RollbackHelmRelease:output_type -> edgecluster.RollbackHelmReleaseResponse
+	38, // 38: edgecluster.Service.UninstallHelmRelease:output_type -> edgecluster.UninstallHelmReleaseResponse
+	39, // 39: edgecluster.Service.ListHelmReleases:output_type -> edgecluster.ListHelmReleasesResponse
+	40, // 40: edgecluster.Service.AddHelmRepository:output_type -> edgecluster.AddHelmRepositoryResponse
+	41, // 41: edgecluster.Service.RemoveHelmRepository:output_type -> edgecluster.RemoveHelmRepositoryResponse
+	42, // 42: edgecluster.Service.ListHelmRepositories:output_type -> edgecluster.ListHelmRepositoriesResponse
+	43, // 43: edgecluster.Service.UpdateHelmRepositories:output_type -> edgecluster.UpdateHelmRepositoriesResponse
+	44, // 44: edgecluster.Service.ListOrphanedNamespaces:output_type -> edgecluster.ListOrphanedNamespacesResponse
+	45, // 45: edgecluster.Service.DeleteOrphanedNamespace:output_type -> edgecluster.DeleteOrphanedNamespaceResponse
+	46, // 46: edgecluster.Service.AdoptOrphanedNamespace:output_type -> edgecluster.AdoptOrphanedNamespaceResponse
+	47, // 47: edgecluster.Service.IssueKubeconfig:output_type -> edgecluster.IssueKubeconfigResponse
+	48, // 48: edgecluster.Service.RevokeKubeconfig:output_type -> edgecluster.RevokeKubeconfigResponse
+	49, // 49: edgecluster.Service.ListKubeconfigIssuances:output_type -> edgecluster.ListKubeconfigIssuancesResponse
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -144,6 +329,7 @@ func file_edge_cluster_operations_proto_init() {
 	file_edge_cluster_node_messages_proto_init()
 	file_edge_cluster_pod_messages_proto_init()
 	file_edge_cluster_service_messages_proto_init()
+	file_edge_cluster_helm_messages_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -207,6 +393,78 @@ type ServiceClient interface {
 	// request: The request to list an existing edge cluster services details
 	// Returns an existing edge cluster services details
 	ListEdgeClusterServices(ctx context.Context, in *ListEdgeClusterServicesRequest, opts ...grpc.CallOption) (*ListEdgeClusterServicesResponse, error)
+	// WatchEdgeCluster streams the provisioning status transitions and step events of an existing edge cluster.
+	// The first message contains the current provisioning status of the edge cluster.
+	// request: The request to watch an existing edge cluster
+	// Returns the stream of the edge cluster provisioning events
+	WatchEdgeCluster(ctx context.Context, in *WatchEdgeClusterRequest, opts ...grpc.CallOption) (Service_WatchEdgeClusterClient, error)
+	// ListSupportedClusterTypes returns the edge cluster types supported by this deployment
+	// request: The request to list the supported edge cluster types
+	// Returns the descriptors of the supported edge cluster types
+	ListSupportedClusterTypes(ctx context.Context, in *ListSupportedClusterTypesRequest, opts ...grpc.CallOption) (*ListSupportedClusterTypesResponse, error)
+	// InstallHelmRelease installs a new helm release on an existing edge cluster
+	// request: The request to install a new helm release
+	// Returns the result of installing the helm release including its revision history
+	InstallHelmRelease(ctx context.Context, in *InstallHelmReleaseRequest, opts ...grpc.CallOption) (*InstallHelmReleaseResponse, error)
+	// UpgradeHelmRelease upgrades an existing helm release on an existing edge cluster
+	// request: The request to upgrade an existing helm release
+	// Returns the result of upgrading the helm release including its revision history
+	UpgradeHelmRelease(ctx context.Context, in *UpgradeHelmReleaseRequest, opts ...grpc.CallOption) (*UpgradeHelmReleaseResponse, error)
+	// RollbackHelmRelease rolls an existing helm release on an existing edge cluster back to one of its previous revisions
+	// request: The request to roll back an existing helm release
+	// Returns the result of rolling back the helm release including its revision history
+	RollbackHelmRelease(ctx context.Context, in *RollbackHelmReleaseRequest, opts ...grpc.CallOption) (*RollbackHelmReleaseResponse, error)
+	// UninstallHelmRelease uninstalls an existing helm release from an existing edge cluster
+	// request: The request to uninstall an existing helm release
+	// Returns the result of uninstalling the helm release
+	UninstallHelmRelease(ctx context.Context, in *UninstallHelmReleaseRequest, opts ...grpc.CallOption) (*UninstallHelmReleaseResponse, error)
+	// ListHelmReleases lists the helm releases installed on an existing edge cluster
+	// request: The request to list the helm releases
+	// Returns the latest revision of the helm releases installed on the edge cluster
+	ListHelmReleases(ctx context.Context, in *ListHelmReleasesRequest, opts ...grpc.CallOption) (*ListHelmReleasesResponse, error)
+	// AddHelmRepository registers a helm chart repository or an OCI registry the charts can be installed from. Admin only.
+	// request: The request to add a helm repository
+	// Returns the result of adding the helm repository
+	AddHelmRepository(ctx context.Context, in *AddHelmRepositoryRequest, opts ...grpc.CallOption) (*AddHelmRepositoryResponse, error)
+	// RemoveHelmRepository removes a registered helm chart repository or OCI registry. Admin only.
+	// request: The request to remove a helm repository
+	// Returns the result of removing the helm repository
+	RemoveHelmRepository(ctx context.Context, in *RemoveHelmRepositoryRequest, opts ...grpc.CallOption) (*RemoveHelmRepositoryResponse, error)
+	// ListHelmRepositories lists the registered helm chart repositories and OCI registries. Admin only.
+	// request: The request to list the helm repositories
+	// Returns the helm repositories with their last index refresh time
+	ListHelmRepositories(ctx context.Context, in *ListHelmRepositoriesRequest, opts ...grpc.CallOption) (*ListHelmRepositoriesResponse, error)
+	// UpdateHelmRepositories downloads the latest index of the registered helm chart repositories. Admin only.
+	// request: The request to update the helm repositories
+	// Returns the updated helm repositories
+	UpdateHelmRepositories(ctx context.Context, in *UpdateHelmRepositoriesRequest, opts ...grpc.CallOption) (*UpdateHelmRepositoriesResponse, error)
+	// ListOrphanedNamespaces lists the namespaces that host the resources of the edge clusters that no longer exist.
+	// Admin only.
+	// request: The request to list the orphaned namespaces
+	// Returns the orphaned namespaces
+	ListOrphanedNamespaces(ctx context.Context, in *ListOrphanedNamespacesRequest, opts ...grpc.CallOption) (*ListOrphanedNamespacesResponse, error)
+	// DeleteOrphanedNamespace deletes an orphaned namespace together with the resources it hosts. Admin only.
+	// request: The request to delete an orphaned namespace
+	// Returns the result of deleting the orphaned namespace
+	DeleteOrphanedNamespace(ctx context.Context, in *DeleteOrphanedNamespaceRequest, opts ...grpc.CallOption) (*DeleteOrphanedNamespaceResponse, error)
+	// AdoptOrphanedNamespace adds the edge cluster an orphaned namespace was provisioned for back to the repository.
+	// Admin only.
+	// request: The request to adopt an orphaned namespace
+	// Returns the adopted edge cluster
+	AdoptOrphanedNamespace(ctx context.Context, in *AdoptOrphanedNamespaceRequest, opts ...grpc.CallOption) (*AdoptOrphanedNamespaceResponse, error)
+	// IssueKubeconfig issues a kubeconfig granting a role on an existing edge cluster that expires after the requested
+	// time
+	// request: The request to issue a kubeconfig
+	// Returns the issued kubeconfig
+	IssueKubeconfig(ctx context.Context, in *IssueKubeconfigRequest, opts ...grpc.CallOption) (*IssueKubeconfigResponse, error)
+	// RevokeKubeconfig revokes a kubeconfig issued for an existing edge cluster
+	// request: The request to revoke an issued kubeconfig
+	// Returns the revoked kubeconfig
+	RevokeKubeconfig(ctx context.Context, in *RevokeKubeconfigRequest, opts ...grpc.CallOption) (*RevokeKubeconfigResponse, error)
+	// ListKubeconfigIssuances lists the kubeconfigs issued for an existing edge cluster
+	// request: The request to list the issued kubeconfigs
+	// Returns the issued kubeconfigs
+	ListKubeconfigIssuances(ctx context.Context, in *ListKubeconfigIssuancesRequest, opts ...grpc.CallOption) (*ListKubeconfigIssuancesResponse, error)
 }
 
 type serviceClient struct {
@@ -289,6 +547,182 @@ func (c *serviceClient) ListEdgeClusterServices(ctx context.Context, in *ListEdg
 	return out, nil
 }
 
+func (c *serviceClient) WatchEdgeCluster(ctx context.Context, in *WatchEdgeClusterRequest, opts ...grpc.CallOption) (Service_WatchEdgeClusterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Service_serviceDesc.Streams[0], "/edgecluster.Service/WatchEdgeCluster", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceWatchEdgeClusterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_WatchEdgeClusterClient interface {
+	Recv() (*WatchEdgeClusterResponse, error)
+	grpc.ClientStream
+}
+
+type serviceWatchEdgeClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceWatchEdgeClusterClient) Recv() (*WatchEdgeClusterResponse, error) {
+	m := new(WatchEdgeClusterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) ListSupportedClusterTypes(ctx context.Context, in *ListSupportedClusterTypesRequest, opts ...grpc.CallOption) (*ListSupportedClusterTypesResponse, error) {
+	out := new(ListSupportedClusterTypesResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/ListSupportedClusterTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) InstallHelmRelease(ctx context.Context, in *InstallHelmReleaseRequest, opts ...grpc.CallOption) (*InstallHelmReleaseResponse, error) {
+	out := new(InstallHelmReleaseResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/InstallHelmRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpgradeHelmRelease(ctx context.Context, in *UpgradeHelmReleaseRequest, opts ...grpc.CallOption) (*UpgradeHelmReleaseResponse, error) {
+	out := new(UpgradeHelmReleaseResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/UpgradeHelmRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RollbackHelmRelease(ctx context.Context, in *RollbackHelmReleaseRequest, opts ...grpc.CallOption) (*RollbackHelmReleaseResponse, error) {
+	out := new(RollbackHelmReleaseResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/RollbackHelmRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UninstallHelmRelease(ctx context.Context, in *UninstallHelmReleaseRequest, opts ...grpc.CallOption) (*UninstallHelmReleaseResponse, error) {
+	out := new(UninstallHelmReleaseResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/UninstallHelmRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListHelmReleases(ctx context.Context, in *ListHelmReleasesRequest, opts ...grpc.CallOption) (*ListHelmReleasesResponse, error) {
+	out := new(ListHelmReleasesResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/ListHelmReleases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AddHelmRepository(ctx context.Context, in *AddHelmRepositoryRequest, opts ...grpc.CallOption) (*AddHelmRepositoryResponse, error) {
+	out := new(AddHelmRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/AddHelmRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RemoveHelmRepository(ctx context.Context, in *RemoveHelmRepositoryRequest, opts ...grpc.CallOption) (*RemoveHelmRepositoryResponse, error) {
+	out := new(RemoveHelmRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/RemoveHelmRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListHelmRepositories(ctx context.Context, in *ListHelmRepositoriesRequest, opts ...grpc.CallOption) (*ListHelmRepositoriesResponse, error) {
+	out := new(ListHelmRepositoriesResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/ListHelmRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpdateHelmRepositories(ctx context.Context, in *UpdateHelmRepositoriesRequest, opts ...grpc.CallOption) (*UpdateHelmRepositoriesResponse, error) {
+	out := new(UpdateHelmRepositoriesResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/UpdateHelmRepositories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListOrphanedNamespaces(ctx context.Context, in *ListOrphanedNamespacesRequest, opts ...grpc.CallOption) (*ListOrphanedNamespacesResponse, error) {
+	out := new(ListOrphanedNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/ListOrphanedNamespaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) DeleteOrphanedNamespace(ctx context.Context, in *DeleteOrphanedNamespaceRequest, opts ...grpc.CallOption) (*DeleteOrphanedNamespaceResponse, error) {
+	out := new(DeleteOrphanedNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/DeleteOrphanedNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AdoptOrphanedNamespace(ctx context.Context, in *AdoptOrphanedNamespaceRequest, opts ...grpc.CallOption) (*AdoptOrphanedNamespaceResponse, error) {
+	out := new(AdoptOrphanedNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/AdoptOrphanedNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) IssueKubeconfig(ctx context.Context, in *IssueKubeconfigRequest, opts ...grpc.CallOption) (*IssueKubeconfigResponse, error) {
+	out := new(IssueKubeconfigResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/IssueKubeconfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RevokeKubeconfig(ctx context.Context, in *RevokeKubeconfigRequest, opts ...grpc.CallOption) (*RevokeKubeconfigResponse, error) {
+	out := new(RevokeKubeconfigResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/RevokeKubeconfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListKubeconfigIssuances(ctx context.Context, in *ListKubeconfigIssuancesRequest, opts ...grpc.CallOption) (*ListKubeconfigIssuancesResponse, error) {
+	out := new(ListKubeconfigIssuancesResponse)
+	err := c.cc.Invoke(ctx, "/edgecluster.Service/ListKubeconfigIssuances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// CreateEdgeCluster creates a new edge cluster
@@ -323,6 +757,78 @@ type ServiceServer interface {
 	// request: The request to list an existing edge cluster services details
 	// Returns an existing edge cluster services details
 	ListEdgeClusterServices(context.Context, *ListEdgeClusterServicesRequest) (*ListEdgeClusterServicesResponse, error)
+	// WatchEdgeCluster streams the provisioning status transitions and step events of an existing edge cluster.
+	// The first message contains the current provisioning status of the edge cluster.
+	// request: The request to watch an existing edge cluster
+	// Returns the stream of the edge cluster provisioning events
+	WatchEdgeCluster(*WatchEdgeClusterRequest, Service_WatchEdgeClusterServer) error
+	// ListSupportedClusterTypes returns the edge cluster types supported by this deployment
+	// request: The request to list the supported edge cluster types
+	// Returns the descriptors of the supported edge cluster types
+	ListSupportedClusterTypes(context.Context, *ListSupportedClusterTypesRequest) (*ListSupportedClusterTypesResponse, error)
+	// InstallHelmRelease installs a new helm release on an existing edge cluster
+	// request: The request to install a new helm release
+	// Returns the result of installing the helm release including its revision history
+	InstallHelmRelease(context.Context, *InstallHelmReleaseRequest) (*InstallHelmReleaseResponse, error)
+	// UpgradeHelmRelease upgrades an existing helm release on an existing edge cluster
+	// request: The request to upgrade an existing helm release
+	// Returns the result of upgrading the helm release including its revision history
+	UpgradeHelmRelease(context.Context, *UpgradeHelmReleaseRequest) (*UpgradeHelmReleaseResponse, error)
+	// RollbackHelmRelease rolls an existing helm release on an existing edge cluster back to one of its previous revisions
+	// request: The request to roll back an existing helm release
+	// Returns the result of rolling back the helm release including its revision history
+	RollbackHelmRelease(context.Context, *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error)
+	// UninstallHelmRelease uninstalls an existing helm release from an existing edge cluster
+	// request: The request to uninstall an existing helm release
+	// Returns the result of uninstalling the helm release
+	UninstallHelmRelease(context.Context, *UninstallHelmReleaseRequest) (*UninstallHelmReleaseResponse, error)
+	// ListHelmReleases lists the helm releases installed on an existing edge cluster
+	// request: The request to list the helm releases
+	// Returns the latest revision of the helm releases installed on the edge cluster
+	ListHelmReleases(context.Context, *ListHelmReleasesRequest) (*ListHelmReleasesResponse, error)
+	// AddHelmRepository registers a helm chart repository or an OCI registry the charts can be installed from. Admin only.
+	// request: The request to add a helm repository
+	// Returns the result of adding the helm repository
+	AddHelmRepository(context.Context, *AddHelmRepositoryRequest) (*AddHelmRepositoryResponse, error)
+	// RemoveHelmRepository removes a registered helm chart repository or OCI registry. Admin only.
+	// request: The request to remove a helm repository
+	// Returns the result of removing the helm repository
+	RemoveHelmRepository(context.Context, *RemoveHelmRepositoryRequest) (*RemoveHelmRepositoryResponse, error)
+	// ListHelmRepositories lists the registered helm chart repositories and OCI registries. Admin only.
+	// request: The request to list the helm repositories
+	// Returns the helm repositories with their last index refresh time
+	ListHelmRepositories(context.Context, *ListHelmRepositoriesRequest) (*ListHelmRepositoriesResponse, error)
+	// UpdateHelmRepositories downloads the latest index of the registered helm chart repositories. Admin only.
+	// request: The request to update the helm repositories
+	// Returns the updated helm repositories
+	UpdateHelmRepositories(context.Context, *UpdateHelmRepositoriesRequest) (*UpdateHelmRepositoriesResponse, error)
+	// ListOrphanedNamespaces lists the namespaces that host the resources of the edge clusters that no longer exist.
+	// Admin only.
+	// request: The request to list the orphaned namespaces
+	// Returns the orphaned namespaces
+	ListOrphanedNamespaces(context.Context, *ListOrphanedNamespacesRequest) (*ListOrphanedNamespacesResponse, error)
+	// DeleteOrphanedNamespace deletes an orphaned namespace together with the resources it hosts. Admin only.
+	// request: The request to delete an orphaned namespace
+	// Returns the result of deleting the orphaned namespace
+	DeleteOrphanedNamespace(context.Context, *DeleteOrphanedNamespaceRequest) (*DeleteOrphanedNamespaceResponse, error)
+	// AdoptOrphanedNamespace adds the edge cluster an orphaned namespace was provisioned for back to the repository.
+	// Admin only.
+	// request: The request to adopt an orphaned namespace
+	// Returns the adopted edge cluster
+	AdoptOrphanedNamespace(context.Context, *AdoptOrphanedNamespaceRequest) (*AdoptOrphanedNamespaceResponse, error)
+	// IssueKubeconfig issues a kubeconfig granting a role on an existing edge cluster that expires after the requested
+	// time
+	// request: The request to issue a kubeconfig
+	// Returns the issued kubeconfig
+	IssueKubeconfig(context.Context, *IssueKubeconfigRequest) (*IssueKubeconfigResponse, error)
+	// RevokeKubeconfig revokes a kubeconfig issued for an existing edge cluster
+	// request: The request to revoke an issued kubeconfig
+	// Returns the revoked kubeconfig
+	RevokeKubeconfig(context.Context, *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error)
+	// ListKubeconfigIssuances lists the kubeconfigs issued for an existing edge cluster
+	// request: The request to list the issued kubeconfigs
+	// Returns the issued kubeconfigs
+	ListKubeconfigIssuances(context.Context, *ListKubeconfigIssuancesRequest) (*ListKubeconfigIssuancesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -353,6 +859,57 @@ func (*UnimplementedServiceServer) ListEdgeClusterPods(context.Context, *ListEdg
 func (*UnimplementedServiceServer) ListEdgeClusterServices(context.Context, *ListEdgeClusterServicesRequest) (*ListEdgeClusterServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEdgeClusterServices not implemented")
 }
+func (*UnimplementedServiceServer) WatchEdgeCluster(*WatchEdgeClusterRequest, Service_WatchEdgeClusterServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEdgeCluster not implemented")
+}
+func (*UnimplementedServiceServer) ListSupportedClusterTypes(context.Context, *ListSupportedClusterTypesRequest) (*ListSupportedClusterTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedClusterTypes not implemented")
+}
+func (*UnimplementedServiceServer) InstallHelmRelease(context.Context, *InstallHelmReleaseRequest) (*InstallHelmReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallHelmRelease not implemented")
+}
+func (*UnimplementedServiceServer) UpgradeHelmRelease(context.Context, *UpgradeHelmReleaseRequest) (*UpgradeHelmReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHelmRelease not implemented")
+}
+func (*UnimplementedServiceServer) RollbackHelmRelease(context.Context, *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackHelmRelease not implemented")
+}
+func (*UnimplementedServiceServer) UninstallHelmRelease(context.Context, *UninstallHelmReleaseRequest) (*UninstallHelmReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninstallHelmRelease not implemented")
+}
+func (*UnimplementedServiceServer) ListHelmReleases(context.Context, *ListHelmReleasesRequest) (*ListHelmReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHelmReleases not implemented")
+}
+func (*UnimplementedServiceServer) AddHelmRepository(context.Context, *AddHelmRepositoryRequest) (*AddHelmRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHelmRepository not implemented")
+}
+func (*UnimplementedServiceServer) RemoveHelmRepository(context.Context, *RemoveHelmRepositoryRequest) (*RemoveHelmRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHelmRepository not implemented")
+}
+func (*UnimplementedServiceServer) ListHelmRepositories(context.Context, *ListHelmRepositoriesRequest) (*ListHelmRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHelmRepositories not implemented")
+}
+func (*UnimplementedServiceServer) UpdateHelmRepositories(context.Context, *UpdateHelmRepositoriesRequest) (*UpdateHelmRepositoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHelmRepositories not implemented")
+}
+func (*UnimplementedServiceServer) ListOrphanedNamespaces(context.Context, *ListOrphanedNamespacesRequest) (*ListOrphanedNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrphanedNamespaces not implemented")
+}
+func (*UnimplementedServiceServer) DeleteOrphanedNamespace(context.Context, *DeleteOrphanedNamespaceRequest) (*DeleteOrphanedNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrphanedNamespace not implemented")
+}
+func (*UnimplementedServiceServer) AdoptOrphanedNamespace(context.Context, *AdoptOrphanedNamespaceRequest) (*AdoptOrphanedNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdoptOrphanedNamespace not implemented")
+}
+func (*UnimplementedServiceServer) IssueKubeconfig(context.Context, *IssueKubeconfigRequest) (*IssueKubeconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueKubeconfig not implemented")
+}
+func (*UnimplementedServiceServer) RevokeKubeconfig(context.Context, *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKubeconfig not implemented")
+}
+func (*UnimplementedServiceServer) ListKubeconfigIssuances(context.Context, *ListKubeconfigIssuancesRequest) (*ListKubeconfigIssuancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKubeconfigIssuances not implemented")
+}
 
 func RegisterServiceServer(s *grpc.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -502,6 +1059,315 @@ func _Service_ListEdgeClusterServices_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_WatchEdgeCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEdgeClusterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).WatchEdgeCluster(m, &serviceWatchEdgeClusterServer{stream})
+}
+
+type Service_WatchEdgeClusterServer interface {
+	Send(*WatchEdgeClusterResponse) error
+	grpc.ServerStream
+}
+
+type serviceWatchEdgeClusterServer struct {
+	grpc.ServerStream
+}
+
+func (x *serviceWatchEdgeClusterServer) Send(m *WatchEdgeClusterResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Service_ListSupportedClusterTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportedClusterTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListSupportedClusterTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/ListSupportedClusterTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListSupportedClusterTypes(ctx, req.(*ListSupportedClusterTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_InstallHelmRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallHelmReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).InstallHelmRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/InstallHelmRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).InstallHelmRelease(ctx, req.(*InstallHelmReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpgradeHelmRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeHelmReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpgradeHelmRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/UpgradeHelmRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpgradeHelmRelease(ctx, req.(*UpgradeHelmReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RollbackHelmRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackHelmReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RollbackHelmRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/RollbackHelmRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RollbackHelmRelease(ctx, req.(*RollbackHelmReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UninstallHelmRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UninstallHelmReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UninstallHelmRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/UninstallHelmRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UninstallHelmRelease(ctx, req.(*UninstallHelmReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListHelmReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHelmReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListHelmReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/ListHelmReleases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListHelmReleases(ctx, req.(*ListHelmReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AddHelmRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHelmRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AddHelmRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/AddHelmRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AddHelmRepository(ctx, req.(*AddHelmRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RemoveHelmRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHelmRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RemoveHelmRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/RemoveHelmRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RemoveHelmRepository(ctx, req.(*RemoveHelmRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListHelmRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHelmRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListHelmRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/ListHelmRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListHelmRepositories(ctx, req.(*ListHelmRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateHelmRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHelmRepositoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateHelmRepositories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/UpdateHelmRepositories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateHelmRepositories(ctx, req.(*UpdateHelmRepositoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListOrphanedNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrphanedNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListOrphanedNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/ListOrphanedNamespaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListOrphanedNamespaces(ctx, req.(*ListOrphanedNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_DeleteOrphanedNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrphanedNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).DeleteOrphanedNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/DeleteOrphanedNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).DeleteOrphanedNamespace(ctx, req.(*DeleteOrphanedNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AdoptOrphanedNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdoptOrphanedNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AdoptOrphanedNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/AdoptOrphanedNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AdoptOrphanedNamespace(ctx, req.(*AdoptOrphanedNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_IssueKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueKubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).IssueKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/IssueKubeconfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).IssueKubeconfig(ctx, req.(*IssueKubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RevokeKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeKubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RevokeKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/RevokeKubeconfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RevokeKubeconfig(ctx, req.(*RevokeKubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListKubeconfigIssuances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKubeconfigIssuancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListKubeconfigIssuances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/edgecluster.Service/ListKubeconfigIssuances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListKubeconfigIssuances(ctx, req.(*ListKubeconfigIssuancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "edgecluster.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -538,7 +1404,77 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListEdgeClusterServices",
 			Handler:    _Service_ListEdgeClusterServices_Handler,
 		},
+		{
+			MethodName: "ListSupportedClusterTypes",
+			Handler:    _Service_ListSupportedClusterTypes_Handler,
+		},
+		{
+			MethodName: "InstallHelmRelease",
+			Handler:    _Service_InstallHelmRelease_Handler,
+		},
+		{
+			MethodName: "UpgradeHelmRelease",
+			Handler:    _Service_UpgradeHelmRelease_Handler,
+		},
+		{
+			MethodName: "RollbackHelmRelease",
+			Handler:    _Service_RollbackHelmRelease_Handler,
+		},
+		{
+			MethodName: "UninstallHelmRelease",
+			Handler:    _Service_UninstallHelmRelease_Handler,
+		},
+		{
+			MethodName: "ListHelmReleases",
+			Handler:    _Service_ListHelmReleases_Handler,
+		},
+		{
+			MethodName: "AddHelmRepository",
+			Handler:    _Service_AddHelmRepository_Handler,
+		},
+		{
+			MethodName: "RemoveHelmRepository",
+			Handler:    _Service_RemoveHelmRepository_Handler,
+		},
+		{
+			MethodName: "ListHelmRepositories",
+			Handler:    _Service_ListHelmRepositories_Handler,
+		},
+		{
+			MethodName: "UpdateHelmRepositories",
+			Handler:    _Service_UpdateHelmRepositories_Handler,
+		},
+		{
+			MethodName: "ListOrphanedNamespaces",
+			Handler:    _Service_ListOrphanedNamespaces_Handler,
+		},
+		{
+			MethodName: "DeleteOrphanedNamespace",
+			Handler:    _Service_DeleteOrphanedNamespace_Handler,
+		},
+		{
+			MethodName: "AdoptOrphanedNamespace",
+			Handler:    _Service_AdoptOrphanedNamespace_Handler,
+		},
+		{
+			MethodName: "IssueKubeconfig",
+			Handler:    _Service_IssueKubeconfig_Handler,
+		},
+		{
+			MethodName: "RevokeKubeconfig",
+			Handler:    _Service_RevokeKubeconfig_Handler,
+		},
+		{
+			MethodName: "ListKubeconfigIssuances",
+			Handler:    _Service_ListKubeconfigIssuances_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEdgeCluster",
+			Handler:       _Service_WatchEdgeCluster_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "edge-cluster-operations.proto",
 }
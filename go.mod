@@ -3,14 +3,20 @@ module github.com/decentralized-cloud/edge-cluster
 go 1.16
 
 require (
+	github.com/Masterminds/semver/v3 v3.1.1
+	github.com/containerd/containerd v1.4.4
+	github.com/deislabs/oras v0.11.1
 	github.com/go-kit/kit v0.10.0
 	github.com/go-ozzo/ozzo-validation v3.6.0+incompatible
 	github.com/gofrs/flock v0.8.0
 	github.com/golang/mock v1.6.0
 	github.com/lucsky/cuid v1.2.0
 	github.com/micro-business/go-core v0.6.2
+	github.com/modern-go/reflect2 v1.0.2 // indirect
 	github.com/onsi/ginkgo v1.16.4
 	github.com/onsi/gomega v1.13.0
+	github.com/opencontainers/go-digest v1.0.0
+	github.com/opencontainers/image-spec v1.0.1
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/client_golang v1.11.0
 	github.com/robfig/cron/v3 v3.0.1
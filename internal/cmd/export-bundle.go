@@ -0,0 +1,32 @@
+// Package cmd implements different commands that can be executed against EdgeCluster service
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/pkg/util"
+	gocoreUtil "github.com/micro-business/go-core/pkg/util"
+	"github.com/spf13/cobra"
+)
+
+func newExportBundleCommand() *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export-bundle",
+		Short: "Export the charts of the chart catalogue into an offline chart bundle",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := util.ExportChartBundle(output); err != nil {
+				gocoreUtil.PrintIfError(err)
+
+				return
+			}
+
+			gocoreUtil.PrintInfo(fmt.Sprintf("Offline chart bundle is exported to %s\n", output))
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "edge-cluster-charts.tgz", "The path of the offline chart bundle to create")
+
+	return cmd
+}
@@ -2,6 +2,8 @@
 package models
 
 import (
+	"time"
+
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -27,29 +29,218 @@ type ClusterType int
 const (
 	// K3S is an edge cluster using K3S server and agent nodes
 	K3S ClusterType = iota
+
+	// K0S is an edge cluster using a single node K0S controller
+	K0S
+
+	// VCluster is a lightweight virtual edge cluster whose control plane runs inside the host namespace and whose
+	// workloads are synced to the host cluster, used for developer sandboxes and CI
+	VCluster
+)
+
+// ClusterTypeDescriptor describes an edge cluster type supported by the service
+type ClusterTypeDescriptor struct {
+	ClusterType       ClusterType
+	Name              string
+	SupportedFeatures []string
+	DefaultCharts     []string
+}
+
+// ChartCatalogueEntry defines a helm chart the provisioners install on the edge clusters. The values and the set
+// expressions can refer to ${EDGE_CLUSTER_ID} and ${EDGE_CLUSTER_TYPE}, which are replaced with the identifier
+// and the type name of the edge cluster the chart is installed on.
+type ChartCatalogueEntry struct {
+	// ReleaseName is the unique name of the helm release the chart is installed as
+	ReleaseName string `bson:"releaseName" json:"releaseName" yaml:"releaseName"`
+
+	// RepositoryName is the name the chart repository is registered with
+	RepositoryName string `bson:"repositoryName" json:"repositoryName" yaml:"repositoryName"`
+
+	// RepositoryURL is the URL of the chart repository
+	RepositoryURL string `bson:"repositoryURL" json:"repositoryURL" yaml:"repositoryURL"`
+
+	// Chart is the name of the chart in the chart repository
+	Chart string `bson:"chart" json:"chart" yaml:"chart"`
+
+	// Version is the exact chart version or the semver constraint (e.g. "~1.2.0") the installed version must satisfy,
+	// the latest version is installed if empty
+	Version string `bson:"version" json:"version" yaml:"version"`
+
+	// Namespace is the namespace the chart is installed to
+	Namespace string `bson:"namespace" json:"namespace" yaml:"namespace"`
+
+	// Values is the YAML values document the chart is installed with
+	Values string `bson:"values" json:"values" yaml:"values"`
+
+	// Set is the list of the set expressions applied on top of the values, e.g. service.type=LoadBalancer
+	Set []string `bson:"set" json:"set" yaml:"set"`
+
+	// SetString is the list of the set expressions whose values are always treated as strings
+	SetString []string `bson:"setString" json:"setString" yaml:"setString"`
+
+	// Atomic uninstalls the chart if the install fails, or rolls it back if the upgrade fails
+	Atomic bool `bson:"atomic" json:"atomic" yaml:"atomic"`
+
+	// Wait waits until all the resources of the release are ready before the next chart is installed
+	Wait bool `bson:"wait" json:"wait" yaml:"wait"`
+
+	// Timeout is the time to wait for the kubernetes operations and the hooks, e.g. 10m
+	Timeout time.Duration `bson:"timeout" json:"timeout" yaml:"timeout"`
+
+	// Order determines the install order of the charts that do not depend on each other, lower first
+	Order int `bson:"order" json:"order" yaml:"order"`
+
+	// DependsOn is the list of the release names that must be installed before this chart
+	DependsOn []string `bson:"dependsOn" json:"dependsOn" yaml:"dependsOn"`
+
+	// ClusterTypes is the list of the edge cluster type names the chart is installed on, all types if empty
+	ClusterTypes []string `bson:"clusterTypes" json:"clusterTypes" yaml:"clusterTypes"`
+}
+
+// ProvisioningStatus is the provisioning lifecycle status of an edge cluster
+type ProvisioningStatus int
+
+const (
+	// ProvisioningStatusPending indicates the edge cluster is created and waiting for the provisioning to start
+	ProvisioningStatusPending ProvisioningStatus = iota
+
+	// ProvisioningStatusProvisioning indicates the edge cluster resources are being provisioned
+	ProvisioningStatusProvisioning
+
+	// ProvisioningStatusInstallingCharts indicates the edge cluster is provisioned and the helm charts are being installed
+	ProvisioningStatusInstallingCharts
+
+	// ProvisioningStatusReady indicates the edge cluster is provisioned and ready to be used
+	ProvisioningStatusReady
+
+	// ProvisioningStatusFailed indicates the edge cluster provisioning failed
+	ProvisioningStatusFailed
+
+	// ProvisioningStatusDeleting indicates the edge cluster provision is being deleted
+	ProvisioningStatusDeleting
+)
+
+// ProvisioningState represents the persisted provisioning state of an edge cluster
+type ProvisioningState struct {
+	Status           ProvisioningStatus
+	LastErrorMessage string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// EdgeClusterEventType is the type of the events emitted while an edge cluster is provisioned
+type EdgeClusterEventType int
+
+const (
+	// EdgeClusterEventTypeStatusChanged indicates the provisioning status of the edge cluster changed
+	EdgeClusterEventTypeStatusChanged EdgeClusterEventType = iota
+
+	// EdgeClusterEventTypeNamespaceCreated indicates the namespace that hosts the edge cluster is created
+	EdgeClusterEventTypeNamespaceCreated
+
+	// EdgeClusterEventTypeLoadBalancerAddressAssigned indicates the load balancer address of the edge cluster is assigned
+	EdgeClusterEventTypeLoadBalancerAddressAssigned
+
+	// EdgeClusterEventTypeServerPodReady indicates the edge cluster server pod is ready
+	EdgeClusterEventTypeServerPodReady
+
+	// EdgeClusterEventTypeKubeconfigFetched indicates the edge cluster kubeconfig is fetched
+	EdgeClusterEventTypeKubeconfigFetched
+
+	// EdgeClusterEventTypeHelmChartInstalled indicates a helm chart is installed on the edge cluster
+	EdgeClusterEventTypeHelmChartInstalled
+
+	// EdgeClusterEventTypeHelmChartUninstalled indicates a helm chart is uninstalled from the edge cluster
+	EdgeClusterEventTypeHelmChartUninstalled
+
+	// EdgeClusterEventTypeCleanupFailed indicates a resource of the edge cluster failed to clean up while its provision was deleted
+	EdgeClusterEventTypeCleanupFailed
+
+	// EdgeClusterEventTypeDriftRepaired indicates a resource of the edge cluster that drifted from its desired state is repaired
+	EdgeClusterEventTypeDriftRepaired
 )
 
+// EdgeClusterEvent represents an event emitted while an edge cluster is provisioned
+type EdgeClusterEvent struct {
+	EdgeClusterID string
+	Type          EdgeClusterEventType
+	Status        ProvisioningStatus
+	Message       string
+	Timestamp     time.Time
+}
+
+// CleanupFailure describes a resource of an edge cluster that failed to clean up while its provision was deleted
+type CleanupFailure struct {
+	// Resource identifies the resource that failed to clean up, e.g. helm-release/monitoring/prometheus
+	Resource string
+
+	// Message is the reason the resource failed to clean up
+	Message string
+}
+
+// DriftRepair describes a resource of an edge cluster that drifted from its desired state and was repaired
+type DriftRepair struct {
+	// Resource identifies the repaired resource, e.g. deployment/<namespace>/k3s
+	Resource string
+
+	// Message describes the drift that was repaired
+	Message string
+}
+
 // ProvisionDetails represents the provision detail of an edge cluster
 type ProvisionDetails struct {
 	Service           *v1.Service
 	KubeconfigContent string
 }
 
+// ServiceExposureMode determines how the API server of an edge cluster is exposed outside the host cluster
+type ServiceExposureMode int
+
+const (
+	// ServiceExposureModeLoadBalancer exposes the API server through a LoadBalancer service and advertises it on the
+	// load balancer address
+	ServiceExposureModeLoadBalancer ServiceExposureMode = iota
+
+	// ServiceExposureModeNodePort exposes the API server through a NodePort service and advertises it on the address
+	// of a host cluster node
+	ServiceExposureModeNodePort
+
+	// ServiceExposureModeIngress exposes the API server through a ClusterIP service behind an ingress with TLS
+	// passthrough and advertises it on the ingress host
+	ServiceExposureModeIngress
+
+	// ServiceExposureModeExternalAddress exposes the API server through a ClusterIP service and advertises it on an
+	// explicit external address. An external IP is assigned to the service, a host name must be routed to it by the
+	// owner of the edge cluster.
+	ServiceExposureModeExternalAddress
+)
+
+// ServiceExposure defines how the API server of an edge cluster is exposed outside the host cluster
+type ServiceExposure struct {
+	Mode ServiceExposureMode `bson:"mode" json:"mode"`
+
+	// Address is the ingress host for the ingress mode, or the external IP address or host name for the external
+	// address mode
+	Address string `bson:"address,omitempty" json:"address,omitempty"`
+}
+
 // EdgeCluster defines the Edge Cluster object
 type EdgeCluster struct {
-	ProjectID     string      `bson:"projectID" json:"projectID"`
-	Name          string      `bson:"name" json:"name"`
-	ClusterSecret string      `bson:"clusterSecret" json:"clusterSecret"`
-	ClusterType   ClusterType `bson:"clusterType" json:"clusterType"`
+	ProjectID       string          `bson:"projectID" json:"projectID"`
+	Name            string          `bson:"name" json:"name"`
+	ClusterSecret   string          `bson:"clusterSecret" json:"clusterSecret"`
+	ClusterType     ClusterType     `bson:"clusterType" json:"clusterType"`
+	ServiceExposure ServiceExposure `bson:"serviceExposure" json:"serviceExposure"`
 }
 
 // EdgeClusterWithCursor implements the pair of the edge cluster with a cursor that determines the
 // location of the edge cluster in the repository.
 type EdgeClusterWithCursor struct {
-	EdgeClusterID    string
-	EdgeCluster      EdgeCluster
-	Cursor           string
-	ProvisionDetails ProvisionDetails
+	EdgeClusterID     string
+	EdgeCluster       EdgeCluster
+	Cursor            string
+	ProvisionDetails  ProvisionDetails
+	ProvisioningState ProvisioningState
 }
 
 // EdgeClusterNode is information about the current status of a node.
@@ -69,3 +260,91 @@ type EdgeClusterService struct {
 	// Service contains information about a deployed edge cluster node service
 	Service v1.Service
 }
+
+// HelmRepository is information about a helm chart repository or an OCI registry the charts are installed from
+type HelmRepository struct {
+	Name string
+	URL  string
+
+	// LastIndexRefreshTime is the time the index of the chart repository was last downloaded, zero if it has never
+	// been downloaded or the repository has no index, e.g. an OCI registry
+	LastIndexRefreshTime time.Time
+}
+
+// HelmRelease is information about a revision of a helm release installed on an edge cluster.
+type HelmRelease struct {
+	Name          string
+	Namespace     string
+	Revision      int
+	Status        string
+	Description   string
+	ChartName     string
+	ChartVersion  string
+	AppVersion    string
+	FirstDeployed time.Time
+	LastDeployed  time.Time
+}
+
+// ProvisionOwnership links the resources of a provisioned edge cluster back to the edge cluster, project and user
+// they are provisioned for. It is recorded on the resources as labels and annotations.
+type ProvisionOwnership struct {
+	EdgeClusterID   string
+	ProjectID       string
+	UserEmail       string
+	EdgeClusterName string
+	ClusterType     ClusterType
+
+	// ServiceVersion is the version of the edge-cluster service that provisioned the resources
+	ServiceVersion string
+}
+
+// OrphanedNamespace is a namespace that hosts the resources of an edge cluster that no longer exists in the repository
+type OrphanedNamespace struct {
+	Name         string
+	CreationTime time.Time
+
+	// Ownership is the ownership recorded on the namespace, nil if the namespace does not record its ownership, e.g.
+	// because it was provisioned by an older version of the service
+	Ownership *ProvisionOwnership
+
+	// Adoptable indicates the recorded ownership points back to the namespace and records the user the edge cluster
+	// belongs to, so the edge cluster can be added back to the repository
+	Adoptable bool
+}
+
+// KubeconfigRole is the role the kubeconfigs issued to the users of an edge cluster grant on the edge cluster
+type KubeconfigRole int
+
+const (
+	// KubeconfigRoleView grants read only access to the resources, except the secrets
+	KubeconfigRoleView KubeconfigRole = iota
+
+	// KubeconfigRoleEdit grants read and write access to the resources, except the roles and role bindings
+	KubeconfigRoleEdit
+
+	// KubeconfigRoleAdmin grants full access to the resources, including the roles and role bindings
+	KubeconfigRoleAdmin
+)
+
+// KubeconfigIssuance is a short-lived kubeconfig issued to a user of an edge cluster. It is tracked so the access it
+// grants can be revoked before it expires.
+type KubeconfigIssuance struct {
+	EdgeClusterID string
+	UserEmail     string
+	Role          KubeconfigRole
+
+	// Namespace is the namespace the role is granted in, the role is granted in all namespaces if empty
+	Namespace string
+
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+
+	// RevokedAt is the time the kubeconfig was revoked, zero if it has not been revoked
+	RevokedAt time.Time
+}
+
+// KubeconfigIssuanceWithID implements the pair of the kubeconfig issuance with its unique identifier
+type KubeconfigIssuanceWithID struct {
+	IssuanceID string
+	Issuance   KubeconfigIssuance
+}
@@ -2,7 +2,10 @@
 package models
 
 import (
+	"errors"
+
 	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
 )
 
 // Validate validates the EdgeCluster and return error if the validation failes
@@ -15,5 +18,38 @@ func (val EdgeCluster) Validate() error {
 		validation.Field(&val.Name, validation.Required),
 		// clusterSecret cannot be empty
 		validation.Field(&val.ClusterSecret, validation.Required),
+		// Only the K3S edge clusters can be exposed other than through a load balancer
+		validation.Field(&val.ServiceExposure, validation.By(func(value interface{}) error {
+			if val.ClusterType != K3S && value.(ServiceExposure).Mode != ServiceExposureModeLoadBalancer {
+				return errors.New("the edge cluster type can only be exposed through a load balancer")
+			}
+
+			return nil
+		})),
+	)
+}
+
+// Validate validates the ServiceExposure and return error if the validation failes
+// Returns error if validation failes
+func (val ServiceExposure) Validate() error {
+	var addressRules []validation.Rule
+
+	switch val.Mode {
+	case ServiceExposureModeIngress:
+		addressRules = []validation.Rule{validation.Required, is.DNSName}
+	case ServiceExposureModeExternalAddress:
+		addressRules = []validation.Rule{validation.Required, is.Host}
+	}
+
+	return validation.ValidateStruct(&val,
+		// Mode must be one of the supported exposure modes
+		validation.Field(&val.Mode, validation.In(
+			ServiceExposureModeLoadBalancer,
+			ServiceExposureModeNodePort,
+			ServiceExposureModeIngress,
+			ServiceExposureModeExternalAddress)),
+		// Address must be the ingress host for the ingress mode, and an IP address or host name for the external
+		// address mode
+		validation.Field(&val.Address, addressRules...),
 	)
 }
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"go.uber.org/zap"
+)
+
+// ExportChartBundle packages the charts of the chart catalogue into an offline chart bundle, pinning the charts
+// without an exact version to the version resolved at the time of the export
+// destination: Mandatory. The path of the bundle file to create
+// Returns error if something goes wrong
+func ExportChartBundle(destination string) error {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	if configurationService, err = configuration.NewEnvConfigurationService(); err != nil {
+		return err
+	}
+
+	if helmService, err = helm.NewHelmHelperService(logger, configurationService); err != nil {
+		return err
+	}
+
+	chartCatalogueService, err := createChartCatalogueService()
+	if err != nil {
+		return err
+	}
+
+	listChartsResponse, err := chartCatalogueService.ListCharts(context.Background(), &catalogue.ListChartsRequest{})
+	if err != nil {
+		return err
+	}
+
+	charts := make([]helm.BundleChart, 0, len(listChartsResponse.Charts))
+	for _, chart := range listChartsResponse.Charts {
+		charts = append(charts, helm.BundleChart{
+			RepositoryName: chart.RepositoryName,
+			RepositoryURL:  chart.RepositoryURL,
+			Chart:          chart.Chart,
+			Version:        chart.Version,
+		})
+	}
+
+	return helmService.ExportBundle(charts, destination)
+}
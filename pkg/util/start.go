@@ -2,28 +2,56 @@
 package util
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
 
 	"github.com/decentralized-cloud/edge-cluster/services/business"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	catalogueFile "github.com/decentralized-cloud/edge-cluster/services/catalogue/file"
+	catalogueMemory "github.com/decentralized-cloud/edge-cluster/services/catalogue/memory"
+	catalogueMongodb "github.com/decentralized-cloud/edge-cluster/services/catalogue/mongodb"
 	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
 	"github.com/decentralized-cloud/edge-cluster/services/cron/cronhelm"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronmaintenance"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/scheduler"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
 	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/envelope"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/k8ssecret"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/keyfile"
 	"github.com/decentralized-cloud/edge-cluster/services/endpoint"
+	eventMemory "github.com/decentralized-cloud/edge-cluster/services/event/memory"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	jobMongodb "github.com/decentralized-cloud/edge-cluster/services/job/mongodb"
+	"github.com/decentralized-cloud/edge-cluster/services/job/workerpool"
+	"github.com/decentralized-cloud/edge-cluster/services/leaderelection"
+	"github.com/decentralized-cloud/edge-cluster/services/leaderelection/lease"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
 	"github.com/decentralized-cloud/edge-cluster/services/repository/mongodb"
 	"github.com/decentralized-cloud/edge-cluster/services/transport/grpc"
 	"github.com/decentralized-cloud/edge-cluster/services/transport/http"
 	"github.com/micro-business/go-core/gokit/middleware"
 	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
 )
 
 var helmService helm.HelmHelperContract
 var configurationService configuration.ConfigurationContract
 var endpointCreatorService endpoint.EndpointCreatorContract
 var middlewareProviderService middleware.MiddlewareProviderContract
+var jobWorkerPoolService job.JobWorkerPoolContract
+var helmChartRefreshJob cron.HelmChartRefreshJobContract
+var schedulerService cron.SchedulerContract
+var leaderElectionService leaderelection.LeaderElectionContract
 
 // StartService setups all dependecies required to start the EdgeCluster service and
 // start the service
@@ -41,13 +69,6 @@ func StartService() {
 		logger.Fatal("failed to setup dependecies", zap.Error(err))
 	}
 
-	cronHelmService, err := cronhelm.NewhelmCronService(
-		logger,
-		helmService)
-	if err != nil {
-		logger.Fatal("failed to create cron helm serviuce", zap.Error(err))
-	}
-
 	grpcTransportService, err := grpc.NewTransportService(
 		logger,
 		configurationService,
@@ -59,7 +80,12 @@ func StartService() {
 
 	httpTansportService, err := http.NewTransportService(
 		logger,
-		configurationService)
+		configurationService,
+		schedulerService,
+		helmChartRefreshJob,
+		leaderElectionService,
+		endpointCreatorService,
+		middlewareProviderService)
 	if err != nil {
 		logger.Fatal("failed to create HTTP transport service", zap.Error(err))
 	}
@@ -69,8 +95,14 @@ func StartService() {
 	signal.Notify(signalChan, os.Interrupt)
 
 	go func() {
-		if serviceErr := cronHelmService.Start(); serviceErr != nil {
-			logger.Fatal("failed to start cron helm service", zap.Error(serviceErr))
+		if serviceErr := leaderElectionService.Start(); serviceErr != nil {
+			logger.Fatal("failed to start leader election service", zap.Error(serviceErr))
+		}
+	}()
+
+	go func() {
+		if serviceErr := jobWorkerPoolService.Start(); serviceErr != nil {
+			logger.Fatal("failed to start job worker pool service", zap.Error(serviceErr))
 		}
 	}()
 
@@ -98,8 +130,12 @@ func StartService() {
 			logger.Error("failed to stop HTTP transport service", zap.Error(err))
 		}
 
-		if err := cronHelmService.Stop(); err != nil {
-			logger.Error("failed to stop cron helm service", zap.Error(err))
+		if err := leaderElectionService.Stop(); err != nil {
+			logger.Error("failed to stop leader election service", zap.Error(err))
+		}
+
+		if err := jobWorkerPoolService.Stop(); err != nil {
+			logger.Error("failed to stop job worker pool service", zap.Error(err))
 		}
 
 		close(cleanupDone)
@@ -116,28 +152,263 @@ func setupDependencies(logger *zap.Logger) (err error) {
 		return
 	}
 
-	if helmService, err = helm.NewHelmHelperService(logger); err != nil {
+	if helmService, err = helm.NewHelmHelperService(logger, configurationService); err != nil {
+		return
+	}
+
+	eventBusService, err := eventMemory.NewMemoryEventBusService()
+	if err != nil {
+		return
+	}
+
+	chartCatalogueService, err := createChartCatalogueService()
+	if err != nil {
+		return
+	}
+
+	if err = addChartCatalogueRepositories(chartCatalogueService); err != nil {
+		return
+	}
+
+	restConfig, err := provision.GetHostRestConfig()
+	if err != nil {
+		return
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return
+	}
+
+	encryptionService, err := createEncryptionService(clientset)
+	if err != nil {
+		return
+	}
+
+	kubeconfigCacheService, err := kubeconfig.NewKubeconfigCacheService(
+		logger,
+		configurationService,
+		clientset,
+		encryptionService)
+	if err != nil {
 		return
 	}
 
 	var edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract
-	if edgeClusterFactoryService, err = edgecluster.NewEdgeClusterFactoryService(logger, configurationService, helmService); err != nil {
+	if edgeClusterFactoryService, err = edgecluster.NewEdgeClusterFactoryService(
+		logger,
+		configurationService,
+		helmService,
+		eventBusService,
+		chartCatalogueService,
+		kubeconfigCacheService); err != nil {
+		return
+	}
+
+	repositoryService, err := mongodb.NewMongodbRepositoryService(configurationService, encryptionService)
+	if err != nil {
 		return
 	}
 
-	repositoryService, err := mongodb.NewMongodbRepositoryService(configurationService)
+	jobQueueService, err := jobMongodb.NewMongodbJobQueueService(configurationService)
 	if err != nil {
 		return
 	}
 
-	businessService, err := business.NewBusinessService(logger, repositoryService, edgeClusterFactoryService)
+	kubeconfigIssuerService, err := access.NewKubeconfigIssuerService(logger)
+	if err != nil {
+		return
+	}
+
+	businessService, err := business.NewBusinessService(
+		logger,
+		configurationService,
+		repositoryService,
+		edgeClusterFactoryService,
+		jobQueueService,
+		eventBusService,
+		helmService,
+		clientset,
+		kubeconfigIssuerService)
+	if err != nil {
+		return err
+	}
+
+	provisioningJobHandlerService, err := business.NewProvisioningJobHandlerService(
+		logger,
+		configurationService,
+		repositoryService,
+		edgeClusterFactoryService,
+		eventBusService)
 	if err != nil {
 		return err
 	}
 
+	if jobWorkerPoolService, err = workerpool.NewJobWorkerPoolService(
+		logger,
+		configurationService,
+		jobQueueService,
+		provisioningJobHandlerService); err != nil {
+		return
+	}
+
 	if endpointCreatorService, err = endpoint.NewEndpointCreatorService(businessService); err != nil {
 		return
 	}
 
+	if err = setupCronJobs(
+		logger,
+		repositoryService,
+		edgeClusterFactoryService,
+		kubeconfigIssuerService,
+		clientset,
+		encryptionService != nil); err != nil {
+		return
+	}
+
+	// Only the leader replica runs the background jobs, while every replica serves the requests and runs the
+	// provisioning jobs, as the job queue already leases every job to a single worker
+	if leaderElectionService, err = lease.NewLeaseLeaderElectionService(
+		logger,
+		configurationService,
+		clientset,
+		[]leaderelection.LeaderServiceContract{schedulerService}); err != nil {
+		return
+	}
+
 	return
 }
+
+// setupCronJobs creates the background jobs and the scheduler that runs them. The encryption key rotation job is only
+// created if the sensitive fields are encrypted.
+func setupCronJobs(
+	logger *zap.Logger,
+	repositoryService repository.RepositoryContract,
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract,
+	kubeconfigIssuerService access.KubeconfigIssuerContract,
+	clientset kubernetes.Interface,
+	encryptionEnabled bool) (err error) {
+	if helmChartRefreshJob, err = cronhelm.NewHelmChartRefreshJob(logger, helmService); err != nil {
+		return
+	}
+
+	driftReconcilerJob, err := cronmaintenance.NewDriftReconcilerJob(
+		logger,
+		repositoryService,
+		edgeClusterFactoryService)
+	if err != nil {
+		return
+	}
+
+	kubeconfigValidityCheckJob, err := cronmaintenance.NewKubeconfigValidityCheckJob(
+		logger,
+		repositoryService,
+		edgeClusterFactoryService)
+	if err != nil {
+		return
+	}
+
+	namespaceGarbageCollectorJob, err := cronmaintenance.NewNamespaceGarbageCollectorJob(
+		logger,
+		configurationService,
+		repositoryService,
+		clientset)
+	if err != nil {
+		return
+	}
+
+	kubeconfigIssuanceCleanupJob, err := cronmaintenance.NewKubeconfigIssuanceCleanupJob(
+		logger,
+		repositoryService,
+		edgeClusterFactoryService,
+		kubeconfigIssuerService)
+	if err != nil {
+		return
+	}
+
+	cronJobs := []cron.CronJobContract{
+		helmChartRefreshJob,
+		driftReconcilerJob,
+		kubeconfigValidityCheckJob,
+		namespaceGarbageCollectorJob,
+		kubeconfigIssuanceCleanupJob,
+	}
+
+	if encryptionEnabled {
+		encryptionKeyRotationJob, err := cronmaintenance.NewEncryptionKeyRotationJob(
+			logger,
+			configurationService,
+			repositoryService)
+		if err != nil {
+			return err
+		}
+
+		cronJobs = append(cronJobs, encryptionKeyRotationJob)
+	}
+
+	schedulerService, err = scheduler.NewSchedulerService(logger, configurationService, cronJobs)
+
+	return
+}
+
+func createChartCatalogueService() (catalogue.ChartCatalogueContract, error) {
+	source, err := configurationService.GetChartCatalogueSource()
+	if err != nil {
+		return nil, err
+	}
+
+	switch source {
+	case "file":
+		return catalogueFile.NewFileChartCatalogueService(configurationService)
+	case "mongodb":
+		return catalogueMongodb.NewMongodbChartCatalogueService(configurationService)
+	default:
+		return catalogueMemory.NewMemoryChartCatalogueService(catalogue.DefaultCharts())
+	}
+}
+
+// createEncryptionService creates the service that encrypts the sensitive fields of the edge clusters using the keys
+// of the configured key provider. Returns nil if no key provider is configured, so the sensitive fields are stored
+// in plain text.
+func createEncryptionService(clientset kubernetes.Interface) (encryption.EncryptionContract, error) {
+	provider, err := configurationService.GetEncryptionKeyProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	var keyProvider encryption.KeyProviderContract
+
+	switch provider {
+	case "":
+		return nil, nil
+	case "keyfile":
+		keyProvider, err = keyfile.NewKeyFileKeyProviderService(configurationService)
+	case "kubernetes":
+		keyProvider, err = k8ssecret.NewSecretKeyProviderService(configurationService, clientset)
+	default:
+		return nil, fmt.Errorf("unknown encryption key provider %s", provider)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return envelope.NewEnvelopeEncryptionService(configurationService, keyProvider)
+}
+
+// addChartCatalogueRepositories registers the repositories of the catalogue charts, so they are kept up to date by
+// the helm chart refresh job before the first chart is installed
+func addChartCatalogueRepositories(chartCatalogueService catalogue.ChartCatalogueContract) error {
+	listChartsResponse, err := chartCatalogueService.ListCharts(context.Background(), &catalogue.ListChartsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for _, chart := range listChartsResponse.Charts {
+		if err = helmService.AddRepository(chart.RepositoryName, chart.RepositoryURL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -26,4 +26,11 @@ docker cp extract-mock-builder:/src/services/configuration/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/endpoint/mock/mock-contract.go ./services/endpoint/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/edgecluster/types/mock/mock-contract.go ./services/edgecluster/types/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/edgecluster/helm/mock/mock-contract.go ./services/edgecluster/helm/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/edgecluster/kubeconfig/mock/mock-contract.go ./services/edgecluster/kubeconfig/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/edgecluster/access/mock/mock-contract.go ./services/edgecluster/access/mock/mock-contract.go
 docker cp extract-mock-builder:/src/services/cron/mock/mock-contract.go ./services/cron/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/job/mock/mock-contract.go ./services/job/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/event/mock/mock-contract.go ./services/event/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/catalogue/mock/mock-contract.go ./services/catalogue/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/leaderelection/mock/mock-contract.go ./services/leaderelection/mock/mock-contract.go
+docker cp extract-mock-builder:/src/services/encryption/mock/mock-contract.go ./services/encryption/mock/mock-contract.go
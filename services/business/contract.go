@@ -69,4 +69,157 @@ type BusinessContract interface {
 	ListEdgeClusterServices(
 		ctx context.Context,
 		request *ListEdgeClusterServicesRequest) (*ListEdgeClusterServicesResponse, error)
+
+	// WatchEdgeCluster streams the provisioning events of an existing edge cluster
+	// ctx: Mandatory The reference to the context. The events channel is closed when the context is cancelled
+	// request: Mandatory. The request to watch an existing edge cluster
+	// Returns either the channel the provisioning events are delivered to or error if something goes wrong.
+	WatchEdgeCluster(
+		ctx context.Context,
+		request *WatchEdgeClusterRequest) (*WatchEdgeClusterResponse, error)
+
+	// ListSupportedClusterTypes returns the edge cluster types supported by this deployment
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the supported edge cluster types
+	// Returns either the list of the supported edge cluster types or error if something goes wrong.
+	ListSupportedClusterTypes(
+		ctx context.Context,
+		request *ListSupportedClusterTypesRequest) (*ListSupportedClusterTypesResponse, error)
+
+	// InstallHelmRelease installs a new helm release on an existing edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to install a new helm release
+	// Returns either the installed helm release and its revision history or error if something goes wrong.
+	InstallHelmRelease(
+		ctx context.Context,
+		request *InstallHelmReleaseRequest) (*InstallHelmReleaseResponse, error)
+
+	// UpgradeHelmRelease upgrades an existing helm release on an existing edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to upgrade an existing helm release
+	// Returns either the upgraded helm release and its revision history or error if something goes wrong.
+	UpgradeHelmRelease(
+		ctx context.Context,
+		request *UpgradeHelmReleaseRequest) (*UpgradeHelmReleaseResponse, error)
+
+	// RollbackHelmRelease rolls an existing helm release on an existing edge cluster back to one of its previous revisions
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to roll back an existing helm release
+	// Returns either the rolled back helm release and its revision history or error if something goes wrong.
+	RollbackHelmRelease(
+		ctx context.Context,
+		request *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error)
+
+	// UninstallHelmRelease uninstalls an existing helm release from an existing edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to uninstall an existing helm release
+	// Returns either the result of uninstalling the helm release or error if something goes wrong.
+	UninstallHelmRelease(
+		ctx context.Context,
+		request *UninstallHelmReleaseRequest) (*UninstallHelmReleaseResponse, error)
+
+	// ListHelmReleases lists the helm releases installed on an existing edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the helm releases
+	// Returns either the latest revision of the helm releases or error if something goes wrong.
+	ListHelmReleases(
+		ctx context.Context,
+		request *ListHelmReleasesRequest) (*ListHelmReleasesResponse, error)
+
+	// AddHelmRepository registers a helm chart repository or an OCI registry the charts can be installed from. The
+	// operation is only allowed for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to add a helm repository
+	// Returns either the result of adding the helm repository or error if something goes wrong.
+	AddHelmRepository(
+		ctx context.Context,
+		request *AddHelmRepositoryRequest) (*AddHelmRepositoryResponse, error)
+
+	// RemoveHelmRepository removes a registered helm chart repository or OCI registry. The operation is only allowed
+	// for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to remove a helm repository
+	// Returns either the result of removing the helm repository or error if something goes wrong.
+	RemoveHelmRepository(
+		ctx context.Context,
+		request *RemoveHelmRepositoryRequest) (*RemoveHelmRepositoryResponse, error)
+
+	// ListHelmRepositories lists the registered helm chart repositories and OCI registries. The operation is only
+	// allowed for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the helm repositories
+	// Returns either the helm repositories with their last index refresh time or error if something goes wrong.
+	ListHelmRepositories(
+		ctx context.Context,
+		request *ListHelmRepositoriesRequest) (*ListHelmRepositoriesResponse, error)
+
+	// UpdateHelmRepositories downloads the latest index of the registered helm chart repositories. The operation is
+	// only allowed for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to update the helm repositories
+	// Returns either the updated helm repositories or error if something goes wrong.
+	UpdateHelmRepositories(
+		ctx context.Context,
+		request *UpdateHelmRepositoriesRequest) (*UpdateHelmRepositoriesResponse, error)
+
+	// ListOrphanedNamespaces lists the namespaces that host an edge cluster that no longer exists in the repository.
+	// The operation is only allowed for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the orphaned namespaces
+	// Returns either the orphaned namespaces or error if something goes wrong.
+	ListOrphanedNamespaces(
+		ctx context.Context,
+		request *ListOrphanedNamespacesRequest) (*ListOrphanedNamespacesResponse, error)
+
+	// DeleteOrphanedNamespace deletes a namespace that hosts an edge cluster that no longer exists in the repository,
+	// together with all the resources it contains. The operation is only allowed for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to delete an orphaned namespace
+	// Returns either the result of deleting the orphaned namespace or error if something goes wrong.
+	DeleteOrphanedNamespace(
+		ctx context.Context,
+		request *DeleteOrphanedNamespaceRequest) (*DeleteOrphanedNamespaceResponse, error)
+
+	// AdoptOrphanedNamespace adds the edge cluster hosted by an orphaned namespace back to the repository using the
+	// ownership recorded on the namespace. The operation is only allowed for the admin users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to adopt an orphaned namespace
+	// Returns either the adopted edge cluster or error if something goes wrong.
+	AdoptOrphanedNamespace(
+		ctx context.Context,
+		request *AdoptOrphanedNamespaceRequest) (*AdoptOrphanedNamespaceResponse, error)
+
+	// IssueKubeconfig issues a kubeconfig granting a role on an existing edge cluster that expires after the requested
+	// time. The issuance is tracked so the kubeconfig can be revoked before it expires.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to issue a kubeconfig
+	// Returns either the issued kubeconfig or error if something goes wrong.
+	IssueKubeconfig(
+		ctx context.Context,
+		request *IssueKubeconfigRequest) (*IssueKubeconfigResponse, error)
+
+	// RevokeKubeconfig revokes the access a kubeconfig issued for an existing edge cluster grants
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to revoke an issued kubeconfig
+	// Returns either the revoked issuance or error if something goes wrong.
+	RevokeKubeconfig(
+		ctx context.Context,
+		request *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error)
+
+	// ListKubeconfigIssuances lists the kubeconfigs issued for an existing edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the issued kubeconfigs
+	// Returns either the issued kubeconfigs or error if something goes wrong.
+	ListKubeconfigIssuances(
+		ctx context.Context,
+		request *ListKubeconfigIssuancesRequest) (*ListKubeconfigIssuancesResponse, error)
+
+	// GetEdgeClusterKubeconfig retrieves the admin kubeconfig of an existing edge cluster, which is used to proxy
+	// the requests of the user to the Kubernetes API of the edge cluster.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to retrieve the kubeconfig of an existing edge cluster
+	// Returns either the kubeconfig of the edge cluster or error if something goes wrong.
+	GetEdgeClusterKubeconfig(
+		ctx context.Context,
+		request *GetEdgeClusterKubeconfigRequest) (*GetEdgeClusterKubeconfigResponse, error)
 }
@@ -0,0 +1,33 @@
+// Package business implements different business services required by the edge-cluster service
+package business
+
+import "fmt"
+
+// PermissionDeniedError indicates that the user is not allowed to perform the operation
+type PermissionDeniedError struct {
+	UserEmail string
+	Operation string
+}
+
+// Error returns message for the PermissionDeniedError error type
+// Returns the error nessage
+func (e PermissionDeniedError) Error() string {
+	return fmt.Sprintf("Permission denied. User %s is not allowed to %s.", e.UserEmail, e.Operation)
+}
+
+// IsPermissionDeniedError indicates whether the error is of type PermissionDeniedError
+func IsPermissionDeniedError(err error) bool {
+	_, ok := err.(PermissionDeniedError)
+
+	return ok
+}
+
+// NewPermissionDeniedError creates a new PermissionDeniedError error
+// userEmail: Mandatory. The email of the user that is not allowed to perform the operation
+// operation: Mandatory. The operation the user is not allowed to perform
+func NewPermissionDeniedError(userEmail, operation string) error {
+	return PermissionDeniedError{
+		UserEmail: userEmail,
+		Operation: operation,
+	}
+}
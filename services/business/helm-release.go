@@ -0,0 +1,332 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+// InstallHelmRelease installs a new helm release on an existing edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to install a new helm release
+// Returns either the installed helm release and its revision history or error if something goes wrong.
+func (service *businessService) InstallHelmRelease(
+	ctx context.Context,
+	request *InstallHelmReleaseRequest) (*InstallHelmReleaseResponse, error) {
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &InstallHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	exists, err := service.helmReleaseExists(kubeconfig, request.Namespace, request.ReleaseName)
+	if err != nil {
+		return &InstallHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	if exists {
+		return &InstallHelmReleaseResponse{
+			Err: commonErrors.NewArgumentError(
+				"request",
+				fmt.Sprintf("helm release %s already exists in namespace %s", request.ReleaseName, request.Namespace)),
+		}, nil
+	}
+
+	release, history, err := service.installChart(
+		kubeconfig,
+		request.Namespace,
+		request.ReleaseName,
+		request.RepositoryName,
+		request.RepositoryURL,
+		request.Chart,
+		helm.InstallChartOptions{
+			Version:          request.Version,
+			Values:           request.Values,
+			Set:              request.Set,
+			SetString:        request.SetString,
+			SetFile:          request.SetFile,
+			Atomic:           request.Atomic,
+			Wait:             request.Wait,
+			Timeout:          request.Timeout,
+			DependencyUpdate: request.DependencyUpdate,
+		})
+	if err != nil {
+		return &InstallHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &InstallHelmReleaseResponse{
+		Release: release,
+		History: history,
+	}, nil
+}
+
+// UpgradeHelmRelease upgrades an existing helm release on an existing edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to upgrade an existing helm release
+// Returns either the upgraded helm release and its revision history or error if something goes wrong.
+func (service *businessService) UpgradeHelmRelease(
+	ctx context.Context,
+	request *UpgradeHelmReleaseRequest) (*UpgradeHelmReleaseResponse, error) {
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &UpgradeHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	exists, err := service.helmReleaseExists(kubeconfig, request.Namespace, request.ReleaseName)
+	if err != nil {
+		return &UpgradeHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	if !exists {
+		return &UpgradeHelmReleaseResponse{
+			Err: commonErrors.NewArgumentError(
+				"request",
+				fmt.Sprintf("helm release %s does not exist in namespace %s", request.ReleaseName, request.Namespace)),
+		}, nil
+	}
+
+	release, history, err := service.installChart(
+		kubeconfig,
+		request.Namespace,
+		request.ReleaseName,
+		request.RepositoryName,
+		request.RepositoryURL,
+		request.Chart,
+		helm.InstallChartOptions{
+			Version:          request.Version,
+			Values:           request.Values,
+			Set:              request.Set,
+			SetString:        request.SetString,
+			SetFile:          request.SetFile,
+			Atomic:           request.Atomic,
+			Wait:             request.Wait,
+			Timeout:          request.Timeout,
+			DependencyUpdate: request.DependencyUpdate,
+		})
+	if err != nil {
+		return &UpgradeHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &UpgradeHelmReleaseResponse{
+		Release: release,
+		History: history,
+	}, nil
+}
+
+// RollbackHelmRelease rolls an existing helm release on an existing edge cluster back to one of its previous revisions
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to roll back an existing helm release
+// Returns either the rolled back helm release and its revision history or error if something goes wrong.
+func (service *businessService) RollbackHelmRelease(
+	ctx context.Context,
+	request *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error) {
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &RollbackHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err = service.helmService.RollbackRelease(kubeconfig, request.Namespace, request.ReleaseName, request.Revision); err != nil {
+		return &RollbackHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	release, history, err := service.getHelmReleaseHistory(kubeconfig, request.Namespace, request.ReleaseName)
+	if err != nil {
+		return &RollbackHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RollbackHelmReleaseResponse{
+		Release: release,
+		History: history,
+	}, nil
+}
+
+// UninstallHelmRelease uninstalls an existing helm release from an existing edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to uninstall an existing helm release
+// Returns either the result of uninstalling the helm release or error if something goes wrong.
+func (service *businessService) UninstallHelmRelease(
+	ctx context.Context,
+	request *UninstallHelmReleaseRequest) (*UninstallHelmReleaseResponse, error) {
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &UninstallHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err = service.helmService.UninstallChart(
+		kubeconfig,
+		request.Namespace,
+		request.ReleaseName,
+		helm.UninstallChartOptions{
+			DisableHooks: request.DisableHooks,
+			Timeout:      request.Timeout,
+		}); err != nil {
+		return &UninstallHelmReleaseResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &UninstallHelmReleaseResponse{}, nil
+}
+
+// ListHelmReleases lists the helm releases installed on an existing edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the helm releases
+// Returns either the latest revision of the helm releases or error if something goes wrong.
+func (service *businessService) ListHelmReleases(
+	ctx context.Context,
+	request *ListHelmReleasesRequest) (*ListHelmReleasesResponse, error) {
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &ListHelmReleasesResponse{
+			Err: err,
+		}, nil
+	}
+
+	releases, err := service.helmService.ListReleases(kubeconfig, request.Namespace)
+	if err != nil {
+		return &ListHelmReleasesResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListHelmReleasesResponse{
+		Releases: releases,
+	}, nil
+}
+
+// getKubeconfig returns the kubeconfig of an existing edge cluster owned by the given user
+func (service *businessService) getKubeconfig(ctx context.Context, userEmail, edgeClusterID string) (string, error) {
+	repositoryResponse, err := service.repositoryService.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+		UserEmail:     userEmail,
+		EdgeClusterID: edgeClusterID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, repositoryResponse.EdgeCluster.ClusterType)
+	if err != nil {
+		return "", commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	}
+
+	response, err := edgeClusterProvisioner.GetProvisionDetails(
+		ctx,
+		&edgeClusterTypes.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID})
+	if err != nil {
+		return "", commonErrors.NewUnknownErrorWithError("failed to retrieve the edge cluster kubeconfig", err)
+	}
+
+	return response.ProvisionDetails.KubeconfigContent, nil
+}
+
+func (service *businessService) helmReleaseExists(kubeconfig, namespace, releaseName string) (bool, error) {
+	releases, err := service.helmService.ListReleases(kubeconfig, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	for _, release := range releases {
+		if release.Name == releaseName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (service *businessService) installChart(
+	kubeconfig string,
+	namespace string,
+	releaseName string,
+	repositoryName string,
+	repositoryURL string,
+	chart string,
+	options helm.InstallChartOptions) (models.HelmRelease, []models.HelmRelease, error) {
+	if err := service.verifyRepository(repositoryName, repositoryURL); err != nil {
+		return models.HelmRelease{}, nil, err
+	}
+
+	if err := service.helmService.InstallChart(
+		kubeconfig,
+		namespace,
+		releaseName,
+		repositoryName,
+		chart,
+		options); err != nil {
+		return models.HelmRelease{}, nil, err
+	}
+
+	return service.getHelmReleaseHistory(kubeconfig, namespace, releaseName)
+}
+
+// verifyRepository verifies the repository the chart is installed from is registered, and with the given URL if one is
+// provided. The chart is always downloaded from the registered URL, and the repositories are only registered by the
+// administrators, so the users cannot point a shared repository name, the credentials configured for it, or the chart
+// reference to a server of their choice.
+func (service *businessService) verifyRepository(repositoryName string, repositoryURL string) error {
+	repositories, err := service.helmService.ListRepositories()
+	if err != nil {
+		return err
+	}
+
+	for _, repository := range repositories {
+		if repository.Name != repositoryName {
+			continue
+		}
+
+		if repositoryURL != "" && strings.TrimSuffix(repository.URL, "/") != strings.TrimSuffix(repositoryURL, "/") {
+			return commonErrors.NewArgumentError(
+				"request",
+				fmt.Sprintf("helm repository %s is registered with a different URL", repositoryName))
+		}
+
+		return nil
+	}
+
+	return commonErrors.NewArgumentError(
+		"request",
+		fmt.Sprintf("helm repository %s is not registered", repositoryName))
+}
+
+// getHelmReleaseHistory returns the latest revision of the helm release along with all its revisions
+func (service *businessService) getHelmReleaseHistory(
+	kubeconfig string,
+	namespace string,
+	releaseName string) (models.HelmRelease, []models.HelmRelease, error) {
+	history, err := service.helmService.GetReleaseHistory(kubeconfig, namespace, releaseName)
+	if err != nil {
+		return models.HelmRelease{}, nil, err
+	}
+
+	if len(history) == 0 {
+		return models.HelmRelease{}, nil, commonErrors.NewUnknownError(fmt.Sprintf("helm release %s has no revision", releaseName))
+	}
+
+	return history[len(history)-1], history, nil
+}
@@ -0,0 +1,501 @@
+package business_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	accessMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/access/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	jobMock "github.com/decentralized-cloud/edge-cluster/services/job/mock"
+	repository "github.com/decentralized-cloud/edge-cluster/services/repository"
+	repsoitoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Helm Release Business Service Tests", func() {
+	var (
+		mockCtrl                          *gomock.Controller
+		sut                               business.BusinessContract
+		mockRepositoryService             *repsoitoryMock.MockRepositoryContract
+		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
+		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockHelmService                   *helmMock.MockHelmHelperContract
+		ctx                               context.Context
+		userEmail                         string
+		edgeClusterID                     string
+		kubeconfig                        string
+		namespace                         string
+		releaseName                       string
+		history                           []models.HelmRelease
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		userEmail = cuid.New() + "@test.com"
+		edgeClusterID = cuid.New()
+		kubeconfig = cuid.New()
+		namespace = cuid.New()
+		releaseName = cuid.New()
+		history = []models.HelmRelease{
+			{Name: releaseName, Namespace: namespace, Revision: 1, Status: "superseded", ChartVersion: "1.0.0"},
+			{Name: releaseName, Namespace: namespace, Revision: 2, Status: "deployed", ChartVersion: "1.1.0"},
+		}
+
+		mockRepositoryService = repsoitoryMock.NewMockRepositoryContract(mockCtrl)
+		mockRepositoryService.
+			EXPECT().
+			ReadEdgeCluster(gomock.Any(), &repository.ReadEdgeClusterRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+			}).
+			Return(&repository.ReadEdgeClusterResponse{
+				EdgeCluster: models.EdgeCluster{ClusterType: models.K3S},
+			}, nil).
+			AnyTimes()
+
+		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		mockEdgeClusterProvisionerService.
+			EXPECT().
+			GetProvisionDetails(gomock.Any(), &edgeClusterTypes.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID}).
+			Return(&edgeClusterTypes.GetProvisionDetailsResponse{
+				ProvisionDetails: models.ProvisionDetails{KubeconfigContent: kubeconfig},
+			}, nil).
+			AnyTimes()
+
+		mockEdgeClusterFactoryService = edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		mockHelmService = helmMock.NewMockHelmHelperContract(mockCtrl)
+
+		mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetAdminEmails().Return([]string{}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetKubeconfigIssuanceMaxTTL().Return(24*time.Hour, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedCIDRs().Return([]string{"203.0.113.0/24"}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedDomains().Return([]string{"edge.example.com"}, nil).AnyTimes()
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, _ = business.NewBusinessService(
+			logger,
+			mockConfigurationService,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService,
+			jobMock.NewMockJobQueueContract(mockCtrl),
+			eventMock.NewMockEventBusContract(mockCtrl),
+			mockHelmService,
+			fake.NewSimpleClientset(),
+			accessMock.NewMockKubeconfigIssuerContract(mockCtrl))
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("InstallHelmRelease", func() {
+		var (
+			request business.InstallHelmReleaseRequest
+		)
+
+		BeforeEach(func() {
+			request = business.InstallHelmReleaseRequest{
+				UserEmail:      userEmail,
+				EdgeClusterID:  edgeClusterID,
+				Namespace:      namespace,
+				ReleaseName:    releaseName,
+				RepositoryName: cuid.New(),
+				RepositoryURL:  "https://" + cuid.New(),
+				Chart:          cuid.New(),
+				Version:        "1.1.0",
+				Values:         []string{"replicas: 2\n", "replicas: 3\n"},
+				Set:            []string{"a=b", "c=d"},
+				SetString:      []string{"e=1"},
+				SetFile:        map[string]string{"config": cuid.New()},
+				Wait:           true,
+				Timeout:        time.Minute,
+			}
+		})
+
+		It("should accept chart repository URLs and OCI registry references as the repository URL", func() {
+			for _, repositoryURL := range []string{"", "https://charts.example.com", "oci://registry.example.com/charts"} {
+				request.RepositoryURL = repositoryURL
+				Ω(request.Validate()).Should(BeNil())
+			}
+
+			for _, repositoryURL := range []string{"oci://", "not a url"} {
+				request.RepositoryURL = repositoryURL
+				Ω(request.Validate()).ShouldNot(BeNil())
+			}
+		})
+
+		It("should reject the chart names that are not the name of a chart in the repository", func() {
+			for _, chart := range []string{"//any-host/x.tgz", "https://any-host/x.tgz", "../x", "x:1.0.0"} {
+				request.Chart = chart
+				Ω(request.Validate()).ShouldNot(BeNil(), chart)
+			}
+		})
+
+		When("the edge cluster does not exist", func() {
+			It("should return the repository error", func() {
+				request.UserEmail = cuid.New() + "@test.com"
+				expectedError := commonErrors.NewNotFoundError()
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+
+		When("the helm release already exists", func() {
+			It("should return ArgumentError without installing the chart", func() {
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return([]models.HelmRelease{{Name: releaseName}}, nil)
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("the helm release does not exist", func() {
+			It("should verify the repository, install the chart and return the release history", func() {
+				gomock.InOrder(
+					mockHelmService.
+						EXPECT().
+						ListReleases(kubeconfig, namespace).
+						Return([]models.HelmRelease{{Name: cuid.New()}}, nil),
+					mockHelmService.
+						EXPECT().
+						ListRepositories().
+						Return([]models.HelmRepository{
+							{Name: cuid.New(), URL: "https://" + cuid.New()},
+							{Name: request.RepositoryName, URL: request.RepositoryURL + "/"},
+						}, nil),
+					mockHelmService.
+						EXPECT().
+						InstallChart(kubeconfig, namespace, releaseName, request.RepositoryName, request.Chart, helm.InstallChartOptions{
+							Version:   "1.1.0",
+							Values:    request.Values,
+							Set:       request.Set,
+							SetString: request.SetString,
+							SetFile:   request.SetFile,
+							Wait:      true,
+							Timeout:   time.Minute,
+						}).
+						Return(nil),
+					mockHelmService.
+						EXPECT().
+						GetReleaseHistory(kubeconfig, namespace, releaseName).
+						Return(history, nil))
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Release).Should(Equal(history[1]))
+				Ω(response.History).Should(Equal(history))
+			})
+
+			It("should return ArgumentError without installing the chart when the repository is not registered", func() {
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return(nil, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: cuid.New(), URL: request.RepositoryURL}}, nil)
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+
+			It("should return ArgumentError without installing the chart when the repository is registered with a different URL", func() {
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return(nil, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: request.RepositoryName, URL: "https://" + cuid.New()}}, nil)
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+
+			It("should return ArgumentError without installing the chart when no URL is provided and the repository is not registered", func() {
+				request.RepositoryURL = ""
+
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return(nil, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: cuid.New(), URL: "https://" + cuid.New()}}, nil)
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+
+			It("should return ArgumentError without installing the chart when the chart reference is an absolute URL", func() {
+				request.RepositoryName = "https:"
+				request.RepositoryURL = ""
+				request.Chart = "//any-host/x.tgz"
+
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return(nil, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: cuid.New(), URL: "https://" + cuid.New()}}, nil)
+
+				Ω(request.Validate()).ShouldNot(BeNil())
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+
+			It("should return the error when installing the chart from the registered repository fails", func() {
+				request.RepositoryURL = ""
+				expectedError := errors.New(cuid.New())
+
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return(nil, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: request.RepositoryName, URL: "https://" + cuid.New()}}, nil)
+
+				mockHelmService.
+					EXPECT().
+					InstallChart(kubeconfig, namespace, releaseName, request.RepositoryName, request.Chart, gomock.Any()).
+					Return(expectedError)
+
+				response, err := sut.InstallHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("UpgradeHelmRelease", func() {
+		var (
+			request business.UpgradeHelmReleaseRequest
+		)
+
+		BeforeEach(func() {
+			request = business.UpgradeHelmReleaseRequest{
+				UserEmail:      userEmail,
+				EdgeClusterID:  edgeClusterID,
+				Namespace:      namespace,
+				ReleaseName:    releaseName,
+				RepositoryName: cuid.New(),
+				Chart:          cuid.New(),
+			}
+		})
+
+		When("the helm release does not exist", func() {
+			It("should return ArgumentError without upgrading the chart", func() {
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return([]models.HelmRelease{}, nil)
+
+				response, err := sut.UpgradeHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("the helm release exists", func() {
+			It("should upgrade the chart from the registered repository and return the release history", func() {
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return([]models.HelmRelease{{Name: releaseName}}, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: request.RepositoryName, URL: "https://" + cuid.New()}}, nil)
+
+				mockHelmService.
+					EXPECT().
+					InstallChart(kubeconfig, namespace, releaseName, request.RepositoryName, request.Chart, gomock.Any()).
+					Return(nil)
+
+				mockHelmService.
+					EXPECT().
+					GetReleaseHistory(kubeconfig, namespace, releaseName).
+					Return(history, nil)
+
+				response, err := sut.UpgradeHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Release.ChartVersion).Should(Equal("1.1.0"))
+				Ω(response.History).Should(HaveLen(2))
+			})
+
+			It("should return ArgumentError without upgrading the chart when no URL is provided and the repository is not registered", func() {
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return([]models.HelmRelease{{Name: releaseName}}, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: cuid.New(), URL: "https://" + cuid.New()}}, nil)
+
+				response, err := sut.UpgradeHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+
+			It("should return ArgumentError without upgrading the chart when the chart reference is an absolute URL", func() {
+				request.RepositoryName = "https:"
+				request.Chart = "//any-host/x.tgz"
+
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, namespace).
+					Return([]models.HelmRelease{{Name: releaseName}}, nil)
+
+				mockHelmService.
+					EXPECT().
+					ListRepositories().
+					Return([]models.HelmRepository{{Name: cuid.New(), URL: "https://" + cuid.New()}}, nil)
+
+				Ω(request.Validate()).ShouldNot(BeNil())
+
+				response, err := sut.UpgradeHelmRelease(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Describe("RollbackHelmRelease", func() {
+		It("should roll back the release and return the release history", func() {
+			mockHelmService.
+				EXPECT().
+				RollbackRelease(kubeconfig, namespace, releaseName, 1).
+				Return(nil)
+
+			mockHelmService.
+				EXPECT().
+				GetReleaseHistory(kubeconfig, namespace, releaseName).
+				Return(history, nil)
+
+			response, err := sut.RollbackHelmRelease(ctx, &business.RollbackHelmReleaseRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				Namespace:     namespace,
+				ReleaseName:   releaseName,
+				Revision:      1,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Release).Should(Equal(history[1]))
+		})
+
+		It("should return the error when rolling back the release fails", func() {
+			expectedError := errors.New(cuid.New())
+			mockHelmService.
+				EXPECT().
+				RollbackRelease(kubeconfig, namespace, releaseName, 0).
+				Return(expectedError)
+
+			response, err := sut.RollbackHelmRelease(ctx, &business.RollbackHelmReleaseRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				Namespace:     namespace,
+				ReleaseName:   releaseName,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(Equal(expectedError))
+		})
+	})
+
+	Describe("UninstallHelmRelease", func() {
+		It("should uninstall the release", func() {
+			mockHelmService.
+				EXPECT().
+				UninstallChart(kubeconfig, namespace, releaseName, helm.UninstallChartOptions{
+					DisableHooks: true,
+					Timeout:      time.Minute,
+				}).
+				Return(nil)
+
+			response, err := sut.UninstallHelmRelease(ctx, &business.UninstallHelmReleaseRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				Namespace:     namespace,
+				ReleaseName:   releaseName,
+				DisableHooks:  true,
+				Timeout:       time.Minute,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+		})
+	})
+
+	Describe("ListHelmReleases", func() {
+		It("should return the releases of the given namespace", func() {
+			mockHelmService.
+				EXPECT().
+				ListReleases(kubeconfig, namespace).
+				Return(history[1:], nil)
+
+			response, err := sut.ListHelmReleases(ctx, &business.ListHelmReleasesRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				Namespace:     namespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Releases).Should(Equal(history[1:]))
+		})
+	})
+})
@@ -0,0 +1,143 @@
+package business
+
+import (
+	"context"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+)
+
+// AddHelmRepository registers a helm chart repository or an OCI registry the charts can be installed from. The
+// operation is only allowed for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to add a helm repository
+// Returns either the result of adding the helm repository or error if something goes wrong.
+func (service *businessService) AddHelmRepository(
+	ctx context.Context,
+	request *AddHelmRepositoryRequest) (*AddHelmRepositoryResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "add helm repositories"); err != nil {
+		return &AddHelmRepositoryResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err := service.helmService.AddRepository(request.Name, request.URL); err != nil {
+		return &AddHelmRepositoryResponse{
+			Err: err,
+		}, nil
+	}
+
+	repositories, err := service.helmService.ListRepositories()
+	if err != nil {
+		return &AddHelmRepositoryResponse{
+			Err: err,
+		}, nil
+	}
+
+	repository := models.HelmRepository{
+		Name: request.Name,
+		URL:  request.URL,
+	}
+
+	for _, registeredRepository := range repositories {
+		if registeredRepository.Name == request.Name {
+			repository = registeredRepository
+
+			break
+		}
+	}
+
+	return &AddHelmRepositoryResponse{
+		Repository: repository,
+	}, nil
+}
+
+// RemoveHelmRepository removes a registered helm chart repository or OCI registry. The operation is only allowed
+// for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to remove a helm repository
+// Returns either the result of removing the helm repository or error if something goes wrong.
+func (service *businessService) RemoveHelmRepository(
+	ctx context.Context,
+	request *RemoveHelmRepositoryRequest) (*RemoveHelmRepositoryResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "remove helm repositories"); err != nil {
+		return &RemoveHelmRepositoryResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err := service.helmService.RemoveRepository(request.Name); err != nil {
+		return &RemoveHelmRepositoryResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RemoveHelmRepositoryResponse{}, nil
+}
+
+// ListHelmRepositories lists the registered helm chart repositories and OCI registries. The operation is only
+// allowed for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the helm repositories
+// Returns either the helm repositories with their last index refresh time or error if something goes wrong.
+func (service *businessService) ListHelmRepositories(
+	ctx context.Context,
+	request *ListHelmRepositoriesRequest) (*ListHelmRepositoriesResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "list helm repositories"); err != nil {
+		return &ListHelmRepositoriesResponse{
+			Err: err,
+		}, nil
+	}
+
+	repositories, err := service.helmService.ListRepositories()
+	if err != nil {
+		return &ListHelmRepositoriesResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListHelmRepositoriesResponse{
+		Repositories: repositories,
+	}, nil
+}
+
+// UpdateHelmRepositories downloads the latest index of the registered helm chart repositories. The operation is
+// only allowed for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to update the helm repositories
+// Returns either the updated helm repositories or error if something goes wrong.
+func (service *businessService) UpdateHelmRepositories(
+	ctx context.Context,
+	request *UpdateHelmRepositoriesRequest) (*UpdateHelmRepositoriesResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "update helm repositories"); err != nil {
+		return &UpdateHelmRepositoriesResponse{
+			Err: err,
+		}, nil
+	}
+
+	if _, err := service.helmService.UpdateCharts(); err != nil {
+		return &UpdateHelmRepositoriesResponse{
+			Err: err,
+		}, nil
+	}
+
+	repositories, err := service.helmService.ListRepositories()
+	if err != nil {
+		return &UpdateHelmRepositoriesResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &UpdateHelmRepositoriesResponse{
+		Repositories: repositories,
+	}, nil
+}
+
+// ensureAdmin returns PermissionDeniedError if the user is not one of the configured admin users
+func (service *businessService) ensureAdmin(userEmail, operation string) error {
+	if !service.adminEmails[strings.ToLower(userEmail)] {
+		return NewPermissionDeniedError(userEmail, operation)
+	}
+
+	return nil
+}
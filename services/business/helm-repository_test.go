@@ -0,0 +1,199 @@
+package business_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	accessMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/access/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	jobMock "github.com/decentralized-cloud/edge-cluster/services/job/mock"
+	repsoitoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Helm Repository Business Service Tests", func() {
+	var (
+		mockCtrl        *gomock.Controller
+		sut             business.BusinessContract
+		mockHelmService *helmMock.MockHelmHelperContract
+		ctx             context.Context
+		adminEmail      string
+		userEmail       string
+		repositoryName  string
+		repositoryURL   string
+		repositories    []models.HelmRepository
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		adminEmail = cuid.New() + "@test.com"
+		userEmail = cuid.New() + "@test.com"
+		repositoryName = cuid.New()
+		repositoryURL = "https://" + cuid.New()
+		repositories = []models.HelmRepository{
+			{Name: cuid.New(), URL: "oci://" + cuid.New()},
+			{Name: repositoryName, URL: repositoryURL, LastIndexRefreshTime: time.Now()},
+		}
+
+		mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetAdminEmails().Return([]string{cuid.New() + "@test.com", strings.ToUpper(adminEmail)}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetKubeconfigIssuanceMaxTTL().Return(24*time.Hour, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedCIDRs().Return([]string{"203.0.113.0/24"}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedDomains().Return([]string{"edge.example.com"}, nil).AnyTimes()
+
+		mockHelmService = helmMock.NewMockHelmHelperContract(mockCtrl)
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, _ = business.NewBusinessService(
+			logger,
+			mockConfigurationService,
+			repsoitoryMock.NewMockRepositoryContract(mockCtrl),
+			edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl),
+			jobMock.NewMockJobQueueContract(mockCtrl),
+			eventMock.NewMockEventBusContract(mockCtrl),
+			mockHelmService,
+			fake.NewSimpleClientset(),
+			accessMock.NewMockKubeconfigIssuerContract(mockCtrl))
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("AddHelmRepository", func() {
+		It("should add the repository and return it with its index refresh time", func() {
+			mockHelmService.EXPECT().AddRepository(repositoryName, repositoryURL).Return(nil)
+			mockHelmService.EXPECT().ListRepositories().Return(repositories, nil)
+
+			response, err := sut.AddHelmRepository(ctx, &business.AddHelmRepositoryRequest{
+				UserEmail: adminEmail,
+				Name:      repositoryName,
+				URL:       repositoryURL,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Repository).Should(Equal(repositories[1]))
+		})
+
+		It("should return the error of the helm service", func() {
+			expectedErr := errors.New(cuid.New())
+			mockHelmService.EXPECT().AddRepository(repositoryName, repositoryURL).Return(expectedErr)
+
+			response, err := sut.AddHelmRepository(ctx, &business.AddHelmRepositoryRequest{
+				UserEmail: adminEmail,
+				Name:      repositoryName,
+				URL:       repositoryURL,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(Equal(expectedErr))
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.AddHelmRepository(ctx, &business.AddHelmRepositoryRequest{
+				UserEmail: userEmail,
+				Name:      repositoryName,
+				URL:       repositoryURL,
+			})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+		})
+
+		It("should accept chart repository URLs and OCI registry references as the repository URL", func() {
+			request := business.AddHelmRepositoryRequest{UserEmail: adminEmail, Name: repositoryName}
+			for _, url := range []string{"https://charts.example.com", "oci://registry.example.com/charts"} {
+				request.URL = url
+				Ω(request.Validate()).Should(BeNil())
+			}
+
+			for _, url := range []string{"", "oci://", "not a url"} {
+				request.URL = url
+				Ω(request.Validate()).ShouldNot(BeNil())
+			}
+		})
+	})
+
+	Describe("RemoveHelmRepository", func() {
+		It("should remove the repository", func() {
+			mockHelmService.EXPECT().RemoveRepository(repositoryName).Return(nil)
+
+			response, err := sut.RemoveHelmRepository(ctx, &business.RemoveHelmRepositoryRequest{
+				UserEmail: adminEmail,
+				Name:      repositoryName,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.RemoveHelmRepository(ctx, &business.RemoveHelmRepositoryRequest{
+				UserEmail: userEmail,
+				Name:      repositoryName,
+			})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+		})
+	})
+
+	Describe("ListHelmRepositories", func() {
+		It("should return the repositories", func() {
+			mockHelmService.EXPECT().ListRepositories().Return(repositories, nil)
+
+			response, err := sut.ListHelmRepositories(ctx, &business.ListHelmRepositoriesRequest{UserEmail: adminEmail})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Repositories).Should(Equal(repositories))
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.ListHelmRepositories(ctx, &business.ListHelmRepositoriesRequest{UserEmail: userEmail})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+		})
+	})
+
+	Describe("UpdateHelmRepositories", func() {
+		It("should update the charts and return the repositories", func() {
+			gomock.InOrder(
+				mockHelmService.EXPECT().UpdateCharts().Return(helm.RepositoryUpdateReport{}, nil),
+				mockHelmService.EXPECT().ListRepositories().Return(repositories, nil),
+			)
+
+			response, err := sut.UpdateHelmRepositories(ctx, &business.UpdateHelmRepositoriesRequest{UserEmail: adminEmail})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Repositories).Should(Equal(repositories))
+		})
+
+		It("should return the error of updating the charts", func() {
+			expectedErr := errors.New(cuid.New())
+			mockHelmService.EXPECT().UpdateCharts().Return(helm.RepositoryUpdateReport{}, expectedErr)
+
+			response, err := sut.UpdateHelmRepositories(ctx, &business.UpdateHelmRepositoriesRequest{UserEmail: adminEmail})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(Equal(expectedErr))
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.UpdateHelmRepositories(ctx, &business.UpdateHelmRepositoriesRequest{UserEmail: userEmail})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+		})
+	})
+})
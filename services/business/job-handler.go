@@ -0,0 +1,328 @@
+// Package business implements different business services required by the edge-cluster service
+package business
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type provisioningJobHandlerService struct {
+	logger                    *zap.Logger
+	repositoryService         repository.RepositoryContract
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract
+	eventBusService           event.EventBusContract
+	uninstallCharts           bool
+	uninstallChartOptions     helm.UninstallChartOptions
+}
+
+// NewProvisioningJobHandlerService creates new instance of the provisioningJobHandlerService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// repositoryService: Mandatory. Reference to the repository service that can persist the edge cluster related data
+// edgeClusterFactoryService: Mandatory. Reference to the factory service that can that can create different type of supported
+// edge cluster provisioner
+// eventBusService: Mandatory. Reference to the event bus the provisioning status changes are published to
+// Returns the new service or error if something goes wrong
+func NewProvisioningJobHandlerService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	repositoryService repository.RepositoryContract,
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract,
+	eventBusService event.EventBusContract) (job.JobHandlerContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	if edgeClusterFactoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterFactoryService", "edgeClusterFactoryService is required")
+	}
+
+	if eventBusService == nil {
+		return nil, commonErrors.NewArgumentNilError("eventBusService", "eventBusService is required")
+	}
+
+	uninstallCharts, err := configurationService.GetDeleteProvisionUninstallCharts()
+	if err != nil {
+		return nil, err
+	}
+
+	uninstallTimeout, err := configurationService.GetDeleteProvisionUninstallTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	disableHooks, err := configurationService.GetDeleteProvisionDisableHooks()
+	if err != nil {
+		return nil, err
+	}
+
+	return &provisioningJobHandlerService{
+		logger:                    logger,
+		repositoryService:         repositoryService,
+		edgeClusterFactoryService: edgeClusterFactoryService,
+		eventBusService:           eventBusService,
+		uninstallCharts:           uninstallCharts,
+		uninstallChartOptions: helm.UninstallChartOptions{
+			DisableHooks: disableHooks,
+			Timeout:      uninstallTimeout,
+		},
+	}, nil
+}
+
+// HandleJob executes the given provisioning job. The provisioning status is only marked as failed if the job is not
+// retried, so the status does not flip between failed and provisioning while the job is retried.
+// ctx: Mandatory The reference to the context. It is cancelled if the worker loses the lease or is stopped
+// request: Mandatory. The request contains the job to execute
+// Returns either the result of executing the job or error if something goes wrong.
+func (service *provisioningJobHandlerService) HandleJob(
+	ctx context.Context,
+	request *job.HandleJobRequest) (*job.HandleJobResponse, error) {
+	var err error
+
+	switch request.Job.Type {
+	case job.JobTypeCreateProvision, job.JobTypeUpdateProvision:
+		err = service.provision(ctx, request)
+	case job.JobTypeDeleteProvision:
+		err = service.deleteProvision(ctx, request)
+	default:
+		err = commonErrors.NewUnknownError("job type is not supported")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &job.HandleJobResponse{}, nil
+}
+
+// provision creates or updates the provision of the edge cluster using the latest stored edge cluster details
+func (service *provisioningJobHandlerService) provision(ctx context.Context, request *job.HandleJobRequest) error {
+	provisioningJob := request.Job
+	repositoryResponse, err := service.repositoryService.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+		UserEmail:     provisioningJob.UserEmail,
+		EdgeClusterID: provisioningJob.EdgeClusterID,
+	})
+
+	if commonErrors.IsNotFoundError(err) {
+		service.logger.Info(
+			"edge cluster no longer exists, skipping the provisioning",
+			zap.String("edgeClusterID", provisioningJob.EdgeClusterID))
+
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if repositoryResponse.ProvisioningState.Status == models.ProvisioningStatusDeleting {
+		service.logger.Info(
+			"edge cluster is being deleted, skipping the provisioning",
+			zap.String("edgeClusterID", provisioningJob.EdgeClusterID))
+
+		return nil
+	}
+
+	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, repositoryResponse.EdgeCluster.ClusterType)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	}
+
+	service.updateProvisioningState(ctx, provisioningJob.EdgeClusterID, models.ProvisioningStatusProvisioning, nil)
+
+	statusReporter := func(status models.ProvisioningStatus) {
+		service.updateProvisioningState(ctx, provisioningJob.EdgeClusterID, status, nil)
+	}
+
+	if provisioningJob.Type == job.JobTypeCreateProvision {
+		_, err = edgeClusterProvisioner.CreateProvision(
+			ctx,
+			&edgeClusterTypes.CreateProvisionRequest{
+				EdgeClusterID:   provisioningJob.EdgeClusterID,
+				ClusterSecret:   repositoryResponse.EdgeCluster.ClusterSecret,
+				StatusReporter:  statusReporter,
+				ProjectID:       repositoryResponse.EdgeCluster.ProjectID,
+				UserEmail:       provisioningJob.UserEmail,
+				EdgeClusterName: repositoryResponse.EdgeCluster.Name,
+				ServiceExposure: repositoryResponse.EdgeCluster.ServiceExposure,
+			})
+	} else {
+		_, err = edgeClusterProvisioner.UpdateProvisionWithRetry(
+			ctx,
+			&edgeClusterTypes.UpdateProvisionRequest{
+				EdgeClusterID:   provisioningJob.EdgeClusterID,
+				ClusterSecret:   repositoryResponse.EdgeCluster.ClusterSecret,
+				StatusReporter:  statusReporter,
+				ProjectID:       repositoryResponse.EdgeCluster.ProjectID,
+				UserEmail:       provisioningJob.UserEmail,
+				EdgeClusterName: repositoryResponse.EdgeCluster.Name,
+				ServiceExposure: repositoryResponse.EdgeCluster.ServiceExposure,
+			})
+	}
+
+	if err != nil {
+		return service.reportFailure(ctx, request, err)
+	}
+
+	service.updateProvisioningState(ctx, provisioningJob.EdgeClusterID, models.ProvisioningStatusReady, nil)
+
+	return nil
+}
+
+// deleteProvision deletes the provision of the edge cluster and then removes the edge cluster from the repository.
+// The resources that failed to clean up before the namespace was deleted are logged and published, but do not fail the job.
+func (service *provisioningJobHandlerService) deleteProvision(ctx context.Context, request *job.HandleJobRequest) error {
+	provisioningJob := request.Job
+	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, provisioningJob.ClusterType)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	}
+
+	deleteProvisionResponse, err := edgeClusterProvisioner.DeleteProvision(
+		ctx,
+		&edgeClusterTypes.DeleteProvisionRequest{
+			EdgeClusterID:         provisioningJob.EdgeClusterID,
+			UninstallCharts:       service.uninstallCharts,
+			UninstallChartOptions: service.uninstallChartOptions,
+		})
+	if err != nil {
+		return service.reportFailure(ctx, request, err)
+	}
+
+	for _, failedCleanup := range deleteProvisionResponse.FailedCleanups {
+		service.reportFailedCleanup(ctx, provisioningJob.EdgeClusterID, failedCleanup)
+	}
+
+	if _, err = service.repositoryService.DeleteEdgeCluster(ctx, &repository.DeleteEdgeClusterRequest{
+		UserEmail:     provisioningJob.UserEmail,
+		EdgeClusterID: provisioningJob.EdgeClusterID,
+	}); err != nil && !commonErrors.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+// reportFailedCleanup logs and publishes a resource of the edge cluster that failed to clean up
+func (service *provisioningJobHandlerService) reportFailedCleanup(
+	ctx context.Context,
+	edgeClusterID string,
+	failedCleanup models.CleanupFailure) {
+	service.logger.Warn(
+		"failed to clean up the edge cluster resource",
+		zap.String("edgeClusterID", edgeClusterID),
+		zap.String("resource", failedCleanup.Resource),
+		zap.String("message", failedCleanup.Message))
+
+	if _, err := service.eventBusService.Publish(ctx, &event.PublishRequest{
+		Event: models.EdgeClusterEvent{
+			EdgeClusterID: edgeClusterID,
+			Type:          models.EdgeClusterEventTypeCleanupFailed,
+			Status:        models.ProvisioningStatusDeleting,
+			Message:       fmt.Sprintf("%s: %s", failedCleanup.Resource, failedCleanup.Message),
+		},
+	}); err != nil {
+		service.logger.Error(
+			"failed to publish the edge cluster cleanup failure",
+			zap.Error(err),
+			zap.String("edgeClusterID", edgeClusterID))
+	}
+}
+
+// reportFailure marks the provisioning status of the edge cluster as failed if the job is not retried, and returns
+// the error the job failed with. The edge cluster details that are rejected by the provisioner fail the same way on
+// every attempt, so the job is not retried. Nothing is persisted if the job was cancelled, as the worker either lost
+// the lease to another worker or is stopping, and the job is resumed.
+func (service *provisioningJobHandlerService) reportFailure(
+	ctx context.Context,
+	request *job.HandleJobRequest,
+	err error) error {
+	if ctx.Err() != nil {
+		return err
+	}
+
+	if commonErrors.IsArgumentError(err) {
+		err = job.NewNonRetryableError(err)
+	}
+
+	if request.FinalAttempt || job.IsNonRetryableError(err) {
+		service.updateProvisioningState(ctx, request.Job.EdgeClusterID, models.ProvisioningStatusFailed, err)
+	}
+
+	return err
+}
+
+func (service *provisioningJobHandlerService) updateProvisioningState(
+	ctx context.Context,
+	edgeClusterID string,
+	status models.ProvisioningStatus,
+	provisioningErr error) {
+	updateProvisioningState(
+		ctx,
+		service.logger,
+		service.repositoryService,
+		service.eventBusService,
+		edgeClusterID,
+		status,
+		provisioningErr)
+}
+
+// updateProvisioningState persists the new provisioning status of the given edge cluster and publishes the status
+// change to the event bus. Failing to persist or publish the provisioning state is logged and does not interrupt
+// the provisioning.
+func updateProvisioningState(
+	ctx context.Context,
+	logger *zap.Logger,
+	repositoryService repository.RepositoryContract,
+	eventBusService event.EventBusContract,
+	edgeClusterID string,
+	status models.ProvisioningStatus,
+	provisioningErr error) {
+	lastErrorMessage := ""
+	if provisioningErr != nil {
+		lastErrorMessage = provisioningErr.Error()
+	}
+
+	if _, err := repositoryService.UpdateProvisioningState(ctx, &repository.UpdateProvisioningStateRequest{
+		EdgeClusterID:    edgeClusterID,
+		Status:           status,
+		LastErrorMessage: lastErrorMessage,
+	}); err != nil {
+		logger.Error(
+			"failed to update the edge cluster provisioning state",
+			zap.Error(err),
+			zap.String("edgeClusterID", edgeClusterID))
+
+		return
+	}
+
+	if _, err := eventBusService.Publish(ctx, &event.PublishRequest{
+		Event: models.EdgeClusterEvent{
+			EdgeClusterID: edgeClusterID,
+			Type:          models.EdgeClusterEventTypeStatusChanged,
+			Status:        status,
+			Message:       lastErrorMessage,
+		},
+	}); err != nil {
+		logger.Error(
+			"failed to publish the edge cluster provisioning status change",
+			zap.Error(err),
+			zap.String("edgeClusterID", edgeClusterID))
+	}
+}
@@ -0,0 +1,421 @@
+package business_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	repository "github.com/decentralized-cloud/edge-cluster/services/repository"
+	repsoitoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Provisioning Job Handler Service Tests", func() {
+	var (
+		mockCtrl                          *gomock.Controller
+		sut                               job.JobHandlerContract
+		mockConfigurationService          *configurationMock.MockConfigurationContract
+		mockRepositoryService             *repsoitoryMock.MockRepositoryContract
+		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
+		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockEventBusService               *eventMock.MockEventBusContract
+		ctx                               context.Context
+		logger                            *zap.Logger
+		provisioningStatuses              []models.ProvisioningStatus
+		publishedStatuses                 []models.ProvisioningStatus
+		publishedCleanupFailures          []string
+		edgeCluster                       models.EdgeCluster
+		provisioningJob                   job.Job
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+
+		provisioningStatuses = []models.ProvisioningStatus{}
+		publishedStatuses = []models.ProvisioningStatus{}
+		publishedCleanupFailures = []string{}
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetDeleteProvisionUninstallCharts().Return(true, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetDeleteProvisionUninstallTimeout().Return(2*time.Minute, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetDeleteProvisionDisableHooks().Return(true, nil).AnyTimes()
+
+		mockRepositoryService = repsoitoryMock.NewMockRepositoryContract(mockCtrl)
+		mockRepositoryService.
+			EXPECT().
+			UpdateProvisioningState(gomock.Any(), gomock.Any()).
+			DoAndReturn(
+				func(
+					_ context.Context,
+					mappedRequest *repository.UpdateProvisioningStateRequest) (*repository.UpdateProvisioningStateResponse, error) {
+					provisioningStatuses = append(provisioningStatuses, mappedRequest.Status)
+
+					return &repository.UpdateProvisioningStateResponse{}, nil
+				}).
+			AnyTimes()
+
+		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		mockEdgeClusterFactoryService = edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		mockEventBusService = eventMock.NewMockEventBusContract(mockCtrl)
+		mockEventBusService.
+			EXPECT().
+			Publish(gomock.Any(), gomock.Any()).
+			DoAndReturn(
+				func(
+					_ context.Context,
+					mappedRequest *event.PublishRequest) (*event.PublishResponse, error) {
+					Ω(mappedRequest.Event.EdgeClusterID).Should(Equal(provisioningJob.EdgeClusterID))
+
+					if mappedRequest.Event.Type == models.EdgeClusterEventTypeCleanupFailed {
+						publishedCleanupFailures = append(publishedCleanupFailures, mappedRequest.Event.Message)
+
+						return &event.PublishResponse{}, nil
+					}
+
+					Ω(mappedRequest.Event.Type).Should(Equal(models.EdgeClusterEventTypeStatusChanged))
+					publishedStatuses = append(publishedStatuses, mappedRequest.Event.Status)
+
+					return &event.PublishResponse{}, nil
+				}).
+			AnyTimes()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, _ = business.NewProvisioningJobHandlerService(
+			logger,
+			mockConfigurationService,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService,
+			mockEventBusService,
+		)
+
+		edgeCluster = models.EdgeCluster{
+			ProjectID:     cuid.New(),
+			Name:          cuid.New(),
+			ClusterSecret: cuid.New(),
+			ClusterType:   models.K3S,
+		}
+
+		provisioningJob = job.Job{
+			JobID:         cuid.New(),
+			EdgeClusterID: cuid.New(),
+			UserEmail:     cuid.New() + "@test.com",
+			ClusterType:   models.K3S,
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate ProvisioningJobHandlerService", func() {
+		When("logger is not provided and NewProvisioningJobHandlerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewProvisioningJobHandlerService(
+					nil,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockEventBusService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("logger", "", err)
+			})
+		})
+
+		When("configuration service is not provided and NewProvisioningJobHandlerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewProvisioningJobHandlerService(
+					logger,
+					nil,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockEventBusService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("edge cluster repository service is not provided and NewProvisioningJobHandlerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewProvisioningJobHandlerService(
+					logger,
+					mockConfigurationService,
+					nil,
+					mockEdgeClusterFactoryService,
+					mockEventBusService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("repositoryService", "", err)
+			})
+		})
+
+		When("edge cluster factory service is not provided and NewProvisioningJobHandlerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewProvisioningJobHandlerService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					nil,
+					mockEventBusService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("edgeClusterFactoryService", "", err)
+			})
+		})
+
+		When("event bus service is not provided and NewProvisioningJobHandlerService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewProvisioningJobHandlerService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("eventBusService", "", err)
+			})
+		})
+	})
+
+	Describe("HandleJob is called with a create provision job", func() {
+		BeforeEach(func() {
+			provisioningJob.Type = job.JobTypeCreateProvision
+		})
+
+		When("the edge cluster exists", func() {
+			BeforeEach(func() {
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+						UserEmail:     provisioningJob.UserEmail,
+						EdgeClusterID: provisioningJob.EdgeClusterID,
+					}).
+					Return(&repository.ReadEdgeClusterResponse{EdgeCluster: edgeCluster}, nil)
+			})
+
+			It("should provision the edge cluster and mark it as ready", func() {
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					CreateProvision(ctx, gomock.Any()).
+					DoAndReturn(
+						func(
+							_ context.Context,
+							mappedRequest *edgeClusterTypes.CreateProvisionRequest) (*edgeClusterTypes.CreateProvisionResponse, error) {
+							Ω(mappedRequest.EdgeClusterID).Should(Equal(provisioningJob.EdgeClusterID))
+							Ω(mappedRequest.ClusterSecret).Should(Equal(edgeCluster.ClusterSecret))
+							Ω(mappedRequest.ProjectID).Should(Equal(edgeCluster.ProjectID))
+							Ω(mappedRequest.UserEmail).Should(Equal(provisioningJob.UserEmail))
+							Ω(mappedRequest.EdgeClusterName).Should(Equal(edgeCluster.Name))
+							mappedRequest.StatusReporter(models.ProvisioningStatusInstallingCharts)
+
+							return &edgeClusterTypes.CreateProvisionResponse{}, nil
+						})
+
+				response, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+				Ω(err).Should(BeNil())
+				Ω(response).ShouldNot(BeNil())
+				Ω(provisioningStatuses).Should(Equal([]models.ProvisioningStatus{
+					models.ProvisioningStatusProvisioning,
+					models.ProvisioningStatusInstallingCharts,
+					models.ProvisioningStatusReady,
+				}))
+				Ω(publishedStatuses).Should(Equal(provisioningStatuses))
+			})
+
+			It("should mark the edge cluster as failed and return the error if the final attempt fails", func() {
+				expectedError := errors.New(cuid.New())
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					CreateProvision(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob, FinalAttempt: true})
+				Ω(err).Should(Equal(expectedError))
+				Ω(response).Should(BeNil())
+				Ω(provisioningStatuses).Should(Equal([]models.ProvisioningStatus{
+					models.ProvisioningStatusProvisioning,
+					models.ProvisioningStatusFailed,
+				}))
+			})
+
+			It("should not mark the edge cluster as failed if the job is retried", func() {
+				expectedError := errors.New(cuid.New())
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					CreateProvision(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+				Ω(err).Should(Equal(expectedError))
+				Ω(response).Should(BeNil())
+				Ω(provisioningStatuses).Should(Equal([]models.ProvisioningStatus{models.ProvisioningStatusProvisioning}))
+			})
+
+			It("should mark the edge cluster as failed and return NonRetryableError if the provisioner rejects the edge cluster", func() {
+				expectedError := commonErrors.NewArgumentError("serviceExposure", cuid.New())
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					CreateProvision(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+				Ω(job.IsNonRetryableError(err)).Should(BeTrue())
+				Ω(errors.Unwrap(err)).Should(Equal(expectedError))
+				Ω(response).Should(BeNil())
+				Ω(provisioningStatuses).Should(Equal([]models.ProvisioningStatus{
+					models.ProvisioningStatusProvisioning,
+					models.ProvisioningStatusFailed,
+				}))
+			})
+		})
+
+		When("the edge cluster no longer exists", func() {
+			It("should complete without provisioning", func() {
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), gomock.Any()).
+					Return(nil, commonErrors.NewNotFoundError())
+
+				response, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+				Ω(err).Should(BeNil())
+				Ω(response).ShouldNot(BeNil())
+				Ω(provisioningStatuses).Should(BeEmpty())
+			})
+		})
+	})
+
+	Describe("HandleJob is called with an update provision job", func() {
+		It("should update the provision using the stored cluster secret", func() {
+			provisioningJob.Type = job.JobTypeUpdateProvision
+			mockRepositoryService.
+				EXPECT().
+				ReadEdgeCluster(gomock.Any(), gomock.Any()).
+				Return(&repository.ReadEdgeClusterResponse{EdgeCluster: edgeCluster}, nil)
+
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				UpdateProvisionWithRetry(ctx, gomock.Any()).
+				DoAndReturn(
+					func(
+						_ context.Context,
+						mappedRequest *edgeClusterTypes.UpdateProvisionRequest) (*edgeClusterTypes.UpdateProvisionResponse, error) {
+						Ω(mappedRequest.EdgeClusterID).Should(Equal(provisioningJob.EdgeClusterID))
+						Ω(mappedRequest.ClusterSecret).Should(Equal(edgeCluster.ClusterSecret))
+
+						return &edgeClusterTypes.UpdateProvisionResponse{}, nil
+					})
+
+			_, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+			Ω(err).Should(BeNil())
+			Ω(provisioningStatuses).Should(Equal([]models.ProvisioningStatus{
+				models.ProvisioningStatusProvisioning,
+				models.ProvisioningStatusReady,
+			}))
+		})
+	})
+
+	Describe("HandleJob is called with a delete provision job", func() {
+		BeforeEach(func() {
+			provisioningJob.Type = job.JobTypeDeleteProvision
+		})
+
+		It("should delete the provision and then the edge cluster", func() {
+			gomock.InOrder(
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					DeleteProvision(ctx, &edgeClusterTypes.DeleteProvisionRequest{
+						EdgeClusterID:   provisioningJob.EdgeClusterID,
+						UninstallCharts: true,
+						UninstallChartOptions: helm.UninstallChartOptions{
+							DisableHooks: true,
+							Timeout:      2 * time.Minute,
+						},
+					}).
+					Return(&edgeClusterTypes.DeleteProvisionResponse{}, nil),
+				mockRepositoryService.
+					EXPECT().
+					DeleteEdgeCluster(ctx, &repository.DeleteEdgeClusterRequest{
+						UserEmail:     provisioningJob.UserEmail,
+						EdgeClusterID: provisioningJob.EdgeClusterID,
+					}).
+					Return(&repository.DeleteEdgeClusterResponse{}, nil),
+			)
+
+			_, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+			Ω(err).Should(BeNil())
+			Ω(publishedCleanupFailures).Should(BeEmpty())
+		})
+
+		It("should report the resources that failed to clean up and still delete the edge cluster", func() {
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				DeleteProvision(gomock.Any(), gomock.Any()).
+				Return(&edgeClusterTypes.DeleteProvisionResponse{
+					FailedCleanups: []models.CleanupFailure{
+						{Resource: "helm-release/monitoring/prometheus", Message: "timed out waiting for the condition"},
+						{Resource: "helm-release/ingress/traefik", Message: "pre-delete hook failed"},
+					},
+				}, nil)
+
+			mockRepositoryService.
+				EXPECT().
+				DeleteEdgeCluster(gomock.Any(), gomock.Any()).
+				Return(&repository.DeleteEdgeClusterResponse{}, nil)
+
+			_, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob})
+			Ω(err).Should(BeNil())
+			Ω(publishedCleanupFailures).Should(Equal([]string{
+				"helm-release/monitoring/prometheus: timed out waiting for the condition",
+				"helm-release/ingress/traefik: pre-delete hook failed",
+			}))
+			Ω(provisioningStatuses).Should(BeEmpty())
+		})
+
+		It("should keep the edge cluster and mark it as failed if deleting the provision fails", func() {
+			expectedError := errors.New(cuid.New())
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				DeleteProvision(gomock.Any(), gomock.Any()).
+				Return(nil, expectedError)
+
+			_, err := sut.HandleJob(ctx, &job.HandleJobRequest{Job: provisioningJob, FinalAttempt: true})
+			Ω(err).Should(Equal(expectedError))
+			Ω(provisioningStatuses).Should(Equal([]models.ProvisioningStatus{models.ProvisioningStatusFailed}))
+		})
+
+		It("should not mark the edge cluster as failed if the job is cancelled", func() {
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				DeleteProvision(gomock.Any(), gomock.Any()).
+				Return(nil, context.Canceled)
+
+			_, err := sut.HandleJob(cancelledCtx, &job.HandleJobRequest{Job: provisioningJob, FinalAttempt: true})
+			Ω(err).Should(Equal(context.Canceled))
+			Ω(provisioningStatuses).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,219 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+// IssueKubeconfig issues a kubeconfig granting a role on an existing edge cluster that expires after the requested
+// time. The issuance is tracked so the kubeconfig can be revoked before it expires.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to issue a kubeconfig
+// Returns either the issued kubeconfig or error if something goes wrong.
+func (service *businessService) IssueKubeconfig(
+	ctx context.Context,
+	request *IssueKubeconfigRequest) (*IssueKubeconfigResponse, error) {
+	if request.TTL > service.kubeconfigIssuanceMaxTTL {
+		return &IssueKubeconfigResponse{
+			Err: commonErrors.NewArgumentError(
+				"ttl",
+				fmt.Sprintf("the kubeconfig cannot be valid for longer than %s", service.kubeconfigIssuanceMaxTTL)),
+		}, nil
+	}
+
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &IssueKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	clientset, err := provision.NewClientsetForKubeconfig(kubeconfig)
+	if err != nil {
+		return &IssueKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	issuedAt := time.Now()
+	issuance := models.KubeconfigIssuance{
+		EdgeClusterID: request.EdgeClusterID,
+		UserEmail:     request.UserEmail,
+		Role:          request.Role,
+		Namespace:     request.Namespace,
+		IssuedAt:      issuedAt,
+		ExpiresAt:     issuedAt.Add(request.TTL),
+	}
+
+	// The issuance is tracked before the access is granted, so no kubeconfig is ever issued without a record it can
+	// be revoked through
+	repositoryResponse, err := service.repositoryService.CreateKubeconfigIssuance(
+		ctx,
+		&repository.CreateKubeconfigIssuanceRequest{Issuance: issuance})
+	if err != nil {
+		return &IssueKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	issueKubeconfigResponse, err := service.kubeconfigIssuerService.IssueKubeconfig(
+		ctx,
+		&access.IssueKubeconfigRequest{
+			Clientset:       clientset,
+			AdminKubeconfig: kubeconfig,
+			IssuanceID:      repositoryResponse.IssuanceID,
+			Role:            request.Role,
+			Namespace:       request.Namespace,
+			TTL:             request.TTL,
+		})
+	if err != nil {
+		if _, revokeErr := service.repositoryService.RevokeKubeconfigIssuance(
+			ctx,
+			&repository.RevokeKubeconfigIssuanceRequest{
+				UserEmail:     request.UserEmail,
+				EdgeClusterID: request.EdgeClusterID,
+				IssuanceID:    repositoryResponse.IssuanceID,
+				RevokedAt:     time.Now(),
+			}); revokeErr != nil {
+			service.logger.Error(
+				"failed to record the failed kubeconfig issuance as revoked",
+				zap.Error(revokeErr),
+				zap.String("issuanceID", repositoryResponse.IssuanceID))
+		}
+
+		return &IssueKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	// The API server can issue the token for a different time than requested, so the time the token actually expires
+	// at is recorded. The kubeconfig is still returned if it cannot be recorded, as the access is then cleaned up once
+	// the requested time passes, which does not keep the access granted for longer than requested.
+	issuance.ExpiresAt = issueKubeconfigResponse.ExpiresAt
+	if _, err = service.repositoryService.UpdateKubeconfigIssuanceExpiry(
+		ctx,
+		&repository.UpdateKubeconfigIssuanceExpiryRequest{
+			UserEmail:     request.UserEmail,
+			EdgeClusterID: request.EdgeClusterID,
+			IssuanceID:    repositoryResponse.IssuanceID,
+			ExpiresAt:     issuance.ExpiresAt,
+		}); err != nil {
+		service.logger.Warn(
+			"failed to record the expiry time of the issued kubeconfig",
+			zap.Error(err),
+			zap.String("issuanceID", repositoryResponse.IssuanceID))
+	}
+
+	return &IssueKubeconfigResponse{
+		IssuanceID:        repositoryResponse.IssuanceID,
+		Issuance:          issuance,
+		KubeconfigContent: issueKubeconfigResponse.KubeconfigContent,
+	}, nil
+}
+
+// RevokeKubeconfig revokes the access a kubeconfig issued for an existing edge cluster grants
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to revoke an issued kubeconfig
+// Returns either the revoked issuance or error if something goes wrong.
+func (service *businessService) RevokeKubeconfig(
+	ctx context.Context,
+	request *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error) {
+	readResponse, err := service.repositoryService.ReadKubeconfigIssuance(
+		ctx,
+		&repository.ReadKubeconfigIssuanceRequest{
+			UserEmail:     request.UserEmail,
+			EdgeClusterID: request.EdgeClusterID,
+			IssuanceID:    request.IssuanceID,
+		})
+	if err != nil {
+		return &RevokeKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &RevokeKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	clientset, err := provision.NewClientsetForKubeconfig(kubeconfig)
+	if err != nil {
+		return &RevokeKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	// The access is revoked on the edge cluster even if the issuance is already recorded as revoked, so revoking
+	// again cleans up whatever a previous attempt left behind
+	if _, err = service.kubeconfigIssuerService.RevokeKubeconfig(
+		ctx,
+		&access.RevokeKubeconfigRequest{
+			Clientset:  clientset,
+			IssuanceID: request.IssuanceID,
+			Namespace:  readResponse.Issuance.Namespace,
+		}); err != nil {
+		return &RevokeKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	if !readResponse.Issuance.RevokedAt.IsZero() {
+		return &RevokeKubeconfigResponse{
+			IssuanceID: request.IssuanceID,
+			Issuance:   readResponse.Issuance,
+		}, nil
+	}
+
+	revokeResponse, err := service.repositoryService.RevokeKubeconfigIssuance(
+		ctx,
+		&repository.RevokeKubeconfigIssuanceRequest{
+			UserEmail:     request.UserEmail,
+			EdgeClusterID: request.EdgeClusterID,
+			IssuanceID:    request.IssuanceID,
+			RevokedAt:     time.Now(),
+		})
+	if err != nil {
+		return &RevokeKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &RevokeKubeconfigResponse{
+		IssuanceID: request.IssuanceID,
+		Issuance:   revokeResponse.Issuance,
+	}, nil
+}
+
+// ListKubeconfigIssuances lists the kubeconfigs issued for an existing edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the issued kubeconfigs
+// Returns either the issued kubeconfigs or error if something goes wrong.
+func (service *businessService) ListKubeconfigIssuances(
+	ctx context.Context,
+	request *ListKubeconfigIssuancesRequest) (*ListKubeconfigIssuancesResponse, error) {
+	repositoryResponse, err := service.repositoryService.ListKubeconfigIssuances(
+		ctx,
+		&repository.ListKubeconfigIssuancesRequest{
+			UserEmail:     request.UserEmail,
+			EdgeClusterID: request.EdgeClusterID,
+		})
+	if err != nil {
+		return &ListKubeconfigIssuancesResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListKubeconfigIssuancesResponse{
+		Issuances: repositoryResponse.Issuances,
+	}, nil
+}
@@ -0,0 +1,444 @@
+package business_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	accessMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/access/mock"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	jobMock "github.com/decentralized-cloud/edge-cluster/services/job/mock"
+	repository "github.com/decentralized-cloud/edge-cluster/services/repository"
+	repsoitoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const edgeClusterKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: default
+  cluster:
+    server: https://10.0.0.1:6443
+users:
+- name: default
+  user:
+    token: admin
+contexts:
+- name: default
+  context:
+    cluster: default
+    user: default
+current-context: default
+`
+
+var _ = Describe("Kubeconfig Issuance Business Service Tests", func() {
+	var (
+		mockCtrl                    *gomock.Controller
+		sut                         business.BusinessContract
+		mockRepositoryService       *repsoitoryMock.MockRepositoryContract
+		mockKubeconfigIssuerService *accessMock.MockKubeconfigIssuerContract
+		ctx                         context.Context
+		userEmail                   string
+		edgeClusterID               string
+		issuanceID                  string
+		namespace                   string
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		userEmail = cuid.New() + "@test.com"
+		edgeClusterID = cuid.New()
+		issuanceID = cuid.New()
+		namespace = cuid.New()
+
+		mockRepositoryService = repsoitoryMock.NewMockRepositoryContract(mockCtrl)
+		mockRepositoryService.
+			EXPECT().
+			ReadEdgeCluster(gomock.Any(), &repository.ReadEdgeClusterRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+			}).
+			Return(&repository.ReadEdgeClusterResponse{
+				EdgeCluster: models.EdgeCluster{ClusterType: models.K3S},
+			}, nil).
+			AnyTimes()
+
+		mockEdgeClusterProvisionerService := edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		mockEdgeClusterProvisionerService.
+			EXPECT().
+			GetProvisionDetails(gomock.Any(), &edgeClusterTypes.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID}).
+			Return(&edgeClusterTypes.GetProvisionDetailsResponse{
+				ProvisionDetails: models.ProvisionDetails{KubeconfigContent: edgeClusterKubeconfig},
+			}, nil).
+			AnyTimes()
+
+		mockEdgeClusterFactoryService := edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		mockKubeconfigIssuerService = accessMock.NewMockKubeconfigIssuerContract(mockCtrl)
+
+		mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetAdminEmails().Return([]string{}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetKubeconfigIssuanceMaxTTL().Return(24*time.Hour, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedCIDRs().Return([]string{"203.0.113.0/24"}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedDomains().Return([]string{"edge.example.com"}, nil).AnyTimes()
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, _ = business.NewBusinessService(
+			logger,
+			mockConfigurationService,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService,
+			jobMock.NewMockJobQueueContract(mockCtrl),
+			eventMock.NewMockEventBusContract(mockCtrl),
+			helmMock.NewMockHelmHelperContract(mockCtrl),
+			fake.NewSimpleClientset(),
+			mockKubeconfigIssuerService)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("IssueKubeconfig", func() {
+		var request business.IssueKubeconfigRequest
+
+		BeforeEach(func() {
+			request = business.IssueKubeconfigRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				Role:          models.KubeconfigRoleEdit,
+				Namespace:     namespace,
+				TTL:           time.Hour,
+			}
+		})
+
+		It("should reject the roles that are not supported and the TTLs shorter than the service account tokens", func() {
+			Ω(request.Validate()).Should(BeNil())
+
+			invalidRequest := request
+			invalidRequest.Role = models.KubeconfigRole(100)
+			Ω(invalidRequest.Validate()).ShouldNot(BeNil())
+
+			invalidRequest = request
+			invalidRequest.TTL = access.MinTTL - time.Second
+			Ω(invalidRequest.Validate()).ShouldNot(BeNil())
+
+			invalidRequest = request
+			invalidRequest.TTL = 0
+			Ω(invalidRequest.Validate()).ShouldNot(BeNil())
+		})
+
+		It("should reject the namespaces that are not valid namespace names", func() {
+			for _, invalidNamespace := range []string{"Default", "kube_system", "-namespace", "namespace.with.dots", strings.Repeat("n", 64)} {
+				invalidRequest := request
+				invalidRequest.Namespace = invalidNamespace
+				Ω(invalidRequest.Validate()).ShouldNot(BeNil(), invalidNamespace)
+			}
+
+			allNamespacesRequest := request
+			allNamespacesRequest.Namespace = ""
+			Ω(allNamespacesRequest.Validate()).Should(BeNil())
+		})
+
+		When("the TTL is longer than the configured maximum", func() {
+			It("should return ArgumentError without issuing the kubeconfig", func() {
+				request.TTL = 25 * time.Hour
+
+				response, err := sut.IssueKubeconfig(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+			})
+		})
+
+		When("the edge cluster does not exist", func() {
+			It("should return the repository error", func() {
+				request.UserEmail = cuid.New() + "@test.com"
+				expectedError := commonErrors.NewNotFoundError()
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.IssueKubeconfig(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+
+		It("should track the issuance and return the issued kubeconfig with the time its token expires at", func() {
+			kubeconfigContent := cuid.New()
+			expiresAt := time.Now().Add(50 * time.Minute)
+
+			gomock.InOrder(
+				mockRepositoryService.
+					EXPECT().
+					CreateKubeconfigIssuance(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, repositoryRequest *repository.CreateKubeconfigIssuanceRequest) (*repository.CreateKubeconfigIssuanceResponse, error) {
+						issuance := repositoryRequest.Issuance
+						Ω(issuance.UserEmail).Should(Equal(userEmail))
+						Ω(issuance.EdgeClusterID).Should(Equal(edgeClusterID))
+						Ω(issuance.Role).Should(Equal(models.KubeconfigRoleEdit))
+						Ω(issuance.Namespace).Should(Equal(namespace))
+						Ω(issuance.ExpiresAt.Sub(issuance.IssuedAt)).Should(Equal(time.Hour))
+						Ω(issuance.RevokedAt.IsZero()).Should(BeTrue())
+
+						return &repository.CreateKubeconfigIssuanceResponse{IssuanceID: issuanceID}, nil
+					}),
+				mockKubeconfigIssuerService.
+					EXPECT().
+					IssueKubeconfig(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, issueRequest *access.IssueKubeconfigRequest) (*access.IssueKubeconfigResponse, error) {
+						Ω(issueRequest.Clientset).ShouldNot(BeNil())
+						Ω(issueRequest.AdminKubeconfig).Should(Equal(edgeClusterKubeconfig))
+						Ω(issueRequest.IssuanceID).Should(Equal(issuanceID))
+						Ω(issueRequest.Role).Should(Equal(models.KubeconfigRoleEdit))
+						Ω(issueRequest.Namespace).Should(Equal(namespace))
+						Ω(issueRequest.TTL).Should(Equal(time.Hour))
+
+						return &access.IssueKubeconfigResponse{KubeconfigContent: kubeconfigContent, ExpiresAt: expiresAt}, nil
+					}),
+				mockRepositoryService.
+					EXPECT().
+					UpdateKubeconfigIssuanceExpiry(gomock.Any(), &repository.UpdateKubeconfigIssuanceExpiryRequest{
+						UserEmail:     userEmail,
+						EdgeClusterID: edgeClusterID,
+						IssuanceID:    issuanceID,
+						ExpiresAt:     expiresAt,
+					}).
+					Return(&repository.UpdateKubeconfigIssuanceExpiryResponse{}, nil),
+			)
+
+			response, err := sut.IssueKubeconfig(ctx, &request)
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.IssuanceID).Should(Equal(issuanceID))
+			Ω(response.Issuance.UserEmail).Should(Equal(userEmail))
+			Ω(response.Issuance.ExpiresAt).Should(Equal(expiresAt))
+			Ω(response.KubeconfigContent).Should(Equal(kubeconfigContent))
+		})
+
+		When("the expiry time of the issued kubeconfig cannot be recorded", func() {
+			It("should still return the issued kubeconfig", func() {
+				kubeconfigContent := cuid.New()
+
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						CreateKubeconfigIssuance(gomock.Any(), gomock.Any()).
+						Return(&repository.CreateKubeconfigIssuanceResponse{IssuanceID: issuanceID}, nil),
+					mockKubeconfigIssuerService.
+						EXPECT().
+						IssueKubeconfig(gomock.Any(), gomock.Any()).
+						Return(&access.IssueKubeconfigResponse{KubeconfigContent: kubeconfigContent, ExpiresAt: time.Now()}, nil),
+					mockRepositoryService.
+						EXPECT().
+						UpdateKubeconfigIssuanceExpiry(gomock.Any(), gomock.Any()).
+						Return(nil, errors.New(cuid.New())),
+				)
+
+				response, err := sut.IssueKubeconfig(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.KubeconfigContent).Should(Equal(kubeconfigContent))
+			})
+		})
+
+		When("the kubeconfig cannot be issued on the edge cluster", func() {
+			It("should record the issuance as revoked and return the error", func() {
+				expectedError := errors.New(cuid.New())
+
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						CreateKubeconfigIssuance(gomock.Any(), gomock.Any()).
+						Return(&repository.CreateKubeconfigIssuanceResponse{IssuanceID: issuanceID}, nil),
+					mockKubeconfigIssuerService.
+						EXPECT().
+						IssueKubeconfig(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError),
+					mockRepositoryService.
+						EXPECT().
+						RevokeKubeconfigIssuance(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, repositoryRequest *repository.RevokeKubeconfigIssuanceRequest) (*repository.RevokeKubeconfigIssuanceResponse, error) {
+							Ω(repositoryRequest.IssuanceID).Should(Equal(issuanceID))
+							Ω(repositoryRequest.RevokedAt.IsZero()).Should(BeFalse())
+
+							return &repository.RevokeKubeconfigIssuanceResponse{}, nil
+						}),
+				)
+
+				response, err := sut.IssueKubeconfig(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+
+	Describe("RevokeKubeconfig", func() {
+		var (
+			request  business.RevokeKubeconfigRequest
+			issuance models.KubeconfigIssuance
+		)
+
+		BeforeEach(func() {
+			request = business.RevokeKubeconfigRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				IssuanceID:    issuanceID,
+			}
+
+			issuance = models.KubeconfigIssuance{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+				Role:          models.KubeconfigRoleView,
+				Namespace:     namespace,
+			}
+		})
+
+		When("the issuance does not exist", func() {
+			It("should return the repository error", func() {
+				expectedError := commonErrors.NewNotFoundError()
+				mockRepositoryService.
+					EXPECT().
+					ReadKubeconfigIssuance(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.RevokeKubeconfig(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+
+		It("should revoke the access on the edge cluster and record the issuance as revoked", func() {
+			revokedIssuance := issuance
+			revokedIssuance.RevokedAt = time.Now()
+
+			gomock.InOrder(
+				mockRepositoryService.
+					EXPECT().
+					ReadKubeconfigIssuance(gomock.Any(), &repository.ReadKubeconfigIssuanceRequest{
+						UserEmail:     userEmail,
+						EdgeClusterID: edgeClusterID,
+						IssuanceID:    issuanceID,
+					}).
+					Return(&repository.ReadKubeconfigIssuanceResponse{Issuance: issuance}, nil),
+				mockKubeconfigIssuerService.
+					EXPECT().
+					RevokeKubeconfig(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, revokeRequest *access.RevokeKubeconfigRequest) (*access.RevokeKubeconfigResponse, error) {
+						Ω(revokeRequest.IssuanceID).Should(Equal(issuanceID))
+						Ω(revokeRequest.Namespace).Should(Equal(namespace))
+
+						return &access.RevokeKubeconfigResponse{}, nil
+					}),
+				mockRepositoryService.
+					EXPECT().
+					RevokeKubeconfigIssuance(gomock.Any(), gomock.Any()).
+					Return(&repository.RevokeKubeconfigIssuanceResponse{Issuance: revokedIssuance}, nil),
+			)
+
+			response, err := sut.RevokeKubeconfig(ctx, &request)
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.IssuanceID).Should(Equal(issuanceID))
+			Ω(response.Issuance).Should(Equal(revokedIssuance))
+		})
+
+		When("the issuance is already revoked", func() {
+			It("should revoke the access on the edge cluster again without updating the issuance", func() {
+				issuance.RevokedAt = time.Now()
+
+				mockRepositoryService.
+					EXPECT().
+					ReadKubeconfigIssuance(gomock.Any(), gomock.Any()).
+					Return(&repository.ReadKubeconfigIssuanceResponse{Issuance: issuance}, nil)
+				mockKubeconfigIssuerService.
+					EXPECT().
+					RevokeKubeconfig(gomock.Any(), gomock.Any()).
+					Return(&access.RevokeKubeconfigResponse{}, nil)
+
+				response, err := sut.RevokeKubeconfig(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.Issuance).Should(Equal(issuance))
+			})
+		})
+	})
+
+	Describe("ListKubeconfigIssuances", func() {
+		It("should return the issuances of the edge cluster", func() {
+			issuances := []models.KubeconfigIssuanceWithID{
+				{IssuanceID: cuid.New(), Issuance: models.KubeconfigIssuance{UserEmail: userEmail, EdgeClusterID: edgeClusterID}},
+				{IssuanceID: cuid.New(), Issuance: models.KubeconfigIssuance{UserEmail: userEmail, EdgeClusterID: edgeClusterID}},
+			}
+
+			mockRepositoryService.
+				EXPECT().
+				ListKubeconfigIssuances(gomock.Any(), &repository.ListKubeconfigIssuancesRequest{
+					UserEmail:     userEmail,
+					EdgeClusterID: edgeClusterID,
+				}).
+				Return(&repository.ListKubeconfigIssuancesResponse{Issuances: issuances}, nil)
+
+			response, err := sut.ListKubeconfigIssuances(ctx, &business.ListKubeconfigIssuancesRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Issuances).Should(Equal(issuances))
+		})
+	})
+
+	Describe("GetEdgeClusterKubeconfig", func() {
+		It("should return the kubeconfig of the edge cluster", func() {
+			response, err := sut.GetEdgeClusterKubeconfig(ctx, &business.GetEdgeClusterKubeconfigRequest{
+				UserEmail:     userEmail,
+				EdgeClusterID: edgeClusterID,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.KubeconfigContent).Should(Equal(edgeClusterKubeconfig))
+		})
+
+		When("the edge cluster does not belong to the user", func() {
+			It("should return the repository error", func() {
+				expectedError := commonErrors.NewNotFoundError()
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.GetEdgeClusterKubeconfig(ctx, &business.GetEdgeClusterKubeconfigRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: edgeClusterID,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+	})
+})
@@ -2,6 +2,8 @@
 package business
 
 import (
+	"time"
+
 	"github.com/decentralized-cloud/edge-cluster/models"
 	"github.com/micro-business/go-core/common"
 )
@@ -28,9 +30,10 @@ type ReadEdgeClusterRequest struct {
 
 // ReadEdgeClusterResponse contains the result of reading an existing edge cluster
 type ReadEdgeClusterResponse struct {
-	Err              error
-	EdgeCluster      models.EdgeCluster
-	ProvisionDetails models.ProvisionDetails
+	Err               error
+	EdgeCluster       models.EdgeCluster
+	ProvisionDetails  models.ProvisionDetails
+	ProvisioningState models.ProvisioningState
 }
 
 // UpdateEdgeClusterRequest contains the request to update an existing edge cluster
@@ -115,3 +118,270 @@ type ListEdgeClusterServicesResponse struct {
 	Err      error
 	Services []models.EdgeClusterService
 }
+
+// WatchEdgeClusterRequest contains the request to watch the provisioning events of an existing edge cluster
+type WatchEdgeClusterRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+}
+
+// WatchEdgeClusterResponse contains the result of watching the provisioning events of an existing edge cluster
+type WatchEdgeClusterResponse struct {
+	Err    error
+	Events <-chan models.EdgeClusterEvent
+}
+
+// ListSupportedClusterTypesRequest contains the request to list the edge cluster types supported by this deployment
+type ListSupportedClusterTypesRequest struct {
+	UserEmail string
+}
+
+// ListSupportedClusterTypesResponse contains the result of listing the edge cluster types supported by this deployment
+type ListSupportedClusterTypesResponse struct {
+	Err          error
+	ClusterTypes []models.ClusterTypeDescriptor
+}
+
+// InstallHelmReleaseRequest contains the request to install a new helm release on an existing edge cluster
+type InstallHelmReleaseRequest struct {
+	UserEmail        string
+	EdgeClusterID    string
+	Namespace        string
+	ReleaseName      string
+	RepositoryName   string
+	RepositoryURL    string
+	Chart            string
+	Version          string
+	Values           []string
+	Set              []string
+	SetString        []string
+	SetFile          map[string]string
+	Atomic           bool
+	Wait             bool
+	Timeout          time.Duration
+	DependencyUpdate bool
+}
+
+// InstallHelmReleaseResponse contains the result of installing a new helm release on an existing edge cluster
+type InstallHelmReleaseResponse struct {
+	Err     error
+	Release models.HelmRelease
+	History []models.HelmRelease
+}
+
+// UpgradeHelmReleaseRequest contains the request to upgrade an existing helm release on an existing edge cluster
+type UpgradeHelmReleaseRequest struct {
+	UserEmail        string
+	EdgeClusterID    string
+	Namespace        string
+	ReleaseName      string
+	RepositoryName   string
+	RepositoryURL    string
+	Chart            string
+	Version          string
+	Values           []string
+	Set              []string
+	SetString        []string
+	SetFile          map[string]string
+	Atomic           bool
+	Wait             bool
+	Timeout          time.Duration
+	DependencyUpdate bool
+}
+
+// UpgradeHelmReleaseResponse contains the result of upgrading an existing helm release on an existing edge cluster
+type UpgradeHelmReleaseResponse struct {
+	Err     error
+	Release models.HelmRelease
+	History []models.HelmRelease
+}
+
+// RollbackHelmReleaseRequest contains the request to roll an existing helm release back to one of its previous revisions
+type RollbackHelmReleaseRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	Namespace     string
+	ReleaseName   string
+	Revision      int
+}
+
+// RollbackHelmReleaseResponse contains the result of rolling back an existing helm release
+type RollbackHelmReleaseResponse struct {
+	Err     error
+	Release models.HelmRelease
+	History []models.HelmRelease
+}
+
+// UninstallHelmReleaseRequest contains the request to uninstall an existing helm release from an existing edge cluster
+type UninstallHelmReleaseRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	Namespace     string
+	ReleaseName   string
+	DisableHooks  bool
+	Timeout       time.Duration
+}
+
+// UninstallHelmReleaseResponse contains the result of uninstalling an existing helm release from an existing edge cluster
+type UninstallHelmReleaseResponse struct {
+	Err error
+}
+
+// ListHelmReleasesRequest contains the request to list the helm releases installed on an existing edge cluster
+type ListHelmReleasesRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	Namespace     string
+}
+
+// ListHelmReleasesResponse contains the result of listing the helm releases installed on an existing edge cluster
+type ListHelmReleasesResponse struct {
+	Err      error
+	Releases []models.HelmRelease
+}
+
+// AddHelmRepositoryRequest contains the request to add a helm chart repository or an OCI registry
+type AddHelmRepositoryRequest struct {
+	UserEmail string
+	Name      string
+	URL       string
+}
+
+// AddHelmRepositoryResponse contains the result of adding a helm chart repository or an OCI registry
+type AddHelmRepositoryResponse struct {
+	Err        error
+	Repository models.HelmRepository
+}
+
+// RemoveHelmRepositoryRequest contains the request to remove a registered helm chart repository or OCI registry
+type RemoveHelmRepositoryRequest struct {
+	UserEmail string
+	Name      string
+}
+
+// RemoveHelmRepositoryResponse contains the result of removing a registered helm chart repository or OCI registry
+type RemoveHelmRepositoryResponse struct {
+	Err error
+}
+
+// ListHelmRepositoriesRequest contains the request to list the registered helm chart repositories and OCI registries
+type ListHelmRepositoriesRequest struct {
+	UserEmail string
+}
+
+// ListHelmRepositoriesResponse contains the result of listing the registered helm chart repositories and OCI registries
+type ListHelmRepositoriesResponse struct {
+	Err          error
+	Repositories []models.HelmRepository
+}
+
+// UpdateHelmRepositoriesRequest contains the request to download the latest index of the registered helm chart repositories
+type UpdateHelmRepositoriesRequest struct {
+	UserEmail string
+}
+
+// UpdateHelmRepositoriesResponse contains the result of downloading the latest index of the registered helm chart repositories
+type UpdateHelmRepositoriesResponse struct {
+	Err          error
+	Repositories []models.HelmRepository
+}
+
+// ListOrphanedNamespacesRequest contains the request to list the namespaces of the edge clusters that no longer exist
+// in the repository
+type ListOrphanedNamespacesRequest struct {
+	UserEmail string
+}
+
+// ListOrphanedNamespacesResponse contains the result of listing the namespaces of the edge clusters that no longer
+// exist in the repository
+type ListOrphanedNamespacesResponse struct {
+	Err        error
+	Namespaces []models.OrphanedNamespace
+}
+
+// DeleteOrphanedNamespaceRequest contains the request to delete an orphaned namespace
+type DeleteOrphanedNamespaceRequest struct {
+	UserEmail string
+	Name      string
+}
+
+// DeleteOrphanedNamespaceResponse contains the result of deleting an orphaned namespace
+type DeleteOrphanedNamespaceResponse struct {
+	Err error
+}
+
+// AdoptOrphanedNamespaceRequest contains the request to add the edge cluster hosted by an orphaned namespace back to
+// the repository
+type AdoptOrphanedNamespaceRequest struct {
+	UserEmail string
+	Name      string
+}
+
+// AdoptOrphanedNamespaceResponse contains the result of adding the edge cluster hosted by an orphaned namespace back
+// to the repository
+type AdoptOrphanedNamespaceResponse struct {
+	Err           error
+	EdgeClusterID string
+	EdgeCluster   models.EdgeCluster
+	Cursor        string
+}
+
+// IssueKubeconfigRequest contains the request to issue a short-lived kubeconfig granting a role on an existing edge
+// cluster
+type IssueKubeconfigRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	Role          models.KubeconfigRole
+
+	// Namespace is the namespace the role is granted in, the role is granted in all namespaces if empty
+	Namespace string
+
+	// TTL is how long the issued kubeconfig is valid for
+	TTL time.Duration
+}
+
+// IssueKubeconfigResponse contains the result of issuing a short-lived kubeconfig
+type IssueKubeconfigResponse struct {
+	Err               error
+	IssuanceID        string
+	Issuance          models.KubeconfigIssuance
+	KubeconfigContent string
+}
+
+// RevokeKubeconfigRequest contains the request to revoke a kubeconfig issued for an existing edge cluster
+type RevokeKubeconfigRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	IssuanceID    string
+}
+
+// RevokeKubeconfigResponse contains the result of revoking an issued kubeconfig
+type RevokeKubeconfigResponse struct {
+	Err        error
+	IssuanceID string
+	Issuance   models.KubeconfigIssuance
+}
+
+// ListKubeconfigIssuancesRequest contains the request to list the kubeconfigs issued for an existing edge cluster
+type ListKubeconfigIssuancesRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+}
+
+// ListKubeconfigIssuancesResponse contains the result of listing the kubeconfigs issued for an existing edge cluster
+type ListKubeconfigIssuancesResponse struct {
+	Err       error
+	Issuances []models.KubeconfigIssuanceWithID
+}
+
+// GetEdgeClusterKubeconfigRequest contains the request to retrieve the kubeconfig of an existing edge cluster
+type GetEdgeClusterKubeconfigRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+}
+
+// GetEdgeClusterKubeconfigResponse contains the result of retrieving the kubeconfig of an existing edge cluster
+type GetEdgeClusterKubeconfigResponse struct {
+	Err               error
+	KubeconfigContent string
+}
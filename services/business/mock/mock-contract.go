@@ -35,6 +35,36 @@ func (m *MockBusinessContract) EXPECT() *MockBusinessContractMockRecorder {
 	return m.recorder
 }
 
+// AddHelmRepository mocks base method.
+func (m *MockBusinessContract) AddHelmRepository(ctx context.Context, request *business.AddHelmRepositoryRequest) (*business.AddHelmRepositoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHelmRepository", ctx, request)
+	ret0, _ := ret[0].(*business.AddHelmRepositoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddHelmRepository indicates an expected call of AddHelmRepository.
+func (mr *MockBusinessContractMockRecorder) AddHelmRepository(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHelmRepository", reflect.TypeOf((*MockBusinessContract)(nil).AddHelmRepository), ctx, request)
+}
+
+// AdoptOrphanedNamespace mocks base method.
+func (m *MockBusinessContract) AdoptOrphanedNamespace(ctx context.Context, request *business.AdoptOrphanedNamespaceRequest) (*business.AdoptOrphanedNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptOrphanedNamespace", ctx, request)
+	ret0, _ := ret[0].(*business.AdoptOrphanedNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdoptOrphanedNamespace indicates an expected call of AdoptOrphanedNamespace.
+func (mr *MockBusinessContractMockRecorder) AdoptOrphanedNamespace(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptOrphanedNamespace", reflect.TypeOf((*MockBusinessContract)(nil).AdoptOrphanedNamespace), ctx, request)
+}
+
 // CreateEdgeCluster mocks base method.
 func (m *MockBusinessContract) CreateEdgeCluster(ctx context.Context, request *business.CreateEdgeClusterRequest) (*business.CreateEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +95,66 @@ func (mr *MockBusinessContractMockRecorder) DeleteEdgeCluster(ctx, request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEdgeCluster", reflect.TypeOf((*MockBusinessContract)(nil).DeleteEdgeCluster), ctx, request)
 }
 
+// DeleteOrphanedNamespace mocks base method.
+func (m *MockBusinessContract) DeleteOrphanedNamespace(ctx context.Context, request *business.DeleteOrphanedNamespaceRequest) (*business.DeleteOrphanedNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedNamespace", ctx, request)
+	ret0, _ := ret[0].(*business.DeleteOrphanedNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrphanedNamespace indicates an expected call of DeleteOrphanedNamespace.
+func (mr *MockBusinessContractMockRecorder) DeleteOrphanedNamespace(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedNamespace", reflect.TypeOf((*MockBusinessContract)(nil).DeleteOrphanedNamespace), ctx, request)
+}
+
+// GetEdgeClusterKubeconfig mocks base method.
+func (m *MockBusinessContract) GetEdgeClusterKubeconfig(ctx context.Context, request *business.GetEdgeClusterKubeconfigRequest) (*business.GetEdgeClusterKubeconfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEdgeClusterKubeconfig", ctx, request)
+	ret0, _ := ret[0].(*business.GetEdgeClusterKubeconfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEdgeClusterKubeconfig indicates an expected call of GetEdgeClusterKubeconfig.
+func (mr *MockBusinessContractMockRecorder) GetEdgeClusterKubeconfig(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdgeClusterKubeconfig", reflect.TypeOf((*MockBusinessContract)(nil).GetEdgeClusterKubeconfig), ctx, request)
+}
+
+// InstallHelmRelease mocks base method.
+func (m *MockBusinessContract) InstallHelmRelease(ctx context.Context, request *business.InstallHelmReleaseRequest) (*business.InstallHelmReleaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallHelmRelease", ctx, request)
+	ret0, _ := ret[0].(*business.InstallHelmReleaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstallHelmRelease indicates an expected call of InstallHelmRelease.
+func (mr *MockBusinessContractMockRecorder) InstallHelmRelease(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallHelmRelease", reflect.TypeOf((*MockBusinessContract)(nil).InstallHelmRelease), ctx, request)
+}
+
+// IssueKubeconfig mocks base method.
+func (m *MockBusinessContract) IssueKubeconfig(ctx context.Context, request *business.IssueKubeconfigRequest) (*business.IssueKubeconfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueKubeconfig", ctx, request)
+	ret0, _ := ret[0].(*business.IssueKubeconfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueKubeconfig indicates an expected call of IssueKubeconfig.
+func (mr *MockBusinessContractMockRecorder) IssueKubeconfig(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueKubeconfig", reflect.TypeOf((*MockBusinessContract)(nil).IssueKubeconfig), ctx, request)
+}
+
 // ListEdgeClusterNodes mocks base method.
 func (m *MockBusinessContract) ListEdgeClusterNodes(ctx context.Context, request *business.ListEdgeClusterNodesRequest) (*business.ListEdgeClusterNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +215,81 @@ func (mr *MockBusinessContractMockRecorder) ListEdgeClusters(ctx, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdgeClusters", reflect.TypeOf((*MockBusinessContract)(nil).ListEdgeClusters), ctx, request)
 }
 
+// ListHelmReleases mocks base method.
+func (m *MockBusinessContract) ListHelmReleases(ctx context.Context, request *business.ListHelmReleasesRequest) (*business.ListHelmReleasesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHelmReleases", ctx, request)
+	ret0, _ := ret[0].(*business.ListHelmReleasesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHelmReleases indicates an expected call of ListHelmReleases.
+func (mr *MockBusinessContractMockRecorder) ListHelmReleases(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHelmReleases", reflect.TypeOf((*MockBusinessContract)(nil).ListHelmReleases), ctx, request)
+}
+
+// ListHelmRepositories mocks base method.
+func (m *MockBusinessContract) ListHelmRepositories(ctx context.Context, request *business.ListHelmRepositoriesRequest) (*business.ListHelmRepositoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHelmRepositories", ctx, request)
+	ret0, _ := ret[0].(*business.ListHelmRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHelmRepositories indicates an expected call of ListHelmRepositories.
+func (mr *MockBusinessContractMockRecorder) ListHelmRepositories(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHelmRepositories", reflect.TypeOf((*MockBusinessContract)(nil).ListHelmRepositories), ctx, request)
+}
+
+// ListKubeconfigIssuances mocks base method.
+func (m *MockBusinessContract) ListKubeconfigIssuances(ctx context.Context, request *business.ListKubeconfigIssuancesRequest) (*business.ListKubeconfigIssuancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubeconfigIssuances", ctx, request)
+	ret0, _ := ret[0].(*business.ListKubeconfigIssuancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubeconfigIssuances indicates an expected call of ListKubeconfigIssuances.
+func (mr *MockBusinessContractMockRecorder) ListKubeconfigIssuances(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubeconfigIssuances", reflect.TypeOf((*MockBusinessContract)(nil).ListKubeconfigIssuances), ctx, request)
+}
+
+// ListOrphanedNamespaces mocks base method.
+func (m *MockBusinessContract) ListOrphanedNamespaces(ctx context.Context, request *business.ListOrphanedNamespacesRequest) (*business.ListOrphanedNamespacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrphanedNamespaces", ctx, request)
+	ret0, _ := ret[0].(*business.ListOrphanedNamespacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrphanedNamespaces indicates an expected call of ListOrphanedNamespaces.
+func (mr *MockBusinessContractMockRecorder) ListOrphanedNamespaces(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrphanedNamespaces", reflect.TypeOf((*MockBusinessContract)(nil).ListOrphanedNamespaces), ctx, request)
+}
+
+// ListSupportedClusterTypes mocks base method.
+func (m *MockBusinessContract) ListSupportedClusterTypes(ctx context.Context, request *business.ListSupportedClusterTypesRequest) (*business.ListSupportedClusterTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSupportedClusterTypes", ctx, request)
+	ret0, _ := ret[0].(*business.ListSupportedClusterTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSupportedClusterTypes indicates an expected call of ListSupportedClusterTypes.
+func (mr *MockBusinessContractMockRecorder) ListSupportedClusterTypes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedClusterTypes", reflect.TypeOf((*MockBusinessContract)(nil).ListSupportedClusterTypes), ctx, request)
+}
+
 // ReadEdgeCluster mocks base method.
 func (m *MockBusinessContract) ReadEdgeCluster(ctx context.Context, request *business.ReadEdgeClusterRequest) (*business.ReadEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +305,66 @@ func (mr *MockBusinessContractMockRecorder) ReadEdgeCluster(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEdgeCluster", reflect.TypeOf((*MockBusinessContract)(nil).ReadEdgeCluster), ctx, request)
 }
 
+// RemoveHelmRepository mocks base method.
+func (m *MockBusinessContract) RemoveHelmRepository(ctx context.Context, request *business.RemoveHelmRepositoryRequest) (*business.RemoveHelmRepositoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveHelmRepository", ctx, request)
+	ret0, _ := ret[0].(*business.RemoveHelmRepositoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveHelmRepository indicates an expected call of RemoveHelmRepository.
+func (mr *MockBusinessContractMockRecorder) RemoveHelmRepository(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHelmRepository", reflect.TypeOf((*MockBusinessContract)(nil).RemoveHelmRepository), ctx, request)
+}
+
+// RevokeKubeconfig mocks base method.
+func (m *MockBusinessContract) RevokeKubeconfig(ctx context.Context, request *business.RevokeKubeconfigRequest) (*business.RevokeKubeconfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKubeconfig", ctx, request)
+	ret0, _ := ret[0].(*business.RevokeKubeconfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeKubeconfig indicates an expected call of RevokeKubeconfig.
+func (mr *MockBusinessContractMockRecorder) RevokeKubeconfig(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKubeconfig", reflect.TypeOf((*MockBusinessContract)(nil).RevokeKubeconfig), ctx, request)
+}
+
+// RollbackHelmRelease mocks base method.
+func (m *MockBusinessContract) RollbackHelmRelease(ctx context.Context, request *business.RollbackHelmReleaseRequest) (*business.RollbackHelmReleaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackHelmRelease", ctx, request)
+	ret0, _ := ret[0].(*business.RollbackHelmReleaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackHelmRelease indicates an expected call of RollbackHelmRelease.
+func (mr *MockBusinessContractMockRecorder) RollbackHelmRelease(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackHelmRelease", reflect.TypeOf((*MockBusinessContract)(nil).RollbackHelmRelease), ctx, request)
+}
+
+// UninstallHelmRelease mocks base method.
+func (m *MockBusinessContract) UninstallHelmRelease(ctx context.Context, request *business.UninstallHelmReleaseRequest) (*business.UninstallHelmReleaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallHelmRelease", ctx, request)
+	ret0, _ := ret[0].(*business.UninstallHelmReleaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UninstallHelmRelease indicates an expected call of UninstallHelmRelease.
+func (mr *MockBusinessContractMockRecorder) UninstallHelmRelease(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallHelmRelease", reflect.TypeOf((*MockBusinessContract)(nil).UninstallHelmRelease), ctx, request)
+}
+
 // UpdateEdgeCluster mocks base method.
 func (m *MockBusinessContract) UpdateEdgeCluster(ctx context.Context, request *business.UpdateEdgeClusterRequest) (*business.UpdateEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,3 +379,48 @@ func (mr *MockBusinessContractMockRecorder) UpdateEdgeCluster(ctx, request inter
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEdgeCluster", reflect.TypeOf((*MockBusinessContract)(nil).UpdateEdgeCluster), ctx, request)
 }
+
+// UpdateHelmRepositories mocks base method.
+func (m *MockBusinessContract) UpdateHelmRepositories(ctx context.Context, request *business.UpdateHelmRepositoriesRequest) (*business.UpdateHelmRepositoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHelmRepositories", ctx, request)
+	ret0, _ := ret[0].(*business.UpdateHelmRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHelmRepositories indicates an expected call of UpdateHelmRepositories.
+func (mr *MockBusinessContractMockRecorder) UpdateHelmRepositories(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHelmRepositories", reflect.TypeOf((*MockBusinessContract)(nil).UpdateHelmRepositories), ctx, request)
+}
+
+// UpgradeHelmRelease mocks base method.
+func (m *MockBusinessContract) UpgradeHelmRelease(ctx context.Context, request *business.UpgradeHelmReleaseRequest) (*business.UpgradeHelmReleaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeHelmRelease", ctx, request)
+	ret0, _ := ret[0].(*business.UpgradeHelmReleaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeHelmRelease indicates an expected call of UpgradeHelmRelease.
+func (mr *MockBusinessContractMockRecorder) UpgradeHelmRelease(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeHelmRelease", reflect.TypeOf((*MockBusinessContract)(nil).UpgradeHelmRelease), ctx, request)
+}
+
+// WatchEdgeCluster mocks base method.
+func (m *MockBusinessContract) WatchEdgeCluster(ctx context.Context, request *business.WatchEdgeClusterRequest) (*business.WatchEdgeClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEdgeCluster", ctx, request)
+	ret0, _ := ret[0].(*business.WatchEdgeClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchEdgeCluster indicates an expected call of WatchEdgeCluster.
+func (mr *MockBusinessContractMockRecorder) WatchEdgeCluster(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEdgeCluster", reflect.TypeOf((*MockBusinessContract)(nil).WatchEdgeCluster), ctx, request)
+}
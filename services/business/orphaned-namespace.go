@@ -0,0 +1,199 @@
+package business
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListOrphanedNamespaces lists the namespaces that host an edge cluster that no longer exists in the repository.
+// The operation is only allowed for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the orphaned namespaces
+// Returns either the orphaned namespaces or error if something goes wrong.
+func (service *businessService) ListOrphanedNamespaces(
+	ctx context.Context,
+	request *ListOrphanedNamespacesRequest) (*ListOrphanedNamespacesResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "list orphaned namespaces"); err != nil {
+		return &ListOrphanedNamespacesResponse{
+			Err: err,
+		}, nil
+	}
+
+	namespaces, err := service.listOrphanedNamespaces(ctx)
+	if err != nil {
+		return &ListOrphanedNamespacesResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListOrphanedNamespacesResponse{
+		Namespaces: namespaces,
+	}, nil
+}
+
+// DeleteOrphanedNamespace deletes a namespace that hosts an edge cluster that no longer exists in the repository,
+// together with all the resources it contains. The operation is only allowed for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete an orphaned namespace
+// Returns either the result of deleting the orphaned namespace or error if something goes wrong.
+func (service *businessService) DeleteOrphanedNamespace(
+	ctx context.Context,
+	request *DeleteOrphanedNamespaceRequest) (*DeleteOrphanedNamespaceResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "delete orphaned namespaces"); err != nil {
+		return &DeleteOrphanedNamespaceResponse{
+			Err: err,
+		}, nil
+	}
+
+	if _, err := service.getOrphanedNamespace(ctx, request.Name); err != nil {
+		return &DeleteOrphanedNamespaceResponse{
+			Err: err,
+		}, nil
+	}
+
+	if err := service.clientset.CoreV1().Namespaces().Delete(ctx, request.Name, metav1.DeleteOptions{}); err != nil {
+		return &DeleteOrphanedNamespaceResponse{
+			Err: commonErrors.NewUnknownErrorWithError("failed to delete the orphaned namespace", err),
+		}, nil
+	}
+
+	service.logger.Info(
+		"deleted the orphaned namespace",
+		zap.String("namespace", request.Name),
+		zap.String("userEmail", request.UserEmail))
+
+	return &DeleteOrphanedNamespaceResponse{}, nil
+}
+
+// AdoptOrphanedNamespace adds the edge cluster hosted by an orphaned namespace back to the repository using the
+// ownership recorded on the namespace. The operation is only allowed for the admin users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to adopt an orphaned namespace
+// Returns either the adopted edge cluster or error if something goes wrong.
+func (service *businessService) AdoptOrphanedNamespace(
+	ctx context.Context,
+	request *AdoptOrphanedNamespaceRequest) (*AdoptOrphanedNamespaceResponse, error) {
+	if err := service.ensureAdmin(request.UserEmail, "adopt orphaned namespaces"); err != nil {
+		return &AdoptOrphanedNamespaceResponse{
+			Err: err,
+		}, nil
+	}
+
+	namespace, err := service.getOrphanedNamespace(ctx, request.Name)
+	if err != nil {
+		return &AdoptOrphanedNamespaceResponse{
+			Err: err,
+		}, nil
+	}
+
+	if !namespace.Adoptable {
+		return &AdoptOrphanedNamespaceResponse{
+			Err: commonErrors.NewArgumentError(
+				"name",
+				"the namespace does not record the edge cluster and the user it belongs to"),
+		}, nil
+	}
+
+	ownership := namespace.Ownership
+
+	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, ownership.ClusterType)
+	if err != nil {
+		return &AdoptOrphanedNamespaceResponse{
+			Err: commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err),
+		}, nil
+	}
+
+	adoptProvisionResponse, err := edgeClusterProvisioner.AdoptProvision(
+		ctx,
+		&edgeClusterTypes.AdoptProvisionRequest{
+			EdgeClusterID: ownership.EdgeClusterID,
+		})
+	if err != nil {
+		return &AdoptOrphanedNamespaceResponse{
+			Err: commonErrors.NewUnknownErrorWithError("failed to adopt the edge cluster provision", err),
+		}, nil
+	}
+
+	repositoryResponse, err := service.repositoryService.AdoptEdgeCluster(ctx, &repository.AdoptEdgeClusterRequest{
+		EdgeClusterID: ownership.EdgeClusterID,
+		UserEmail:     ownership.UserEmail,
+		EdgeCluster: models.EdgeCluster{
+			ProjectID:       ownership.ProjectID,
+			Name:            ownership.EdgeClusterName,
+			ClusterSecret:   adoptProvisionResponse.ClusterSecret,
+			ClusterType:     ownership.ClusterType,
+			ServiceExposure: adoptProvisionResponse.ServiceExposure,
+		},
+	})
+	if err != nil {
+		return &AdoptOrphanedNamespaceResponse{
+			Err: err,
+		}, nil
+	}
+
+	service.logger.Info(
+		"adopted the orphaned namespace",
+		zap.String("namespace", request.Name),
+		zap.String("edgeClusterID", ownership.EdgeClusterID),
+		zap.String("userEmail", request.UserEmail))
+
+	return &AdoptOrphanedNamespaceResponse{
+		EdgeClusterID: ownership.EdgeClusterID,
+		EdgeCluster:   repositoryResponse.EdgeCluster,
+		Cursor:        repositoryResponse.Cursor,
+	}, nil
+}
+
+// listOrphanedNamespaces lists the namespaces that host an edge cluster that no longer exists in the repository
+func (service *businessService) listOrphanedNamespaces(ctx context.Context) ([]models.OrphanedNamespace, error) {
+	response, err := service.repositoryService.ListAllEdgeClusters(ctx, &repository.ListAllEdgeClustersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	edgeClusterIDs := make([]string, 0, len(response.EdgeClusters)+len(response.Failures))
+	for _, edgeCluster := range response.EdgeClusters {
+		edgeClusterIDs = append(edgeClusterIDs, edgeCluster.EdgeClusterID)
+	}
+
+	// The edge clusters that cannot be read still exist, so their namespaces are not reported as orphaned
+	for _, failure := range response.Failures {
+		service.logger.Warn(
+			"the edge cluster cannot be read from the repository",
+			zap.Error(failure.Err),
+			zap.String("edgeClusterID", failure.EdgeClusterID))
+
+		edgeClusterIDs = append(edgeClusterIDs, failure.EdgeClusterID)
+	}
+
+	namespaces, err := provision.ListOrphanedNamespaces(ctx, service.clientset, edgeClusterIDs)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to list the orphaned namespaces", err)
+	}
+
+	return namespaces, nil
+}
+
+// getOrphanedNamespace returns the orphaned namespace with the given name, or NotFoundError if the namespace does not
+// exist or hosts an edge cluster that exists in the repository
+func (service *businessService) getOrphanedNamespace(ctx context.Context, name string) (models.OrphanedNamespace, error) {
+	namespaces, err := service.listOrphanedNamespaces(ctx)
+	if err != nil {
+		return models.OrphanedNamespace{}, err
+	}
+
+	for _, namespace := range namespaces {
+		if namespace.Name == name {
+			return namespace, nil
+		}
+	}
+
+	return models.OrphanedNamespace{}, commonErrors.NewNotFoundError()
+}
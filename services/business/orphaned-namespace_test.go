@@ -0,0 +1,314 @@
+package business_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	accessMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/access/mock"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	_ "github.com/decentralized-cloud/edge-cluster/services/edgecluster/k3s" // register the K3S cluster type
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	jobMock "github.com/decentralized-cloud/edge-cluster/services/job/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	repsoitoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Orphaned Namespace Business Service Tests", func() {
+	var (
+		mockCtrl                          *gomock.Controller
+		sut                               business.BusinessContract
+		mockRepositoryService             *repsoitoryMock.MockRepositoryContract
+		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
+		clientset                         *fake.Clientset
+		ctx                               context.Context
+		adminEmail                        string
+		userEmail                         string
+		inUseEdgeClusterID                string
+		ownership                         models.ProvisionOwnership
+		adoptableNamespace                string
+		unownedNamespace                  string
+		inUseNamespace                    string
+		undecryptableNamespace            string
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		adminEmail = cuid.New() + "@test.com"
+		userEmail = cuid.New() + "@test.com"
+		inUseEdgeClusterID = cuid.New()
+		ownership = provision.NewOwnership(cuid.New(), cuid.New(), cuid.New()+"@test.com", cuid.New(), models.K3S)
+
+		adoptable := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: provision.GetNamespace(ownership.EdgeClusterID)}}
+		provision.SetOwnership(&adoptable.ObjectMeta, ownership)
+		adoptableNamespace = adoptable.Name
+		unownedNamespace = provision.GetNamespace(cuid.New())
+		inUseNamespace = provision.GetNamespace(inUseEdgeClusterID)
+		undecryptableEdgeClusterID := cuid.New()
+		undecryptableNamespace = provision.GetNamespace(undecryptableEdgeClusterID)
+
+		clientset = fake.NewSimpleClientset(
+			adoptable,
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   unownedNamespace,
+				Labels: map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+			}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   inUseNamespace,
+				Labels: map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+			}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   undecryptableNamespace,
+				Labels: map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+			}})
+
+		mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetAdminEmails().Return([]string{strings.ToUpper(adminEmail)}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetKubeconfigIssuanceMaxTTL().Return(24*time.Hour, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedCIDRs().Return([]string{"203.0.113.0/24"}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedDomains().Return([]string{"edge.example.com"}, nil).AnyTimes()
+
+		mockRepositoryService = repsoitoryMock.NewMockRepositoryContract(mockCtrl)
+		mockRepositoryService.
+			EXPECT().
+			ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+			Return(&repository.ListAllEdgeClustersResponse{
+				EdgeClusters: []repository.EdgeClusterRecord{{EdgeClusterID: inUseEdgeClusterID}},
+				Failures: []repository.ListEdgeClusterFailure{{
+					EdgeClusterID: undecryptableEdgeClusterID,
+					Err:           errors.New("failed to decrypt the cluster secret"),
+				}},
+			}, nil).
+			AnyTimes()
+
+		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		mockEdgeClusterFactoryService = edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, _ = business.NewBusinessService(
+			logger,
+			mockConfigurationService,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService,
+			jobMock.NewMockJobQueueContract(mockCtrl),
+			eventMock.NewMockEventBusContract(mockCtrl),
+			helmMock.NewMockHelmHelperContract(mockCtrl),
+			clientset,
+			accessMock.NewMockKubeconfigIssuerContract(mockCtrl))
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("ListOrphanedNamespaces", func() {
+		It("should return the namespaces that host an edge cluster that no longer exists", func() {
+			response, err := sut.ListOrphanedNamespaces(ctx, &business.ListOrphanedNamespacesRequest{UserEmail: adminEmail})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.Namespaces).Should(HaveLen(2))
+
+			for _, namespace := range response.Namespaces {
+				Ω(namespace.Name).ShouldNot(Equal(inUseNamespace))
+				Ω(namespace.Name).ShouldNot(Equal(undecryptableNamespace))
+
+				if namespace.Name == adoptableNamespace {
+					Ω(namespace.Adoptable).Should(BeTrue())
+					Ω(*namespace.Ownership).Should(Equal(ownership))
+				} else {
+					Ω(namespace.Adoptable).Should(BeFalse())
+				}
+			}
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.ListOrphanedNamespaces(ctx, &business.ListOrphanedNamespacesRequest{UserEmail: userEmail})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+		})
+	})
+
+	Describe("DeleteOrphanedNamespace", func() {
+		It("should delete the orphaned namespace", func() {
+			response, err := sut.DeleteOrphanedNamespace(ctx, &business.DeleteOrphanedNamespaceRequest{
+				UserEmail: adminEmail,
+				Name:      unownedNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+
+			_, err = clientset.CoreV1().Namespaces().Get(ctx, unownedNamespace, metav1.GetOptions{})
+			Ω(apierrors.IsNotFound(err)).Should(BeTrue())
+		})
+
+		It("should return NotFoundError and keep the namespace when it hosts an existing edge cluster", func() {
+			response, err := sut.DeleteOrphanedNamespace(ctx, &business.DeleteOrphanedNamespaceRequest{
+				UserEmail: adminEmail,
+				Name:      inUseNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(commonErrors.IsNotFoundError(response.Err)).Should(BeTrue())
+
+			_, err = clientset.CoreV1().Namespaces().Get(ctx, inUseNamespace, metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.DeleteOrphanedNamespace(ctx, &business.DeleteOrphanedNamespaceRequest{
+				UserEmail: userEmail,
+				Name:      unownedNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+
+			_, err = clientset.CoreV1().Namespaces().Get(ctx, unownedNamespace, metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+		})
+	})
+
+	Describe("AdoptOrphanedNamespace", func() {
+		It("should add the edge cluster back to the repository with the cluster secret of its provision", func() {
+			clusterSecret := cuid.New()
+
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				AdoptProvision(gomock.Any(), &edgeClusterTypes.AdoptProvisionRequest{EdgeClusterID: ownership.EdgeClusterID}).
+				Return(&edgeClusterTypes.AdoptProvisionResponse{ClusterSecret: clusterSecret}, nil)
+
+			expectedEdgeCluster := models.EdgeCluster{
+				ProjectID:     ownership.ProjectID,
+				Name:          ownership.EdgeClusterName,
+				ClusterSecret: clusterSecret,
+				ClusterType:   models.K3S,
+			}
+
+			mockRepositoryService.
+				EXPECT().
+				AdoptEdgeCluster(gomock.Any(), &repository.AdoptEdgeClusterRequest{
+					EdgeClusterID: ownership.EdgeClusterID,
+					UserEmail:     ownership.UserEmail,
+					EdgeCluster:   expectedEdgeCluster,
+				}).
+				Return(&repository.AdoptEdgeClusterResponse{
+					EdgeCluster: expectedEdgeCluster,
+					Cursor:      ownership.EdgeClusterID,
+				}, nil)
+
+			response, err := sut.AdoptOrphanedNamespace(ctx, &business.AdoptOrphanedNamespaceRequest{
+				UserEmail: adminEmail,
+				Name:      adoptableNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.EdgeClusterID).Should(Equal(ownership.EdgeClusterID))
+			Ω(response.EdgeCluster).Should(Equal(expectedEdgeCluster))
+			Ω(response.Cursor).Should(Equal(ownership.EdgeClusterID))
+		})
+
+		It("should add the edge cluster back to the repository with the service exposure of its provision", func() {
+			clusterSecret := cuid.New()
+			serviceExposure := models.ServiceExposure{
+				Mode:    models.ServiceExposureModeIngress,
+				Address: cuid.New() + ".edge.example.com",
+			}
+
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				AdoptProvision(gomock.Any(), &edgeClusterTypes.AdoptProvisionRequest{EdgeClusterID: ownership.EdgeClusterID}).
+				Return(&edgeClusterTypes.AdoptProvisionResponse{
+					ClusterSecret:   clusterSecret,
+					ServiceExposure: serviceExposure,
+				}, nil)
+
+			expectedEdgeCluster := models.EdgeCluster{
+				ProjectID:       ownership.ProjectID,
+				Name:            ownership.EdgeClusterName,
+				ClusterSecret:   clusterSecret,
+				ClusterType:     models.K3S,
+				ServiceExposure: serviceExposure,
+			}
+
+			mockRepositoryService.
+				EXPECT().
+				AdoptEdgeCluster(gomock.Any(), &repository.AdoptEdgeClusterRequest{
+					EdgeClusterID: ownership.EdgeClusterID,
+					UserEmail:     ownership.UserEmail,
+					EdgeCluster:   expectedEdgeCluster,
+				}).
+				Return(&repository.AdoptEdgeClusterResponse{
+					EdgeCluster: expectedEdgeCluster,
+					Cursor:      ownership.EdgeClusterID,
+				}, nil)
+
+			response, err := sut.AdoptOrphanedNamespace(ctx, &business.AdoptOrphanedNamespaceRequest{
+				UserEmail: adminEmail,
+				Name:      adoptableNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(BeNil())
+			Ω(response.EdgeCluster.ServiceExposure).Should(Equal(serviceExposure))
+		})
+
+		It("should return ArgumentError when the namespace does not record its ownership", func() {
+			response, err := sut.AdoptOrphanedNamespace(ctx, &business.AdoptOrphanedNamespaceRequest{
+				UserEmail: adminEmail,
+				Name:      unownedNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+		})
+
+		It("should return the error when the provision cannot be adopted", func() {
+			expectedError := errors.New(cuid.New())
+
+			mockEdgeClusterProvisionerService.
+				EXPECT().
+				AdoptProvision(gomock.Any(), gomock.Any()).
+				Return(nil, expectedError)
+
+			response, err := sut.AdoptOrphanedNamespace(ctx, &business.AdoptOrphanedNamespaceRequest{
+				UserEmail: adminEmail,
+				Name:      adoptableNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(response.Err).Should(HaveOccurred())
+			Ω(response.Err.Error()).Should(ContainSubstring(expectedError.Error()))
+		})
+
+		It("should return PermissionDeniedError when the user is not an admin", func() {
+			response, err := sut.AdoptOrphanedNamespace(ctx, &business.AdoptOrphanedNamespaceRequest{
+				UserEmail: userEmail,
+				Name:      adoptableNamespace,
+			})
+			Ω(err).Should(BeNil())
+			Ω(business.IsPermissionDeniedError(response.Err)).Should(BeTrue())
+		})
+	})
+})
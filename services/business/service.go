@@ -3,33 +3,67 @@ package business
 
 import (
 	"context"
-
+	"strings"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
 	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
 	"github.com/decentralized-cloud/edge-cluster/services/repository"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
 )
 
 type businessService struct {
 	logger                    *zap.Logger
 	repositoryService         repository.RepositoryContract
 	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract
+	jobQueueService           job.JobQueueContract
+	eventBusService           event.EventBusContract
+	helmService               helm.HelmHelperContract
+	clientset                 kubernetes.Interface
+	kubeconfigIssuerService   access.KubeconfigIssuerContract
+	adminEmails               map[string]bool
+	kubeconfigIssuanceMaxTTL  time.Duration
+	serviceExposurePolicy     provision.ServiceExposurePolicy
 }
 
 // NewBusinessService creates new instance of the BusinessService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
 // repositoryService: Mandatory. Reference to the repository service that can persist the edge cluster related data
 // edgeClusterFactoryService: Mandatory. Reference to the factory service that can that can create different type of supported
 // edge cluster provisioner
+// jobQueueService: Mandatory. Reference to the queue that keeps the provisioning jobs
+// eventBusService: Mandatory. Reference to the event bus the edge cluster provisioning events are published to
+// helmService: Mandatory. Reference to the service that manages the helm releases and repositories
+// clientset: Mandatory. The client set of the cluster the edge clusters are provisioned in
+// kubeconfigIssuerService: Mandatory. Reference to the service that issues the kubeconfigs to the users of the edge clusters
 // logger: Mandatory. Reference to the logger service
 // Returns the new service or error if something goes wrong
 func NewBusinessService(
 	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
 	repositoryService repository.RepositoryContract,
-	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract) (BusinessContract, error) {
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract,
+	jobQueueService job.JobQueueContract,
+	eventBusService event.EventBusContract,
+	helmService helm.HelmHelperContract,
+	clientset kubernetes.Interface,
+	kubeconfigIssuerService access.KubeconfigIssuerContract) (BusinessContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
 	if repositoryService == nil {
 		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
 	}
@@ -38,10 +72,68 @@ func NewBusinessService(
 		return nil, commonErrors.NewArgumentNilError("edgeClusterFactoryService", "edgeClusterFactoryService is required")
 	}
 
+	if jobQueueService == nil {
+		return nil, commonErrors.NewArgumentNilError("jobQueueService", "jobQueueService is required")
+	}
+
+	if eventBusService == nil {
+		return nil, commonErrors.NewArgumentNilError("eventBusService", "eventBusService is required")
+	}
+
+	if helmService == nil {
+		return nil, commonErrors.NewArgumentNilError("helmService", "helmService is required")
+	}
+
+	if clientset == nil {
+		return nil, commonErrors.NewArgumentNilError("clientset", "clientset is required")
+	}
+
+	if kubeconfigIssuerService == nil {
+		return nil, commonErrors.NewArgumentNilError("kubeconfigIssuerService", "kubeconfigIssuerService is required")
+	}
+
+	adminEmails, err := configurationService.GetAdminEmails()
+	if err != nil {
+		return nil, err
+	}
+
+	adminEmailSet := map[string]bool{}
+	for _, adminEmail := range adminEmails {
+		adminEmailSet[strings.ToLower(adminEmail)] = true
+	}
+
+	kubeconfigIssuanceMaxTTL, err := configurationService.GetKubeconfigIssuanceMaxTTL()
+	if err != nil {
+		return nil, err
+	}
+
+	allowedCIDRs, err := configurationService.GetServiceExposureAllowedCIDRs()
+	if err != nil {
+		return nil, err
+	}
+
+	allowedDomains, err := configurationService.GetServiceExposureAllowedDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceExposurePolicy, err := provision.NewServiceExposurePolicy(allowedCIDRs, allowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
 	return &businessService{
 		logger:                    logger,
 		repositoryService:         repositoryService,
 		edgeClusterFactoryService: edgeClusterFactoryService,
+		jobQueueService:           jobQueueService,
+		eventBusService:           eventBusService,
+		helmService:               helmService,
+		clientset:                 clientset,
+		kubeconfigIssuerService:   kubeconfigIssuerService,
+		adminEmails:               adminEmailSet,
+		kubeconfigIssuanceMaxTTL:  kubeconfigIssuanceMaxTTL,
+		serviceExposurePolicy:     serviceExposurePolicy,
 	}, nil
 }
 
@@ -52,6 +144,21 @@ func NewBusinessService(
 func (service *businessService) CreateEdgeCluster(
 	ctx context.Context,
 	request *CreateEdgeClusterRequest) (*CreateEdgeClusterResponse, error) {
+	if _, err := service.edgeClusterFactoryService.Create(ctx, request.EdgeCluster.ClusterType); err != nil {
+		if edgeClusterTypes.IsEdgeClusterTypeNotSupportedError(err) {
+			return &CreateEdgeClusterResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	}
+
+	if err := service.verifyServiceExposure(ctx, "", request.EdgeCluster.ServiceExposure); err != nil {
+		return &CreateEdgeClusterResponse{
+			Err: err,
+		}, nil
+	}
 
 	repositoryResponse, err := service.repositoryService.CreateEdgeCluster(ctx, &repository.CreateEdgeClusterRequest{
 		UserEmail:   request.UserEmail,
@@ -64,25 +171,15 @@ func (service *businessService) CreateEdgeCluster(
 		}, nil
 	}
 
-	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, request.EdgeCluster.ClusterType)
-	if err != nil {
-		return nil, commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	if err = service.enqueueProvisioningJob(
+		ctx,
+		job.JobTypeCreateProvision,
+		repositoryResponse.EdgeClusterID,
+		request.UserEmail,
+		request.EdgeCluster.ClusterType); err != nil {
+		return nil, err
 	}
 
-	go func() {
-		if _, err = edgeClusterProvisioner.CreateProvision(
-			context.Background(),
-			&edgeClusterTypes.CreateProvisionRequest{
-				EdgeClusterID: repositoryResponse.EdgeClusterID,
-				ClusterSecret: request.EdgeCluster.ClusterSecret,
-			}); err != nil {
-
-			service.logger.Error("failed to provision egde cluster", zap.Error(err))
-
-			return
-		}
-	}()
-
 	return &CreateEdgeClusterResponse{
 		EdgeClusterID: repositoryResponse.EdgeClusterID,
 		EdgeCluster:   repositoryResponse.EdgeCluster,
@@ -114,7 +211,8 @@ func (service *businessService) ReadEdgeCluster(
 	}
 
 	response := &ReadEdgeClusterResponse{
-		EdgeCluster: repositoryResponse.EdgeCluster,
+		EdgeCluster:       repositoryResponse.EdgeCluster,
+		ProvisioningState: repositoryResponse.ProvisioningState,
 	}
 
 	if provisionDetailsReponse, err := edgeClusterProvisioner.GetProvisionDetails(
@@ -133,6 +231,21 @@ func (service *businessService) ReadEdgeCluster(
 func (service *businessService) UpdateEdgeCluster(
 	ctx context.Context,
 	request *UpdateEdgeClusterRequest) (*UpdateEdgeClusterResponse, error) {
+	if _, err := service.edgeClusterFactoryService.Create(ctx, request.EdgeCluster.ClusterType); err != nil {
+		if edgeClusterTypes.IsEdgeClusterTypeNotSupportedError(err) {
+			return &UpdateEdgeClusterResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	}
+
+	if err := service.verifyServiceExposure(ctx, request.EdgeClusterID, request.EdgeCluster.ServiceExposure); err != nil {
+		return &UpdateEdgeClusterResponse{
+			Err: err,
+		}, nil
+	}
 
 	repositoryResponse, err := service.repositoryService.UpdateEdgeCluster(ctx, &repository.UpdateEdgeClusterRequest{
 		UserEmail:     request.UserEmail,
@@ -146,25 +259,15 @@ func (service *businessService) UpdateEdgeCluster(
 		}, nil
 	}
 
-	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, request.EdgeCluster.ClusterType)
-	if err != nil {
-		return nil, commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
+	if err = service.enqueueProvisioningJob(
+		ctx,
+		job.JobTypeUpdateProvision,
+		request.EdgeClusterID,
+		request.UserEmail,
+		request.EdgeCluster.ClusterType); err != nil {
+		return nil, err
 	}
 
-	go func() {
-		if _, err = edgeClusterProvisioner.UpdateProvisionWithRetry(
-			context.Background(),
-			&edgeClusterTypes.UpdateProvisionRequest{
-				EdgeClusterID: request.EdgeClusterID,
-				ClusterSecret: request.EdgeCluster.ClusterSecret,
-			}); err != nil {
-			service.logger.Error("failed to update the existing edge cluster provision", zap.Error(err))
-
-			return
-		}
-
-	}()
-
 	return &UpdateEdgeClusterResponse{
 		EdgeCluster: repositoryResponse.EdgeCluster,
 		Cursor:      repositoryResponse.Cursor,
@@ -178,8 +281,7 @@ func (service *businessService) UpdateEdgeCluster(
 func (service *businessService) DeleteEdgeCluster(
 	ctx context.Context,
 	request *DeleteEdgeClusterRequest) (*DeleteEdgeClusterResponse, error) {
-
-	_, err := service.repositoryService.DeleteEdgeCluster(ctx, &repository.DeleteEdgeClusterRequest{
+	repositoryResponse, err := service.repositoryService.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
 		UserEmail:     request.UserEmail,
 		EdgeClusterID: request.EdgeClusterID,
 	})
@@ -190,19 +292,26 @@ func (service *businessService) DeleteEdgeCluster(
 		}, nil
 	}
 
-	edgeClusterProvisioner, err := service.edgeClusterFactoryService.Create(ctx, request.EdgeCluster.ClusterType)
-	if err != nil {
+	if _, err = service.edgeClusterFactoryService.Create(ctx, repositoryResponse.EdgeCluster.ClusterType); err != nil {
 		return nil, commonErrors.NewUnknownErrorWithError("failed to create egde cluster provisioner", err)
 	}
 
-	_, err = edgeClusterProvisioner.DeleteProvision(
+	updateProvisioningState(
 		ctx,
-		&edgeClusterTypes.DeleteProvisionRequest{
-			EdgeClusterID: request.EdgeClusterID,
-		})
-
-	if err != nil {
-		return nil, commonErrors.NewUnknownErrorWithError("failed to delete the existing edge cluster provision", err)
+		service.logger,
+		service.repositoryService,
+		service.eventBusService,
+		request.EdgeClusterID,
+		models.ProvisioningStatusDeleting,
+		nil)
+
+	if err = service.enqueueProvisioningJob(
+		ctx,
+		job.JobTypeDeleteProvision,
+		request.EdgeClusterID,
+		request.UserEmail,
+		repositoryResponse.EdgeCluster.ClusterType); err != nil {
+		return nil, err
 	}
 
 	return &DeleteEdgeClusterResponse{}, nil
@@ -370,3 +479,160 @@ func (service *businessService) ListEdgeClusterServices(
 		Services: response.Services,
 	}, nil
 }
+
+// WatchEdgeCluster streams the provisioning events of an existing edge cluster. The current provisioning
+// status is delivered first, followed by the events published while the provisioning progresses.
+// ctx: Mandatory The reference to the context. The events channel is closed when the context is cancelled
+// request: Mandatory. The request to watch an existing edge cluster
+// Returns either the channel the events are delivered to or error if something goes wrong.
+func (service *businessService) WatchEdgeCluster(
+	ctx context.Context,
+	request *WatchEdgeClusterRequest) (*WatchEdgeClusterResponse, error) {
+	subscriptionCtx, cancelSubscription := context.WithCancel(ctx)
+
+	// Subscribing before reading the current state makes sure no status change is missed in between
+	subscribeResponse, err := service.eventBusService.Subscribe(subscriptionCtx, &event.SubscribeRequest{
+		EdgeClusterID: request.EdgeClusterID,
+	})
+
+	if err != nil {
+		cancelSubscription()
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to subscribe to the edge cluster events", err)
+	}
+
+	repositoryResponse, err := service.repositoryService.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+		UserEmail:     request.UserEmail,
+		EdgeClusterID: request.EdgeClusterID,
+	})
+
+	if err != nil {
+		cancelSubscription()
+
+		return &WatchEdgeClusterResponse{
+			Err: err,
+		}, nil
+	}
+
+	events := make(chan models.EdgeClusterEvent)
+
+	go func() {
+		defer close(events)
+		defer cancelSubscription()
+
+		snapshot := models.EdgeClusterEvent{
+			EdgeClusterID: request.EdgeClusterID,
+			Type:          models.EdgeClusterEventTypeStatusChanged,
+			Status:        repositoryResponse.ProvisioningState.Status,
+			Message:       repositoryResponse.ProvisioningState.LastErrorMessage,
+			Timestamp:     repositoryResponse.ProvisioningState.UpdatedAt,
+		}
+
+		select {
+		case events <- snapshot:
+		case <-ctx.Done():
+			return
+		}
+
+		for edgeClusterEvent := range subscribeResponse.Events {
+			select {
+			case events <- edgeClusterEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return &WatchEdgeClusterResponse{
+		Events: events,
+	}, nil
+}
+
+// ListSupportedClusterTypes returns the edge cluster types supported by this deployment
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the supported edge cluster types
+// Returns either the list of the supported edge cluster types or error if something goes wrong.
+func (service *businessService) ListSupportedClusterTypes(
+	ctx context.Context,
+	request *ListSupportedClusterTypesRequest) (*ListSupportedClusterTypesResponse, error) {
+	response, err := service.edgeClusterFactoryService.ListSupportedClusterTypes(
+		ctx,
+		&edgeClusterTypes.ListSupportedClusterTypesRequest{})
+
+	if err != nil {
+		return &ListSupportedClusterTypesResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &ListSupportedClusterTypesResponse{
+		ClusterTypes: response.ClusterTypes,
+	}, nil
+}
+
+// GetEdgeClusterKubeconfig retrieves the admin kubeconfig of an existing edge cluster, which is used to proxy
+// the requests of the user to the Kubernetes API of the edge cluster.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to retrieve the kubeconfig of an existing edge cluster
+// Returns either the kubeconfig of the edge cluster or error if something goes wrong.
+func (service *businessService) GetEdgeClusterKubeconfig(
+	ctx context.Context,
+	request *GetEdgeClusterKubeconfigRequest) (*GetEdgeClusterKubeconfigResponse, error) {
+	kubeconfig, err := service.getKubeconfig(ctx, request.UserEmail, request.EdgeClusterID)
+	if err != nil {
+		return &GetEdgeClusterKubeconfigResponse{
+			Err: err,
+		}, nil
+	}
+
+	return &GetEdgeClusterKubeconfigResponse{
+		KubeconfigContent: kubeconfig,
+	}, nil
+}
+
+// verifyServiceExposure verifies the edge cluster is exposed on an address the operator allows and no other service of
+// the host cluster claims. The edge cluster being updated does not conflict with its own claims.
+func (service *businessService) verifyServiceExposure(
+	ctx context.Context,
+	edgeClusterID string,
+	serviceExposure models.ServiceExposure) error {
+	if err := service.serviceExposurePolicy.VerifyAddress(serviceExposure); err != nil {
+		return err
+	}
+
+	namespace := ""
+	if edgeClusterID != "" {
+		namespace = provision.GetNamespace(edgeClusterID)
+	}
+
+	return provision.VerifyServiceExposureClaims(ctx, service.clientset, namespace, serviceExposure)
+}
+
+// enqueueProvisioningJob adds a new provisioning job for the given edge cluster to the job queue. The edge cluster is
+// marked as failed if the job cannot be enqueued.
+func (service *businessService) enqueueProvisioningJob(
+	ctx context.Context,
+	jobType job.JobType,
+	edgeClusterID string,
+	userEmail string,
+	clusterType models.ClusterType) error {
+	if _, err := service.jobQueueService.EnqueueJob(ctx, &job.EnqueueJobRequest{
+		Type:          jobType,
+		EdgeClusterID: edgeClusterID,
+		UserEmail:     userEmail,
+		ClusterType:   clusterType,
+	}); err != nil {
+		updateProvisioningState(
+			ctx,
+			service.logger,
+			service.repositoryService,
+			service.eventBusService,
+			edgeClusterID,
+			models.ProvisioningStatusFailed,
+			err)
+
+		return commonErrors.NewUnknownErrorWithError("failed to enqueue the provisioning job", err)
+	}
+
+	return nil
+}
@@ -10,8 +10,16 @@ import (
 
 	"github.com/decentralized-cloud/edge-cluster/models"
 	"github.com/decentralized-cloud/edge-cluster/services/business"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	accessMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/access/mock"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
 	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
 	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	jobMock "github.com/decentralized-cloud/edge-cluster/services/job/mock"
 	repository "github.com/decentralized-cloud/edge-cluster/services/repository"
 	repsoitoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
 	"github.com/golang/mock/gomock"
@@ -19,6 +27,9 @@ import (
 	"github.com/micro-business/go-core/common"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -35,9 +46,15 @@ var _ = Describe("Business Service Tests", func() {
 	var (
 		mockCtrl                          *gomock.Controller
 		sut                               business.BusinessContract
+		mockConfigurationService          *configurationMock.MockConfigurationContract
 		mockRepositoryService             *repsoitoryMock.MockRepositoryContract
 		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
 		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockJobQueueService               *jobMock.MockJobQueueContract
+		mockEventBusService               *eventMock.MockEventBusContract
+		mockHelmService                   *helmMock.MockHelmHelperContract
+		mockKubeconfigIssuerService       *accessMock.MockKubeconfigIssuerContract
+		clientset                         *fake.Clientset
 		ctx                               context.Context
 		logger                            *zap.Logger
 	)
@@ -45,7 +62,18 @@ var _ = Describe("Business Service Tests", func() {
 	BeforeEach(func() {
 		mockCtrl = gomock.NewController(GinkgoT())
 
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetAdminEmails().Return([]string{}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetKubeconfigIssuanceMaxTTL().Return(24*time.Hour, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedCIDRs().Return([]string{"203.0.113.0/24"}, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetServiceExposureAllowedDomains().Return([]string{"edge.example.com"}, nil).AnyTimes()
+
 		mockRepositoryService = repsoitoryMock.NewMockRepositoryContract(mockCtrl)
+		mockRepositoryService.
+			EXPECT().
+			UpdateProvisioningState(gomock.Any(), gomock.Any()).
+			Return(&repository.UpdateProvisioningStateResponse{}, nil).
+			AnyTimes()
 
 		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
 		mockEdgeClusterProvisionerService.
@@ -77,14 +105,32 @@ var _ = Describe("Business Service Tests", func() {
 			Return(mockEdgeClusterProvisionerService, nil).
 			AnyTimes()
 
+		mockJobQueueService = jobMock.NewMockJobQueueContract(mockCtrl)
+		mockEventBusService = eventMock.NewMockEventBusContract(mockCtrl)
+		mockEventBusService.
+			EXPECT().
+			Publish(gomock.Any(), gomock.Any()).
+			Return(&event.PublishResponse{}, nil).
+			AnyTimes()
+
+		mockHelmService = helmMock.NewMockHelmHelperContract(mockCtrl)
+		mockKubeconfigIssuerService = accessMock.NewMockKubeconfigIssuerContract(mockCtrl)
+		clientset = fake.NewSimpleClientset()
+
 		var err error
 		logger, err = zap.NewProduction()
 		Ω(err).Should(BeNil())
 
 		sut, _ = business.NewBusinessService(
 			logger,
+			mockConfigurationService,
 			mockRepositoryService,
 			mockEdgeClusterFactoryService,
+			mockJobQueueService,
+			mockEventBusService,
+			mockHelmService,
+			clientset,
+			mockKubeconfigIssuerService,
 		)
 		ctx = context.Background()
 	})
@@ -94,12 +140,37 @@ var _ = Describe("Business Service Tests", func() {
 	})
 
 	Context("user tries to instantiate BusinessService", func() {
+		When("configuration service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(
+					logger,
+					nil,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					mockEventBusService,
+					mockHelmService,
+
+					clientset,
+					mockKubeconfigIssuerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
 		When("edge cluster repository service is not provided and NewBusinessService is called", func() {
 			It("should return ArgumentNilError", func() {
 				service, err := business.NewBusinessService(
 					logger,
+					mockConfigurationService,
 					nil,
-					mockEdgeClusterFactoryService)
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					mockEventBusService,
+					mockHelmService,
+
+					clientset,
+					mockKubeconfigIssuerService)
 				Ω(service).Should(BeNil())
 				assertArgumentNilError("repositoryService", "", err)
 			})
@@ -109,19 +180,120 @@ var _ = Describe("Business Service Tests", func() {
 			It("should return ArgumentNilError", func() {
 				service, err := business.NewBusinessService(
 					logger,
+					mockConfigurationService,
 					mockRepositoryService,
-					nil)
+					nil,
+					mockJobQueueService,
+					mockEventBusService,
+					mockHelmService,
+
+					clientset,
+					mockKubeconfigIssuerService)
 				Ω(service).Should(BeNil())
 				assertArgumentNilError("edgeClusterFactoryService", "", err)
 			})
 		})
 
+		When("job queue service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					nil,
+					mockEventBusService,
+					mockHelmService,
+
+					clientset,
+					mockKubeconfigIssuerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("jobQueueService", "", err)
+			})
+		})
+
+		When("event bus service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					nil,
+					mockHelmService,
+
+					clientset,
+					mockKubeconfigIssuerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("eventBusService", "", err)
+			})
+		})
+
+		When("helm service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					mockEventBusService,
+					nil,
+
+					clientset,
+					mockKubeconfigIssuerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("helmService", "", err)
+			})
+		})
+
+		When("clientset is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					mockEventBusService,
+					mockHelmService,
+					nil,
+					mockKubeconfigIssuerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("clientset", "", err)
+			})
+		})
+
+		When("kubeconfig issuer service is not provided and NewBusinessService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := business.NewBusinessService(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					mockEventBusService,
+					mockHelmService,
+					clientset,
+					nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("kubeconfigIssuerService", "", err)
+			})
+		})
+
 		When("all dependencies are resolved and NewBusinessService is called", func() {
 			It("should instantiate the new BusinessService", func() {
 				service, err := business.NewBusinessService(
 					logger,
+					mockConfigurationService,
 					mockRepositoryService,
-					mockEdgeClusterFactoryService)
+					mockEdgeClusterFactoryService,
+					mockJobQueueService,
+					mockEventBusService,
+					mockHelmService,
+					clientset,
+					mockKubeconfigIssuerService)
 				Ω(err).Should(BeNil())
 				Ω(service).ShouldNot(BeNil())
 			})
@@ -144,6 +316,68 @@ var _ = Describe("Business Service Tests", func() {
 		})
 
 		Context("edge cluster service is instantiated", func() {
+			When("the edge cluster type is not supported", func() {
+				It("should return ArgumentError without persisting the edge cluster", func() {
+					unsupportedClusterType := models.ClusterType(rand.Intn(100) + 100)
+					request.EdgeCluster.ClusterType = unsupportedClusterType
+					mockEdgeClusterFactoryService.
+						EXPECT().
+						Create(gomock.Any(), unsupportedClusterType).
+						Return(nil, edgeClusterTypes.NewEdgeClusterTypeNotSupportedError(unsupportedClusterType))
+
+					response, err := sut.CreateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+					Ω(errors.Unwrap(response.Err)).Should(Equal(edgeClusterTypes.NewEdgeClusterTypeNotSupportedError(unsupportedClusterType)))
+				})
+			})
+
+			When("the edge cluster is exposed on a host name outside the allowed domains", func() {
+				It("should return ArgumentError without persisting the edge cluster", func() {
+					request.EdgeCluster.ServiceExposure = models.ServiceExposure{
+						Mode:    models.ServiceExposureModeIngress,
+						Address: cuid.New() + ".example.com",
+					}
+
+					response, err := sut.CreateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the edge cluster is exposed on an external IP outside the allowed networks", func() {
+				It("should return ArgumentError without persisting the edge cluster", func() {
+					request.EdgeCluster.ServiceExposure = models.ServiceExposure{
+						Mode:    models.ServiceExposureModeExternalAddress,
+						Address: "8.8.8.8",
+					}
+
+					response, err := sut.CreateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the edge cluster is exposed on a host name claimed by another ingress", func() {
+				It("should return ArgumentError without persisting the edge cluster", func() {
+					host := cuid.New() + ".edge.example.com"
+					_, err := clientset.NetworkingV1().Ingresses(cuid.New()).Create(ctx, &networkingv1.Ingress{
+						ObjectMeta: metav1.ObjectMeta{Name: cuid.New()},
+						Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: host}}},
+					}, metav1.CreateOptions{})
+					Ω(err).Should(BeNil())
+
+					request.EdgeCluster.ServiceExposure = models.ServiceExposure{
+						Mode:    models.ServiceExposureModeIngress,
+						Address: host,
+					}
+
+					response, err := sut.CreateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
 			When("CreateEdgeCluster is called", func() {
 				It("should call edge cluster repository CreateEdgeCluster method", func() {
 					mockRepositoryService.
@@ -158,6 +392,11 @@ var _ = Describe("Business Service Tests", func() {
 								return &repository.CreateEdgeClusterResponse{}, nil
 							})
 
+					mockJobQueueService.
+						EXPECT().
+						EnqueueJob(gomock.Any(), gomock.Any()).
+						Return(&job.EnqueueJobResponse{JobID: cuid.New()}, nil)
+
 					response, err := sut.CreateEdgeCluster(ctx, &request)
 					Ω(err).Should(BeNil())
 					Ω(response.Err).Should(BeNil())
@@ -195,6 +434,11 @@ var _ = Describe("Business Service Tests", func() {
 							CreateEdgeCluster(gomock.Any(), gomock.Any()).
 							Return(&expectedResponse, nil)
 
+						mockJobQueueService.
+							EXPECT().
+							EnqueueJob(gomock.Any(), gomock.Any()).
+							Return(&job.EnqueueJobResponse{JobID: cuid.New()}, nil)
+
 						response, err := sut.CreateEdgeCluster(ctx, &request)
 						Ω(err).Should(BeNil())
 						Ω(response.Err).Should(BeNil())
@@ -203,6 +447,52 @@ var _ = Describe("Business Service Tests", func() {
 						Ω(response.EdgeCluster).Should(Equal(expectedResponse.EdgeCluster))
 					})
 				})
+
+				When("edge cluster repository CreateEdgeCluster return no error", func() {
+					It("should enqueue a create provision job", func() {
+						edgeClusterID := cuid.New()
+						mockRepositoryService.
+							EXPECT().
+							CreateEdgeCluster(gomock.Any(), gomock.Any()).
+							Return(&repository.CreateEdgeClusterResponse{EdgeClusterID: edgeClusterID}, nil)
+
+						mockJobQueueService.
+							EXPECT().
+							EnqueueJob(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *job.EnqueueJobRequest) (*job.EnqueueJobResponse, error) {
+									Ω(mappedRequest.Type).Should(Equal(job.JobTypeCreateProvision))
+									Ω(mappedRequest.EdgeClusterID).Should(Equal(edgeClusterID))
+									Ω(mappedRequest.ClusterType).Should(Equal(request.EdgeCluster.ClusterType))
+
+									return &job.EnqueueJobResponse{JobID: cuid.New()}, nil
+								})
+
+						response, err := sut.CreateEdgeCluster(ctx, &request)
+						Ω(err).Should(BeNil())
+						Ω(response.Err).Should(BeNil())
+					})
+				})
+
+				When("job queue EnqueueJob returns error", func() {
+					It("should return error", func() {
+						mockRepositoryService.
+							EXPECT().
+							CreateEdgeCluster(gomock.Any(), gomock.Any()).
+							Return(&repository.CreateEdgeClusterResponse{EdgeClusterID: cuid.New()}, nil)
+
+						mockJobQueueService.
+							EXPECT().
+							EnqueueJob(gomock.Any(), gomock.Any()).
+							Return(nil, errors.New(cuid.New()))
+
+						response, err := sut.CreateEdgeCluster(ctx, &request)
+						Ω(err).ShouldNot(BeNil())
+						Ω(response).Should(BeNil())
+					})
+				})
 			})
 		})
 	})
@@ -279,6 +569,161 @@ var _ = Describe("Business Service Tests", func() {
 		})
 	})
 
+	Describe("WatchEdgeCluster is called", func() {
+		var (
+			request       business.WatchEdgeClusterRequest
+			watchCtx      context.Context
+			cancelWatch   context.CancelFunc
+			busEvents     chan models.EdgeClusterEvent
+			subscribedCtx context.Context
+		)
+
+		BeforeEach(func() {
+			request = business.WatchEdgeClusterRequest{
+				UserEmail:     cuid.New() + "@test.com",
+				EdgeClusterID: cuid.New(),
+			}
+
+			watchCtx, cancelWatch = context.WithCancel(ctx)
+			busEvents = make(chan models.EdgeClusterEvent, 1)
+			mockEventBusService.
+				EXPECT().
+				Subscribe(gomock.Any(), &event.SubscribeRequest{EdgeClusterID: request.EdgeClusterID}).
+				DoAndReturn(
+					func(
+						subscriptionCtx context.Context,
+						_ *event.SubscribeRequest) (*event.SubscribeResponse, error) {
+						subscribedCtx = subscriptionCtx
+
+						return &event.SubscribeResponse{Events: busEvents}, nil
+					})
+		})
+
+		AfterEach(func() {
+			cancelWatch()
+		})
+
+		When("edge cluster repository ReadEdgeCluster returns error", func() {
+			It("should return the same error and end the subscription", func() {
+				expectedError := errors.New(cuid.New())
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), &repository.ReadEdgeClusterRequest{
+						UserEmail:     request.UserEmail,
+						EdgeClusterID: request.EdgeClusterID,
+					}).
+					Return(nil, expectedError)
+
+				response, err := sut.WatchEdgeCluster(watchCtx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+				Eventually(subscribedCtx.Done()).Should(BeClosed())
+			})
+		})
+
+		When("edge cluster exists", func() {
+			var (
+				provisioningState models.ProvisioningState
+			)
+
+			BeforeEach(func() {
+				provisioningState = models.ProvisioningState{
+					Status:    models.ProvisioningStatusProvisioning,
+					UpdatedAt: time.Now().UTC(),
+				}
+
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), gomock.Any()).
+					Return(&repository.ReadEdgeClusterResponse{
+						EdgeCluster:       models.EdgeCluster{ClusterType: models.K3S},
+						ProvisioningState: provisioningState,
+					}, nil)
+			})
+
+			It("should send the current status followed by the published events", func() {
+				response, err := sut.WatchEdgeCluster(watchCtx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+
+				var snapshot models.EdgeClusterEvent
+				Eventually(response.Events).Should(Receive(&snapshot))
+				Ω(snapshot.EdgeClusterID).Should(Equal(request.EdgeClusterID))
+				Ω(snapshot.Type).Should(Equal(models.EdgeClusterEventTypeStatusChanged))
+				Ω(snapshot.Status).Should(Equal(provisioningState.Status))
+				Ω(snapshot.Timestamp).Should(Equal(provisioningState.UpdatedAt))
+
+				publishedEvent := models.EdgeClusterEvent{
+					EdgeClusterID: request.EdgeClusterID,
+					Type:          models.EdgeClusterEventTypeNamespaceCreated,
+					Status:        models.ProvisioningStatusProvisioning,
+					Message:       cuid.New(),
+				}
+				busEvents <- publishedEvent
+
+				Eventually(response.Events).Should(Receive(Equal(publishedEvent)))
+			})
+
+			It("should close the events channel when the context is cancelled", func() {
+				response, err := sut.WatchEdgeCluster(watchCtx, &request)
+				Ω(err).Should(BeNil())
+
+				cancelWatch()
+
+				Eventually(response.Events).Should(BeClosed())
+			})
+		})
+	})
+
+	Describe("ListSupportedClusterTypes is called", func() {
+		var (
+			request business.ListSupportedClusterTypesRequest
+		)
+
+		BeforeEach(func() {
+			request = business.ListSupportedClusterTypesRequest{
+				UserEmail: cuid.New() + "@test.com",
+			}
+		})
+
+		When("edge cluster factory ListSupportedClusterTypes returns error", func() {
+			It("should return the same error", func() {
+				expectedError := errors.New(cuid.New())
+				mockEdgeClusterFactoryService.
+					EXPECT().
+					ListSupportedClusterTypes(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				response, err := sut.ListSupportedClusterTypes(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(Equal(expectedError))
+			})
+		})
+
+		When("edge cluster factory ListSupportedClusterTypes returns the supported types", func() {
+			It("should return the same types", func() {
+				clusterTypes := []models.ClusterTypeDescriptor{
+					{
+						ClusterType:       models.K3S,
+						Name:              cuid.New(),
+						SupportedFeatures: []string{cuid.New()},
+						DefaultCharts:     []string{cuid.New()},
+					},
+				}
+
+				mockEdgeClusterFactoryService.
+					EXPECT().
+					ListSupportedClusterTypes(gomock.Any(), gomock.Any()).
+					Return(&edgeClusterTypes.ListSupportedClusterTypesResponse{ClusterTypes: clusterTypes}, nil)
+
+				response, err := sut.ListSupportedClusterTypes(ctx, &request)
+				Ω(err).Should(BeNil())
+				Ω(response.Err).Should(BeNil())
+				Ω(response.ClusterTypes).Should(Equal(clusterTypes))
+			})
+		})
+	})
+
 	Describe("UpdateEdgeCluster", func() {
 		var (
 			request business.UpdateEdgeClusterRequest
@@ -297,6 +742,75 @@ var _ = Describe("Business Service Tests", func() {
 		})
 
 		Context("edge cluster service is instantiated", func() {
+			When("the edge cluster type is not supported", func() {
+				It("should return ArgumentError without persisting the edge cluster", func() {
+					unsupportedClusterType := models.ClusterType(rand.Intn(100) + 100)
+					request.EdgeCluster.ClusterType = unsupportedClusterType
+					mockEdgeClusterFactoryService.
+						EXPECT().
+						Create(gomock.Any(), unsupportedClusterType).
+						Return(nil, edgeClusterTypes.NewEdgeClusterTypeNotSupportedError(unsupportedClusterType))
+
+					response, err := sut.UpdateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+					Ω(errors.Unwrap(response.Err)).Should(Equal(edgeClusterTypes.NewEdgeClusterTypeNotSupportedError(unsupportedClusterType)))
+				})
+			})
+
+			When("the edge cluster is exposed on a host name claimed by another ingress", func() {
+				It("should return ArgumentError without persisting the edge cluster", func() {
+					host := cuid.New() + ".edge.example.com"
+					_, err := clientset.NetworkingV1().Ingresses(cuid.New()).Create(ctx, &networkingv1.Ingress{
+						ObjectMeta: metav1.ObjectMeta{Name: cuid.New()},
+						Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: host}}},
+					}, metav1.CreateOptions{})
+					Ω(err).Should(BeNil())
+
+					request.EdgeCluster.ServiceExposure = models.ServiceExposure{
+						Mode:    models.ServiceExposureModeIngress,
+						Address: host,
+					}
+
+					response, err := sut.UpdateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(commonErrors.IsArgumentError(response.Err)).Should(BeTrue())
+				})
+			})
+
+			When("the edge cluster is exposed on a host name claimed by its own ingress", func() {
+				It("should persist the edge cluster", func() {
+					host := cuid.New() + ".edge.example.com"
+					_, err := clientset.NetworkingV1().Ingresses(provision.GetNamespace(request.EdgeClusterID)).Create(
+						ctx,
+						&networkingv1.Ingress{
+							ObjectMeta: metav1.ObjectMeta{Name: "k3s"},
+							Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: host}}},
+						},
+						metav1.CreateOptions{})
+					Ω(err).Should(BeNil())
+
+					request.EdgeCluster.ServiceExposure = models.ServiceExposure{
+						Mode:    models.ServiceExposureModeIngress,
+						Address: host,
+					}
+
+					mockRepositoryService.
+						EXPECT().
+						UpdateEdgeCluster(ctx, gomock.Any()).
+						Return(&repository.UpdateEdgeClusterResponse{}, nil)
+
+					mockJobQueueService.
+						EXPECT().
+						EnqueueJob(gomock.Any(), gomock.Any()).
+						Return(&job.EnqueueJobResponse{JobID: cuid.New()}, nil)
+
+					response, err := sut.UpdateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
+
 			When("UpdateEdgeCluster is called", func() {
 				It("should call edge cluster repository UpdateEdgeCluster method", func() {
 					mockRepositoryService.
@@ -315,6 +829,11 @@ var _ = Describe("Business Service Tests", func() {
 								return &repository.UpdateEdgeClusterResponse{}, nil
 							})
 
+					mockJobQueueService.
+						EXPECT().
+						EnqueueJob(gomock.Any(), gomock.Any()).
+						Return(&job.EnqueueJobResponse{JobID: cuid.New()}, nil)
+
 					response, err := sut.UpdateEdgeCluster(ctx, &request)
 					Ω(err).Should(BeNil())
 					Ω(response.Err).Should(BeNil())
@@ -351,12 +870,43 @@ var _ = Describe("Business Service Tests", func() {
 						UpdateEdgeCluster(gomock.Any(), gomock.Any()).
 						Return(&expectedResponse, nil)
 
+					mockJobQueueService.
+						EXPECT().
+						EnqueueJob(gomock.Any(), gomock.Any()).
+						Return(&job.EnqueueJobResponse{JobID: cuid.New()}, nil)
+
 					response, err := sut.UpdateEdgeCluster(ctx, &request)
 					Ω(err).Should(BeNil())
 					Ω(response.Err).Should(BeNil())
 					Ω(response.EdgeCluster).Should(Equal(expectedResponse.EdgeCluster))
 				})
 			})
+
+			When("edge cluster repository UpdateEdgeCluster return no error", func() {
+				It("should enqueue an update provision job", func() {
+					mockRepositoryService.
+						EXPECT().
+						UpdateEdgeCluster(gomock.Any(), gomock.Any()).
+						Return(&repository.UpdateEdgeClusterResponse{}, nil)
+
+					mockJobQueueService.
+						EXPECT().
+						EnqueueJob(ctx, gomock.Any()).
+						DoAndReturn(
+							func(
+								_ context.Context,
+								mappedRequest *job.EnqueueJobRequest) (*job.EnqueueJobResponse, error) {
+								Ω(mappedRequest.Type).Should(Equal(job.JobTypeUpdateProvision))
+								Ω(mappedRequest.EdgeClusterID).Should(Equal(request.EdgeClusterID))
+
+								return &job.EnqueueJobResponse{JobID: cuid.New()}, nil
+							})
+
+					response, err := sut.UpdateEdgeCluster(ctx, &request)
+					Ω(err).Should(BeNil())
+					Ω(response.Err).Should(BeNil())
+				})
+			})
 		})
 	})
 
@@ -372,49 +922,66 @@ var _ = Describe("Business Service Tests", func() {
 		})
 
 		Context("edge cluster service is instantiated", func() {
-			When("DeleteEdgeCluster is called", func() {
-				It("should call edge cluster repository DeleteEdgeCluster method", func() {
+			When("edge cluster repository ReadEdgeCluster returns error", func() {
+				It("should return the same error and should not delete the edge cluster", func() {
+					expectedError := errors.New(cuid.New())
 					mockRepositoryService.
 						EXPECT().
-						DeleteEdgeCluster(ctx, gomock.Any()).
-						DoAndReturn(
-							func(
-								_ context.Context,
-								mappedRequest *repository.DeleteEdgeClusterRequest) (*repository.DeleteEdgeClusterResponse, error) {
-								Ω(mappedRequest.EdgeClusterID).Should(Equal(request.EdgeClusterID))
-								return &repository.DeleteEdgeClusterResponse{}, nil
-							})
+						ReadEdgeCluster(gomock.Any(), gomock.Any()).
+						Return(nil, expectedError)
 
 					response, err := sut.DeleteEdgeCluster(ctx, &request)
 					Ω(err).Should(BeNil())
-					Ω(response.Err).Should(BeNil())
+					Ω(response.Err).Should(Equal(expectedError))
 				})
 			})
+		})
 
-			When("edge cluster repository DeleteEdgeCluster returns error", func() {
-				It("should return the same error", func() {
-					expectedError := errors.New(cuid.New())
-					mockRepositoryService.
+		Context("edge cluster exists", func() {
+			BeforeEach(func() {
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(ctx, gomock.Any()).
+					Return(&repository.ReadEdgeClusterResponse{
+						EdgeCluster: models.EdgeCluster{
+							Name:        cuid.New(),
+							ProjectID:   cuid.New(),
+							ClusterType: models.K3S,
+						}}, nil)
+			})
+
+			When("DeleteEdgeCluster is called", func() {
+				It("should enqueue a delete provision job", func() {
+					mockJobQueueService.
 						EXPECT().
-						DeleteEdgeCluster(gomock.Any(), gomock.Any()).
-						Return(nil, expectedError)
+						EnqueueJob(ctx, gomock.Any()).
+						DoAndReturn(
+							func(
+								_ context.Context,
+								mappedRequest *job.EnqueueJobRequest) (*job.EnqueueJobResponse, error) {
+								Ω(mappedRequest.Type).Should(Equal(job.JobTypeDeleteProvision))
+								Ω(mappedRequest.EdgeClusterID).Should(Equal(request.EdgeClusterID))
+								Ω(mappedRequest.ClusterType).Should(Equal(models.K3S))
+
+								return &job.EnqueueJobResponse{JobID: cuid.New()}, nil
+							})
 
 					response, err := sut.DeleteEdgeCluster(ctx, &request)
 					Ω(err).Should(BeNil())
-					Ω(response.Err).Should(Equal(expectedError))
+					Ω(response.Err).Should(BeNil())
 				})
 			})
 
-			When("edge cluster repository DeleteEdgeCluster completes successfully", func() {
-				It("should return no error", func() {
-					mockRepositoryService.
+			When("job queue EnqueueJob returns error", func() {
+				It("should return error", func() {
+					mockJobQueueService.
 						EXPECT().
-						DeleteEdgeCluster(gomock.Any(), gomock.Any()).
-						Return(&repository.DeleteEdgeClusterResponse{}, nil)
+						EnqueueJob(gomock.Any(), gomock.Any()).
+						Return(nil, errors.New(cuid.New()))
 
 					response, err := sut.DeleteEdgeCluster(ctx, &request)
-					Ω(err).Should(BeNil())
-					Ω(response.Err).Should(BeNil())
+					Ω(err).ShouldNot(BeNil())
+					Ω(response).Should(BeNil())
 				})
 			})
 		})
@@ -2,8 +2,15 @@
 package business
 
 import (
+	"errors"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
+	k8sValidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Validate validates the CreateEdgeClusterRequest model and return error if the validation failes
@@ -93,3 +100,280 @@ func (val ListEdgeClusterServicesRequest) Validate() error {
 		validation.Field(&val.EdgeClusterID, validation.Required),
 	)
 }
+
+// Validate validates the WatchEdgeClusterRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val WatchEdgeClusterRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+	)
+}
+
+// Validate validates the ListSupportedClusterTypesRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListSupportedClusterTypesRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the InstallHelmReleaseRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val InstallHelmReleaseRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+		// Namespace cannot be empty
+		validation.Field(&val.Namespace, validation.Required),
+		// ReleaseName cannot be empty
+		validation.Field(&val.ReleaseName, validation.Required),
+		// RepositoryName cannot be empty
+		validation.Field(&val.RepositoryName, validation.Required),
+		// RepositoryURL must be a valid URL or an OCI registry reference if provided
+		validation.Field(&val.RepositoryURL, validation.By(validateRepositoryURL)),
+		// Chart cannot be empty and must be the name of a chart in the repository
+		validation.Field(&val.Chart, validation.Required, validation.By(validateChartName)),
+		// Timeout cannot be negative, zero uses the default timeout
+		validation.Field(&val.Timeout, validation.Min(0)),
+	)
+}
+
+// Validate validates the UpgradeHelmReleaseRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val UpgradeHelmReleaseRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+		// Namespace cannot be empty
+		validation.Field(&val.Namespace, validation.Required),
+		// ReleaseName cannot be empty
+		validation.Field(&val.ReleaseName, validation.Required),
+		// RepositoryName cannot be empty
+		validation.Field(&val.RepositoryName, validation.Required),
+		// RepositoryURL must be a valid URL or an OCI registry reference if provided
+		validation.Field(&val.RepositoryURL, validation.By(validateRepositoryURL)),
+		// Chart cannot be empty and must be the name of a chart in the repository
+		validation.Field(&val.Chart, validation.Required, validation.By(validateChartName)),
+		// Timeout cannot be negative, zero uses the default timeout
+		validation.Field(&val.Timeout, validation.Min(0)),
+	)
+}
+
+// Validate validates the RollbackHelmReleaseRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RollbackHelmReleaseRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+		// Namespace cannot be empty
+		validation.Field(&val.Namespace, validation.Required),
+		// ReleaseName cannot be empty
+		validation.Field(&val.ReleaseName, validation.Required),
+		// Revision cannot be negative, zero rolls back to the previous revision
+		validation.Field(&val.Revision, validation.Min(0)),
+	)
+}
+
+// Validate validates the UninstallHelmReleaseRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val UninstallHelmReleaseRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+		// Namespace cannot be empty
+		validation.Field(&val.Namespace, validation.Required),
+		// ReleaseName cannot be empty
+		validation.Field(&val.ReleaseName, validation.Required),
+		// Timeout cannot be negative, zero uses the default timeout
+		validation.Field(&val.Timeout, validation.Min(0)),
+	)
+}
+
+// Validate validates the ListHelmReleasesRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListHelmReleasesRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+	)
+}
+
+// Validate validates the AddHelmRepositoryRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val AddHelmRepositoryRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Name cannot be empty
+		validation.Field(&val.Name, validation.Required),
+		// URL must be a valid chart repository URL or OCI registry reference
+		validation.Field(&val.URL, validation.Required, validation.By(validateRepositoryURL)),
+	)
+}
+
+// Validate validates the RemoveHelmRepositoryRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RemoveHelmRepositoryRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Name cannot be empty
+		validation.Field(&val.Name, validation.Required),
+	)
+}
+
+// Validate validates the ListHelmRepositoriesRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListHelmRepositoriesRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the UpdateHelmRepositoriesRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val UpdateHelmRepositoriesRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the ListOrphanedNamespacesRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListOrphanedNamespacesRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+	)
+}
+
+// Validate validates the DeleteOrphanedNamespaceRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val DeleteOrphanedNamespaceRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Name cannot be empty
+		validation.Field(&val.Name, validation.Required),
+	)
+}
+
+// Validate validates the AdoptOrphanedNamespaceRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val AdoptOrphanedNamespaceRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// Name cannot be empty
+		validation.Field(&val.Name, validation.Required),
+	)
+}
+
+// Validate validates the IssueKubeconfigRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val IssueKubeconfigRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+		// Role must be one of the supported roles
+		validation.Field(&val.Role, validation.In(models.KubeconfigRoleView, models.KubeconfigRoleEdit, models.KubeconfigRoleAdmin)),
+		// Namespace must be a valid namespace name if provided
+		validation.Field(&val.Namespace, validation.By(validateNamespaceName)),
+		// TTL must be provided and cannot be shorter than the service account tokens can be issued for
+		validation.Field(&val.TTL, validation.Required, validation.Min(access.MinTTL)),
+	)
+}
+
+// Validate validates the RevokeKubeconfigRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val RevokeKubeconfigRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+		// IssuanceID cannot be empty
+		validation.Field(&val.IssuanceID, validation.Required),
+	)
+}
+
+// Validate validates the ListKubeconfigIssuancesRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val ListKubeconfigIssuancesRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+	)
+}
+
+// Validate validates the GetEdgeClusterKubeconfigRequest model and return error if the validation failes
+// Returns error if validation failes
+func (val GetEdgeClusterKubeconfigRequest) Validate() error {
+	return validation.ValidateStruct(&val,
+		// Email must be provided
+		validation.Field(&val.UserEmail, validation.Required, is.Email),
+		// EdgeClusterID cannot be empty
+		validation.Field(&val.EdgeClusterID, validation.Required),
+	)
+}
+
+// validateRepositoryURL accepts the chart repository URLs and the oci:// references of the OCI registries, e.g.
+// oci://registry.example.com/charts
+func validateRepositoryURL(value interface{}) error {
+	url, _ := value.(string)
+	if helm.IsOCIReference(url) {
+		if strings.TrimPrefix(url, helm.OCIScheme) == "" {
+			return errors.New("must contain the OCI registry host")
+		}
+
+		return nil
+	}
+
+	return is.URL.Validate(url)
+}
+
+// validateChartName accepts the names of the charts in a repository. The chart is referenced as repository/chart, so a
+// chart name with a path or a scheme could resolve to a chart outside the repository, e.g. an absolute URL.
+func validateChartName(value interface{}) error {
+	chart, _ := value.(string)
+	if strings.ContainsAny(chart, "/:") {
+		return errors.New("must be the name of a chart in the repository")
+	}
+
+	return nil
+}
+
+// validateNamespaceName accepts the names the namespaces can be created with, which must be DNS-1123 labels. An empty
+// name is accepted, as it grants the role in all namespaces.
+func validateNamespaceName(value interface{}) error {
+	namespace, _ := value.(string)
+	if namespace == "" {
+		return nil
+	}
+
+	if errs := k8sValidation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+// Package catalogue implements the services that provide the catalogue of the helm charts installed on the edge clusters
+package catalogue
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+const (
+	// EdgeClusterIDPlaceholder is replaced with the identifier of the edge cluster the chart is installed on
+	EdgeClusterIDPlaceholder = "EDGE_CLUSTER_ID"
+
+	// EdgeClusterTypePlaceholder is replaced with the type name of the edge cluster the chart is installed on
+	EdgeClusterTypePlaceholder = "EDGE_CLUSTER_TYPE"
+)
+
+// DefaultCharts returns the charts installed on the edge clusters when no chart catalogue is configured
+func DefaultCharts() []models.ChartCatalogueEntry {
+	return []models.ChartCatalogueEntry{
+		{
+			ReleaseName:    "portainer",
+			RepositoryName: "portainer",
+			RepositoryURL:  "https://portainer.github.io/k8s",
+			Chart:          "portainer",
+			Namespace:      "portainer",
+			Set:            []string{"service.type=LoadBalancer"},
+			Order:          0,
+		},
+		{
+			ReleaseName:    "edge-core",
+			RepositoryName: "decentralized-cloud",
+			RepositoryURL:  "https://decentralized-cloud.github.io/helm",
+			Chart:          "edge-core",
+			Namespace:      "edgecluster",
+			Set:            []string{"pod.edgeClusterType=${" + EdgeClusterTypePlaceholder + "}"},
+			Order:          1,
+		},
+	}
+}
+
+// ValidateCharts validates the charts of a catalogue
+// charts: Mandatory. The charts to validate
+// Returns error if a chart misses a required field, a release name is used more than once or a chart depends on an
+// unknown release
+func ValidateCharts(charts []models.ChartCatalogueEntry) error {
+	releaseNames := map[string]bool{}
+	for _, chart := range charts {
+		if strings.TrimSpace(chart.ReleaseName) == "" {
+			return commonErrors.NewUnknownError("chart catalogue entry release name is required")
+		}
+
+		if releaseNames[chart.ReleaseName] {
+			return commonErrors.NewUnknownError(fmt.Sprintf("chart catalogue release %s is defined more than once", chart.ReleaseName))
+		}
+
+		releaseNames[chart.ReleaseName] = true
+
+		if strings.TrimSpace(chart.RepositoryName) == "" ||
+			strings.TrimSpace(chart.RepositoryURL) == "" ||
+			strings.TrimSpace(chart.Chart) == "" ||
+			strings.TrimSpace(chart.Namespace) == "" {
+			return commonErrors.NewUnknownError(fmt.Sprintf(
+				"chart catalogue release %s requires repository name, repository URL, chart and namespace",
+				chart.ReleaseName))
+		}
+	}
+
+	for _, chart := range charts {
+		for _, dependency := range chart.DependsOn {
+			if !releaseNames[dependency] {
+				return commonErrors.NewUnknownError(fmt.Sprintf(
+					"chart catalogue release %s depends on unknown release %s",
+					chart.ReleaseName,
+					dependency))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResolveInstallOrder returns the charts to be installed on an edge cluster of the given type, ordered so every
+// chart comes after the charts it depends on. Charts that do not depend on each other are ordered by their order
+// and then by their release name.
+// charts: Mandatory. The charts of the catalogue
+// clusterTypeName: Mandatory. The type name of the edge cluster the charts are installed on
+// Returns either the charts to install in the install order or error if the dependencies cannot be satisfied
+func ResolveInstallOrder(charts []models.ChartCatalogueEntry, clusterTypeName string) ([]models.ChartCatalogueEntry, error) {
+	if err := ValidateCharts(charts); err != nil {
+		return nil, err
+	}
+
+	applicableCharts := map[string]models.ChartCatalogueEntry{}
+	for _, chart := range charts {
+		if isApplicable(chart, clusterTypeName) {
+			applicableCharts[chart.ReleaseName] = chart
+		}
+	}
+
+	remainingDependencies := map[string]int{}
+	dependants := map[string][]string{}
+	for releaseName, chart := range applicableCharts {
+		for _, dependency := range chart.DependsOn {
+			if _, ok := applicableCharts[dependency]; !ok {
+				return nil, commonErrors.NewUnknownError(fmt.Sprintf(
+					"chart catalogue release %s depends on release %s that is not installed on %s edge clusters",
+					releaseName,
+					dependency,
+					clusterTypeName))
+			}
+
+			remainingDependencies[releaseName]++
+			dependants[dependency] = append(dependants[dependency], releaseName)
+		}
+	}
+
+	ready := []models.ChartCatalogueEntry{}
+	for releaseName, chart := range applicableCharts {
+		if remainingDependencies[releaseName] == 0 {
+			ready = append(ready, chart)
+		}
+	}
+
+	ordered := make([]models.ChartCatalogueEntry, 0, len(applicableCharts))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			if ready[i].Order != ready[j].Order {
+				return ready[i].Order < ready[j].Order
+			}
+
+			return ready[i].ReleaseName < ready[j].ReleaseName
+		})
+
+		next := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, next)
+
+		for _, dependant := range dependants[next.ReleaseName] {
+			remainingDependencies[dependant]--
+			if remainingDependencies[dependant] == 0 {
+				ready = append(ready, applicableCharts[dependant])
+			}
+		}
+	}
+
+	if len(ordered) != len(applicableCharts) {
+		return nil, commonErrors.NewUnknownError("chart catalogue contains circular dependencies")
+	}
+
+	return ordered, nil
+}
+
+// ExpandPlaceholders replaces the placeholders in the given value with the details of the edge cluster. Unknown
+// placeholders are left untouched.
+// value: Mandatory. The value to replace the placeholders in
+// edgeClusterID: Mandatory. The identifier of the edge cluster the chart is installed on
+// clusterTypeName: Mandatory. The type name of the edge cluster the chart is installed on
+// Returns the value with the placeholders replaced
+func ExpandPlaceholders(value, edgeClusterID, clusterTypeName string) string {
+	return strings.NewReplacer(
+		"${"+EdgeClusterIDPlaceholder+"}", edgeClusterID,
+		"${"+EdgeClusterTypePlaceholder+"}", clusterTypeName).Replace(value)
+}
+
+func isApplicable(chart models.ChartCatalogueEntry, clusterTypeName string) bool {
+	if len(chart.ClusterTypes) == 0 {
+		return true
+	}
+
+	for _, clusterType := range chart.ClusterTypes {
+		if strings.EqualFold(clusterType, clusterTypeName) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package catalogue_test
+
+import (
+	"testing"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/lucsky/cuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCatalogue(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Chart Catalogue Tests")
+}
+
+var _ = Describe("Chart Catalogue Tests", func() {
+	newChart := func(releaseName string, order int, dependsOn ...string) models.ChartCatalogueEntry {
+		return models.ChartCatalogueEntry{
+			ReleaseName:    releaseName,
+			RepositoryName: cuid.New(),
+			RepositoryURL:  "https://" + cuid.New(),
+			Chart:          cuid.New(),
+			Namespace:      cuid.New(),
+			Order:          order,
+			DependsOn:      dependsOn,
+		}
+	}
+
+	releaseNames := func(charts []models.ChartCatalogueEntry) []string {
+		names := []string{}
+		for _, chart := range charts {
+			names = append(names, chart.ReleaseName)
+		}
+
+		return names
+	}
+
+	Context("DefaultCharts is called", func() {
+		It("should return a valid catalogue installing portainer before edge-core", func() {
+			charts, err := catalogue.ResolveInstallOrder(catalogue.DefaultCharts(), "K3S")
+			Ω(err).Should(BeNil())
+			Ω(releaseNames(charts)).Should(Equal([]string{"portainer", "edge-core"}))
+		})
+	})
+
+	Context("ValidateCharts is called", func() {
+		It("should accept an empty catalogue", func() {
+			Ω(catalogue.ValidateCharts(nil)).Should(BeNil())
+		})
+
+		It("should reject a chart without release name", func() {
+			Ω(catalogue.ValidateCharts([]models.ChartCatalogueEntry{newChart("", 0)})).ShouldNot(BeNil())
+		})
+
+		It("should reject a chart without repository URL", func() {
+			chart := newChart(cuid.New(), 0)
+			chart.RepositoryURL = ""
+
+			Ω(catalogue.ValidateCharts([]models.ChartCatalogueEntry{chart})).ShouldNot(BeNil())
+		})
+
+		It("should reject duplicated release names", func() {
+			releaseName := cuid.New()
+
+			Ω(catalogue.ValidateCharts([]models.ChartCatalogueEntry{
+				newChart(releaseName, 0),
+				newChart(releaseName, 1),
+			})).ShouldNot(BeNil())
+		})
+
+		It("should reject dependencies on unknown releases", func() {
+			Ω(catalogue.ValidateCharts([]models.ChartCatalogueEntry{newChart(cuid.New(), 0, cuid.New())})).ShouldNot(BeNil())
+		})
+	})
+
+	Context("ResolveInstallOrder is called", func() {
+		It("should order independent charts by order and then by release name", func() {
+			charts, err := catalogue.ResolveInstallOrder([]models.ChartCatalogueEntry{
+				newChart("c", 1),
+				newChart("b", 0),
+				newChart("a", 1),
+			}, "K3S")
+
+			Ω(err).Should(BeNil())
+			Ω(releaseNames(charts)).Should(Equal([]string{"b", "a", "c"}))
+		})
+
+		It("should install the dependencies first regardless of their order", func() {
+			charts, err := catalogue.ResolveInstallOrder([]models.ChartCatalogueEntry{
+				newChart("app", 0, "database", "cache"),
+				newChart("database", 5),
+				newChart("cache", 10, "database"),
+				newChart("monitoring", 1),
+			}, "K3S")
+
+			Ω(err).Should(BeNil())
+			Ω(releaseNames(charts)).Should(Equal([]string{"monitoring", "database", "cache", "app"}))
+		})
+
+		It("should return error when dependencies are circular", func() {
+			_, err := catalogue.ResolveInstallOrder([]models.ChartCatalogueEntry{
+				newChart("a", 0, "b"),
+				newChart("b", 0, "a"),
+			}, "K3S")
+
+			Ω(err).ShouldNot(BeNil())
+		})
+
+		It("should only return the charts of the given cluster type", func() {
+			k0sChart := newChart("k0s-only", 0)
+			k0sChart.ClusterTypes = []string{"k0s"}
+
+			charts, err := catalogue.ResolveInstallOrder([]models.ChartCatalogueEntry{k0sChart, newChart("all", 1)}, "K3S")
+			Ω(err).Should(BeNil())
+			Ω(releaseNames(charts)).Should(Equal([]string{"all"}))
+
+			charts, err = catalogue.ResolveInstallOrder([]models.ChartCatalogueEntry{k0sChart, newChart("all", 1)}, "K0S")
+			Ω(err).Should(BeNil())
+			Ω(releaseNames(charts)).Should(Equal([]string{"k0s-only", "all"}))
+		})
+
+		It("should return error when a chart depends on a chart not installed on the cluster type", func() {
+			k0sChart := newChart("k0s-only", 0)
+			k0sChart.ClusterTypes = []string{"K0S"}
+
+			_, err := catalogue.ResolveInstallOrder([]models.ChartCatalogueEntry{k0sChart, newChart("all", 1, "k0s-only")}, "K3S")
+			Ω(err).ShouldNot(BeNil())
+		})
+	})
+
+	Context("ExpandPlaceholders is called", func() {
+		It("should replace the known placeholders and leave the rest untouched", func() {
+			edgeClusterID := cuid.New()
+
+			Ω(catalogue.ExpandPlaceholders(
+				"id=${EDGE_CLUSTER_ID},type=${EDGE_CLUSTER_TYPE},other=${OTHER},price=$5",
+				edgeClusterID,
+				"K3S")).Should(Equal("id=" + edgeClusterID + ",type=K3S,other=${OTHER},price=$5"))
+		})
+	})
+})
@@ -0,0 +1,18 @@
+// Package catalogue implements the services that provide the catalogue of the helm charts installed on the edge clusters
+package catalogue
+
+import (
+	"context"
+)
+
+// ChartCatalogueContract declares the methods to be implemented by the service that provides the helm charts the
+// provisioners install on the edge clusters
+type ChartCatalogueContract interface {
+	// ListCharts returns all the charts of the catalogue.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the charts of the catalogue
+	// Returns either the charts of the catalogue or error if something goes wrong.
+	ListCharts(
+		ctx context.Context,
+		request *ListChartsRequest) (*ListChartsResponse, error)
+}
@@ -0,0 +1,83 @@
+// Package file implements a chart catalogue that is loaded from a YAML file. The file is read on every request, so
+// changes to the file, e.g. a mounted ConfigMap, are picked up without restarting the service.
+package file
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type catalogueFile struct {
+	Charts []models.ChartCatalogueEntry `yaml:"charts"`
+}
+
+type fileChartCatalogueService struct {
+	filePath string
+}
+
+// NewFileChartCatalogueService creates new instance of the fileChartCatalogueService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewFileChartCatalogueService(
+	configurationService configuration.ConfigurationContract) (catalogue.ChartCatalogueContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	filePath, err := configurationService.GetChartCatalogueFilePath()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the chart catalogue file path", err)
+	}
+
+	service := &fileChartCatalogueService{
+		filePath: filePath,
+	}
+
+	// Fail fast on a missing or an invalid catalogue instead of failing the first provisioning
+	if _, err = service.readCharts(); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// ListCharts returns all the charts of the catalogue.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the charts of the catalogue
+// Returns either the charts of the catalogue or error if something goes wrong.
+func (service *fileChartCatalogueService) ListCharts(
+	ctx context.Context,
+	request *catalogue.ListChartsRequest) (*catalogue.ListChartsResponse, error) {
+	charts, err := service.readCharts()
+	if err != nil {
+		return nil, err
+	}
+
+	return &catalogue.ListChartsResponse{
+		Charts: charts,
+	}, nil
+}
+
+func (service *fileChartCatalogueService) readCharts() ([]models.ChartCatalogueEntry, error) {
+	content, err := ioutil.ReadFile(service.filePath)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to read the chart catalogue file", err)
+	}
+
+	var file catalogueFile
+	if err = yaml.UnmarshalStrict(content, &file); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to parse the chart catalogue file", err)
+	}
+
+	if err = catalogue.ValidateCharts(file.Charts); err != nil {
+		return nil, err
+	}
+
+	return file.Charts, nil
+}
@@ -0,0 +1,168 @@
+package file_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue/file"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/golang/mock/gomock"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const catalogueContent = `charts:
+  - releaseName: edge-core
+    repositoryName: decentralized-cloud
+    repositoryURL: https://decentralized-cloud.github.io/helm
+    chart: edge-core
+    version: 0.1.0
+    namespace: edgecluster
+    values: |
+      pod:
+        edgeClusterType: ${EDGE_CLUSTER_TYPE}
+    order: 1
+    dependsOn:
+      - portainer
+  - releaseName: portainer
+    repositoryName: portainer
+    repositoryURL: https://portainer.github.io/k8s
+    chart: portainer
+    namespace: portainer
+    set:
+      - service.type=LoadBalancer
+    atomic: true
+    timeout: 10m
+    clusterTypes:
+      - K3S
+`
+
+func TestFileChartCatalogueService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "File Chart Catalogue Service Tests")
+}
+
+var _ = Describe("File Chart Catalogue Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		directory                string
+		filePath                 string
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+
+		var err error
+		directory, err = ioutil.TempDir("", "chart-catalogue")
+		Ω(err).Should(BeNil())
+
+		filePath = filepath.Join(directory, "catalogue.yaml")
+		mockConfigurationService.
+			EXPECT().
+			GetChartCatalogueFilePath().
+			Return(filePath, nil).
+			AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+		_ = os.RemoveAll(directory)
+	})
+
+	Context("user tries to instantiate FileChartCatalogueService", func() {
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := file.NewFileChartCatalogueService(nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("the catalogue file does not exist", func() {
+			It("should return error", func() {
+				service, err := file.NewFileChartCatalogueService(mockConfigurationService)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("the catalogue file contains unknown fields", func() {
+			It("should return error", func() {
+				Ω(ioutil.WriteFile(filePath, []byte("charts:\n  - releaseNam: typo\n"), 0600)).Should(BeNil())
+
+				service, err := file.NewFileChartCatalogueService(mockConfigurationService)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("the catalogue file is valid", func() {
+			It("should list the charts of the file and pick up the changes to the file", func() {
+				Ω(ioutil.WriteFile(filePath, []byte(catalogueContent), 0600)).Should(BeNil())
+
+				service, err := file.NewFileChartCatalogueService(mockConfigurationService)
+				Ω(err).Should(BeNil())
+
+				response, err := service.ListCharts(context.Background(), &catalogue.ListChartsRequest{})
+				Ω(err).Should(BeNil())
+				Ω(response.Charts).Should(Equal([]models.ChartCatalogueEntry{
+					{
+						ReleaseName:    "edge-core",
+						RepositoryName: "decentralized-cloud",
+						RepositoryURL:  "https://decentralized-cloud.github.io/helm",
+						Chart:          "edge-core",
+						Version:        "0.1.0",
+						Namespace:      "edgecluster",
+						Values:         "pod:\n  edgeClusterType: ${EDGE_CLUSTER_TYPE}\n",
+						Order:          1,
+						DependsOn:      []string{"portainer"},
+					},
+					{
+						ReleaseName:    "portainer",
+						RepositoryName: "portainer",
+						RepositoryURL:  "https://portainer.github.io/k8s",
+						Chart:          "portainer",
+						Namespace:      "portainer",
+						Set:            []string{"service.type=LoadBalancer"},
+						Atomic:         true,
+						Timeout:        10 * time.Minute,
+						ClusterTypes:   []string{"K3S"},
+					},
+				}))
+
+				Ω(ioutil.WriteFile(filePath, []byte("charts: []\n"), 0600)).Should(BeNil())
+
+				response, err = service.ListCharts(context.Background(), &catalogue.ListChartsRequest{})
+				Ω(err).Should(BeNil())
+				Ω(response.Charts).Should(BeEmpty())
+			})
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
@@ -0,0 +1,38 @@
+// Package memory implements a chart catalogue that keeps a fixed list of charts in memory
+package memory
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+)
+
+type memoryChartCatalogueService struct {
+	charts []models.ChartCatalogueEntry
+}
+
+// NewMemoryChartCatalogueService creates new instance of the memoryChartCatalogueService, setting up all dependencies and returns the instance
+// charts: Mandatory. The charts of the catalogue
+// Returns the new service or error if something goes wrong
+func NewMemoryChartCatalogueService(charts []models.ChartCatalogueEntry) (catalogue.ChartCatalogueContract, error) {
+	if err := catalogue.ValidateCharts(charts); err != nil {
+		return nil, err
+	}
+
+	return &memoryChartCatalogueService{
+		charts: append([]models.ChartCatalogueEntry{}, charts...),
+	}, nil
+}
+
+// ListCharts returns all the charts of the catalogue.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the charts of the catalogue
+// Returns either the charts of the catalogue or error if something goes wrong.
+func (service *memoryChartCatalogueService) ListCharts(
+	ctx context.Context,
+	request *catalogue.ListChartsRequest) (*catalogue.ListChartsResponse, error) {
+	return &catalogue.ListChartsResponse{
+		Charts: append([]models.ChartCatalogueEntry{}, service.charts...),
+	}, nil
+}
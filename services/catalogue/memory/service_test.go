@@ -0,0 +1,44 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue/memory"
+	"github.com/lucsky/cuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryChartCatalogueService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Memory Chart Catalogue Service Tests")
+}
+
+var _ = Describe("Memory Chart Catalogue Service Tests", func() {
+	Context("user tries to instantiate MemoryChartCatalogueService", func() {
+		When("the charts are invalid", func() {
+			It("should return error", func() {
+				service, err := memory.NewMemoryChartCatalogueService([]models.ChartCatalogueEntry{{ReleaseName: cuid.New()}})
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("the charts are valid", func() {
+			It("should list the given charts", func() {
+				charts := catalogue.DefaultCharts()
+
+				service, err := memory.NewMemoryChartCatalogueService(charts)
+				Ω(err).Should(BeNil())
+
+				response, err := service.ListCharts(context.Background(), &catalogue.ListChartsRequest{})
+				Ω(err).Should(BeNil())
+				Ω(response.Charts).Should(Equal(charts))
+			})
+		})
+	})
+})
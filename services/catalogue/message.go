@@ -0,0 +1,15 @@
+// Package catalogue implements the services that provide the catalogue of the helm charts installed on the edge clusters
+package catalogue
+
+import (
+	"github.com/decentralized-cloud/edge-cluster/models"
+)
+
+// ListChartsRequest contains the request to list the charts of the catalogue
+type ListChartsRequest struct {
+}
+
+// ListChartsResponse contains the result of listing the charts of the catalogue
+type ListChartsResponse struct {
+	Charts []models.ChartCatalogueEntry
+}
@@ -0,0 +1,102 @@
+// Package mongodb implements a chart catalogue that is stored in a MongoDB collection, one document per chart
+package mongodb
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type mongodbChartCatalogueService struct {
+	connectionString       string
+	databaseName           string
+	databaseCollectionName string
+}
+
+// NewMongodbChartCatalogueService creates new instance of the mongodbChartCatalogueService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewMongodbChartCatalogueService(
+	configurationService configuration.ConfigurationContract) (catalogue.ChartCatalogueContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	connectionString, err := configurationService.GetDatabaseConnectionString()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get connection string to mongodb", err)
+	}
+
+	databaseName, err := configurationService.GetDatabaseName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database name", err)
+	}
+
+	databaseCollectionName, err := configurationService.GetChartCatalogueDatabaseCollectionName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the chart catalogue database collection name", err)
+	}
+
+	return &mongodbChartCatalogueService{
+		connectionString:       connectionString,
+		databaseName:           databaseName,
+		databaseCollectionName: databaseCollectionName,
+	}, nil
+}
+
+// ListCharts returns all the charts of the catalogue.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the charts of the catalogue
+// Returns either the charts of the catalogue or error if something goes wrong.
+func (service *mongodbChartCatalogueService) ListCharts(
+	ctx context.Context,
+	request *catalogue.ListChartsRequest) (*catalogue.ListChartsResponse, error) {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	cursor, err := collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "order", Value: 1}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the chart catalogue.", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	charts := []models.ChartCatalogueEntry{}
+	if err = cursor.All(ctx, &charts); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to decode the chart catalogue.", err)
+	}
+
+	if err = catalogue.ValidateCharts(charts); err != nil {
+		return nil, err
+	}
+
+	return &catalogue.ListChartsResponse{
+		Charts: charts,
+	}, nil
+}
+
+func (service *mongodbChartCatalogueService) createClientAndCollection(ctx context.Context) (*mongo.Client, *mongo.Collection, error) {
+	clientOptions := options.Client().ApplyURI(service.connectionString)
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, nil, commonErrors.NewUnknownErrorWithError("could not connect to mongodb database.", err)
+	}
+
+	return client, client.Database(service.databaseName).Collection(service.databaseCollectionName), nil
+}
+
+func disconnect(ctx context.Context, client *mongo.Client) {
+	_ = client.Disconnect(ctx)
+}
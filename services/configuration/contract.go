@@ -1,6 +1,8 @@
 // Package configuration implements configuration service required by the edge-cluster service
 package configuration
 
+import "time"
+
 // ConfigurationContract declares the service that provides configuration required by different Tenat modules
 type ConfigurationContract interface {
 	// GetGrpcHost returns gRPC host name
@@ -38,4 +40,213 @@ type ConfigurationContract interface {
 	// GetK3SDockerImage returns the K3S docker image to be used when creating edge cluster service of type K3S
 	// Returns the K3S docker image to be used when creating edge cluster service of type K3S or error if something goes wrong
 	GetK3SDockerImage() (string, error)
+
+	// GetK0SDockerImage returns the K0S docker image to be used when creating edge cluster service of type K0S
+	// Returns the K0S docker image to be used when creating edge cluster service of type K0S or error if something goes wrong
+	GetK0SDockerImage() (string, error)
+
+	// GetVClusterSyncerDockerImage returns the syncer docker image to be used when creating edge cluster service of type VCluster
+	// Returns the syncer docker image to be used when creating edge cluster service of type VCluster or error if something goes wrong
+	GetVClusterSyncerDockerImage() (string, error)
+
+	// GetVClusterServiceCIDR returns the service CIDR of the host cluster the virtual edge clusters are created in
+	// Returns the service CIDR of the host cluster or error if something goes wrong
+	GetVClusterServiceCIDR() (string, error)
+
+	// GetChartCatalogueSource returns where the chart catalogue is loaded from. The supported sources are default, file and mongodb.
+	// Returns where the chart catalogue is loaded from or error if something goes wrong
+	GetChartCatalogueSource() (string, error)
+
+	// GetChartCatalogueFilePath returns the path of the YAML file the chart catalogue is loaded from
+	// Returns the path of the YAML file the chart catalogue is loaded from or error if something goes wrong
+	GetChartCatalogueFilePath() (string, error)
+
+	// GetChartCatalogueDatabaseCollectionName returns the database collection name the chart catalogue is loaded from
+	// Returns the database collection name the chart catalogue is loaded from or error if something goes wrong
+	GetChartCatalogueDatabaseCollectionName() (string, error)
+
+	// GetJobDatabaseCollectionName returns the database collection name used to persist the provisioning jobs
+	// Returns the database collection name used to persist the provisioning jobs or error if something goes wrong
+	GetJobDatabaseCollectionName() (string, error)
+
+	// GetJobWorkerCount returns the number of workers that process the provisioning jobs concurrently
+	// Returns the number of workers that process the provisioning jobs concurrently or error if something goes wrong
+	GetJobWorkerCount() (int, error)
+
+	// GetJobLeaseDuration returns the duration a worker holds the lease on a provisioning job before it must renew it
+	// Returns the duration a worker holds the lease on a provisioning job or error if something goes wrong
+	GetJobLeaseDuration() (time.Duration, error)
+
+	// GetJobPollInterval returns the interval workers wait before checking the job queue again when it is empty
+	// Returns the interval workers wait before checking the job queue again or error if something goes wrong
+	GetJobPollInterval() (time.Duration, error)
+
+	// GetJobMaxAttempts returns the maximum number of times a provisioning job is attempted before it is marked as failed
+	// Returns the maximum number of times a provisioning job is attempted or error if something goes wrong
+	GetJobMaxAttempts() (int, error)
+
+	// GetJobRetryBackoff returns the initial delay before a failed provisioning job is retried. The delay doubles after every attempt.
+	// Returns the initial delay before a failed provisioning job is retried or error if something goes wrong
+	GetJobRetryBackoff() (time.Duration, error)
+
+	// GetEnabledClusterTypes returns the names of the edge cluster types this deployment supports. An empty list
+	// enables all the registered edge cluster types.
+	// Returns the names of the enabled edge cluster types or error if something goes wrong
+	GetEnabledClusterTypes() ([]string, error)
+
+	// GetDeleteProvisionUninstallCharts returns whether the helm charts installed on an edge cluster are uninstalled
+	// before its provision is deleted
+	// Returns whether the installed helm charts are uninstalled before the provision is deleted or error if something goes wrong
+	GetDeleteProvisionUninstallCharts() (bool, error)
+
+	// GetDeleteProvisionUninstallTimeout returns the time to wait for every helm chart to be uninstalled, including its hooks
+	// Returns the time to wait for every helm chart to be uninstalled or error if something goes wrong
+	GetDeleteProvisionUninstallTimeout() (time.Duration, error)
+
+	// GetDeleteProvisionDisableHooks returns whether the hooks of the helm charts are skipped when they are uninstalled
+	// Returns whether the hooks of the helm charts are skipped when they are uninstalled or error if something goes wrong
+	GetDeleteProvisionDisableHooks() (bool, error)
+
+	// GetHelmRegistryConfigPath returns the path of the docker config.json file that holds the credentials of the OCI
+	// registries the helm charts are pulled from. The registries are accessed anonymously if empty.
+	// Returns the path of the OCI registry credentials file or error if something goes wrong
+	GetHelmRegistryConfigPath() (string, error)
+
+	// GetHelmRegistryPlainHTTP returns whether the OCI registries the helm charts are pulled from are accessed over plain HTTP
+	// Returns whether the OCI registries are accessed over plain HTTP or error if something goes wrong
+	GetHelmRegistryPlainHTTP() (bool, error)
+
+	// GetHelmOfflineBundlePath returns the path of the offline chart bundle, either a directory or a bundle tarball,
+	// the helm charts are resolved from without any network access. The charts are resolved online if empty.
+	// Returns the path of the offline chart bundle or error if something goes wrong
+	GetHelmOfflineBundlePath() (string, error)
+
+	// GetHelmRepositoryCredentialsFilePath returns the path of the YAML file that holds the credentials of the private
+	// helm chart repositories. The chart repositories are accessed anonymously if empty.
+	// Returns the path of the helm repository credentials file or error if something goes wrong
+	GetHelmRepositoryCredentialsFilePath() (string, error)
+
+	// GetHelmRepositoryCredentialsNamespace returns the namespace of the kubernetes secrets the helm repository
+	// credentials are read from when a repository refers to a secret without a namespace
+	// Returns the namespace of the helm repository credentials secrets or error if something goes wrong
+	GetHelmRepositoryCredentialsNamespace() (string, error)
+
+	// GetHelmRepositoryUpdateConcurrency returns the maximum number of helm chart repositories whose indexes are
+	// downloaded concurrently
+	// Returns the maximum number of chart repositories updated concurrently or error if something goes wrong
+	GetHelmRepositoryUpdateConcurrency() (int, error)
+
+	// GetHelmRepositoryUpdateTimeout returns the time to wait for the index of every helm chart repository to be downloaded
+	// Returns the time to wait for the index of every chart repository to be downloaded or error if something goes wrong
+	GetHelmRepositoryUpdateTimeout() (time.Duration, error)
+
+	// GetAdminEmails returns the emails of the users that are allowed to call the admin-only operations, e.g. managing
+	// the helm repositories. Nobody can call the admin-only operations if empty.
+	// Returns the emails of the admin users or error if something goes wrong
+	GetAdminEmails() ([]string, error)
+
+	// GetCronJobSchedules returns the cron schedules of the background jobs keyed by the job name, e.g.
+	// helm-chart-refresh: @every 30m. A job that is not listed runs on its default schedule and a job whose
+	// schedule is "disabled" does not run.
+	// Returns the cron schedules of the background jobs or error if something goes wrong
+	GetCronJobSchedules() (map[string]string, error)
+
+	// GetCronJobTimeout returns the time every run of a background job is allowed to take before it is cancelled
+	// Returns the time every run of a background job is allowed to take or error if something goes wrong
+	GetCronJobTimeout() (time.Duration, error)
+
+	// GetOrphanedNamespaceGracePeriod returns how long a namespace that hosts an edge cluster missing from the
+	// repository is kept before it is garbage collected
+	// Returns how long an orphaned edge cluster namespace is kept or error if something goes wrong
+	GetOrphanedNamespaceGracePeriod() (time.Duration, error)
+
+	// GetLeaderElectionEnabled returns whether the replicas of the service elect a leader that is the only replica
+	// running the background jobs. Every replica runs the background jobs if disabled.
+	// Returns whether the leader election is enabled or error if something goes wrong
+	GetLeaderElectionEnabled() (bool, error)
+
+	// GetLeaderElectionNamespace returns the namespace of the lease the replicas of the service elect the leader with
+	// Returns the namespace of the leader election lease or error if something goes wrong
+	GetLeaderElectionNamespace() (string, error)
+
+	// GetLeaderElectionLeaseName returns the name of the lease the replicas of the service elect the leader with
+	// Returns the name of the leader election lease or error if something goes wrong
+	GetLeaderElectionLeaseName() (string, error)
+
+	// GetLeaderElectionIdentity returns the unique identity of this replica in the leader election, e.g. the pod name
+	// Returns the identity of this replica or error if something goes wrong
+	GetLeaderElectionIdentity() (string, error)
+
+	// GetLeaderElectionLeaseDuration returns how long the other replicas wait before they try to take over the
+	// leadership once the leader stops renewing the lease
+	// Returns the duration of the leader election lease or error if something goes wrong
+	GetLeaderElectionLeaseDuration() (time.Duration, error)
+
+	// GetLeaderElectionRenewDeadline returns how long the leader keeps trying to renew the lease before it gives up
+	// the leadership
+	// Returns the leader election renew deadline or error if something goes wrong
+	GetLeaderElectionRenewDeadline() (time.Duration, error)
+
+	// GetLeaderElectionRetryPeriod returns how long the replicas wait between their attempts to acquire or renew the lease
+	// Returns the leader election retry period or error if something goes wrong
+	GetLeaderElectionRetryPeriod() (time.Duration, error)
+
+	// GetEncryptionKeyProvider returns the provider of the keys the sensitive edge cluster fields are encrypted with,
+	// either keyfile or kubernetes. The sensitive fields are stored in plain text if empty.
+	// Returns the encryption key provider or error if something goes wrong
+	GetEncryptionKeyProvider() (string, error)
+
+	// GetEncryptionKeyFilePath returns the path of the key ring file the keyfile encryption key provider reads the keys from
+	// Returns the path of the encryption key ring file or error if something goes wrong
+	GetEncryptionKeyFilePath() (string, error)
+
+	// GetEncryptionKeySecretNamespace returns the namespace of the secret the kubernetes encryption key provider reads the
+	// keys from
+	// Returns the namespace of the encryption key secret or error if something goes wrong
+	GetEncryptionKeySecretNamespace() (string, error)
+
+	// GetEncryptionKeySecretName returns the name of the secret the kubernetes encryption key provider reads the keys from
+	// Returns the name of the encryption key secret or error if something goes wrong
+	GetEncryptionKeySecretName() (string, error)
+
+	// GetEncryptionKeySecretKey returns the key of the secret data that holds the key ring read by the kubernetes
+	// encryption key provider
+	// Returns the key of the encryption key secret data or error if something goes wrong
+	GetEncryptionKeySecretKey() (string, error)
+
+	// GetEncryptionKeyRefreshInterval returns how long the encryption keys are cached before they are read again from
+	// the key provider
+	// Returns how long the encryption keys are cached or error if something goes wrong
+	GetEncryptionKeyRefreshInterval() (time.Duration, error)
+
+	// GetEncryptionRotationBatchSize returns the number of edge clusters whose sensitive fields are re-encrypted with
+	// the primary key in every batch
+	// Returns the number of edge clusters re-encrypted in every batch or error if something goes wrong
+	GetEncryptionRotationBatchSize() (int, error)
+
+	// GetKubeconfigCacheTTL returns how long the kubeconfig of an edge cluster is served from memory before it is checked
+	// again against the control plane pod it was read from
+	// Returns how long the kubeconfigs are cached in memory or error if something goes wrong
+	GetKubeconfigCacheTTL() (time.Duration, error)
+
+	// GetKubeconfigIssuanceDatabaseCollectionName returns the database collection name used to track the kubeconfigs
+	// issued to the users of the edge clusters
+	// Returns the database collection name used to track the issued kubeconfigs or error if something goes wrong
+	GetKubeconfigIssuanceDatabaseCollectionName() (string, error)
+
+	// GetKubeconfigIssuanceMaxTTL returns the longest time the kubeconfigs issued to the users of the edge clusters can
+	// be valid for
+	// Returns the longest time the issued kubeconfigs can be valid for or error if something goes wrong
+	GetKubeconfigIssuanceMaxTTL() (time.Duration, error)
+
+	// GetServiceExposureAllowedCIDRs returns the networks the external IPs the edge clusters are exposed on must belong
+	// to. No edge cluster can be exposed on an external IP if empty.
+	// Returns the CIDRs of the allowed networks or error if something goes wrong
+	GetServiceExposureAllowedCIDRs() ([]string, error)
+
+	// GetServiceExposureAllowedDomains returns the domains the host names the edge clusters are exposed on must belong
+	// to, e.g. edge.example.com allows edge.example.com and its sub domains. No edge cluster can be exposed on a host
+	// name if empty.
+	// Returns the allowed domains or error if something goes wrong
+	GetServiceExposureAllowedDomains() ([]string, error)
 }
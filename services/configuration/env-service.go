@@ -2,9 +2,11 @@
 package configuration
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	commonErrors "github.com/micro-business/go-core/system/errors"
 )
@@ -121,3 +123,489 @@ func (service *envConfigurationService) GetK3SDockerImage() (string, error) {
 
 	return value, nil
 }
+
+// GetK0SDockerImage returns the K0S docker image to be used when creating edge cluster service of type K0S
+// Returns the K0S docker image to be used when creating edge cluster service of type K0S or error if something goes wrong
+func (service *envConfigurationService) GetK0SDockerImage() (string, error) {
+	value := os.Getenv("K0S_DOCKER_IMAGE")
+
+	if strings.Trim(value, " ") == "" {
+		return "", commonErrors.NewUnknownError("K0S_DOCKER_IMAGE is required")
+	}
+
+	return value, nil
+}
+
+// GetVClusterSyncerDockerImage returns the syncer docker image to be used when creating edge cluster service of type VCluster
+// Returns the syncer docker image to be used when creating edge cluster service of type VCluster or error if something goes wrong
+func (service *envConfigurationService) GetVClusterSyncerDockerImage() (string, error) {
+	value := os.Getenv("VCLUSTER_SYNCER_DOCKER_IMAGE")
+
+	if strings.Trim(value, " ") == "" {
+		return "", commonErrors.NewUnknownError("VCLUSTER_SYNCER_DOCKER_IMAGE is required")
+	}
+
+	return value, nil
+}
+
+// GetVClusterServiceCIDR returns the service CIDR of the host cluster the virtual edge clusters are created in
+// Returns the service CIDR of the host cluster or error if something goes wrong
+func (service *envConfigurationService) GetVClusterServiceCIDR() (string, error) {
+	value := os.Getenv("VCLUSTER_SERVICE_CIDR")
+
+	if strings.Trim(value, " ") == "" {
+		return "10.96.0.0/12", nil
+	}
+
+	return value, nil
+}
+
+// GetChartCatalogueSource returns where the chart catalogue is loaded from. The supported sources are default, file and mongodb.
+// Returns where the chart catalogue is loaded from or error if something goes wrong
+func (service *envConfigurationService) GetChartCatalogueSource() (string, error) {
+	value := strings.ToLower(strings.Trim(os.Getenv("CHART_CATALOGUE_SOURCE"), " "))
+
+	switch value {
+	case "":
+		return "default", nil
+	case "default", "file", "mongodb":
+		return value, nil
+	default:
+		return "", commonErrors.NewUnknownError("CHART_CATALOGUE_SOURCE must be one of default, file or mongodb")
+	}
+}
+
+// GetChartCatalogueFilePath returns the path of the YAML file the chart catalogue is loaded from
+// Returns the path of the YAML file the chart catalogue is loaded from or error if something goes wrong
+func (service *envConfigurationService) GetChartCatalogueFilePath() (string, error) {
+	value := os.Getenv("CHART_CATALOGUE_FILE_PATH")
+
+	if strings.Trim(value, " ") == "" {
+		return "", commonErrors.NewUnknownError("CHART_CATALOGUE_FILE_PATH is required")
+	}
+
+	return value, nil
+}
+
+// GetChartCatalogueDatabaseCollectionName returns the database collection name the chart catalogue is loaded from
+// Returns the database collection name the chart catalogue is loaded from or error if something goes wrong
+func (service *envConfigurationService) GetChartCatalogueDatabaseCollectionName() (string, error) {
+	value := os.Getenv("CHART_CATALOGUE_DATABASE_COLLECTION_NAME")
+
+	if strings.Trim(value, " ") == "" {
+		return "edge-cluster-charts", nil
+	}
+
+	return value, nil
+}
+
+// GetJobDatabaseCollectionName returns the database collection name used to persist the provisioning jobs
+// Returns the database collection name used to persist the provisioning jobs or error if something goes wrong
+func (service *envConfigurationService) GetJobDatabaseCollectionName() (string, error) {
+	value := os.Getenv("EDGE_CLUSTER_JOB_DATABASE_COLLECTION_NAME")
+
+	if strings.Trim(value, " ") == "" {
+		return "edge-cluster-jobs", nil
+	}
+
+	return value, nil
+}
+
+// GetJobWorkerCount returns the number of workers that process the provisioning jobs concurrently
+// Returns the number of workers that process the provisioning jobs concurrently or error if something goes wrong
+func (service *envConfigurationService) GetJobWorkerCount() (int, error) {
+	return getIntWithDefault("JOB_WORKER_COUNT", 4)
+}
+
+// GetJobLeaseDuration returns the duration a worker holds the lease on a provisioning job before it must renew it
+// Returns the duration a worker holds the lease on a provisioning job or error if something goes wrong
+func (service *envConfigurationService) GetJobLeaseDuration() (time.Duration, error) {
+	return getDurationWithDefault("JOB_LEASE_DURATION", 2*time.Minute)
+}
+
+// GetJobPollInterval returns the interval workers wait before checking the job queue again when it is empty
+// Returns the interval workers wait before checking the job queue again or error if something goes wrong
+func (service *envConfigurationService) GetJobPollInterval() (time.Duration, error) {
+	return getDurationWithDefault("JOB_POLL_INTERVAL", 5*time.Second)
+}
+
+// GetJobMaxAttempts returns the maximum number of times a provisioning job is attempted before it is marked as failed
+// Returns the maximum number of times a provisioning job is attempted or error if something goes wrong
+func (service *envConfigurationService) GetJobMaxAttempts() (int, error) {
+	return getIntWithDefault("JOB_MAX_ATTEMPTS", 5)
+}
+
+// GetJobRetryBackoff returns the initial delay before a failed provisioning job is retried. The delay doubles after every attempt.
+// Returns the initial delay before a failed provisioning job is retried or error if something goes wrong
+func (service *envConfigurationService) GetJobRetryBackoff() (time.Duration, error) {
+	return getDurationWithDefault("JOB_RETRY_BACKOFF", 10*time.Second)
+}
+
+// GetEnabledClusterTypes returns the names of the edge cluster types this deployment supports. An empty list
+// enables all the registered edge cluster types.
+// Returns the names of the enabled edge cluster types or error if something goes wrong
+func (service *envConfigurationService) GetEnabledClusterTypes() ([]string, error) {
+	clusterTypes := []string{}
+
+	for _, clusterType := range strings.Split(os.Getenv("ENABLED_CLUSTER_TYPES"), ",") {
+		if clusterType = strings.Trim(clusterType, " "); clusterType != "" {
+			clusterTypes = append(clusterTypes, clusterType)
+		}
+	}
+
+	return clusterTypes, nil
+}
+
+// GetDeleteProvisionUninstallCharts returns whether the helm charts installed on an edge cluster are uninstalled
+// before its provision is deleted
+// Returns whether the installed helm charts are uninstalled before the provision is deleted or error if something goes wrong
+func (service *envConfigurationService) GetDeleteProvisionUninstallCharts() (bool, error) {
+	return getBoolWithDefault("DELETE_PROVISION_UNINSTALL_CHARTS", false)
+}
+
+// GetDeleteProvisionUninstallTimeout returns the time to wait for every helm chart to be uninstalled, including its hooks
+// Returns the time to wait for every helm chart to be uninstalled or error if something goes wrong
+func (service *envConfigurationService) GetDeleteProvisionUninstallTimeout() (time.Duration, error) {
+	return getDurationWithDefault("DELETE_PROVISION_UNINSTALL_TIMEOUT", 5*time.Minute)
+}
+
+// GetDeleteProvisionDisableHooks returns whether the hooks of the helm charts are skipped when they are uninstalled
+// Returns whether the hooks of the helm charts are skipped when they are uninstalled or error if something goes wrong
+func (service *envConfigurationService) GetDeleteProvisionDisableHooks() (bool, error) {
+	return getBoolWithDefault("DELETE_PROVISION_DISABLE_HOOKS", false)
+}
+
+// GetHelmRegistryConfigPath returns the path of the docker config.json file that holds the credentials of the OCI
+// registries the helm charts are pulled from. The registries are accessed anonymously if empty.
+// Returns the path of the OCI registry credentials file or error if something goes wrong
+func (service *envConfigurationService) GetHelmRegistryConfigPath() (string, error) {
+	return os.Getenv("HELM_REGISTRY_CONFIG"), nil
+}
+
+// GetHelmRegistryPlainHTTP returns whether the OCI registries the helm charts are pulled from are accessed over plain HTTP
+// Returns whether the OCI registries are accessed over plain HTTP or error if something goes wrong
+func (service *envConfigurationService) GetHelmRegistryPlainHTTP() (bool, error) {
+	return getBoolWithDefault("HELM_REGISTRY_PLAIN_HTTP", false)
+}
+
+// GetHelmOfflineBundlePath returns the path of the offline chart bundle, either a directory or a bundle tarball,
+// the helm charts are resolved from without any network access. The charts are resolved online if empty.
+// Returns the path of the offline chart bundle or error if something goes wrong
+func (service *envConfigurationService) GetHelmOfflineBundlePath() (string, error) {
+	return os.Getenv("HELM_OFFLINE_BUNDLE_PATH"), nil
+}
+
+// GetHelmRepositoryCredentialsFilePath returns the path of the YAML file that holds the credentials of the private
+// helm chart repositories. The chart repositories are accessed anonymously if empty.
+// Returns the path of the helm repository credentials file or error if something goes wrong
+func (service *envConfigurationService) GetHelmRepositoryCredentialsFilePath() (string, error) {
+	return os.Getenv("HELM_REPOSITORY_CREDENTIALS_FILE"), nil
+}
+
+// GetHelmRepositoryCredentialsNamespace returns the namespace of the kubernetes secrets the helm repository
+// credentials are read from when a repository refers to a secret without a namespace
+// Returns the namespace of the helm repository credentials secrets or error if something goes wrong
+func (service *envConfigurationService) GetHelmRepositoryCredentialsNamespace() (string, error) {
+	value := os.Getenv("HELM_REPOSITORY_CREDENTIALS_NAMESPACE")
+
+	if strings.Trim(value, " ") == "" {
+		return "default", nil
+	}
+
+	return value, nil
+}
+
+// GetHelmRepositoryUpdateConcurrency returns the maximum number of helm chart repositories whose indexes are
+// downloaded concurrently
+// Returns the maximum number of chart repositories updated concurrently or error if something goes wrong
+func (service *envConfigurationService) GetHelmRepositoryUpdateConcurrency() (int, error) {
+	return getIntWithDefault("HELM_REPOSITORY_UPDATE_CONCURRENCY", 4)
+}
+
+// GetHelmRepositoryUpdateTimeout returns the time to wait for the index of every helm chart repository to be downloaded
+// Returns the time to wait for the index of every chart repository to be downloaded or error if something goes wrong
+func (service *envConfigurationService) GetHelmRepositoryUpdateTimeout() (time.Duration, error) {
+	return getDurationWithDefault("HELM_REPOSITORY_UPDATE_TIMEOUT", 2*time.Minute)
+}
+
+// GetAdminEmails returns the emails of the users that are allowed to call the admin-only operations, e.g. managing
+// the helm repositories. Nobody can call the admin-only operations if empty.
+// Returns the emails of the admin users or error if something goes wrong
+func (service *envConfigurationService) GetAdminEmails() ([]string, error) {
+	emails := []string{}
+
+	for _, email := range strings.Split(os.Getenv("ADMIN_EMAILS"), ",") {
+		if email = strings.Trim(email, " "); email != "" {
+			emails = append(emails, email)
+		}
+	}
+
+	return emails, nil
+}
+
+// GetCronJobSchedules returns the cron schedules of the background jobs keyed by the job name, e.g.
+// helm-chart-refresh: @every 30m. A job that is not listed runs on its default schedule and a job whose
+// schedule is "disabled" does not run.
+// Returns the cron schedules of the background jobs or error if something goes wrong
+func (service *envConfigurationService) GetCronJobSchedules() (map[string]string, error) {
+	schedules := map[string]string{}
+
+	// The schedules are separated by semicolons, as the cron expressions can contain commas
+	for _, item := range strings.Split(os.Getenv("CRON_JOB_SCHEDULES"), ";") {
+		if item = strings.Trim(item, " "); item == "" {
+			continue
+		}
+
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 || strings.Trim(parts[0], " ") == "" || strings.Trim(parts[1], " ") == "" {
+			return nil, commonErrors.NewUnknownError(fmt.Sprintf("failed to parse the cron job schedule %s", item))
+		}
+
+		schedules[strings.Trim(parts[0], " ")] = strings.Trim(parts[1], " ")
+	}
+
+	return schedules, nil
+}
+
+// GetCronJobTimeout returns the time every run of a background job is allowed to take before it is cancelled
+// Returns the time every run of a background job is allowed to take or error if something goes wrong
+func (service *envConfigurationService) GetCronJobTimeout() (time.Duration, error) {
+	return getDurationWithDefault("CRON_JOB_TIMEOUT", 20*time.Minute)
+}
+
+// GetOrphanedNamespaceGracePeriod returns how long a namespace that hosts an edge cluster missing from the
+// repository is kept before it is garbage collected
+// Returns how long an orphaned edge cluster namespace is kept or error if something goes wrong
+func (service *envConfigurationService) GetOrphanedNamespaceGracePeriod() (time.Duration, error) {
+	return getDurationWithDefault("ORPHANED_NAMESPACE_GRACE_PERIOD", time.Hour)
+}
+
+// GetLeaderElectionEnabled returns whether the replicas of the service elect a leader that is the only replica
+// running the background jobs. Every replica runs the background jobs if disabled.
+// Returns whether the leader election is enabled or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionEnabled() (bool, error) {
+	return getBoolWithDefault("LEADER_ELECTION_ENABLED", false)
+}
+
+// GetLeaderElectionNamespace returns the namespace of the lease the replicas of the service elect the leader with
+// Returns the namespace of the leader election lease or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionNamespace() (string, error) {
+	value := os.Getenv("LEADER_ELECTION_NAMESPACE")
+
+	if strings.Trim(value, " ") == "" {
+		return "default", nil
+	}
+
+	return value, nil
+}
+
+// GetLeaderElectionLeaseName returns the name of the lease the replicas of the service elect the leader with
+// Returns the name of the leader election lease or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionLeaseName() (string, error) {
+	value := os.Getenv("LEADER_ELECTION_LEASE_NAME")
+
+	if strings.Trim(value, " ") == "" {
+		return "edge-cluster-leader", nil
+	}
+
+	return value, nil
+}
+
+// GetLeaderElectionIdentity returns the unique identity of this replica in the leader election, e.g. the pod name
+// Returns the identity of this replica or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionIdentity() (string, error) {
+	value := os.Getenv("LEADER_ELECTION_IDENTITY")
+
+	if strings.Trim(value, " ") == "" {
+		return os.Hostname()
+	}
+
+	return value, nil
+}
+
+// GetLeaderElectionLeaseDuration returns how long the other replicas wait before they try to take over the
+// leadership once the leader stops renewing the lease
+// Returns the duration of the leader election lease or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionLeaseDuration() (time.Duration, error) {
+	return getDurationWithDefault("LEADER_ELECTION_LEASE_DURATION", 15*time.Second)
+}
+
+// GetLeaderElectionRenewDeadline returns how long the leader keeps trying to renew the lease before it gives up
+// the leadership
+// Returns the leader election renew deadline or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionRenewDeadline() (time.Duration, error) {
+	return getDurationWithDefault("LEADER_ELECTION_RENEW_DEADLINE", 10*time.Second)
+}
+
+// GetLeaderElectionRetryPeriod returns how long the replicas wait between their attempts to acquire or renew the lease
+// Returns the leader election retry period or error if something goes wrong
+func (service *envConfigurationService) GetLeaderElectionRetryPeriod() (time.Duration, error) {
+	return getDurationWithDefault("LEADER_ELECTION_RETRY_PERIOD", 2*time.Second)
+}
+
+// GetEncryptionKeyProvider returns the provider of the keys the sensitive edge cluster fields are encrypted with,
+// either keyfile or kubernetes. The sensitive fields are stored in plain text if empty.
+// Returns the encryption key provider or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionKeyProvider() (string, error) {
+	return strings.ToLower(strings.Trim(os.Getenv("ENCRYPTION_KEY_PROVIDER"), " ")), nil
+}
+
+// GetEncryptionKeyFilePath returns the path of the key ring file the keyfile encryption key provider reads the keys from
+// Returns the path of the encryption key ring file or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionKeyFilePath() (string, error) {
+	value := os.Getenv("ENCRYPTION_KEY_FILE")
+
+	if strings.Trim(value, " ") == "" {
+		return "", commonErrors.NewUnknownError("ENCRYPTION_KEY_FILE is required")
+	}
+
+	return value, nil
+}
+
+// GetEncryptionKeySecretNamespace returns the namespace of the secret the kubernetes encryption key provider reads the
+// keys from
+// Returns the namespace of the encryption key secret or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionKeySecretNamespace() (string, error) {
+	value := os.Getenv("ENCRYPTION_KEY_SECRET_NAMESPACE")
+
+	if strings.Trim(value, " ") == "" {
+		return "default", nil
+	}
+
+	return value, nil
+}
+
+// GetEncryptionKeySecretName returns the name of the secret the kubernetes encryption key provider reads the keys from
+// Returns the name of the encryption key secret or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionKeySecretName() (string, error) {
+	value := os.Getenv("ENCRYPTION_KEY_SECRET_NAME")
+
+	if strings.Trim(value, " ") == "" {
+		return "edge-cluster-encryption-keys", nil
+	}
+
+	return value, nil
+}
+
+// GetEncryptionKeySecretKey returns the key of the secret data that holds the key ring read by the kubernetes
+// encryption key provider
+// Returns the key of the encryption key secret data or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionKeySecretKey() (string, error) {
+	value := os.Getenv("ENCRYPTION_KEY_SECRET_KEY")
+
+	if strings.Trim(value, " ") == "" {
+		return "keyring.json", nil
+	}
+
+	return value, nil
+}
+
+// GetEncryptionKeyRefreshInterval returns how long the encryption keys are cached before they are read again from
+// the key provider
+// Returns how long the encryption keys are cached or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionKeyRefreshInterval() (time.Duration, error) {
+	return getDurationWithDefault("ENCRYPTION_KEY_REFRESH_INTERVAL", 5*time.Minute)
+}
+
+// GetEncryptionRotationBatchSize returns the number of edge clusters whose sensitive fields are re-encrypted with
+// the primary key in every batch
+// Returns the number of edge clusters re-encrypted in every batch or error if something goes wrong
+func (service *envConfigurationService) GetEncryptionRotationBatchSize() (int, error) {
+	return getIntWithDefault("ENCRYPTION_ROTATION_BATCH_SIZE", 100)
+}
+
+// GetKubeconfigCacheTTL returns how long the kubeconfig of an edge cluster is served from memory before it is checked
+// again against the control plane pod it was read from
+// Returns how long the kubeconfigs are cached in memory or error if something goes wrong
+func (service *envConfigurationService) GetKubeconfigCacheTTL() (time.Duration, error) {
+	return getDurationWithDefault("KUBECONFIG_CACHE_TTL", time.Minute)
+}
+
+// GetKubeconfigIssuanceDatabaseCollectionName returns the database collection name used to track the kubeconfigs
+// issued to the users of the edge clusters
+// Returns the database collection name used to track the issued kubeconfigs or error if something goes wrong
+func (service *envConfigurationService) GetKubeconfigIssuanceDatabaseCollectionName() (string, error) {
+	value := os.Getenv("EDGE_CLUSTER_KUBECONFIG_ISSUANCE_DATABASE_COLLECTION_NAME")
+
+	if strings.Trim(value, " ") == "" {
+		return "edge-cluster-kubeconfig-issuances", nil
+	}
+
+	return value, nil
+}
+
+// GetKubeconfigIssuanceMaxTTL returns the longest time the kubeconfigs issued to the users of the edge clusters can
+// be valid for
+// Returns the longest time the issued kubeconfigs can be valid for or error if something goes wrong
+func (service *envConfigurationService) GetKubeconfigIssuanceMaxTTL() (time.Duration, error) {
+	return getDurationWithDefault("KUBECONFIG_ISSUANCE_MAX_TTL", 24*time.Hour)
+}
+
+// GetServiceExposureAllowedCIDRs returns the networks the external IPs the edge clusters are exposed on must belong
+// to. No edge cluster can be exposed on an external IP if empty.
+// Returns the CIDRs of the allowed networks or error if something goes wrong
+func (service *envConfigurationService) GetServiceExposureAllowedCIDRs() ([]string, error) {
+	return getStringList("SERVICE_EXPOSURE_ALLOWED_CIDRS"), nil
+}
+
+// GetServiceExposureAllowedDomains returns the domains the host names the edge clusters are exposed on must belong
+// to, e.g. edge.example.com allows edge.example.com and its sub domains. No edge cluster can be exposed on a host
+// name if empty.
+// Returns the allowed domains or error if something goes wrong
+func (service *envConfigurationService) GetServiceExposureAllowedDomains() ([]string, error) {
+	return getStringList("SERVICE_EXPOSURE_ALLOWED_DOMAINS"), nil
+}
+
+func getStringList(name string) []string {
+	items := []string{}
+
+	for _, item := range strings.Split(os.Getenv(name), ",") {
+		if item = strings.Trim(item, " "); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func getIntWithDefault(name string, defaultValue int) (int, error) {
+	valueStr := os.Getenv(name)
+	if strings.Trim(valueStr, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to convert %s to integer", name), err)
+	}
+
+	return value, nil
+}
+
+func getDurationWithDefault(name string, defaultValue time.Duration) (time.Duration, error) {
+	valueStr := os.Getenv(name)
+	if strings.Trim(valueStr, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		return 0, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to convert %s to duration", name), err)
+	}
+
+	return value, nil
+}
+
+func getBoolWithDefault(name string, defaultValue bool) (bool, error) {
+	valueStr := os.Getenv(name)
+	if strings.Trim(valueStr, " ") == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return false, commonErrors.NewUnknownErrorWithError(fmt.Sprintf("failed to convert %s to boolean", name), err)
+	}
+
+	return value, nil
+}
@@ -6,6 +6,7 @@ package mock_configuration
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -33,6 +34,96 @@ func (m *MockConfigurationContract) EXPECT() *MockConfigurationContractMockRecor
 	return m.recorder
 }
 
+// GetAdminEmails mocks base method.
+func (m *MockConfigurationContract) GetAdminEmails() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminEmails")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminEmails indicates an expected call of GetAdminEmails.
+func (mr *MockConfigurationContractMockRecorder) GetAdminEmails() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminEmails", reflect.TypeOf((*MockConfigurationContract)(nil).GetAdminEmails))
+}
+
+// GetChartCatalogueDatabaseCollectionName mocks base method.
+func (m *MockConfigurationContract) GetChartCatalogueDatabaseCollectionName() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChartCatalogueDatabaseCollectionName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChartCatalogueDatabaseCollectionName indicates an expected call of GetChartCatalogueDatabaseCollectionName.
+func (mr *MockConfigurationContractMockRecorder) GetChartCatalogueDatabaseCollectionName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChartCatalogueDatabaseCollectionName", reflect.TypeOf((*MockConfigurationContract)(nil).GetChartCatalogueDatabaseCollectionName))
+}
+
+// GetChartCatalogueFilePath mocks base method.
+func (m *MockConfigurationContract) GetChartCatalogueFilePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChartCatalogueFilePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChartCatalogueFilePath indicates an expected call of GetChartCatalogueFilePath.
+func (mr *MockConfigurationContractMockRecorder) GetChartCatalogueFilePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChartCatalogueFilePath", reflect.TypeOf((*MockConfigurationContract)(nil).GetChartCatalogueFilePath))
+}
+
+// GetChartCatalogueSource mocks base method.
+func (m *MockConfigurationContract) GetChartCatalogueSource() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChartCatalogueSource")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChartCatalogueSource indicates an expected call of GetChartCatalogueSource.
+func (mr *MockConfigurationContractMockRecorder) GetChartCatalogueSource() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChartCatalogueSource", reflect.TypeOf((*MockConfigurationContract)(nil).GetChartCatalogueSource))
+}
+
+// GetCronJobSchedules mocks base method.
+func (m *MockConfigurationContract) GetCronJobSchedules() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCronJobSchedules")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCronJobSchedules indicates an expected call of GetCronJobSchedules.
+func (mr *MockConfigurationContractMockRecorder) GetCronJobSchedules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCronJobSchedules", reflect.TypeOf((*MockConfigurationContract)(nil).GetCronJobSchedules))
+}
+
+// GetCronJobTimeout mocks base method.
+func (m *MockConfigurationContract) GetCronJobTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCronJobTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCronJobTimeout indicates an expected call of GetCronJobTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetCronJobTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCronJobTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetCronJobTimeout))
+}
+
 // GetDatabaseCollectionName mocks base method.
 func (m *MockConfigurationContract) GetDatabaseCollectionName() (string, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +169,171 @@ func (mr *MockConfigurationContractMockRecorder) GetDatabaseName() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseName", reflect.TypeOf((*MockConfigurationContract)(nil).GetDatabaseName))
 }
 
+// GetDeleteProvisionDisableHooks mocks base method.
+func (m *MockConfigurationContract) GetDeleteProvisionDisableHooks() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleteProvisionDisableHooks")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleteProvisionDisableHooks indicates an expected call of GetDeleteProvisionDisableHooks.
+func (mr *MockConfigurationContractMockRecorder) GetDeleteProvisionDisableHooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleteProvisionDisableHooks", reflect.TypeOf((*MockConfigurationContract)(nil).GetDeleteProvisionDisableHooks))
+}
+
+// GetDeleteProvisionUninstallCharts mocks base method.
+func (m *MockConfigurationContract) GetDeleteProvisionUninstallCharts() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleteProvisionUninstallCharts")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleteProvisionUninstallCharts indicates an expected call of GetDeleteProvisionUninstallCharts.
+func (mr *MockConfigurationContractMockRecorder) GetDeleteProvisionUninstallCharts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleteProvisionUninstallCharts", reflect.TypeOf((*MockConfigurationContract)(nil).GetDeleteProvisionUninstallCharts))
+}
+
+// GetDeleteProvisionUninstallTimeout mocks base method.
+func (m *MockConfigurationContract) GetDeleteProvisionUninstallTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleteProvisionUninstallTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleteProvisionUninstallTimeout indicates an expected call of GetDeleteProvisionUninstallTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetDeleteProvisionUninstallTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleteProvisionUninstallTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetDeleteProvisionUninstallTimeout))
+}
+
+// GetEnabledClusterTypes mocks base method.
+func (m *MockConfigurationContract) GetEnabledClusterTypes() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnabledClusterTypes")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnabledClusterTypes indicates an expected call of GetEnabledClusterTypes.
+func (mr *MockConfigurationContractMockRecorder) GetEnabledClusterTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnabledClusterTypes", reflect.TypeOf((*MockConfigurationContract)(nil).GetEnabledClusterTypes))
+}
+
+// GetEncryptionKeyFilePath mocks base method.
+func (m *MockConfigurationContract) GetEncryptionKeyFilePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeyFilePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeyFilePath indicates an expected call of GetEncryptionKeyFilePath.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionKeyFilePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeyFilePath", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionKeyFilePath))
+}
+
+// GetEncryptionKeyProvider mocks base method.
+func (m *MockConfigurationContract) GetEncryptionKeyProvider() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeyProvider")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeyProvider indicates an expected call of GetEncryptionKeyProvider.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionKeyProvider() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeyProvider", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionKeyProvider))
+}
+
+// GetEncryptionKeyRefreshInterval mocks base method.
+func (m *MockConfigurationContract) GetEncryptionKeyRefreshInterval() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeyRefreshInterval")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeyRefreshInterval indicates an expected call of GetEncryptionKeyRefreshInterval.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionKeyRefreshInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeyRefreshInterval", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionKeyRefreshInterval))
+}
+
+// GetEncryptionKeySecretKey mocks base method.
+func (m *MockConfigurationContract) GetEncryptionKeySecretKey() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeySecretKey")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeySecretKey indicates an expected call of GetEncryptionKeySecretKey.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionKeySecretKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeySecretKey", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionKeySecretKey))
+}
+
+// GetEncryptionKeySecretName mocks base method.
+func (m *MockConfigurationContract) GetEncryptionKeySecretName() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeySecretName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeySecretName indicates an expected call of GetEncryptionKeySecretName.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionKeySecretName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeySecretName", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionKeySecretName))
+}
+
+// GetEncryptionKeySecretNamespace mocks base method.
+func (m *MockConfigurationContract) GetEncryptionKeySecretNamespace() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKeySecretNamespace")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKeySecretNamespace indicates an expected call of GetEncryptionKeySecretNamespace.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionKeySecretNamespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKeySecretNamespace", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionKeySecretNamespace))
+}
+
+// GetEncryptionRotationBatchSize mocks base method.
+func (m *MockConfigurationContract) GetEncryptionRotationBatchSize() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionRotationBatchSize")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionRotationBatchSize indicates an expected call of GetEncryptionRotationBatchSize.
+func (mr *MockConfigurationContractMockRecorder) GetEncryptionRotationBatchSize() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionRotationBatchSize", reflect.TypeOf((*MockConfigurationContract)(nil).GetEncryptionRotationBatchSize))
+}
+
 // GetGrpcHost mocks base method.
 func (m *MockConfigurationContract) GetGrpcHost() (string, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +364,111 @@ func (mr *MockConfigurationContractMockRecorder) GetGrpcPort() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrpcPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetGrpcPort))
 }
 
+// GetHelmOfflineBundlePath mocks base method.
+func (m *MockConfigurationContract) GetHelmOfflineBundlePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmOfflineBundlePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmOfflineBundlePath indicates an expected call of GetHelmOfflineBundlePath.
+func (mr *MockConfigurationContractMockRecorder) GetHelmOfflineBundlePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmOfflineBundlePath", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmOfflineBundlePath))
+}
+
+// GetHelmRegistryConfigPath mocks base method.
+func (m *MockConfigurationContract) GetHelmRegistryConfigPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmRegistryConfigPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmRegistryConfigPath indicates an expected call of GetHelmRegistryConfigPath.
+func (mr *MockConfigurationContractMockRecorder) GetHelmRegistryConfigPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmRegistryConfigPath", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmRegistryConfigPath))
+}
+
+// GetHelmRegistryPlainHTTP mocks base method.
+func (m *MockConfigurationContract) GetHelmRegistryPlainHTTP() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmRegistryPlainHTTP")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmRegistryPlainHTTP indicates an expected call of GetHelmRegistryPlainHTTP.
+func (mr *MockConfigurationContractMockRecorder) GetHelmRegistryPlainHTTP() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmRegistryPlainHTTP", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmRegistryPlainHTTP))
+}
+
+// GetHelmRepositoryCredentialsFilePath mocks base method.
+func (m *MockConfigurationContract) GetHelmRepositoryCredentialsFilePath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmRepositoryCredentialsFilePath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmRepositoryCredentialsFilePath indicates an expected call of GetHelmRepositoryCredentialsFilePath.
+func (mr *MockConfigurationContractMockRecorder) GetHelmRepositoryCredentialsFilePath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmRepositoryCredentialsFilePath", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmRepositoryCredentialsFilePath))
+}
+
+// GetHelmRepositoryCredentialsNamespace mocks base method.
+func (m *MockConfigurationContract) GetHelmRepositoryCredentialsNamespace() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmRepositoryCredentialsNamespace")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmRepositoryCredentialsNamespace indicates an expected call of GetHelmRepositoryCredentialsNamespace.
+func (mr *MockConfigurationContractMockRecorder) GetHelmRepositoryCredentialsNamespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmRepositoryCredentialsNamespace", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmRepositoryCredentialsNamespace))
+}
+
+// GetHelmRepositoryUpdateConcurrency mocks base method.
+func (m *MockConfigurationContract) GetHelmRepositoryUpdateConcurrency() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmRepositoryUpdateConcurrency")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmRepositoryUpdateConcurrency indicates an expected call of GetHelmRepositoryUpdateConcurrency.
+func (mr *MockConfigurationContractMockRecorder) GetHelmRepositoryUpdateConcurrency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmRepositoryUpdateConcurrency", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmRepositoryUpdateConcurrency))
+}
+
+// GetHelmRepositoryUpdateTimeout mocks base method.
+func (m *MockConfigurationContract) GetHelmRepositoryUpdateTimeout() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelmRepositoryUpdateTimeout")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelmRepositoryUpdateTimeout indicates an expected call of GetHelmRepositoryUpdateTimeout.
+func (mr *MockConfigurationContractMockRecorder) GetHelmRepositoryUpdateTimeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelmRepositoryUpdateTimeout", reflect.TypeOf((*MockConfigurationContract)(nil).GetHelmRepositoryUpdateTimeout))
+}
+
 // GetHttpHost mocks base method.
 func (m *MockConfigurationContract) GetHttpHost() (string, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +499,96 @@ func (mr *MockConfigurationContractMockRecorder) GetHttpPort() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHttpPort", reflect.TypeOf((*MockConfigurationContract)(nil).GetHttpPort))
 }
 
+// GetJobDatabaseCollectionName mocks base method.
+func (m *MockConfigurationContract) GetJobDatabaseCollectionName() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobDatabaseCollectionName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobDatabaseCollectionName indicates an expected call of GetJobDatabaseCollectionName.
+func (mr *MockConfigurationContractMockRecorder) GetJobDatabaseCollectionName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobDatabaseCollectionName", reflect.TypeOf((*MockConfigurationContract)(nil).GetJobDatabaseCollectionName))
+}
+
+// GetJobLeaseDuration mocks base method.
+func (m *MockConfigurationContract) GetJobLeaseDuration() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobLeaseDuration")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobLeaseDuration indicates an expected call of GetJobLeaseDuration.
+func (mr *MockConfigurationContractMockRecorder) GetJobLeaseDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobLeaseDuration", reflect.TypeOf((*MockConfigurationContract)(nil).GetJobLeaseDuration))
+}
+
+// GetJobMaxAttempts mocks base method.
+func (m *MockConfigurationContract) GetJobMaxAttempts() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobMaxAttempts")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobMaxAttempts indicates an expected call of GetJobMaxAttempts.
+func (mr *MockConfigurationContractMockRecorder) GetJobMaxAttempts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobMaxAttempts", reflect.TypeOf((*MockConfigurationContract)(nil).GetJobMaxAttempts))
+}
+
+// GetJobPollInterval mocks base method.
+func (m *MockConfigurationContract) GetJobPollInterval() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobPollInterval")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobPollInterval indicates an expected call of GetJobPollInterval.
+func (mr *MockConfigurationContractMockRecorder) GetJobPollInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobPollInterval", reflect.TypeOf((*MockConfigurationContract)(nil).GetJobPollInterval))
+}
+
+// GetJobRetryBackoff mocks base method.
+func (m *MockConfigurationContract) GetJobRetryBackoff() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobRetryBackoff")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobRetryBackoff indicates an expected call of GetJobRetryBackoff.
+func (mr *MockConfigurationContractMockRecorder) GetJobRetryBackoff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobRetryBackoff", reflect.TypeOf((*MockConfigurationContract)(nil).GetJobRetryBackoff))
+}
+
+// GetJobWorkerCount mocks base method.
+func (m *MockConfigurationContract) GetJobWorkerCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobWorkerCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobWorkerCount indicates an expected call of GetJobWorkerCount.
+func (mr *MockConfigurationContractMockRecorder) GetJobWorkerCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobWorkerCount", reflect.TypeOf((*MockConfigurationContract)(nil).GetJobWorkerCount))
+}
+
 // GetJwksURL mocks base method.
 func (m *MockConfigurationContract) GetJwksURL() (string, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +604,21 @@ func (mr *MockConfigurationContractMockRecorder) GetJwksURL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJwksURL", reflect.TypeOf((*MockConfigurationContract)(nil).GetJwksURL))
 }
 
+// GetK0SDockerImage mocks base method.
+func (m *MockConfigurationContract) GetK0SDockerImage() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetK0SDockerImage")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetK0SDockerImage indicates an expected call of GetK0SDockerImage.
+func (mr *MockConfigurationContractMockRecorder) GetK0SDockerImage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetK0SDockerImage", reflect.TypeOf((*MockConfigurationContract)(nil).GetK0SDockerImage))
+}
+
 // GetK3SDockerImage mocks base method.
 func (m *MockConfigurationContract) GetK3SDockerImage() (string, error) {
 	m.ctrl.T.Helper()
@@ -167,3 +633,228 @@ func (mr *MockConfigurationContractMockRecorder) GetK3SDockerImage() *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetK3SDockerImage", reflect.TypeOf((*MockConfigurationContract)(nil).GetK3SDockerImage))
 }
+
+// GetKubeconfigCacheTTL mocks base method.
+func (m *MockConfigurationContract) GetKubeconfigCacheTTL() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeconfigCacheTTL")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubeconfigCacheTTL indicates an expected call of GetKubeconfigCacheTTL.
+func (mr *MockConfigurationContractMockRecorder) GetKubeconfigCacheTTL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeconfigCacheTTL", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubeconfigCacheTTL))
+}
+
+// GetKubeconfigIssuanceDatabaseCollectionName mocks base method.
+func (m *MockConfigurationContract) GetKubeconfigIssuanceDatabaseCollectionName() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeconfigIssuanceDatabaseCollectionName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubeconfigIssuanceDatabaseCollectionName indicates an expected call of GetKubeconfigIssuanceDatabaseCollectionName.
+func (mr *MockConfigurationContractMockRecorder) GetKubeconfigIssuanceDatabaseCollectionName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeconfigIssuanceDatabaseCollectionName", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubeconfigIssuanceDatabaseCollectionName))
+}
+
+// GetKubeconfigIssuanceMaxTTL mocks base method.
+func (m *MockConfigurationContract) GetKubeconfigIssuanceMaxTTL() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeconfigIssuanceMaxTTL")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubeconfigIssuanceMaxTTL indicates an expected call of GetKubeconfigIssuanceMaxTTL.
+func (mr *MockConfigurationContractMockRecorder) GetKubeconfigIssuanceMaxTTL() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeconfigIssuanceMaxTTL", reflect.TypeOf((*MockConfigurationContract)(nil).GetKubeconfigIssuanceMaxTTL))
+}
+
+// GetLeaderElectionEnabled mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionEnabled() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionEnabled")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionEnabled indicates an expected call of GetLeaderElectionEnabled.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionEnabled", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionEnabled))
+}
+
+// GetLeaderElectionIdentity mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionIdentity() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionIdentity")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionIdentity indicates an expected call of GetLeaderElectionIdentity.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionIdentity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionIdentity", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionIdentity))
+}
+
+// GetLeaderElectionLeaseDuration mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionLeaseDuration() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionLeaseDuration")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionLeaseDuration indicates an expected call of GetLeaderElectionLeaseDuration.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionLeaseDuration() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionLeaseDuration", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionLeaseDuration))
+}
+
+// GetLeaderElectionLeaseName mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionLeaseName() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionLeaseName")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionLeaseName indicates an expected call of GetLeaderElectionLeaseName.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionLeaseName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionLeaseName", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionLeaseName))
+}
+
+// GetLeaderElectionNamespace mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionNamespace() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionNamespace")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionNamespace indicates an expected call of GetLeaderElectionNamespace.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionNamespace() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionNamespace", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionNamespace))
+}
+
+// GetLeaderElectionRenewDeadline mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionRenewDeadline() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionRenewDeadline")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionRenewDeadline indicates an expected call of GetLeaderElectionRenewDeadline.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionRenewDeadline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionRenewDeadline", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionRenewDeadline))
+}
+
+// GetLeaderElectionRetryPeriod mocks base method.
+func (m *MockConfigurationContract) GetLeaderElectionRetryPeriod() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderElectionRetryPeriod")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderElectionRetryPeriod indicates an expected call of GetLeaderElectionRetryPeriod.
+func (mr *MockConfigurationContractMockRecorder) GetLeaderElectionRetryPeriod() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderElectionRetryPeriod", reflect.TypeOf((*MockConfigurationContract)(nil).GetLeaderElectionRetryPeriod))
+}
+
+// GetOrphanedNamespaceGracePeriod mocks base method.
+func (m *MockConfigurationContract) GetOrphanedNamespaceGracePeriod() (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrphanedNamespaceGracePeriod")
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrphanedNamespaceGracePeriod indicates an expected call of GetOrphanedNamespaceGracePeriod.
+func (mr *MockConfigurationContractMockRecorder) GetOrphanedNamespaceGracePeriod() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphanedNamespaceGracePeriod", reflect.TypeOf((*MockConfigurationContract)(nil).GetOrphanedNamespaceGracePeriod))
+}
+
+// GetServiceExposureAllowedCIDRs mocks base method.
+func (m *MockConfigurationContract) GetServiceExposureAllowedCIDRs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceExposureAllowedCIDRs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceExposureAllowedCIDRs indicates an expected call of GetServiceExposureAllowedCIDRs.
+func (mr *MockConfigurationContractMockRecorder) GetServiceExposureAllowedCIDRs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceExposureAllowedCIDRs", reflect.TypeOf((*MockConfigurationContract)(nil).GetServiceExposureAllowedCIDRs))
+}
+
+// GetServiceExposureAllowedDomains mocks base method.
+func (m *MockConfigurationContract) GetServiceExposureAllowedDomains() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceExposureAllowedDomains")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetServiceExposureAllowedDomains indicates an expected call of GetServiceExposureAllowedDomains.
+func (mr *MockConfigurationContractMockRecorder) GetServiceExposureAllowedDomains() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceExposureAllowedDomains", reflect.TypeOf((*MockConfigurationContract)(nil).GetServiceExposureAllowedDomains))
+}
+
+// GetVClusterServiceCIDR mocks base method.
+func (m *MockConfigurationContract) GetVClusterServiceCIDR() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVClusterServiceCIDR")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVClusterServiceCIDR indicates an expected call of GetVClusterServiceCIDR.
+func (mr *MockConfigurationContractMockRecorder) GetVClusterServiceCIDR() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVClusterServiceCIDR", reflect.TypeOf((*MockConfigurationContract)(nil).GetVClusterServiceCIDR))
+}
+
+// GetVClusterSyncerDockerImage mocks base method.
+func (m *MockConfigurationContract) GetVClusterSyncerDockerImage() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVClusterSyncerDockerImage")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVClusterSyncerDockerImage indicates an expected call of GetVClusterSyncerDockerImage.
+func (mr *MockConfigurationContractMockRecorder) GetVClusterSyncerDockerImage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVClusterSyncerDockerImage", reflect.TypeOf((*MockConfigurationContract)(nil).GetVClusterSyncerDockerImage))
+}
@@ -1,6 +1,12 @@
 // Package cron implements different cron services required by the edge-cluster
 package cron
 
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+)
+
 // CronContract declares the methods to be implemented by the cron service
 type CronContract interface {
 	// Start the cron service.
@@ -11,3 +17,39 @@ type CronContract interface {
 	// Returns error if something goes wrong.
 	Stop() error
 }
+
+// SchedulerContract declares the methods to be implemented by the cron service that runs the background jobs on
+// their schedules
+type SchedulerContract interface {
+	CronContract
+
+	// ListJobStatuses returns the status of the last run of every background job, ordered by the job name.
+	// Returns the status of the background jobs
+	ListJobStatuses() []JobStatus
+}
+
+// CronJobContract declares the methods to be implemented by a background job run by the scheduler
+type CronJobContract interface {
+	// Name returns the unique name of the job, used to configure its schedule
+	// Returns the unique name of the job
+	Name() string
+
+	// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it
+	// Returns the default cron schedule of the job
+	DefaultSchedule() string
+
+	// Run runs the job once.
+	// ctx: Mandatory The reference to the context. It is cancelled if the run times out or the scheduler is stopped
+	// Returns error if something goes wrong.
+	Run(ctx context.Context) error
+}
+
+// HelmChartRefreshJobContract declares the methods to be implemented by the background job that keeps the local
+// helm repository updated
+type HelmChartRefreshJobContract interface {
+	CronJobContract
+
+	// GetLastUpdateReport returns the report of the last update of the helm chart repositories.
+	// Returns the report of the last update and false if the chart repositories are not updated yet
+	GetLastUpdateReport() (helm.RepositoryUpdateReport, bool)
+}
@@ -1 +0,0 @@
-package cronhelm_test
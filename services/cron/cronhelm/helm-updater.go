@@ -1,28 +1,55 @@
-// Package cronhelm provides a cron job to keep the local helm repository updated
+// Package cronhelm provides the background job that keeps the local helm repository updated
 package cronhelm
 
 import (
+	"context"
+	"sync"
+
 	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
 	commonErrors "github.com/micro-business/go-core/system/errors"
-	cron "github.com/robfig/cron/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
 )
 
-type helmCronService struct {
-	logger      *zap.Logger
-	cronSpec    string
-	cron        *cron.Cron
-	helmService helm.HelmHelperContract
+var (
+	repositoryUpdateSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "edge_cluster_helm_repository_update_success",
+		Help: "Whether the index of the helm chart repository was downloaded successfully in the last update (1) or not (0)",
+	}, []string{"repository"})
+
+	repositoryUpdateDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "edge_cluster_helm_repository_update_duration_seconds",
+		Help: "The time it took to download the index of the helm chart repository in the last update",
+	}, []string{"repository"})
+
+	repositoryUpdateFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "edge_cluster_helm_repository_update_failures_total",
+		Help: "The number of times the index of the helm chart repository could not be downloaded",
+	}, []string{"repository"})
+
+	repositoryUpdateLastRun = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "edge_cluster_helm_repository_update_last_run_timestamp_seconds",
+		Help: "The time the last update of the helm chart repositories started",
+	})
+)
+
+type helmChartRefreshJob struct {
+	logger           *zap.Logger
+	helmService      helm.HelmHelperContract
+	lastReport       helm.RepositoryUpdateReport
+	lastReportExists bool
+	lastReportLock   sync.RWMutex
 }
 
-// NewhelmCronService creates new instance of the helmCronService, setting up all dependencies and returns the instance
+// NewHelmChartRefreshJob creates new instance of the helmChartRefreshJob, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
-// configurationService: Mandatory. Reference to the service that provides required configurations
-// Returns the new service or error if something goes wrong
-func NewhelmCronService(
+// helmService: Mandatory. Reference to the service that updates the helm chart repositories
+// Returns the new job or error if something goes wrong
+func NewHelmChartRefreshJob(
 	logger *zap.Logger,
-	helmService helm.HelmHelperContract) (cronContract.CronContract, error) {
+	helmService helm.HelmHelperContract) (cronContract.HelmChartRefreshJobContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
@@ -31,44 +58,71 @@ func NewhelmCronService(
 		return nil, commonErrors.NewArgumentNilError("helmService", "helmService is required")
 	}
 
-	return &helmCronService{
+	return &helmChartRefreshJob{
 		logger:      logger,
-		cronSpec:    "@every 30m",
-		cron:        cron.New(),
 		helmService: helmService,
 	}, nil
 }
 
-// Stop starts the helm crom service
-// Returns error if something goes wrong
-func (service *helmCronService) Start() error {
-	service.logger.Info("cron Helm service started")
+// Name returns the unique name of the job, used to configure its schedule
+// Returns the unique name of the job
+func (service *helmChartRefreshJob) Name() string {
+	return cronContract.HelmChartRefreshJobName
+}
+
+// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it
+// Returns the default cron schedule of the job
+func (service *helmChartRefreshJob) DefaultSchedule() string {
+	return "@every 30m"
+}
 
-	_, err := service.cron.AddFunc(service.cronSpec, service.updateHelmCharts)
-	if err != nil {
-		return err
-	}
+// Run invokes helm service to update the list of the helm charts. The chart repositories are updated with their own
+// timeout, so the given context is not used.
+// ctx: Mandatory The reference to the context
+// Returns error if any of the chart repositories failed to update
+func (service *helmChartRefreshJob) Run(ctx context.Context) error {
+	report, err := service.helmService.UpdateCharts()
 
-	service.cron.Start()
+	service.recordReport(report)
 
-	go service.updateHelmCharts()
+	service.logger.Info(
+		"finished updating helm repositories.",
+		zap.Int("repositories", len(report.Repositories)),
+		zap.Int("failed", len(report.Failed())),
+		zap.Duration("duration", report.Duration))
 
-	return nil
+	return err
 }
 
-// Stop stops the helm crom service
-// Returns error if something goes wrong
-func (service *helmCronService) Stop() error {
-	service.cron.Stop()
+// GetLastUpdateReport returns the report of the last update of the helm chart repositories.
+// Returns the report of the last update and false if the chart repositories are not updated yet
+func (service *helmChartRefreshJob) GetLastUpdateReport() (helm.RepositoryUpdateReport, bool) {
+	service.lastReportLock.RLock()
+	defer service.lastReportLock.RUnlock()
 
-	return nil
+	return service.lastReport, service.lastReportExists
 }
 
-// updateHelmCharts invoke helm service to update the list of the helm charts
-func (service *helmCronService) updateHelmCharts() {
-	if err := service.helmService.UpdateCharts(); err != nil {
-		service.logger.Error("failed to update helm chart repositories", zap.Error(err))
-	}
+// recordReport keeps the report of the last update and exposes it as metrics
+func (service *helmChartRefreshJob) recordReport(report helm.RepositoryUpdateReport) {
+	service.lastReportLock.Lock()
+	service.lastReport = report
+	service.lastReportExists = true
+	service.lastReportLock.Unlock()
 
-	service.logger.Info("finished updating helm repositories.")
+	// The removed chart repositories must not be reported anymore
+	repositoryUpdateSuccess.Reset()
+	repositoryUpdateDuration.Reset()
+	repositoryUpdateLastRun.Set(float64(report.StartTime.Unix()))
+
+	for _, result := range report.Repositories {
+		repositoryUpdateDuration.WithLabelValues(result.Name).Set(result.Duration.Seconds())
+
+		if result.Err != nil {
+			repositoryUpdateSuccess.WithLabelValues(result.Name).Set(0)
+			repositoryUpdateFailures.WithLabelValues(result.Name).Inc()
+		} else {
+			repositoryUpdateSuccess.WithLabelValues(result.Name).Set(1)
+		}
+	}
 }
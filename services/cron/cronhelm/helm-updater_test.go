@@ -0,0 +1,102 @@
+package cronhelm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronhelm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestHelmChartRefreshJob(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Helm Chart Refresh Job Tests")
+}
+
+var _ = Describe("Helm Chart Refresh Job Tests", func() {
+	var (
+		mockCtrl        *gomock.Controller
+		mockHelmService *helmMock.MockHelmHelperContract
+		logger          *zap.Logger
+		sut             cron.HelmChartRefreshJobContract
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockHelmService = helmMock.NewMockHelmHelperContract(mockCtrl)
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, err = cronhelm.NewHelmChartRefreshJob(logger, mockHelmService)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate HelmChartRefreshJob", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := cronhelm.NewHelmChartRefreshJob(nil, mockHelmService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("helm service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := cronhelm.NewHelmChartRefreshJob(logger, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the job is registered with the scheduler", func() {
+		It("should be named after the helm chart refresh job", func() {
+			Ω(sut.Name()).Should(Equal(cron.HelmChartRefreshJobName))
+			Ω(sut.DefaultSchedule()).Should(Equal("@every 30m"))
+		})
+	})
+
+	Context("the job is run", func() {
+		It("should not report anything before the chart repositories are updated", func() {
+			_, ok := sut.GetLastUpdateReport()
+			Ω(ok).Should(BeFalse())
+		})
+
+		It("should record the report of the update even if some of the chart repositories failed", func() {
+			report := helm.RepositoryUpdateReport{
+				StartTime: time.Now(),
+				Duration:  time.Second,
+				Repositories: []helm.RepositoryUpdateResult{
+					{Name: cuid.New(), URL: "https://" + cuid.New(), Duration: time.Second, Err: errors.New(cuid.New())},
+					{Name: cuid.New(), URL: "https://" + cuid.New(), Duration: time.Millisecond},
+				},
+			}
+			expectedErr := errors.New(cuid.New())
+			mockHelmService.EXPECT().UpdateCharts().Return(report, expectedErr)
+
+			Ω(sut.Run(context.Background())).Should(Equal(expectedErr))
+
+			lastReport, ok := sut.GetLastUpdateReport()
+			Ω(ok).Should(BeTrue())
+			Ω(lastReport).Should(Equal(report))
+			Ω(lastReport.Failed()).Should(HaveLen(1))
+		})
+	})
+})
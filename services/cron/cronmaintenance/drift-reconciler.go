@@ -0,0 +1,131 @@
+package cronmaintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	driftRepairs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "edge_cluster_drift_repairs_total",
+		Help: "The total number of resources of the edge clusters that drifted from their desired state and were repaired",
+	}, []string{"kind"})
+)
+
+type driftReconcilerJob struct {
+	logger                    *zap.Logger
+	repositoryService         repository.RepositoryContract
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract
+}
+
+// NewDriftReconcilerJob creates new instance of the driftReconcilerJob, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// repositoryService: Mandatory. Reference to the repository service that persists the edge clusters
+// edgeClusterFactoryService: Mandatory. Reference to the factory service that creates the edge cluster provisioners
+// Returns the new job or error if something goes wrong
+func NewDriftReconcilerJob(
+	logger *zap.Logger,
+	repositoryService repository.RepositoryContract,
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract) (cronContract.CronJobContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	if edgeClusterFactoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterFactoryService", "edgeClusterFactoryService is required")
+	}
+
+	return &driftReconcilerJob{
+		logger:                    logger,
+		repositoryService:         repositoryService,
+		edgeClusterFactoryService: edgeClusterFactoryService,
+	}, nil
+}
+
+// Name returns the unique name of the job, used to configure its schedule
+// Returns the unique name of the job
+func (service *driftReconcilerJob) Name() string {
+	return cronContract.DriftReconcilerJobName
+}
+
+// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it
+// Returns the default cron schedule of the job
+func (service *driftReconcilerJob) DefaultSchedule() string {
+	return "@every 10m"
+}
+
+// Run converges the provision of every ready edge cluster to the state stored in the repository. The resources that
+// drifted from their desired state, e.g. a deleted service or a scaled down deployment, are repaired by the
+// provisioner of the edge cluster, which publishes an event for every repair.
+// ctx: Mandatory The reference to the context
+// Returns error if any of the edge clusters could not be reconciled
+func (service *driftReconcilerJob) Run(ctx context.Context) error {
+	edgeClusters, err := listEdgeClustersWithStatus(ctx, service.logger, service.repositoryService, models.ProvisioningStatusReady)
+	if err != nil {
+		return err
+	}
+
+	errs := []string{}
+	for _, edgeCluster := range edgeClusters {
+		if err = service.reconcile(ctx, edgeCluster); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			errs = append(errs, fmt.Sprintf("%s: %s", edgeCluster.EdgeClusterID, err.Error()))
+		}
+	}
+
+	return newJobError("reconcile", errs, len(edgeClusters), "edge clusters")
+}
+
+// reconcile repairs the resources of the given edge cluster that drifted from their desired state
+func (service *driftReconcilerJob) reconcile(ctx context.Context, edgeCluster repository.EdgeClusterRecord) error {
+	provisioner, err := service.edgeClusterFactoryService.Create(ctx, edgeCluster.EdgeCluster.ClusterType)
+	if err != nil {
+		return err
+	}
+
+	response, err := provisioner.ReconcileProvision(ctx, &edgeClusterTypes.ReconcileProvisionRequest{
+		EdgeClusterID:   edgeCluster.EdgeClusterID,
+		ClusterSecret:   edgeCluster.EdgeCluster.ClusterSecret,
+		ProjectID:       edgeCluster.EdgeCluster.ProjectID,
+		UserEmail:       edgeCluster.UserEmail,
+		EdgeClusterName: edgeCluster.EdgeCluster.Name,
+		ServiceExposure: edgeCluster.EdgeCluster.ServiceExposure,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, repair := range response.Repairs {
+		driftRepairs.WithLabelValues(getResourceKind(repair.Resource)).Inc()
+
+		service.logger.Info(
+			"repaired the drifted resource of the edge cluster",
+			zap.String("edgeClusterID", edgeCluster.EdgeClusterID),
+			zap.String("resource", repair.Resource),
+			zap.String("drift", repair.Message))
+	}
+
+	return nil
+}
+
+// getResourceKind returns the kind of the resource identified by provision.GetResource
+func getResourceKind(resource string) string {
+	return strings.SplitN(resource, "/", 2)[0]
+}
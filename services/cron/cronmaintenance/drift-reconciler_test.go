@@ -0,0 +1,186 @@
+package cronmaintenance_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronmaintenance"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	repositoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drift Reconciler Job Tests", func() {
+	var (
+		mockCtrl                          *gomock.Controller
+		mockRepositoryService             *repositoryMock.MockRepositoryContract
+		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
+		logger                            *zap.Logger
+		ctx                               context.Context
+		sut                               cron.CronJobContract
+		readyEdgeCluster                  repository.EdgeClusterRecord
+		secondReadyEdgeCluster            repository.EdgeClusterRecord
+		pendingEdgeCluster                repository.EdgeClusterRecord
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+		mockEdgeClusterFactoryService = edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		ctx = context.Background()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		readyEdgeCluster = repository.EdgeClusterRecord{
+			EdgeClusterID:     cuid.New(),
+			UserEmail:         cuid.New() + "@test.com",
+			EdgeCluster:       models.EdgeCluster{ProjectID: cuid.New(), Name: cuid.New(), ClusterSecret: cuid.New(), ClusterType: models.K3S},
+			ProvisioningState: models.ProvisioningState{Status: models.ProvisioningStatusReady},
+		}
+
+		secondReadyEdgeCluster = repository.EdgeClusterRecord{
+			EdgeClusterID:     cuid.New(),
+			UserEmail:         cuid.New() + "@test.com",
+			EdgeCluster:       models.EdgeCluster{ProjectID: cuid.New(), Name: cuid.New(), ClusterSecret: cuid.New(), ClusterType: models.K3S},
+			ProvisioningState: models.ProvisioningState{Status: models.ProvisioningStatusReady},
+		}
+
+		pendingEdgeCluster = repository.EdgeClusterRecord{
+			EdgeClusterID:     cuid.New(),
+			UserEmail:         cuid.New() + "@test.com",
+			EdgeCluster:       models.EdgeCluster{Name: cuid.New(), ClusterType: models.K3S},
+			ProvisioningState: models.ProvisioningState{Status: models.ProvisioningStatusPending},
+		}
+
+		mockRepositoryService.
+			EXPECT().
+			ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+			Return(&repository.ListAllEdgeClustersResponse{
+				EdgeClusters: []repository.EdgeClusterRecord{readyEdgeCluster, pendingEdgeCluster, secondReadyEdgeCluster},
+
+				// The edge clusters that cannot be read are skipped, so they are never reconciled
+				Failures: []repository.ListEdgeClusterFailure{{EdgeClusterID: cuid.New(), Err: errors.New(cuid.New())}},
+			}, nil).
+			AnyTimes()
+
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		sut, err = cronmaintenance.NewDriftReconcilerJob(
+			logger,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate DriftReconcilerJob", func() {
+		When("all dependencies are resolved and NewDriftReconcilerJob is called", func() {
+			It("should instantiate the new job", func() {
+				Ω(sut).ShouldNot(BeNil())
+				Ω(sut.Name()).Should(Equal(cron.DriftReconcilerJobName))
+			})
+		})
+
+		When("edge cluster factory service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := cronmaintenance.NewDriftReconcilerJob(logger, mockRepositoryService, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the job is run", func() {
+		When("the provisions of the ready edge clusters are reconciled", func() {
+			It("should reconcile only the ready edge clusters with their stored cluster secret", func() {
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					ReconcileProvision(gomock.Any(), &edgeClusterTypes.ReconcileProvisionRequest{
+						EdgeClusterID:   readyEdgeCluster.EdgeClusterID,
+						ClusterSecret:   readyEdgeCluster.EdgeCluster.ClusterSecret,
+						ProjectID:       readyEdgeCluster.EdgeCluster.ProjectID,
+						UserEmail:       readyEdgeCluster.UserEmail,
+						EdgeClusterName: readyEdgeCluster.EdgeCluster.Name,
+					}).
+					Return(&edgeClusterTypes.ReconcileProvisionResponse{
+						Repairs: []models.DriftRepair{{Resource: "service/" + cuid.New() + "/k3s", Message: cuid.New()}},
+					}, nil)
+
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					ReconcileProvision(gomock.Any(), &edgeClusterTypes.ReconcileProvisionRequest{
+						EdgeClusterID:   secondReadyEdgeCluster.EdgeClusterID,
+						ClusterSecret:   secondReadyEdgeCluster.EdgeCluster.ClusterSecret,
+						ProjectID:       secondReadyEdgeCluster.EdgeCluster.ProjectID,
+						UserEmail:       secondReadyEdgeCluster.UserEmail,
+						EdgeClusterName: secondReadyEdgeCluster.EdgeCluster.Name,
+					}).
+					Return(&edgeClusterTypes.ReconcileProvisionResponse{}, nil)
+
+				Ω(sut.Run(ctx)).Should(BeNil())
+			})
+		})
+
+		When("reconciling one of the edge clusters fails", func() {
+			It("should continue with the rest of the edge clusters and return error", func() {
+				reconcileErr := errors.New(cuid.New())
+
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					ReconcileProvision(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(
+						_ context.Context,
+						request *edgeClusterTypes.ReconcileProvisionRequest) (*edgeClusterTypes.ReconcileProvisionResponse, error) {
+						if request.EdgeClusterID == readyEdgeCluster.EdgeClusterID {
+							return nil, reconcileErr
+						}
+
+						return &edgeClusterTypes.ReconcileProvisionResponse{}, nil
+					}).
+					Times(2)
+
+				err := sut.Run(ctx)
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring(readyEdgeCluster.EdgeClusterID))
+				Ω(err.Error()).Should(ContainSubstring(reconcileErr.Error()))
+				Ω(err.Error()).Should(ContainSubstring("1 of 2"))
+			})
+		})
+
+		When("the edge clusters cannot be listed", func() {
+			It("should return the error", func() {
+				listErr := errors.New(cuid.New())
+				mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+				mockRepositoryService.
+					EXPECT().
+					ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(nil, listErr)
+
+				service, _ := cronmaintenance.NewDriftReconcilerJob(logger, mockRepositoryService, mockEdgeClusterFactoryService)
+
+				Ω(service.Run(ctx)).Should(Equal(listErr))
+			})
+		})
+	})
+})
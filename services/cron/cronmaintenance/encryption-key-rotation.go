@@ -0,0 +1,125 @@
+package cronmaintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	encryptedEdgeClusters = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "edge_cluster_encrypted_edge_clusters_total",
+		Help: "The total number of edge clusters that were stored in plain text or encrypted with a retired key and were encrypted with the primary key",
+	})
+)
+
+type encryptionKeyRotationJob struct {
+	logger            *zap.Logger
+	repositoryService repository.RepositoryContract
+	batchSize         int
+}
+
+// NewEncryptionKeyRotationJob creates new instance of the encryptionKeyRotationJob, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// repositoryService: Mandatory. Reference to the repository service that persists the edge clusters
+// Returns the new job or error if something goes wrong
+func NewEncryptionKeyRotationJob(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	repositoryService repository.RepositoryContract) (cronContract.CronJobContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	batchSize, err := configurationService.GetEncryptionRotationBatchSize()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the encryption rotation batch size", err)
+	}
+
+	if batchSize <= 0 {
+		return nil, commonErrors.NewArgumentError("batchSize", "the encryption rotation batch size must be positive")
+	}
+
+	return &encryptionKeyRotationJob{
+		logger:            logger,
+		repositoryService: repositoryService,
+		batchSize:         batchSize,
+	}, nil
+}
+
+// Name returns the unique name of the job, used to configure its schedule
+// Returns the unique name of the job
+func (service *encryptionKeyRotationJob) Name() string {
+	return cronContract.EncryptionKeyRotationJobName
+}
+
+// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it
+// Returns the default cron schedule of the job
+func (service *encryptionKeyRotationJob) DefaultSchedule() string {
+	return "@every 1h"
+}
+
+// Run encrypts the sensitive fields of the edge clusters that are stored in plain text, e.g. the ones stored before
+// the encryption was configured, and re-encrypts the ones encrypted with a retired key once the keys are rotated. The
+// edge clusters are processed in batches, so a large repository is not loaded at once. The retired key can be removed
+// from the key ring once a run reports no more edge clusters left to encrypt.
+// ctx: Mandatory The reference to the context
+// Returns error if any of the edge clusters could not be encrypted
+func (service *encryptionKeyRotationJob) Run(ctx context.Context) error {
+	errs := []string{}
+	total := 0
+	encrypted := 0
+	cursor := ""
+
+	for {
+		response, err := service.repositoryService.EncryptEdgeClusters(ctx, &repository.EncryptEdgeClustersRequest{
+			BatchSize: service.batchSize,
+			After:     cursor,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, failure := range response.Failures {
+			errs = append(errs, fmt.Sprintf("%s: %s", failure.EdgeClusterID, failure.Err.Error()))
+		}
+
+		total += response.EncryptedCount + len(response.Failures)
+		encrypted += response.EncryptedCount
+		encryptedEdgeClusters.Add(float64(response.EncryptedCount))
+		cursor = response.Cursor
+
+		if !response.HasMore {
+			break
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	if total > 0 {
+		service.logger.Info(
+			"encrypted the edge clusters with the primary key",
+			zap.Int("encrypted", encrypted),
+			zap.Int("failed", len(errs)))
+	}
+
+	return newJobError("encrypt", errs, total, "edge clusters")
+}
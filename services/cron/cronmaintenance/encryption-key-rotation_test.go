@@ -0,0 +1,122 @@
+package cronmaintenance_test
+
+import (
+	"context"
+	"errors"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronmaintenance"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	repositoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encryption Key Rotation Job Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockRepositoryService    *repositoryMock.MockRepositoryContract
+		logger                   *zap.Logger
+		ctx                      context.Context
+		sut                      cron.CronJobContract
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+		ctx = context.Background()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		mockConfigurationService.EXPECT().GetEncryptionRotationBatchSize().Return(2, nil).AnyTimes()
+
+		sut, err = cronmaintenance.NewEncryptionKeyRotationJob(logger, mockConfigurationService, mockRepositoryService)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate EncryptionKeyRotationJob", func() {
+		When("repository service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := cronmaintenance.NewEncryptionKeyRotationJob(logger, mockConfigurationService, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the job is run", func() {
+		When("the edge clusters span several batches", func() {
+			It("should continue every batch from the cursor of the previous batch", func() {
+				firstCursor := cuid.New()
+
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						EncryptEdgeClusters(gomock.Any(), &repository.EncryptEdgeClustersRequest{BatchSize: 2}).
+						Return(&repository.EncryptEdgeClustersResponse{
+							EncryptedCount: 2,
+							Cursor:         firstCursor,
+							HasMore:        true,
+						}, nil),
+					mockRepositoryService.
+						EXPECT().
+						EncryptEdgeClusters(gomock.Any(), &repository.EncryptEdgeClustersRequest{BatchSize: 2, After: firstCursor}).
+						Return(&repository.EncryptEdgeClustersResponse{
+							EncryptedCount: 1,
+							Cursor:         cuid.New(),
+						}, nil))
+
+				Ω(sut.Run(ctx)).Should(BeNil())
+			})
+		})
+
+		When("an edge cluster cannot be encrypted", func() {
+			It("should encrypt the other edge clusters and return the error", func() {
+				edgeClusterID := cuid.New()
+				expectedError := errors.New(cuid.New())
+
+				mockRepositoryService.
+					EXPECT().
+					EncryptEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(&repository.EncryptEdgeClustersResponse{
+						EncryptedCount: 1,
+						Failures:       []repository.EncryptEdgeClusterFailure{{EdgeClusterID: edgeClusterID, Err: expectedError}},
+						Cursor:         edgeClusterID,
+					}, nil)
+
+				err := sut.Run(ctx)
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("1 of 2"))
+				Ω(err.Error()).Should(ContainSubstring(edgeClusterID))
+				Ω(err.Error()).Should(ContainSubstring(expectedError.Error()))
+			})
+		})
+
+		When("the edge clusters cannot be listed", func() {
+			It("should return the error", func() {
+				expectedError := errors.New(cuid.New())
+
+				mockRepositoryService.
+					EXPECT().
+					EncryptEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(nil, expectedError)
+
+				Ω(sut.Run(ctx)).Should(Equal(expectedError))
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+package cronmaintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+type kubeconfigIssuanceCleanupJob struct {
+	logger                    *zap.Logger
+	repositoryService         repository.RepositoryContract
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract
+	kubeconfigIssuerService   access.KubeconfigIssuerContract
+}
+
+// NewKubeconfigIssuanceCleanupJob creates new instance of the kubeconfigIssuanceCleanupJob, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// repositoryService: Mandatory. Reference to the repository service that persists the edge clusters and the issued kubeconfigs
+// edgeClusterFactoryService: Mandatory. Reference to the factory service that creates the edge cluster provisioners
+// kubeconfigIssuerService: Mandatory. Reference to the service that revokes the access of the issued kubeconfigs
+// Returns the new job or error if something goes wrong
+func NewKubeconfigIssuanceCleanupJob(
+	logger *zap.Logger,
+	repositoryService repository.RepositoryContract,
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract,
+	kubeconfigIssuerService access.KubeconfigIssuerContract) (cronContract.CronJobContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	if edgeClusterFactoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterFactoryService", "edgeClusterFactoryService is required")
+	}
+
+	if kubeconfigIssuerService == nil {
+		return nil, commonErrors.NewArgumentNilError("kubeconfigIssuerService", "kubeconfigIssuerService is required")
+	}
+
+	return &kubeconfigIssuanceCleanupJob{
+		logger:                    logger,
+		repositoryService:         repositoryService,
+		edgeClusterFactoryService: edgeClusterFactoryService,
+		kubeconfigIssuerService:   kubeconfigIssuerService,
+	}, nil
+}
+
+// Name returns the unique name of the job, used to configure its schedule
+// Returns the unique name of the job
+func (service *kubeconfigIssuanceCleanupJob) Name() string {
+	return cronContract.KubeconfigIssuanceCleanupJobName
+}
+
+// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it
+// Returns the default cron schedule of the job
+func (service *kubeconfigIssuanceCleanupJob) DefaultSchedule() string {
+	return "@every 15m"
+}
+
+// Run deletes the service account and the role binding of every expired issued kubeconfig from its edge cluster, and
+// records the issuance as revoked so it is not cleaned up again. The token of an expired kubeconfig is already
+// rejected by the API server, but its service account and role binding are left on the edge cluster until removed.
+// ctx: Mandatory The reference to the context
+// Returns error if the access of any of the expired kubeconfigs could not be removed
+func (service *kubeconfigIssuanceCleanupJob) Run(ctx context.Context) error {
+	response, err := service.repositoryService.ListExpiredKubeconfigIssuances(
+		ctx,
+		&repository.ListExpiredKubeconfigIssuancesRequest{ExpiredBefore: time.Now()})
+	if err != nil {
+		return err
+	}
+
+	errs := []string{}
+	for _, issuance := range response.Issuances {
+		if err = service.cleanup(ctx, issuance.IssuanceID, issuance.Issuance); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			errs = append(errs, fmt.Sprintf("%s: %s", issuance.IssuanceID, err.Error()))
+		}
+	}
+
+	return newJobError("clean up", errs, len(response.Issuances), "expired kubeconfig issuances")
+}
+
+// cleanup removes the access of the given expired issuance from its edge cluster and records it as revoked. The
+// access is not removed if the edge cluster is already removed, as it is removed together with the edge cluster.
+func (service *kubeconfigIssuanceCleanupJob) cleanup(
+	ctx context.Context,
+	issuanceID string,
+	issuance models.KubeconfigIssuance) error {
+	readResponse, err := service.repositoryService.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+		UserEmail:     issuance.UserEmail,
+		EdgeClusterID: issuance.EdgeClusterID,
+	})
+	if err != nil && !commonErrors.IsNotFoundError(err) {
+		return err
+	}
+
+	if err == nil {
+		if err = service.revoke(ctx, issuanceID, issuance, readResponse.EdgeCluster.ClusterType); err != nil {
+			return err
+		}
+	}
+
+	if _, err = service.repositoryService.RevokeKubeconfigIssuance(ctx, &repository.RevokeKubeconfigIssuanceRequest{
+		UserEmail:     issuance.UserEmail,
+		EdgeClusterID: issuance.EdgeClusterID,
+		IssuanceID:    issuanceID,
+		RevokedAt:     time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	service.logger.Info(
+		"removed the access of the expired kubeconfig from the edge cluster",
+		zap.String("edgeClusterID", issuance.EdgeClusterID),
+		zap.String("issuanceID", issuanceID))
+
+	return nil
+}
+
+// revoke deletes the service account and the role binding of the given issuance from its edge cluster
+func (service *kubeconfigIssuanceCleanupJob) revoke(
+	ctx context.Context,
+	issuanceID string,
+	issuance models.KubeconfigIssuance,
+	clusterType models.ClusterType) error {
+	provisioner, err := service.edgeClusterFactoryService.Create(ctx, clusterType)
+	if err != nil {
+		return err
+	}
+
+	response, err := provisioner.GetProvisionDetails(ctx, &edgeClusterTypes.GetProvisionDetailsRequest{
+		EdgeClusterID: issuance.EdgeClusterID,
+	})
+	if err != nil {
+		return err
+	}
+
+	clientset, err := provision.NewClientsetForKubeconfig(response.ProvisionDetails.KubeconfigContent)
+	if err != nil {
+		return err
+	}
+
+	_, err = service.kubeconfigIssuerService.RevokeKubeconfig(ctx, &access.RevokeKubeconfigRequest{
+		Clientset:  clientset,
+		IssuanceID: issuanceID,
+		Namespace:  issuance.Namespace,
+	})
+
+	return err
+}
@@ -0,0 +1,180 @@
+package cronmaintenance_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronmaintenance"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	accessMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/access/mock"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	repositoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kubeconfig Issuance Cleanup Job Tests", func() {
+	const kubeconfigContent = `apiVersion: v1
+kind: Config
+clusters:
+- name: edge-cluster
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: edge-cluster
+  context:
+    cluster: edge-cluster
+    user: edge-cluster
+current-context: edge-cluster
+users:
+- name: edge-cluster
+  user:
+    token: token
+`
+
+	var (
+		mockCtrl                          *gomock.Controller
+		mockRepositoryService             *repositoryMock.MockRepositoryContract
+		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
+		mockKubeconfigIssuerService       *accessMock.MockKubeconfigIssuerContract
+		ctx                               context.Context
+		sut                               cron.CronJobContract
+		issuanceID                        string
+		issuance                          models.KubeconfigIssuance
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+		mockEdgeClusterFactoryService = edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		mockKubeconfigIssuerService = accessMock.NewMockKubeconfigIssuerContract(mockCtrl)
+		ctx = context.Background()
+		issuanceID = cuid.New()
+		issuance = models.KubeconfigIssuance{
+			EdgeClusterID: cuid.New(),
+			UserEmail:     cuid.New() + "@test.com",
+			Role:          models.KubeconfigRoleView,
+			Namespace:     cuid.New(),
+		}
+
+		mockRepositoryService.
+			EXPECT().
+			ListExpiredKubeconfigIssuances(gomock.Any(), gomock.Any()).
+			Return(&repository.ListExpiredKubeconfigIssuancesResponse{
+				Issuances: []models.KubeconfigIssuanceWithID{{IssuanceID: issuanceID, Issuance: issuance}},
+			}, nil)
+
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		mockEdgeClusterProvisionerService.
+			EXPECT().
+			GetProvisionDetails(gomock.Any(), &edgeClusterTypes.GetProvisionDetailsRequest{EdgeClusterID: issuance.EdgeClusterID}).
+			Return(&edgeClusterTypes.GetProvisionDetailsResponse{
+				ProvisionDetails: models.ProvisionDetails{KubeconfigContent: kubeconfigContent},
+			}, nil).
+			AnyTimes()
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, err = cronmaintenance.NewKubeconfigIssuanceCleanupJob(
+			logger,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService,
+			mockKubeconfigIssuerService)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("the job is run", func() {
+		It("should remove the access of the expired kubeconfig from the edge cluster and record it as revoked", func() {
+			gomock.InOrder(
+				mockRepositoryService.
+					EXPECT().
+					ReadEdgeCluster(gomock.Any(), &repository.ReadEdgeClusterRequest{
+						UserEmail:     issuance.UserEmail,
+						EdgeClusterID: issuance.EdgeClusterID,
+					}).
+					Return(&repository.ReadEdgeClusterResponse{EdgeCluster: models.EdgeCluster{ClusterType: models.K3S}}, nil),
+				mockKubeconfigIssuerService.
+					EXPECT().
+					RevokeKubeconfig(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *access.RevokeKubeconfigRequest) (*access.RevokeKubeconfigResponse, error) {
+						Ω(request.Clientset).ShouldNot(BeNil())
+						Ω(request.IssuanceID).Should(Equal(issuanceID))
+						Ω(request.Namespace).Should(Equal(issuance.Namespace))
+
+						return &access.RevokeKubeconfigResponse{}, nil
+					}),
+				mockRepositoryService.
+					EXPECT().
+					RevokeKubeconfigIssuance(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *repository.RevokeKubeconfigIssuanceRequest) (*repository.RevokeKubeconfigIssuanceResponse, error) {
+						Ω(request.IssuanceID).Should(Equal(issuanceID))
+						Ω(request.UserEmail).Should(Equal(issuance.UserEmail))
+						Ω(request.EdgeClusterID).Should(Equal(issuance.EdgeClusterID))
+						Ω(request.RevokedAt.IsZero()).Should(BeFalse())
+
+						return &repository.RevokeKubeconfigIssuanceResponse{}, nil
+					}),
+			)
+
+			Ω(sut.Run(ctx)).Should(BeNil())
+		})
+
+		When("the edge cluster is already removed", func() {
+			It("should record the expired kubeconfig as revoked without connecting to the edge cluster", func() {
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						ReadEdgeCluster(gomock.Any(), gomock.Any()).
+						Return(nil, commonErrors.NewNotFoundError()),
+					mockRepositoryService.
+						EXPECT().
+						RevokeKubeconfigIssuance(gomock.Any(), gomock.Any()).
+						Return(&repository.RevokeKubeconfigIssuanceResponse{}, nil),
+				)
+
+				Ω(sut.Run(ctx)).Should(BeNil())
+			})
+		})
+
+		When("the access of the expired kubeconfig cannot be removed", func() {
+			It("should report the issuance without recording it as revoked", func() {
+				gomock.InOrder(
+					mockRepositoryService.
+						EXPECT().
+						ReadEdgeCluster(gomock.Any(), gomock.Any()).
+						Return(&repository.ReadEdgeClusterResponse{EdgeCluster: models.EdgeCluster{ClusterType: models.K3S}}, nil),
+					mockKubeconfigIssuerService.
+						EXPECT().
+						RevokeKubeconfig(gomock.Any(), gomock.Any()).
+						Return(nil, errors.New(cuid.New())),
+				)
+
+				err := sut.Run(ctx)
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("1 of 1"))
+				Ω(err.Error()).Should(ContainSubstring(issuanceID))
+			})
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package cronmaintenance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	kubeconfigValid = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "edge_cluster_kubeconfig_valid",
+		Help: "Whether the API server of the ready edge cluster was reachable with its kubeconfig in the last check (1) or not (0)",
+	}, []string{"edge_cluster_id"})
+)
+
+type kubeconfigValidityCheckJob struct {
+	logger                    *zap.Logger
+	repositoryService         repository.RepositoryContract
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract
+}
+
+// NewKubeconfigValidityCheckJob creates new instance of the kubeconfigValidityCheckJob, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// repositoryService: Mandatory. Reference to the repository service that persists the edge clusters
+// edgeClusterFactoryService: Mandatory. Reference to the factory service that creates the edge cluster provisioners
+// Returns the new job or error if something goes wrong
+func NewKubeconfigValidityCheckJob(
+	logger *zap.Logger,
+	repositoryService repository.RepositoryContract,
+	edgeClusterFactoryService edgeClusterTypes.EdgeClusterFactoryContract) (cronContract.CronJobContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	if edgeClusterFactoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("edgeClusterFactoryService", "edgeClusterFactoryService is required")
+	}
+
+	return &kubeconfigValidityCheckJob{
+		logger:                    logger,
+		repositoryService:         repositoryService,
+		edgeClusterFactoryService: edgeClusterFactoryService,
+	}, nil
+}
+
+// Name returns the unique name of the job, used to configure its schedule
+// Returns the unique name of the job
+func (service *kubeconfigValidityCheckJob) Name() string {
+	return cronContract.KubeconfigValidityCheckJobName
+}
+
+// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it
+// Returns the default cron schedule of the job
+func (service *kubeconfigValidityCheckJob) DefaultSchedule() string {
+	return "@every 15m"
+}
+
+// Run checks the API server of every ready edge cluster is reachable with its kubeconfig and exposes the result as
+// metrics.
+// ctx: Mandatory The reference to the context
+// Returns error if the API server of any of the ready edge clusters is not reachable
+func (service *kubeconfigValidityCheckJob) Run(ctx context.Context) error {
+	edgeClusters, err := listEdgeClustersWithStatus(ctx, service.logger, service.repositoryService, models.ProvisioningStatusReady)
+	if err != nil {
+		return err
+	}
+
+	// The removed edge clusters must not be reported anymore
+	kubeconfigValid.Reset()
+
+	errs := []string{}
+	for _, edgeCluster := range edgeClusters {
+		if err = service.check(ctx, edgeCluster); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			kubeconfigValid.WithLabelValues(edgeCluster.EdgeClusterID).Set(0)
+			errs = append(errs, fmt.Sprintf("%s: %s", edgeCluster.EdgeClusterID, err.Error()))
+
+			service.logger.Warn(
+				"the API server of the edge cluster is not reachable with its kubeconfig",
+				zap.Error(err),
+				zap.String("edgeClusterID", edgeCluster.EdgeClusterID))
+
+			continue
+		}
+
+		kubeconfigValid.WithLabelValues(edgeCluster.EdgeClusterID).Set(1)
+	}
+
+	return newJobError("reach", errs, len(edgeClusters), "edge clusters")
+}
+
+// check connects to the API server of the given edge cluster with its kubeconfig
+func (service *kubeconfigValidityCheckJob) check(ctx context.Context, edgeCluster repository.EdgeClusterRecord) error {
+	provisioner, err := service.edgeClusterFactoryService.Create(ctx, edgeCluster.EdgeCluster.ClusterType)
+	if err != nil {
+		return err
+	}
+
+	response, err := provisioner.GetProvisionDetails(ctx, &edgeClusterTypes.GetProvisionDetailsRequest{
+		EdgeClusterID: edgeCluster.EdgeClusterID,
+	})
+	if err != nil {
+		return err
+	}
+
+	clientset, err := provision.NewClientsetForKubeconfig(response.ProvisionDetails.KubeconfigContent)
+	if err != nil {
+		return err
+	}
+
+	// The version of the API server is requested with the context, so the check is cancelled when the job times out
+	_, err = clientset.Discovery().RESTClient().Get().AbsPath("/version").DoRaw(ctx)
+
+	return err
+}
@@ -0,0 +1,147 @@
+package cronmaintenance_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronmaintenance"
+	edgeClusterTypes "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	edgeClusterFactoryMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/types/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	repositoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Kubeconfig Validity Check Job Tests", func() {
+	var (
+		mockCtrl                          *gomock.Controller
+		mockRepositoryService             *repositoryMock.MockRepositoryContract
+		mockEdgeClusterFactoryService     *edgeClusterFactoryMock.MockEdgeClusterFactoryContract
+		mockEdgeClusterProvisionerService *edgeClusterFactoryMock.MockEdgeClusterProvisionerContract
+		healthyServer                     *httptest.Server
+		unhealthyServer                   *httptest.Server
+		ctx                               context.Context
+		sut                               cron.CronJobContract
+		healthyEdgeClusterID              string
+		unhealthyEdgeClusterID            string
+	)
+
+	newKubeconfig := func(server string) string {
+		return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: edge-cluster
+  cluster:
+    server: %s
+contexts:
+- name: edge-cluster
+  context:
+    cluster: edge-cluster
+    user: edge-cluster
+current-context: edge-cluster
+users:
+- name: edge-cluster
+  user:
+    token: %s
+`, server, cuid.New())
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+		mockEdgeClusterFactoryService = edgeClusterFactoryMock.NewMockEdgeClusterFactoryContract(mockCtrl)
+		mockEdgeClusterProvisionerService = edgeClusterFactoryMock.NewMockEdgeClusterProvisionerContract(mockCtrl)
+		ctx = context.Background()
+
+		healthyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"major":"1","minor":"21","gitVersion":"v1.21.0"}`))
+		}))
+
+		unhealthyServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+
+		healthyEdgeClusterID = cuid.New()
+		unhealthyEdgeClusterID = cuid.New()
+
+		mockRepositoryService.
+			EXPECT().
+			ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+			Return(&repository.ListAllEdgeClustersResponse{
+				EdgeClusters: []repository.EdgeClusterRecord{
+					{
+						EdgeClusterID:     healthyEdgeClusterID,
+						EdgeCluster:       models.EdgeCluster{ClusterType: models.K3S},
+						ProvisioningState: models.ProvisioningState{Status: models.ProvisioningStatusReady},
+					},
+					{
+						EdgeClusterID:     unhealthyEdgeClusterID,
+						EdgeCluster:       models.EdgeCluster{ClusterType: models.K3S},
+						ProvisioningState: models.ProvisioningState{Status: models.ProvisioningStatusReady},
+					},
+					{
+						EdgeClusterID:     cuid.New(),
+						EdgeCluster:       models.EdgeCluster{ClusterType: models.K3S},
+						ProvisioningState: models.ProvisioningState{Status: models.ProvisioningStatusProvisioning},
+					},
+				},
+			}, nil)
+
+		mockEdgeClusterFactoryService.
+			EXPECT().
+			Create(gomock.Any(), models.K3S).
+			Return(mockEdgeClusterProvisionerService, nil).
+			AnyTimes()
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, err = cronmaintenance.NewKubeconfigValidityCheckJob(
+			logger,
+			mockRepositoryService,
+			mockEdgeClusterFactoryService)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		healthyServer.Close()
+		unhealthyServer.Close()
+		mockCtrl.Finish()
+	})
+
+	Context("the job is run", func() {
+		When("the API server of a ready edge cluster is not reachable with its kubeconfig", func() {
+			It("should only report the unreachable edge cluster", func() {
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					GetProvisionDetails(gomock.Any(), &edgeClusterTypes.GetProvisionDetailsRequest{EdgeClusterID: healthyEdgeClusterID}).
+					Return(&edgeClusterTypes.GetProvisionDetailsResponse{
+						ProvisionDetails: models.ProvisionDetails{KubeconfigContent: newKubeconfig(healthyServer.URL)},
+					}, nil)
+
+				mockEdgeClusterProvisionerService.
+					EXPECT().
+					GetProvisionDetails(gomock.Any(), &edgeClusterTypes.GetProvisionDetailsRequest{EdgeClusterID: unhealthyEdgeClusterID}).
+					Return(&edgeClusterTypes.GetProvisionDetailsResponse{
+						ProvisionDetails: models.ProvisionDetails{KubeconfigContent: newKubeconfig(unhealthyServer.URL)},
+					}, nil)
+
+				err := sut.Run(ctx)
+				Ω(err).Should(HaveOccurred())
+				Ω(err.Error()).Should(ContainSubstring("1 of 2"))
+				Ω(err.Error()).Should(ContainSubstring(unhealthyEdgeClusterID))
+				Ω(err.Error()).ShouldNot(ContainSubstring(healthyEdgeClusterID))
+			})
+		})
+	})
+})
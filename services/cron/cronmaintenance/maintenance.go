@@ -0,0 +1,55 @@
+// Package cronmaintenance provides the background jobs that maintain the provisioned edge clusters
+package cronmaintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	"go.uber.org/zap"
+)
+
+// listEdgeClustersWithStatus returns the edge clusters of all the users that have the given provisioning status. The
+// edge clusters that cannot be read from the repository are logged and skipped.
+func listEdgeClustersWithStatus(
+	ctx context.Context,
+	logger *zap.Logger,
+	repositoryService repository.RepositoryContract,
+	status models.ProvisioningStatus) ([]repository.EdgeClusterRecord, error) {
+	response, err := repositoryService.ListAllEdgeClusters(ctx, &repository.ListAllEdgeClustersRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	logListFailures(logger, response.Failures)
+
+	edgeClusters := []repository.EdgeClusterRecord{}
+	for _, edgeCluster := range response.EdgeClusters {
+		if edgeCluster.ProvisioningState.Status == status {
+			edgeClusters = append(edgeClusters, edgeCluster)
+		}
+	}
+
+	return edgeClusters, nil
+}
+
+// logListFailures logs the edge clusters that failed to be read from the repository
+func logListFailures(logger *zap.Logger, failures []repository.ListEdgeClusterFailure) {
+	for _, failure := range failures {
+		logger.Warn(
+			"skipping the edge cluster that cannot be read from the repository",
+			zap.Error(failure.Err),
+			zap.String("edgeClusterID", failure.EdgeClusterID))
+	}
+}
+
+// newJobError combines the errors of the items a job failed to process into one error
+func newJobError(operation string, errs []string, total int, items string) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("unable to %s %d of %d %s: %s", operation, len(errs), total, items, strings.Join(errs, "; "))
+}
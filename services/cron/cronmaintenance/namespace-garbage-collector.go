@@ -0,0 +1,145 @@
+package cronmaintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type namespaceGarbageCollectorJob struct {
+	logger            *zap.Logger
+	repositoryService repository.RepositoryContract
+	clientset         kubernetes.Interface
+	gracePeriod       time.Duration
+}
+
+// NewNamespaceGarbageCollectorJob creates new instance of the namespaceGarbageCollectorJob, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// repositoryService: Mandatory. Reference to the repository service that persists the edge clusters
+// clientset: Mandatory. The client set of the cluster the edge clusters are provisioned in
+// Returns the new job or error if something goes wrong
+func NewNamespaceGarbageCollectorJob(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	repositoryService repository.RepositoryContract,
+	clientset kubernetes.Interface) (cronContract.CronJobContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if repositoryService == nil {
+		return nil, commonErrors.NewArgumentNilError("repositoryService", "repositoryService is required")
+	}
+
+	if clientset == nil {
+		return nil, commonErrors.NewArgumentNilError("clientset", "clientset is required")
+	}
+
+	gracePeriod, err := configurationService.GetOrphanedNamespaceGracePeriod()
+	if err != nil {
+		return nil, err
+	}
+
+	return &namespaceGarbageCollectorJob{
+		logger:            logger,
+		repositoryService: repositoryService,
+		clientset:         clientset,
+		gracePeriod:       gracePeriod,
+	}, nil
+}
+
+// Name returns the unique name of the job, used to configure its schedule
+// Returns the unique name of the job
+func (service *namespaceGarbageCollectorJob) Name() string {
+	return cronContract.NamespaceGarbageCollectorJobName
+}
+
+// DefaultSchedule returns the cron schedule the job runs on if no schedule is configured for it. The job deletes
+// namespaces, so it only runs once the operator schedules it.
+// Returns the default cron schedule of the job
+func (service *namespaceGarbageCollectorJob) DefaultSchedule() string {
+	return cronContract.DisabledSchedule
+}
+
+// Run deletes the namespaces that host an edge cluster that no longer exists in the repository, e.g. because the
+// provision was not cleaned up when the edge cluster was deleted. Namespaces younger than the grace period are kept,
+// so an edge cluster that is being created is never collected. Nothing is deleted if the edge clusters cannot be listed,
+// or if the repository returns no edge cluster while there are namespaces to delete, as that is more likely caused by
+// the repository being wrongly configured or emptied than by every edge cluster being removed.
+// ctx: Mandatory The reference to the context
+// Returns error if any of the orphaned namespaces could not be deleted
+func (service *namespaceGarbageCollectorJob) Run(ctx context.Context) error {
+	response, err := service.repositoryService.ListAllEdgeClusters(ctx, &repository.ListAllEdgeClustersRequest{})
+	if err != nil {
+		return err
+	}
+
+	// The edge clusters that cannot be read still exist, so their namespaces are not collected
+	logListFailures(service.logger, response.Failures)
+
+	edgeClusterIDs := make([]string, 0, len(response.EdgeClusters)+len(response.Failures))
+	for _, edgeCluster := range response.EdgeClusters {
+		edgeClusterIDs = append(edgeClusterIDs, edgeCluster.EdgeClusterID)
+	}
+
+	for _, failure := range response.Failures {
+		edgeClusterIDs = append(edgeClusterIDs, failure.EdgeClusterID)
+	}
+
+	namespaces, err := provision.ListOrphanedNamespaces(ctx, service.clientset, edgeClusterIDs)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(-service.gracePeriod)
+	orphanedNamespaces := []models.OrphanedNamespace{}
+	for _, namespace := range namespaces {
+		if namespace.CreationTime.After(deadline) {
+			continue
+		}
+
+		orphanedNamespaces = append(orphanedNamespaces, namespace)
+	}
+
+	if len(edgeClusterIDs) == 0 && len(orphanedNamespaces) > 0 {
+		service.logger.Error(
+			"the repository returned no edge cluster, skipping the deletion of the orphaned namespaces",
+			zap.Int("namespaces", len(orphanedNamespaces)))
+
+		return fmt.Errorf(
+			"the repository returned no edge cluster while %d namespaces are to be deleted",
+			len(orphanedNamespaces))
+	}
+
+	errs := []string{}
+	for _, namespace := range orphanedNamespaces {
+		service.logger.Info(
+			"deleting the namespace of the edge cluster that no longer exists",
+			zap.String("namespace", namespace.Name))
+
+		if err = service.clientset.CoreV1().Namespaces().Delete(ctx, namespace.Name, metav1.DeleteOptions{}); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			errs = append(errs, fmt.Sprintf("%s: %s", namespace.Name, err.Error()))
+		}
+	}
+
+	return newJobError("delete", errs, len(orphanedNamespaces), "orphaned namespaces")
+}
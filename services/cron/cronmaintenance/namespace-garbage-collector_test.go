@@ -0,0 +1,173 @@
+package cronmaintenance_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/cronmaintenance"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	repositoryMock "github.com/decentralized-cloud/edge-cluster/services/repository/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Namespace Garbage Collector Job Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockRepositoryService    *repositoryMock.MockRepositoryContract
+		clientset                *fake.Clientset
+		logger                   *zap.Logger
+		ctx                      context.Context
+		sut                      cron.CronJobContract
+		existingEdgeClusterID    string
+		inUseNamespace           string
+		orphanedNamespace        string
+		recentNamespace          string
+		unlabelledNamespace      string
+	)
+
+	newNamespace := func(name string, age time.Duration) *v1.Namespace {
+		return &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+				Labels:            map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+			},
+		}
+	}
+
+	listNamespaces := func() []string {
+		namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		Ω(err).Should(BeNil())
+
+		names := []string{}
+		for _, namespace := range namespaces.Items {
+			names = append(names, namespace.Name)
+		}
+
+		return names
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockRepositoryService = repositoryMock.NewMockRepositoryContract(mockCtrl)
+		ctx = context.Background()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		existingEdgeClusterID = cuid.New()
+		inUseNamespace = provision.GetNamespace(existingEdgeClusterID)
+		orphanedNamespace = provision.GetNamespace(cuid.New())
+		recentNamespace = provision.GetNamespace(cuid.New())
+		unlabelledNamespace = provision.GetNamespace(cuid.New())
+
+		clientset = fake.NewSimpleClientset(
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+			&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: unlabelledNamespace}},
+			newNamespace(inUseNamespace, 24*time.Hour),
+			newNamespace(orphanedNamespace, 24*time.Hour),
+			newNamespace(recentNamespace, time.Minute))
+
+		mockConfigurationService.EXPECT().GetOrphanedNamespaceGracePeriod().Return(time.Hour, nil).AnyTimes()
+
+		sut, err = cronmaintenance.NewNamespaceGarbageCollectorJob(
+			logger,
+			mockConfigurationService,
+			mockRepositoryService,
+			clientset)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate NamespaceGarbageCollectorJob", func() {
+		When("client set is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := cronmaintenance.NewNamespaceGarbageCollectorJob(
+					logger,
+					mockConfigurationService,
+					mockRepositoryService,
+					nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the job is run", func() {
+		When("a namespace hosts an edge cluster that no longer exists", func() {
+			It("should only delete the orphaned namespaces older than the grace period", func() {
+				mockRepositoryService.
+					EXPECT().
+					ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(&repository.ListAllEdgeClustersResponse{
+						EdgeClusters: []repository.EdgeClusterRecord{{EdgeClusterID: existingEdgeClusterID}},
+					}, nil)
+
+				Ω(sut.Run(ctx)).Should(BeNil())
+				Ω(listNamespaces()).Should(ConsistOf("kube-system", unlabelledNamespace, inUseNamespace, recentNamespace))
+			})
+		})
+
+		When("an edge cluster cannot be read from the repository", func() {
+			It("should keep the namespace of the edge cluster", func() {
+				mockRepositoryService.
+					EXPECT().
+					ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(&repository.ListAllEdgeClustersResponse{
+						Failures: []repository.ListEdgeClusterFailure{{
+							EdgeClusterID: existingEdgeClusterID,
+							Err:           errors.New(cuid.New()),
+						}},
+					}, nil)
+
+				Ω(sut.Run(ctx)).Should(BeNil())
+				Ω(listNamespaces()).Should(ConsistOf("kube-system", unlabelledNamespace, inUseNamespace, recentNamespace))
+			})
+		})
+
+		When("the repository returns no edge cluster", func() {
+			It("should not delete any namespace and return error", func() {
+				mockRepositoryService.
+					EXPECT().
+					ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(&repository.ListAllEdgeClustersResponse{}, nil)
+
+				Ω(sut.Run(ctx)).ShouldNot(BeNil())
+				Ω(listNamespaces()).Should(HaveLen(5))
+			})
+		})
+
+		When("the edge clusters cannot be listed", func() {
+			It("should not delete any namespace and return error", func() {
+				expectedErr := errors.New(cuid.New())
+
+				mockRepositoryService.
+					EXPECT().
+					ListAllEdgeClusters(gomock.Any(), gomock.Any()).
+					Return(nil, expectedErr)
+
+				Ω(sut.Run(ctx)).Should(Equal(expectedErr))
+				Ω(listNamespaces()).Should(HaveLen(5))
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package cron
+
+import "time"
+
+const (
+	// HelmChartRefreshJobName is the name of the job that keeps the local helm repository updated
+	HelmChartRefreshJobName = "helm-chart-refresh"
+
+	// DriftReconcilerJobName is the name of the job that repairs the provision of the ready edge clusters that drifted from their stored state
+	DriftReconcilerJobName = "drift-reconciler"
+
+	// NamespaceGarbageCollectorJobName is the name of the job that deletes the namespaces of the removed edge clusters
+	NamespaceGarbageCollectorJobName = "namespace-garbage-collector"
+
+	// KubeconfigValidityCheckJobName is the name of the job that checks the ready edge clusters are reachable
+	KubeconfigValidityCheckJobName = "kubeconfig-validity-check"
+
+	// KubeconfigIssuanceCleanupJobName is the name of the job that removes the access of the expired issued kubeconfigs
+	// from the edge clusters
+	KubeconfigIssuanceCleanupJobName = "kubeconfig-issuance-cleanup"
+
+	// EncryptionKeyRotationJobName is the name of the job that encrypts the plain text edge clusters and re-encrypts the
+	// edge clusters encrypted with a retired key
+	EncryptionKeyRotationJobName = "encryption-key-rotation"
+
+	// DisabledSchedule is the schedule that stops a job from running
+	DisabledSchedule = "disabled"
+)
+
+// JobStatus contains the status of the last run of a background job
+type JobStatus struct {
+	Name             string
+	Schedule         string
+	Running          bool
+	LastStartTime    time.Time
+	LastDuration     time.Duration
+	LastErrorMessage string
+	LastSuccessTime  time.Time
+	RunCount         int
+	FailureCount     int
+	SkipCount        int
+}
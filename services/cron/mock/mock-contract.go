@@ -5,8 +5,11 @@
 package mock_cron
 
 import (
+	context "context"
 	reflect "reflect"
 
+	cron "github.com/decentralized-cloud/edge-cluster/services/cron"
+	helm "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -60,3 +63,213 @@ func (mr *MockCronContractMockRecorder) Stop() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockCronContract)(nil).Stop))
 }
+
+// MockSchedulerContract is a mock of SchedulerContract interface.
+type MockSchedulerContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerContractMockRecorder
+}
+
+// MockSchedulerContractMockRecorder is the mock recorder for MockSchedulerContract.
+type MockSchedulerContractMockRecorder struct {
+	mock *MockSchedulerContract
+}
+
+// NewMockSchedulerContract creates a new mock instance.
+func NewMockSchedulerContract(ctrl *gomock.Controller) *MockSchedulerContract {
+	mock := &MockSchedulerContract{ctrl: ctrl}
+	mock.recorder = &MockSchedulerContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedulerContract) EXPECT() *MockSchedulerContractMockRecorder {
+	return m.recorder
+}
+
+// ListJobStatuses mocks base method.
+func (m *MockSchedulerContract) ListJobStatuses() []cron.JobStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobStatuses")
+	ret0, _ := ret[0].([]cron.JobStatus)
+	return ret0
+}
+
+// ListJobStatuses indicates an expected call of ListJobStatuses.
+func (mr *MockSchedulerContractMockRecorder) ListJobStatuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobStatuses", reflect.TypeOf((*MockSchedulerContract)(nil).ListJobStatuses))
+}
+
+// Start mocks base method.
+func (m *MockSchedulerContract) Start() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockSchedulerContractMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockSchedulerContract)(nil).Start))
+}
+
+// Stop mocks base method.
+func (m *MockSchedulerContract) Stop() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockSchedulerContractMockRecorder) Stop() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSchedulerContract)(nil).Stop))
+}
+
+// MockCronJobContract is a mock of CronJobContract interface.
+type MockCronJobContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockCronJobContractMockRecorder
+}
+
+// MockCronJobContractMockRecorder is the mock recorder for MockCronJobContract.
+type MockCronJobContractMockRecorder struct {
+	mock *MockCronJobContract
+}
+
+// NewMockCronJobContract creates a new mock instance.
+func NewMockCronJobContract(ctrl *gomock.Controller) *MockCronJobContract {
+	mock := &MockCronJobContract{ctrl: ctrl}
+	mock.recorder = &MockCronJobContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCronJobContract) EXPECT() *MockCronJobContractMockRecorder {
+	return m.recorder
+}
+
+// DefaultSchedule mocks base method.
+func (m *MockCronJobContract) DefaultSchedule() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultSchedule")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DefaultSchedule indicates an expected call of DefaultSchedule.
+func (mr *MockCronJobContractMockRecorder) DefaultSchedule() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultSchedule", reflect.TypeOf((*MockCronJobContract)(nil).DefaultSchedule))
+}
+
+// Name mocks base method.
+func (m *MockCronJobContract) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockCronJobContractMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockCronJobContract)(nil).Name))
+}
+
+// Run mocks base method.
+func (m *MockCronJobContract) Run(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockCronJobContractMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockCronJobContract)(nil).Run), ctx)
+}
+
+// MockHelmChartRefreshJobContract is a mock of HelmChartRefreshJobContract interface.
+type MockHelmChartRefreshJobContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockHelmChartRefreshJobContractMockRecorder
+}
+
+// MockHelmChartRefreshJobContractMockRecorder is the mock recorder for MockHelmChartRefreshJobContract.
+type MockHelmChartRefreshJobContractMockRecorder struct {
+	mock *MockHelmChartRefreshJobContract
+}
+
+// NewMockHelmChartRefreshJobContract creates a new mock instance.
+func NewMockHelmChartRefreshJobContract(ctrl *gomock.Controller) *MockHelmChartRefreshJobContract {
+	mock := &MockHelmChartRefreshJobContract{ctrl: ctrl}
+	mock.recorder = &MockHelmChartRefreshJobContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHelmChartRefreshJobContract) EXPECT() *MockHelmChartRefreshJobContractMockRecorder {
+	return m.recorder
+}
+
+// DefaultSchedule mocks base method.
+func (m *MockHelmChartRefreshJobContract) DefaultSchedule() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultSchedule")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DefaultSchedule indicates an expected call of DefaultSchedule.
+func (mr *MockHelmChartRefreshJobContractMockRecorder) DefaultSchedule() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultSchedule", reflect.TypeOf((*MockHelmChartRefreshJobContract)(nil).DefaultSchedule))
+}
+
+// GetLastUpdateReport mocks base method.
+func (m *MockHelmChartRefreshJobContract) GetLastUpdateReport() (helm.RepositoryUpdateReport, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastUpdateReport")
+	ret0, _ := ret[0].(helm.RepositoryUpdateReport)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetLastUpdateReport indicates an expected call of GetLastUpdateReport.
+func (mr *MockHelmChartRefreshJobContractMockRecorder) GetLastUpdateReport() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastUpdateReport", reflect.TypeOf((*MockHelmChartRefreshJobContract)(nil).GetLastUpdateReport))
+}
+
+// Name mocks base method.
+func (m *MockHelmChartRefreshJobContract) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockHelmChartRefreshJobContractMockRecorder) Name() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockHelmChartRefreshJobContract)(nil).Name))
+}
+
+// Run mocks base method.
+func (m *MockHelmChartRefreshJobContract) Run(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockHelmChartRefreshJobContractMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockHelmChartRefreshJobContract)(nil).Run), ctx)
+}
@@ -0,0 +1,286 @@
+// Package scheduler implements the cron service that runs the background jobs on their configured schedules
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	cronContract "github.com/decentralized-cloud/edge-cluster/services/cron"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	cron "github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+var (
+	jobLastRun = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "edge_cluster_cron_job_last_run_timestamp_seconds",
+		Help: "The time the last run of the background job started",
+	}, []string{"job"})
+
+	jobDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "edge_cluster_cron_job_duration_seconds",
+		Help: "The time the last run of the background job took",
+	}, []string{"job"})
+
+	jobSuccess = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "edge_cluster_cron_job_success",
+		Help: "Whether the last run of the background job succeeded (1) or not (0)",
+	}, []string{"job"})
+
+	jobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "edge_cluster_cron_job_runs_total",
+		Help: "The number of times the background job was triggered, by result (success, failure or skipped)",
+	}, []string{"job", "result"})
+)
+
+type scheduledJob struct {
+	job      cronContract.CronJobContract
+	schedule cron.Schedule
+	status   cronContract.JobStatus
+}
+
+type schedulerService struct {
+	logger        *zap.Logger
+	cron          *cron.Cron
+	jobTimeout    time.Duration
+	jobs          []*scheduledJob
+	jobsLock      sync.Mutex
+	lifecycleLock sync.Mutex
+	cancel        context.CancelFunc
+	runs          sync.WaitGroup
+}
+
+// NewSchedulerService creates new instance of the schedulerService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// jobs: Mandatory. The background jobs to run. Every job must have a unique name.
+// Returns the new service or error if something goes wrong
+func NewSchedulerService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	jobs []cronContract.CronJobContract) (cronContract.SchedulerContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	schedules, err := configurationService.GetCronJobSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	jobTimeout, err := configurationService.GetCronJobTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJobs, err := newScheduledJobs(jobs, schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedulerService{
+		logger:     logger,
+		jobTimeout: jobTimeout,
+		jobs:       scheduledJobs,
+	}, nil
+}
+
+// Start schedules the enabled jobs and runs each of them once. The scheduler can be started again after it is
+// stopped, e.g. when the replica regains the leadership.
+// Returns error if something goes wrong
+func (service *schedulerService) Start() error {
+	service.lifecycleLock.Lock()
+	defer service.lifecycleLock.Unlock()
+
+	if service.cancel != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	service.cancel = cancel
+	service.cron = cron.New()
+
+	for _, item := range service.jobs {
+		if item.schedule == nil {
+			service.logger.Info("cron job is disabled", zap.String("job", item.status.Name))
+
+			continue
+		}
+
+		item := item
+		service.cron.Schedule(item.schedule, cron.FuncJob(func() { service.runJob(ctx, item) }))
+
+		service.runs.Add(1)
+		go func() {
+			defer service.runs.Done()
+
+			service.runJob(ctx, item)
+		}()
+	}
+
+	service.cron.Start()
+	service.logger.Info("cron scheduler service started", zap.Int("jobs", len(service.jobs)))
+
+	return nil
+}
+
+// Stop stops scheduling the jobs, cancels the running jobs and waits for them to return
+// Returns error if something goes wrong
+func (service *schedulerService) Stop() error {
+	service.lifecycleLock.Lock()
+	defer service.lifecycleLock.Unlock()
+
+	if service.cancel == nil {
+		return nil
+	}
+
+	service.cancel()
+	<-service.cron.Stop().Done()
+	service.runs.Wait()
+	service.cancel = nil
+	service.logger.Info("cron scheduler service stopped")
+
+	return nil
+}
+
+// ListJobStatuses returns the status of the last run of every background job, ordered by the job name.
+// Returns the status of the background jobs
+func (service *schedulerService) ListJobStatuses() []cronContract.JobStatus {
+	service.jobsLock.Lock()
+	defer service.jobsLock.Unlock()
+
+	statuses := make([]cronContract.JobStatus, 0, len(service.jobs))
+	for _, item := range service.jobs {
+		statuses = append(statuses, item.status)
+	}
+
+	return statuses
+}
+
+// runJob runs the given job once, unless its previous run has not finished yet
+func (service *schedulerService) runJob(ctx context.Context, item *scheduledJob) {
+	name := item.status.Name
+
+	service.jobsLock.Lock()
+	if item.status.Running {
+		item.status.SkipCount++
+		service.jobsLock.Unlock()
+
+		jobRuns.WithLabelValues(name, "skipped").Inc()
+		service.logger.Warn("skipped the cron job as its previous run has not finished yet", zap.String("job", name))
+
+		return
+	}
+
+	startTime := time.Now()
+	item.status.Running = true
+	item.status.LastStartTime = startTime
+	service.jobsLock.Unlock()
+
+	jobLastRun.WithLabelValues(name).Set(float64(startTime.Unix()))
+
+	runCtx, cancel := context.WithTimeout(ctx, service.jobTimeout)
+	err := item.job.Run(runCtx)
+	cancel()
+
+	duration := time.Since(startTime)
+
+	service.jobsLock.Lock()
+	item.status.Running = false
+	item.status.LastDuration = duration
+	item.status.RunCount++
+
+	if err != nil {
+		item.status.FailureCount++
+		item.status.LastErrorMessage = err.Error()
+	} else {
+		item.status.LastErrorMessage = ""
+		item.status.LastSuccessTime = startTime.Add(duration)
+	}
+	service.jobsLock.Unlock()
+
+	jobDuration.WithLabelValues(name).Set(duration.Seconds())
+
+	if err != nil {
+		jobSuccess.WithLabelValues(name).Set(0)
+		jobRuns.WithLabelValues(name, "failure").Inc()
+		service.logger.Error("cron job failed", zap.String("job", name), zap.Duration("duration", duration), zap.Error(err))
+
+		return
+	}
+
+	jobSuccess.WithLabelValues(name).Set(1)
+	jobRuns.WithLabelValues(name, "success").Inc()
+	service.logger.Info("cron job finished", zap.String("job", name), zap.Duration("duration", duration))
+}
+
+// newScheduledJobs parses the schedule of every job, so an invalid schedule stops the service from starting rather
+// than silently disabling the job. A configured schedule must belong to one of the given jobs.
+func newScheduledJobs(
+	jobs []cronContract.CronJobContract,
+	schedules map[string]string) ([]*scheduledJob, error) {
+	scheduledJobs := make([]*scheduledJob, 0, len(jobs))
+	names := map[string]bool{}
+
+	for _, job := range jobs {
+		if job == nil {
+			return nil, commonErrors.NewArgumentNilError("jobs", "jobs must not contain nil job")
+		}
+
+		name := job.Name()
+		if names[name] {
+			return nil, commonErrors.NewArgumentError("jobs", fmt.Sprintf("cron job %s is registered more than once", name))
+		}
+
+		names[name] = true
+
+		spec, ok := schedules[name]
+		if !ok {
+			spec = job.DefaultSchedule()
+		}
+
+		item := &scheduledJob{
+			job: job,
+			status: cronContract.JobStatus{
+				Name:     name,
+				Schedule: spec,
+			},
+		}
+
+		if spec != cronContract.DisabledSchedule {
+			schedule, err := cron.ParseStandard(spec)
+			if err != nil {
+				return nil, commonErrors.NewArgumentErrorWithError(
+					"schedules",
+					fmt.Sprintf("failed to parse the schedule %s of the cron job %s", spec, name),
+					err)
+			}
+
+			item.schedule = schedule
+		}
+
+		scheduledJobs = append(scheduledJobs, item)
+	}
+
+	for name := range schedules {
+		if !names[name] {
+			return nil, commonErrors.NewArgumentError("schedules", fmt.Sprintf("cron job %s does not exist", name))
+		}
+	}
+
+	sort.Slice(scheduledJobs, func(i, j int) bool {
+		return scheduledJobs[i].status.Name < scheduledJobs[j].status.Name
+	})
+
+	return scheduledJobs, nil
+}
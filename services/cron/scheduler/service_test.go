@@ -0,0 +1,233 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	cronMock "github.com/decentralized-cloud/edge-cluster/services/cron/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/cron/scheduler"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSchedulerService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Scheduler Service Tests")
+}
+
+var _ = Describe("Scheduler Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockJob                  *cronMock.MockCronJobContract
+		logger                   *zap.Logger
+		schedules                map[string]string
+		jobTimeout               time.Duration
+		jobName                  string
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockJob = cronMock.NewMockCronJobContract(mockCtrl)
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		schedules = map[string]string{}
+		jobTimeout = time.Minute
+		jobName = cuid.New()
+
+		mockConfigurationService.
+			EXPECT().
+			GetCronJobSchedules().
+			DoAndReturn(func() (map[string]string, error) { return schedules, nil }).
+			AnyTimes()
+
+		mockConfigurationService.
+			EXPECT().
+			GetCronJobTimeout().
+			DoAndReturn(func() (time.Duration, error) { return jobTimeout, nil }).
+			AnyTimes()
+
+		mockJob.EXPECT().Name().Return(jobName).AnyTimes()
+		mockJob.EXPECT().DefaultSchedule().Return("@every 1h").AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate SchedulerService", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := scheduler.NewSchedulerService(nil, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := scheduler.NewSchedulerService(logger, nil, []cron.CronJobContract{mockJob})
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the schedule of a job that does not exist is configured", func() {
+			It("should return ArgumentError", func() {
+				schedules[cuid.New()] = "@every 1m"
+
+				service, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the configured schedule is invalid", func() {
+			It("should return ArgumentError", func() {
+				schedules[jobName] = cuid.New()
+
+				service, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the same job is registered twice", func() {
+			It("should return ArgumentError", func() {
+				service, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob, mockJob})
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the scheduler is started", func() {
+		When("the job is disabled", func() {
+			It("should not run the job", func() {
+				schedules[jobName] = cron.DisabledSchedule
+
+				sut, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(err).Should(BeNil())
+				Ω(sut.Start()).Should(BeNil())
+				Ω(sut.Stop()).Should(BeNil())
+
+				statuses := sut.ListJobStatuses()
+				Ω(statuses).Should(HaveLen(1))
+				Ω(statuses[0].Name).Should(Equal(jobName))
+				Ω(statuses[0].Schedule).Should(Equal(cron.DisabledSchedule))
+				Ω(statuses[0].RunCount).Should(Equal(0))
+			})
+		})
+
+		When("the job fails", func() {
+			It("should record the failure of the job run", func() {
+				expectedErr := errors.New(cuid.New())
+				mockJob.EXPECT().Run(gomock.Any()).Return(expectedErr)
+
+				sut, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(err).Should(BeNil())
+				Ω(sut.Start()).Should(BeNil())
+				Ω(sut.Stop()).Should(BeNil())
+
+				statuses := sut.ListJobStatuses()
+				Ω(statuses).Should(HaveLen(1))
+				Ω(statuses[0].Schedule).Should(Equal("@every 1h"))
+				Ω(statuses[0].Running).Should(BeFalse())
+				Ω(statuses[0].RunCount).Should(Equal(1))
+				Ω(statuses[0].FailureCount).Should(Equal(1))
+				Ω(statuses[0].LastErrorMessage).Should(Equal(expectedErr.Error()))
+				Ω(statuses[0].LastSuccessTime.IsZero()).Should(BeTrue())
+			})
+		})
+
+		When("the job does not finish before its next run is due", func() {
+			It("should skip the overlapping runs", func() {
+				schedules[jobName] = "@every 1s"
+				release := make(chan struct{})
+
+				mockJob.
+					EXPECT().
+					Run(gomock.Any()).
+					DoAndReturn(func(ctx context.Context) error {
+						<-release
+
+						return nil
+					})
+
+				sut, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(err).Should(BeNil())
+				Ω(sut.Start()).Should(BeNil())
+
+				Eventually(func() int {
+					return sut.ListJobStatuses()[0].SkipCount
+				}, 5*time.Second).Should(BeNumerically(">=", 1))
+
+				Ω(sut.ListJobStatuses()[0].Running).Should(BeTrue())
+
+				mockJob.EXPECT().Run(gomock.Any()).Return(nil).AnyTimes()
+				close(release)
+				Ω(sut.Stop()).Should(BeNil())
+
+				status := sut.ListJobStatuses()[0]
+				Ω(status.Running).Should(BeFalse())
+				Ω(status.FailureCount).Should(Equal(0))
+				Ω(status.LastSuccessTime.IsZero()).Should(BeFalse())
+			})
+		})
+
+		When("the job runs longer than the job timeout", func() {
+			It("should cancel the job", func() {
+				jobTimeout = 10 * time.Millisecond
+
+				mockJob.
+					EXPECT().
+					Run(gomock.Any()).
+					DoAndReturn(func(ctx context.Context) error {
+						<-ctx.Done()
+
+						return ctx.Err()
+					})
+
+				sut, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(err).Should(BeNil())
+				Ω(sut.Start()).Should(BeNil())
+
+				Eventually(func() int {
+					return sut.ListJobStatuses()[0].RunCount
+				}).Should(Equal(1))
+
+				Ω(sut.Stop()).Should(BeNil())
+				Ω(sut.ListJobStatuses()[0].LastErrorMessage).Should(Equal(context.DeadlineExceeded.Error()))
+			})
+		})
+
+		When("the scheduler is stopped and started again", func() {
+			It("should run the job again", func() {
+				mockJob.EXPECT().Run(gomock.Any()).Return(nil).Times(2)
+
+				sut, err := scheduler.NewSchedulerService(logger, mockConfigurationService, []cron.CronJobContract{mockJob})
+				Ω(err).Should(BeNil())
+				Ω(sut.Start()).Should(BeNil())
+				Ω(sut.Stop()).Should(BeNil())
+				Ω(sut.Stop()).Should(BeNil())
+				Ω(sut.Start()).Should(BeNil())
+				Ω(sut.Stop()).Should(BeNil())
+
+				Ω(sut.ListJobStatuses()[0].RunCount).Should(Equal(2))
+			})
+		})
+	})
+})
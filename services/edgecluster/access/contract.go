@@ -0,0 +1,26 @@
+// Package access grants the users of the edge clusters scoped, short-lived access to the edge clusters
+package access
+
+import "context"
+
+// KubeconfigIssuerContract declares the methods to be implemented by the service that issues the kubeconfigs granting
+// a role on an edge cluster for a limited time, and revokes the access they grant before they expire
+type KubeconfigIssuerContract interface {
+	// IssueKubeconfig creates a service account on the edge cluster, grants it the requested role and returns a
+	// kubeconfig authenticating as the service account with a token that expires after the requested time.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to issue a new kubeconfig
+	// Returns either the issued kubeconfig or error if something goes wrong.
+	IssueKubeconfig(
+		ctx context.Context,
+		request *IssueKubeconfigRequest) (*IssueKubeconfigResponse, error)
+
+	// RevokeKubeconfig deletes the service account of an issued kubeconfig together with its role binding, which
+	// invalidates the token of the kubeconfig. Revoking a kubeconfig that is already revoked succeeds.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to revoke an issued kubeconfig
+	// Returns either the result of revoking the kubeconfig or error if something goes wrong.
+	RevokeKubeconfig(
+		ctx context.Context,
+		request *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error)
+}
@@ -0,0 +1,63 @@
+package access
+
+import (
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// Namespace is the namespace of the edge cluster the service accounts of the issued kubeconfigs are created in
+	Namespace = "edge-cluster-access"
+
+	// IssuanceIDKey is the label that records the issuance the service account and its role binding are created for
+	IssuanceIDKey = "edge-cluster.decentralized-cloud.io/kubeconfig-issuance-id"
+
+	// MinTTL is the shortest time an issued kubeconfig can be valid for, the API server does not issue the service
+	// account tokens for a shorter time
+	MinTTL = 10 * time.Minute
+)
+
+// IssueKubeconfigRequest contains the request to issue a kubeconfig granting a role on an edge cluster
+type IssueKubeconfigRequest struct {
+	// Clientset is the client set of the edge cluster
+	Clientset kubernetes.Interface
+
+	// AdminKubeconfig is the admin kubeconfig of the edge cluster, the issued kubeconfig connects to the same server
+	AdminKubeconfig string
+
+	// IssuanceID is the unique identifier the issuance is tracked with
+	IssuanceID string
+
+	// Role is the role granted to the service account
+	Role models.KubeconfigRole
+
+	// Namespace is the namespace the role is granted in, the role is granted in all namespaces if empty
+	Namespace string
+
+	// TTL is how long the issued kubeconfig is valid for
+	TTL time.Duration
+}
+
+// IssueKubeconfigResponse contains the result of issuing a kubeconfig
+type IssueKubeconfigResponse struct {
+	KubeconfigContent string
+	ExpiresAt         time.Time
+}
+
+// RevokeKubeconfigRequest contains the request to revoke the access an issued kubeconfig grants on an edge cluster
+type RevokeKubeconfigRequest struct {
+	// Clientset is the client set of the edge cluster
+	Clientset kubernetes.Interface
+
+	// IssuanceID is the unique identifier the issuance is tracked with
+	IssuanceID string
+
+	// Namespace is the namespace the role was granted in, empty if it was granted in all namespaces
+	Namespace string
+}
+
+// RevokeKubeconfigResponse contains the result of revoking an issued kubeconfig
+type RevokeKubeconfigResponse struct {
+}
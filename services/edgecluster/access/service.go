@@ -0,0 +1,246 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+type kubeconfigIssuerService struct {
+	logger *zap.Logger
+}
+
+// NewKubeconfigIssuerService creates new instance of the kubeconfigIssuerService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// Returns the new service or error if something goes wrong
+func NewKubeconfigIssuerService(logger *zap.Logger) (KubeconfigIssuerContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	return &kubeconfigIssuerService{
+		logger: logger,
+	}, nil
+}
+
+// IssueKubeconfig creates a service account on the edge cluster, grants it the requested role and returns a
+// kubeconfig authenticating as the service account with a token that expires after the requested time.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to issue a new kubeconfig
+// Returns either the issued kubeconfig or error if something goes wrong.
+func (service *kubeconfigIssuerService) IssueKubeconfig(
+	ctx context.Context,
+	request *IssueKubeconfigRequest) (response *IssueKubeconfigResponse, err error) {
+	clusterRoleName, err := getClusterRoleName(request.Role, request.Namespace)
+	if err != nil {
+		return
+	}
+
+	adminConfig, err := clientcmd.Load([]byte(request.AdminKubeconfig))
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to parse the admin kubeconfig", err)
+	}
+
+	adminContext, ok := adminConfig.Contexts[adminConfig.CurrentContext]
+	if !ok || adminConfig.Clusters[adminContext.Cluster] == nil {
+		return nil, types.NewUnknownError("the admin kubeconfig does not refer to a cluster")
+	}
+
+	if err = service.createNamespace(ctx, request.Clientset); err != nil {
+		return
+	}
+
+	name := getServiceAccountName(request.IssuanceID)
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		// Remove whatever was created, so the failed issuance does not grant any access
+		if _, revokeErr := service.RevokeKubeconfig(ctx, &RevokeKubeconfigRequest{
+			Clientset:  request.Clientset,
+			IssuanceID: request.IssuanceID,
+			Namespace:  request.Namespace,
+		}); revokeErr != nil {
+			service.logger.Error(
+				"failed to clean up the failed kubeconfig issuance",
+				zap.Error(revokeErr),
+				zap.String("issuanceID", request.IssuanceID))
+		}
+	}()
+
+	if _, err = request.Clientset.CoreV1().ServiceAccounts(Namespace).Create(
+		ctx,
+		&v1.ServiceAccount{ObjectMeta: getObjectMeta(name, Namespace, request.IssuanceID)},
+		metav1.CreateOptions{}); err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to create the service account", err)
+	}
+
+	if err = service.createRoleBinding(ctx, request.Clientset, name, request.IssuanceID, request.Namespace, clusterRoleName); err != nil {
+		return
+	}
+
+	expirationSeconds := int64(request.TTL.Seconds())
+	tokenRequest, err := request.Clientset.CoreV1().ServiceAccounts(Namespace).CreateToken(
+		ctx,
+		name,
+		&authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+		},
+		metav1.CreateOptions{})
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to create the service account token", err)
+	}
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[adminContext.Cluster] = adminConfig.Clusters[adminContext.Cluster].DeepCopy()
+	config.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: tokenRequest.Status.Token}
+	config.Contexts[name] = &clientcmdapi.Context{
+		Cluster:   adminContext.Cluster,
+		AuthInfo:  name,
+		Namespace: request.Namespace,
+	}
+	config.CurrentContext = name
+
+	content, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to serialize the kubeconfig content", err)
+	}
+
+	return &IssueKubeconfigResponse{
+		KubeconfigContent: string(content),
+		ExpiresAt:         tokenRequest.Status.ExpirationTimestamp.Time,
+	}, nil
+}
+
+// RevokeKubeconfig deletes the service account of an issued kubeconfig together with its role binding, which
+// invalidates the token of the kubeconfig. Revoking a kubeconfig that is already revoked succeeds.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to revoke an issued kubeconfig
+// Returns either the result of revoking the kubeconfig or error if something goes wrong.
+func (service *kubeconfigIssuerService) RevokeKubeconfig(
+	ctx context.Context,
+	request *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error) {
+	name := getServiceAccountName(request.IssuanceID)
+
+	var err error
+	if request.Namespace == "" {
+		err = request.Clientset.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	} else {
+		err = request.Clientset.RbacV1().RoleBindings(request.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	}
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, types.NewUnknownErrorWithError("failed to delete the role binding", err)
+	}
+
+	err = request.Clientset.CoreV1().ServiceAccounts(Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, types.NewUnknownErrorWithError("failed to delete the service account", err)
+	}
+
+	return &RevokeKubeconfigResponse{}, nil
+}
+
+func (service *kubeconfigIssuerService) createNamespace(ctx context.Context, clientset kubernetes.Interface) error {
+	_, err := clientset.CoreV1().Namespaces().Create(
+		ctx,
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: Namespace}},
+		metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return types.NewUnknownErrorWithError("failed to create the namespace of the service accounts", err)
+	}
+
+	return nil
+}
+
+func (service *kubeconfigIssuerService) createRoleBinding(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	name string,
+	issuanceID string,
+	namespace string,
+	clusterRoleName string) (err error) {
+	roleRef := rbacv1.RoleRef{
+		APIGroup: rbacv1.GroupName,
+		Kind:     "ClusterRole",
+		Name:     clusterRoleName,
+	}
+
+	subjects := []rbacv1.Subject{{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      name,
+		Namespace: Namespace,
+	}}
+
+	if namespace == "" {
+		_, err = clientset.RbacV1().ClusterRoleBindings().Create(
+			ctx,
+			&rbacv1.ClusterRoleBinding{
+				ObjectMeta: getObjectMeta(name, "", issuanceID),
+				RoleRef:    roleRef,
+				Subjects:   subjects,
+			},
+			metav1.CreateOptions{})
+	} else {
+		_, err = clientset.RbacV1().RoleBindings(namespace).Create(
+			ctx,
+			&rbacv1.RoleBinding{
+				ObjectMeta: getObjectMeta(name, namespace, issuanceID),
+				RoleRef:    roleRef,
+				Subjects:   subjects,
+			},
+			metav1.CreateOptions{})
+	}
+
+	if err != nil {
+		return types.NewUnknownErrorWithError("failed to create the role binding", err)
+	}
+
+	return nil
+}
+
+// getClusterRoleName returns the name of the default cluster role that grants the given role. The admin role is
+// granted through cluster-admin if it is granted in all namespaces, so it also covers the cluster scoped resources.
+func getClusterRoleName(role models.KubeconfigRole, namespace string) (string, error) {
+	switch role {
+	case models.KubeconfigRoleView:
+		return "view", nil
+	case models.KubeconfigRoleEdit:
+		return "edit", nil
+	case models.KubeconfigRoleAdmin:
+		if namespace == "" {
+			return "cluster-admin", nil
+		}
+
+		return "admin", nil
+	default:
+		return "", commonErrors.NewArgumentError("role", fmt.Sprintf("kubeconfig role is not supported: %v", role))
+	}
+}
+
+func getServiceAccountName(issuanceID string) string {
+	return "kubeconfig-" + strings.ToLower(issuanceID)
+}
+
+func getObjectMeta(name, namespace, issuanceID string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    map[string]string{IssuanceIDKey: issuanceID},
+	}
+}
@@ -0,0 +1,193 @@
+package access_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/access"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestKubeconfigIssuerService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kubeconfig Issuer Service Tests")
+}
+
+const adminKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: default
+  cluster:
+    server: https://10.0.0.1:6443
+    certificate-authority-data: Y2VydGlmaWNhdGU=
+users:
+- name: default
+  user:
+    client-certificate-data: Y2VydGlmaWNhdGU=
+    client-key-data: a2V5
+contexts:
+- name: default
+  context:
+    cluster: default
+    user: default
+current-context: default
+`
+
+var _ = Describe("Kubeconfig Issuer Service Tests", func() {
+	var (
+		clientset      *fake.Clientset
+		ctx            context.Context
+		issuanceID     string
+		serviceAccount string
+		token          string
+		expiresAt      time.Time
+		sut            access.KubeconfigIssuerContract
+	)
+
+	newRequest := func(role models.KubeconfigRole, namespace string) *access.IssueKubeconfigRequest {
+		return &access.IssueKubeconfigRequest{
+			Clientset:       clientset,
+			AdminKubeconfig: adminKubeconfig,
+			IssuanceID:      issuanceID,
+			Role:            role,
+			Namespace:       namespace,
+			TTL:             time.Hour,
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		issuanceID = cuid.New()
+		serviceAccount = "kubeconfig-" + issuanceID
+		token = cuid.New()
+		expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+
+		clientset = fake.NewSimpleClientset()
+		clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+
+			tokenRequest := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest).DeepCopy()
+			Ω(*tokenRequest.Spec.ExpirationSeconds).Should(Equal(int64(time.Hour.Seconds())))
+
+			tokenRequest.Status = authenticationv1.TokenRequestStatus{
+				Token:               token,
+				ExpirationTimestamp: metav1.NewTime(expiresAt),
+			}
+
+			return true, tokenRequest, nil
+		})
+
+		logger, err := zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, err = access.NewKubeconfigIssuerService(logger)
+		Ω(err).Should(BeNil())
+	})
+
+	Context("user tries to instantiate KubeconfigIssuerService", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := access.NewKubeconfigIssuerService(nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("KubeconfigIssuerService is instantiated", func() {
+		Describe("IssueKubeconfig", func() {
+			It("should grant the role in the namespace and return a kubeconfig with the service account token", func() {
+				response, err := sut.IssueKubeconfig(ctx, newRequest(models.KubeconfigRoleEdit, "apps"))
+				Ω(err).Should(BeNil())
+				Ω(response.ExpiresAt.Equal(expiresAt)).Should(BeTrue())
+
+				_, err = clientset.CoreV1().ServiceAccounts(access.Namespace).Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+
+				roleBinding, err := clientset.RbacV1().RoleBindings("apps").Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(roleBinding.Labels[access.IssuanceIDKey]).Should(Equal(issuanceID))
+				Ω(roleBinding.RoleRef.Name).Should(Equal("edit"))
+				Ω(roleBinding.Subjects).Should(HaveLen(1))
+				Ω(roleBinding.Subjects[0].Name).Should(Equal(serviceAccount))
+				Ω(roleBinding.Subjects[0].Namespace).Should(Equal(access.Namespace))
+
+				config, err := clientcmd.Load([]byte(response.KubeconfigContent))
+				Ω(err).Should(BeNil())
+				Ω(config.Contexts[config.CurrentContext].Namespace).Should(Equal("apps"))
+				Ω(config.Clusters[config.Contexts[config.CurrentContext].Cluster].Server).Should(Equal("https://10.0.0.1:6443"))
+				Ω(config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].Token).Should(Equal(token))
+				Ω(config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].ClientKeyData).Should(BeEmpty())
+			})
+
+			It("should grant cluster-admin when the admin role is requested in all namespaces", func() {
+				_, err := sut.IssueKubeconfig(ctx, newRequest(models.KubeconfigRoleAdmin, ""))
+				Ω(err).Should(BeNil())
+
+				clusterRoleBinding, err := clientset.RbacV1().ClusterRoleBindings().Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(clusterRoleBinding.RoleRef.Name).Should(Equal("cluster-admin"))
+			})
+
+			It("should return ArgumentError when the role is not supported", func() {
+				response, err := sut.IssueKubeconfig(ctx, newRequest(models.KubeconfigRole(100), ""))
+				Ω(response).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+
+			It("should remove the service account when the token cannot be created", func() {
+				expectedError := errors.New(cuid.New())
+				clientset.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return action.GetSubresource() == "token", nil, expectedError
+				})
+
+				response, err := sut.IssueKubeconfig(ctx, newRequest(models.KubeconfigRoleView, "apps"))
+				Ω(response).Should(BeNil())
+				Ω(err).Should(HaveOccurred())
+
+				_, err = clientset.CoreV1().ServiceAccounts(access.Namespace).Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(apierrors.IsNotFound(err)).Should(BeTrue())
+
+				_, err = clientset.RbacV1().RoleBindings("apps").Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(apierrors.IsNotFound(err)).Should(BeTrue())
+			})
+		})
+
+		Describe("RevokeKubeconfig", func() {
+			It("should delete the service account and its role binding", func() {
+				_, err := sut.IssueKubeconfig(ctx, newRequest(models.KubeconfigRoleView, ""))
+				Ω(err).Should(BeNil())
+
+				revokeRequest := &access.RevokeKubeconfigRequest{Clientset: clientset, IssuanceID: issuanceID}
+				_, err = sut.RevokeKubeconfig(ctx, revokeRequest)
+				Ω(err).Should(BeNil())
+
+				_, err = clientset.CoreV1().ServiceAccounts(access.Namespace).Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(apierrors.IsNotFound(err)).Should(BeTrue())
+
+				_, err = clientset.RbacV1().ClusterRoleBindings().Get(ctx, serviceAccount, metav1.GetOptions{})
+				Ω(apierrors.IsNotFound(err)).Should(BeTrue())
+
+				_, err = sut.RevokeKubeconfig(ctx, revokeRequest)
+				Ω(err).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,356 @@
+package helm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// BundleManifestFileName is the name of the file in the root of the offline chart bundle that lists the exact
+// versions of the packaged charts
+const BundleManifestFileName = "bundle.yaml"
+
+// bundleManifest is the content of the bundle manifest file
+type bundleManifest struct {
+	Charts []BundleChart `yaml:"charts"`
+}
+
+// ExportBundle packages the charts into an offline chart bundle, a gzipped tarball that contains a directory per
+// chart repository with the chart archives and their index file, and the bundle manifest. The bundle can be used as
+// the offline bundle of the helm helper, either as is or extracted into a directory.
+// charts: Mandatory. the charts to package, the version constraints are resolved to the exact versions
+// destination: Mandatory. the path of the bundle file to create
+// Returns error if something goes wrong
+func (service *helmHelper) ExportBundle(charts []BundleChart, destination string) error {
+	if len(charts) == 0 {
+		return commonErrors.NewArgumentError("charts", "at least one chart is required")
+	}
+
+	if strings.TrimSpace(destination) == "" {
+		return commonErrors.NewArgumentError("destination", "destination is required")
+	}
+
+	bundleDirectory, err := ioutil.TempDir("", "chart-bundle")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = os.RemoveAll(bundleDirectory)
+	}()
+
+	manifest := bundleManifest{}
+	repositoryDirectories := map[string]bool{}
+	exported := map[string]bool{}
+
+	for _, chart := range charts {
+		if strings.TrimSpace(chart.RepositoryName) == "" || strings.Contains(chart.RepositoryName, "..") || strings.ContainsAny(chart.RepositoryName, `/\`) {
+			return commonErrors.NewArgumentError("charts", "repository name must be provided and must be a valid directory name")
+		}
+
+		if err = service.AddRepository(chart.RepositoryName, chart.RepositoryURL); err != nil {
+			return err
+		}
+
+		repositoryDirectory := filepath.Join(bundleDirectory, chart.RepositoryName)
+
+		chartPath, err := service.PullChart(chart.RepositoryName, chart.Chart, chart.Version, repositoryDirectory)
+		if err != nil {
+			return errors.Wrapf(err, "failed to export %s/%s", chart.RepositoryName, chart.Chart)
+		}
+
+		loadedChart, err := loader.Load(chartPath)
+		if err != nil {
+			return err
+		}
+
+		repositoryDirectories[repositoryDirectory] = true
+		exportedChart := BundleChart{
+			RepositoryName: chart.RepositoryName,
+			RepositoryURL:  chart.RepositoryURL,
+			Chart:          chart.Chart,
+			Version:        loadedChart.Metadata.Version,
+		}
+
+		key := fmt.Sprintf("%s/%s:%s", exportedChart.RepositoryName, exportedChart.Chart, exportedChart.Version)
+		if exported[key] {
+			continue
+		}
+
+		exported[key] = true
+		manifest.Charts = append(manifest.Charts, exportedChart)
+	}
+
+	for repositoryDirectory := range repositoryDirectories {
+		index, err := repo.IndexDirectory(repositoryDirectory, "")
+		if err != nil {
+			return err
+		}
+
+		index.SortEntries()
+
+		if err = index.WriteFile(filepath.Join(repositoryDirectory, "index.yaml"), 0644); err != nil {
+			return err
+		}
+	}
+
+	manifestContent, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	if err = ioutil.WriteFile(filepath.Join(bundleDirectory, BundleManifestFileName), manifestContent, 0644); err != nil {
+		return err
+	}
+
+	if err = writeBundle(bundleDirectory, destination); err != nil {
+		return err
+	}
+
+	service.logger.Info("offline chart bundle has been exported", zap.String("destination", destination), zap.Int("charts", len(manifest.Charts)))
+
+	return nil
+}
+
+// listBundleRepositories returns the repositories the charts of the offline bundle are exported from. The index
+// refresh time of a repository is the time its charts were exported.
+func (service *helmHelper) listBundleRepositories() ([]models.HelmRepository, error) {
+	manifestContent, err := ioutil.ReadFile(filepath.Join(service.offlineBundleDirectory, BundleManifestFileName))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the offline chart bundle manifest")
+	}
+
+	var manifest bundleManifest
+	if err = yaml.Unmarshal(manifestContent, &manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to parse the offline chart bundle manifest")
+	}
+
+	repositories := []models.HelmRepository{}
+	listed := map[string]bool{}
+
+	for _, chart := range manifest.Charts {
+		if listed[chart.RepositoryName] {
+			continue
+		}
+
+		listed[chart.RepositoryName] = true
+		repositories = append(repositories, models.HelmRepository{
+			Name:                 chart.RepositoryName,
+			URL:                  chart.RepositoryURL,
+			LastIndexRefreshTime: getModificationTime(filepath.Join(service.offlineBundleDirectory, chart.RepositoryName, "index.yaml")),
+		})
+	}
+
+	sort.Slice(repositories, func(i, j int) bool {
+		return repositories[i].Name < repositories[j].Name
+	})
+
+	return repositories, nil
+}
+
+// locateBundleChart returns the path of the chart archive in the offline bundle that satisfies the version. The
+// charts are resolved by the name of the repository they are exported from.
+func (service *helmHelper) locateBundleChart(repository, chartName, version string) (string, error) {
+	if IsOCIReference(repository) {
+		return "", errors.Errorf("charts cannot be referred to by the OCI registry reference %q in offline mode, use the repository name instead", repository)
+	}
+
+	repositoryDirectory := filepath.Join(service.offlineBundleDirectory, repository)
+
+	index, err := repo.LoadIndexFile(filepath.Join(repositoryDirectory, "index.yaml"))
+	if err != nil {
+		return "", errors.Wrapf(err, "repository %q is not in the offline chart bundle", repository)
+	}
+
+	chartVersion, err := index.Get(chartName, version)
+	if err != nil {
+		return "", errors.Wrapf(err, "chart %s/%s %s is not in the offline chart bundle", repository, chartName, version)
+	}
+
+	if len(chartVersion.URLs) == 0 {
+		return "", errors.Errorf("chart %s/%s %s has no archive in the offline chart bundle", repository, chartName, chartVersion.Version)
+	}
+
+	return filepath.Join(repositoryDirectory, filepath.Base(chartVersion.URLs[0])), nil
+}
+
+// copyBundleChart copies the chart archive in the offline bundle that satisfies the version to the destination directory
+func (service *helmHelper) copyBundleChart(repository, chartName, version, destination string) (string, error) {
+	bundleChartPath, err := service.locateBundleChart(repository, chartName, version)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := ioutil.ReadFile(bundleChartPath)
+	if err != nil {
+		return "", err
+	}
+
+	if err = os.MkdirAll(destination, 0755); err != nil {
+		return "", err
+	}
+
+	chartPath := filepath.Join(destination, filepath.Base(bundleChartPath))
+	if err = ioutil.WriteFile(chartPath, content, 0644); err != nil {
+		return "", err
+	}
+
+	return chartPath, nil
+}
+
+// prepareOfflineBundle returns the directory of the offline bundle. A bundle tarball is extracted into the helm
+// repository cache first, replacing the previously extracted bundle.
+func prepareOfflineBundle(bundlePath, cacheDirectory string) (string, error) {
+	info, err := os.Stat(bundlePath)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read the offline chart bundle")
+	}
+
+	if info.IsDir() {
+		return bundlePath, nil
+	}
+
+	bundleDirectory := filepath.Join(cacheDirectory, "offline-bundle")
+	if err = os.RemoveAll(bundleDirectory); err != nil {
+		return "", err
+	}
+
+	if err = extractBundle(bundlePath, bundleDirectory); err != nil {
+		return "", errors.Wrap(err, "failed to extract the offline chart bundle")
+	}
+
+	return bundleDirectory, nil
+}
+
+// writeBundle writes the files of the directory into a gzipped tarball
+func writeBundle(directory, destination string) (err error) {
+	if err = os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		return
+	}
+
+	file, err := os.Create(destination)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if err = filepath.Walk(directory, func(path string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil || !info.Mode().IsRegular() {
+			return walkErr
+		}
+
+		name, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+
+		header.Name = filepath.ToSlash(name)
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		content, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		defer func() {
+			_ = content.Close()
+		}()
+
+		_, err = io.Copy(tarWriter, content)
+
+		return err
+	}); err != nil {
+		return
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return
+	}
+
+	return gzipWriter.Close()
+}
+
+// extractBundle extracts the regular files of the gzipped tarball into the directory, rejecting the entries that
+// would be written outside of it
+func extractBundle(bundlePath, directory string) error {
+	file, err := os.Open(bundlePath)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		path := filepath.Join(directory, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(path, filepath.Clean(directory)+string(os.PathSeparator)) {
+			return errors.Errorf("bundle entry %q is outside of the bundle", header.Name)
+		}
+
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+
+		content, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.Copy(content, tarReader)
+		_ = content.Close()
+
+		if err != nil {
+			return err
+		}
+	}
+}
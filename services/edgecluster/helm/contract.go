@@ -1,17 +1,32 @@
 // Package helm provides functionality to manage helm charts on a remote cluster
 package helm
 
+import "github.com/decentralized-cloud/edge-cluster/models"
+
 // HelmHelperContract declares the contract that can manage helm charts on remote cluster
 type HelmHelperContract interface {
-	// AddRepository adds the new repository to the local helm repo list
+	// AddRepository adds the new repository to the local helm repo list. The repository is accessed with the credentials
+	// configured for its name, and its index is downloaded again if the URL or the credentials have changed.
 	// name: Mandaory. the helm repo name to add
-	// url: Mandaory. the helm repo url to add
+	// url: Mandaory. the helm repo url to add, either a chart repository URL or an OCI registry reference, e.g. oci://registry.example.com/charts
 	// Returns error if something goes wrong
 	AddRepository(name, url string) error
 
-	// UpdateCharts updates the charts list for the local helm repo
-	// Returns error if something goes wrong
-	UpdateCharts() error
+	// RemoveRepository removes the repository from the local helm repo list together with its cached index
+	// name: Mandaory. the name of the helm repo to remove
+	// Returns error if something goes wrong, NotFoundError if the repository does not exist
+	RemoveRepository(name string) error
+
+	// ListRepositories lists the repositories of the local helm repo list, and the repositories of the offline chart
+	// bundle in offline mode
+	// Returns either the repositories ordered by their names or error if something goes wrong
+	ListRepositories() ([]models.HelmRepository, error)
+
+	// UpdateCharts updates the charts list for the local helm repo using the current credentials of the repositories.
+	// The indexes are downloaded concurrently, and a repository that does not respond in time does not block the others.
+	// It does nothing in offline mode.
+	// Returns the result of every repository, and error if something goes wrong or any of the repositories failed
+	UpdateCharts() (RepositoryUpdateReport, error)
 
 	// InstallChart installs chart on a remote cluster using the provided kubeconfig.
 	// If the helm chart was already registered, the method will try to upgrade the chart
@@ -21,7 +36,52 @@ type HelmHelperContract interface {
 	// name: Mandaory. the name of the helm chart release
 	// chart: Mandaory. the name of the chart to install
 	// repo: Mandaory. the name of the repo to install
-	// args: Mandaory. extra arguments to install the helm chart with
+	// options: Mandaory. the options to install the helm chart with, e.g. the chart version and the values
+	// Returns error if something goes wrong
+	InstallChart(kubeconfig, namespace, name, repo, chart string, options InstallChartOptions) error
+
+	// PullChart downloads the chart archive from the repository to the destination directory
+	// repo: Mandaory. the name of the repo, or the oci:// reference of the OCI registry, to pull the chart from
+	// chart: Mandaory. the name of the chart to pull
+	// version: Optional. the exact chart version or the semver constraint, the latest version if empty. Charts in
+	// OCI registries require the exact version.
+	// destination: Mandatory. the directory the chart archive is saved to
+	// Returns the path of the saved chart archive or error if something goes wrong
+	PullChart(repo, chart, version, destination string) (string, error)
+
+	// ExportBundle packages the charts into an offline chart bundle the helm helper can resolve the charts from without
+	// any network access
+	// charts: Mandatory. the charts to package, the version constraints are resolved to the exact versions
+	// destination: Mandatory. the path of the bundle file to create
+	// Returns error if something goes wrong
+	ExportBundle(charts []BundleChart, destination string) error
+
+	// UninstallChart uninstalls the helm release from a remote cluster using the provided kubeconfig
+	// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+	// namespace: Mandatory. the namespace the helm chart is installed to
+	// name: Mandaory. the name of the helm chart release
+	// options: Mandaory. the options to uninstall the helm release with, e.g. whether the hooks run and the timeout
+	// Returns error if something goes wrong
+	UninstallChart(kubeconfig, namespace, name string, options UninstallChartOptions) error
+
+	// RollbackRelease rolls the helm release on a remote cluster back to one of its previous revisions
+	// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+	// namespace: Mandatory. the namespace the helm chart is installed to
+	// name: Mandaory. the name of the helm chart release
+	// revision: Optional. the revision to roll back to, the previous revision if zero
 	// Returns error if something goes wrong
-	InstallChart(kubeconfig, namespace, name, repo, chart string, args map[string]string) error
+	RollbackRelease(kubeconfig, namespace, name string, revision int) error
+
+	// ListReleases lists the latest revision of the helm releases installed on a remote cluster
+	// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+	// namespace: Optional. the namespace to list the releases in, all namespaces if empty
+	// Returns either the helm releases or error if something goes wrong
+	ListReleases(kubeconfig, namespace string) ([]models.HelmRelease, error)
+
+	// GetReleaseHistory returns the revisions of the helm release installed on a remote cluster
+	// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+	// namespace: Mandatory. the namespace the helm chart is installed to
+	// name: Mandaory. the name of the helm chart release
+	// Returns either the revisions of the helm release ordered from the oldest to the latest or error if something goes wrong
+	GetReleaseHistory(kubeconfig, namespace, name string) ([]models.HelmRelease, error)
 }
@@ -0,0 +1,459 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/repo"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// SecretUsernameKey is the key of the repository credentials secret that holds the basic authentication user name
+	SecretUsernameKey = "username"
+
+	// SecretPasswordKey is the key of the repository credentials secret that holds the basic authentication password
+	SecretPasswordKey = "password"
+
+	// SecretTokenKey is the key of the repository credentials secret that holds the bearer token
+	SecretTokenKey = "token"
+
+	// SecretCertKey is the key of the repository credentials secret that holds the PEM encoded client certificate
+	SecretCertKey = "tls.crt"
+
+	// SecretKeyKey is the key of the repository credentials secret that holds the PEM encoded client certificate key
+	SecretKeyKey = "tls.key"
+
+	// SecretCAKey is the key of the repository credentials secret that holds the PEM encoded CA bundle
+	SecretCAKey = "ca.crt"
+)
+
+// repositoryCredentialsFile is the content of the helm repository credentials file
+type repositoryCredentialsFile struct {
+	Repositories []RepositoryCredentials `yaml:"repositories"`
+}
+
+// privateRepository is a chart repository whose requests are authenticated with its credentials
+type privateRepository struct {
+	url         *url.URL
+	credentials RepositoryCredentials
+}
+
+// readRepositoryCredentials returns the credentials of the chart repository with the given name, or empty credentials
+// if the repository is accessed anonymously. The credentials are refused if they are configured for a different URL,
+// so they are never sent to a server other than the one they are issued for. The credentials file and the secrets are
+// read on every call so rotated credentials are picked up without a restart. The certificates read from the secrets
+// are written to the repository cache, as helm reads the TLS configuration from files.
+func (service *helmHelper) readRepositoryCredentials(name, repositoryURL string) (RepositoryCredentials, error) {
+	credentials, found, err := service.findRepositoryCredentials(name)
+	if err != nil || !found {
+		return RepositoryCredentials{}, err
+	}
+
+	if credentials.URL == "" {
+		return RepositoryCredentials{}, errors.Errorf("the credentials of the %q chart repository must specify the URL they are sent to", name)
+	}
+
+	if !isRepositoryURLMatching(credentials.URL, repositoryURL) {
+		return RepositoryCredentials{}, errors.Errorf("the credentials of the %q chart repository are configured for a different URL", name)
+	}
+
+	if credentials.SecretName != "" {
+		if credentials, err = service.readSecretCredentials(credentials); err != nil {
+			return RepositoryCredentials{}, err
+		}
+	}
+
+	if (credentials.CertFile == "") != (credentials.KeyFile == "") {
+		return RepositoryCredentials{}, errors.Errorf("both the client certificate and its key are required to access the %q chart repository", name)
+	}
+
+	return credentials, nil
+}
+
+// findRepositoryCredentials returns the credentials of the chart repository as they are provided in the credentials file
+func (service *helmHelper) findRepositoryCredentials(name string) (RepositoryCredentials, bool, error) {
+	if service.repositoryCredentialsFilePath == "" {
+		return RepositoryCredentials{}, false, nil
+	}
+
+	content, err := ioutil.ReadFile(service.repositoryCredentialsFilePath)
+	if err != nil {
+		return RepositoryCredentials{}, false, errors.Wrap(err, "failed to read the helm repository credentials file")
+	}
+
+	var file repositoryCredentialsFile
+	if err = yaml.UnmarshalStrict(content, &file); err != nil {
+		return RepositoryCredentials{}, false, errors.Wrap(err, "failed to parse the helm repository credentials file")
+	}
+
+	for _, credentials := range file.Repositories {
+		if credentials.Name == name {
+			return credentials, true, nil
+		}
+	}
+
+	return RepositoryCredentials{}, false, nil
+}
+
+// readSecretCredentials overrides the credentials with the values of the kubernetes secret they refer to
+func (service *helmHelper) readSecretCredentials(credentials RepositoryCredentials) (RepositoryCredentials, error) {
+	namespace := credentials.SecretNamespace
+	if namespace == "" {
+		namespace = service.repositoryCredentialsNamespace
+	}
+
+	clientset, err := service.getKubernetesClientset()
+	if err != nil {
+		return RepositoryCredentials{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, credentials.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return RepositoryCredentials{}, errors.Wrapf(err, "failed to read the credentials of the %q chart repository from the %s/%s secret", credentials.Name, namespace, credentials.SecretName)
+	}
+
+	if value, ok := secret.Data[SecretUsernameKey]; ok {
+		credentials.Username = string(value)
+	}
+
+	if value, ok := secret.Data[SecretPasswordKey]; ok {
+		credentials.Password = string(value)
+	}
+
+	if value, ok := secret.Data[SecretTokenKey]; ok {
+		credentials.BearerToken = strings.TrimSpace(string(value))
+	}
+
+	credentialsDirectory := filepath.Join(service.settings.RepositoryCache, "credentials", credentials.Name)
+	for key, path := range map[string]*string{
+		SecretCertKey: &credentials.CertFile,
+		SecretKeyKey:  &credentials.KeyFile,
+		SecretCAKey:   &credentials.CAFile,
+	} {
+		value, ok := secret.Data[key]
+		if !ok {
+			continue
+		}
+
+		if *path, err = writeCredentialsFile(credentialsDirectory, key, value); err != nil {
+			return RepositoryCredentials{}, err
+		}
+	}
+
+	return credentials, nil
+}
+
+// getKubernetesClientset returns the clientset of the cluster the service runs in, creating it on the first call
+func (service *helmHelper) getKubernetesClientset() (kubernetes.Interface, error) {
+	service.clientsetLock.Lock()
+	defer service.clientsetLock.Unlock()
+
+	if service.clientset != nil {
+		return service.clientset, nil
+	}
+
+	restConfig, err := getRestConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the kubernetes client the helm repository credentials secrets are read with")
+	}
+
+	if service.clientset, err = kubernetes.NewForConfig(restConfig); err != nil {
+		return nil, err
+	}
+
+	return service.clientset, nil
+}
+
+// applyRepositoryCredentials sets the credentials of the chart repository entry to the current ones, and returns
+// whether the entry has changed
+func (service *helmHelper) applyRepositoryCredentials(entry *repo.Entry) (bool, error) {
+	credentials, err := service.readRepositoryCredentials(entry.Name, entry.URL)
+	if err != nil {
+		return false, err
+	}
+
+	updated := repo.Entry{
+		Name:                  entry.Name,
+		URL:                   entry.URL,
+		Username:              credentials.Username,
+		Password:              credentials.Password,
+		CertFile:              credentials.CertFile,
+		KeyFile:               credentials.KeyFile,
+		CAFile:                credentials.CAFile,
+		InsecureSkipTLSverify: credentials.InsecureSkipTLSVerify,
+	}
+
+	if err = service.setPrivateRepository(entry.Name, entry.URL, credentials); err != nil {
+		return false, err
+	}
+
+	changed := updated != *entry
+	*entry = updated
+
+	return changed, nil
+}
+
+// setPrivateRepository registers the credentials the requests to the chart repository are authenticated with, or
+// removes them if the repository is accessed anonymously
+func (service *helmHelper) setPrivateRepository(name, repositoryURL string, credentials RepositoryCredentials) error {
+	service.privateRepositoriesLock.Lock()
+	defer service.privateRepositoriesLock.Unlock()
+
+	if credentials == (RepositoryCredentials{}) {
+		delete(service.privateRepositories, name)
+
+		return nil
+	}
+
+	parsedURL, err := url.Parse(repositoryURL)
+	if err != nil {
+		return commonErrors.NewArgumentErrorWithError("url", "url must be a valid URL", err)
+	}
+
+	service.privateRepositories[name] = privateRepository{
+		url:         parsedURL,
+		credentials: credentials,
+	}
+
+	return nil
+}
+
+// findPrivateRepository returns the private chart repository the URL belongs to. The credentials are only sent to the
+// scheme and the host of the repository, the repository with the longest matching path is chosen.
+func (service *helmHelper) findPrivateRepository(href string) (privateRepository, bool) {
+	parsedURL, err := url.Parse(href)
+	if err != nil {
+		return privateRepository{}, false
+	}
+
+	service.privateRepositoriesLock.RLock()
+	defer service.privateRepositoriesLock.RUnlock()
+
+	var found privateRepository
+	var ok bool
+
+	for _, repository := range service.privateRepositories {
+		if repository.url.Scheme != parsedURL.Scheme || repository.url.Host != parsedURL.Host {
+			continue
+		}
+
+		if ok && len(repository.url.Path) <= len(found.url.Path) {
+			continue
+		}
+
+		if strings.HasPrefix(parsedURL.Path, strings.TrimRight(repository.url.Path, "/")) {
+			found, ok = repository, true
+		}
+	}
+
+	return found, ok
+}
+
+// isRepositoryURLMatching returns whether the repository URL has the same scheme and host as the URL the credentials
+// are configured for, and is under its path
+func isRepositoryURLMatching(credentialsURL, repositoryURL string) bool {
+	parsedCredentialsURL, err := url.Parse(credentialsURL)
+	if err != nil {
+		return false
+	}
+
+	parsedRepositoryURL, err := url.Parse(repositoryURL)
+	if err != nil {
+		return false
+	}
+
+	if !strings.EqualFold(parsedCredentialsURL.Scheme, parsedRepositoryURL.Scheme) ||
+		!strings.EqualFold(parsedCredentialsURL.Host, parsedRepositoryURL.Host) {
+		return false
+	}
+
+	credentialsPath := strings.TrimRight(parsedCredentialsURL.Path, "/")
+	repositoryPath := strings.TrimRight(parsedRepositoryURL.Path, "/")
+
+	return repositoryPath == credentialsPath || strings.HasPrefix(repositoryPath, credentialsPath+"/")
+}
+
+// getters returns the getters the charts and the repository indexes are downloaded with. The HTTP getter is replaced
+// with one that authenticates the requests to the private repositories itself, as the helm getter supports neither
+// the bearer tokens nor the credentials of the charts referred to by the repository name.
+func (service *helmHelper) getters() getter.Providers {
+	return service.gettersWithTimeout(DefaultTimeout)
+}
+
+// gettersWithTimeout returns the getters the charts and the repository indexes are downloaded with, giving up on
+// every HTTP download after the timeout
+func (service *helmHelper) gettersWithTimeout(timeout time.Duration) getter.Providers {
+	providers := getter.Providers{}
+	for _, provider := range getter.All(service.settings) {
+		if provider.Provides("http") || provider.Provides("https") {
+			provider = getter.Provider{
+				Schemes: provider.Schemes,
+				New: func(options ...getter.Option) (getter.Getter, error) {
+					return &privateRepositoryGetter{
+						service: service,
+						options: append(options, getter.WithTimeout(timeout)),
+						timeout: timeout,
+					}, nil
+				},
+			}
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return providers
+}
+
+// privateRepositoryGetter downloads the files of the private chart repositories itself, and delegates the rest of
+// the downloads to the helm HTTP getter
+type privateRepositoryGetter struct {
+	service *helmHelper
+	options []getter.Option
+	timeout time.Duration
+}
+
+// Get downloads the content of the URL
+func (repositoryGetter *privateRepositoryGetter) Get(href string, options ...getter.Option) (*bytes.Buffer, error) {
+	repository, ok := repositoryGetter.service.findPrivateRepository(href)
+	if !ok {
+		httpGetter, err := getter.NewHTTPGetter(repositoryGetter.options...)
+		if err != nil {
+			return nil, err
+		}
+
+		return httpGetter.Get(href, options...)
+	}
+
+	tlsConfig, err := newTLSConfig(repository.credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(http.MethodGet, href, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.credentials.BearerToken != "" {
+		request.Header.Set("Authorization", "Bearer "+repository.credentials.BearerToken)
+	} else if repository.credentials.Username != "" || repository.credentials.Password != "" {
+		request.SetBasicAuth(repository.credentials.Username, repository.credentials.Password)
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+		Timeout: repositoryGetter.timeout,
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch %s : %s", href, response.Status)
+	}
+
+	buffer := bytes.NewBuffer(nil)
+	_, err = io.Copy(buffer, response.Body)
+
+	return buffer, err
+}
+
+// newTLSConfig returns the TLS configuration of the chart repository credentials
+func newTLSConfig(credentials RepositoryCredentials) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		// #nosec G402 skipping the verification is an explicit choice of the operator
+		InsecureSkipVerify: credentials.InsecureSkipTLSVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if credentials.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(credentials.CertFile, credentials.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the client certificate")
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if credentials.CAFile != "" {
+		caBundle, err := ioutil.ReadFile(credentials.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the CA bundle")
+		}
+
+		certificatePool := x509.NewCertPool()
+		if !certificatePool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.Errorf("the CA bundle %s contains no PEM encoded certificate", credentials.CAFile)
+		}
+
+		tlsConfig.RootCAs = certificatePool
+	}
+
+	return tlsConfig, nil
+}
+
+// writeCredentialsFile writes the credentials to a file only the service user can read, as helm reads the TLS
+// configuration from files
+func writeCredentialsFile(directory, name string, content []byte) (string, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(directory, name)
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("failed to write the %s credentials file", name))
+	}
+
+	return path, nil
+}
+
+// getRestConfig returns the configuration of the cluster the service runs in, the same way the edge cluster
+// provisioners locate it
+func getRestConfig() (*rest.Config, error) {
+	if kubeConfig := os.Getenv("KUBECONFIG"); kubeConfig != "" {
+		return clientcmd.BuildConfigFromFlags("", kubeConfig)
+	}
+
+	homePath, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfigFilePath := filepath.Join(homePath, ".kube", "config")
+	if _, err = os.Stat(kubeConfigFilePath); !os.IsNotExist(err) {
+		return clientcmd.BuildConfigFromFlags("", kubeConfigFilePath)
+	}
+
+	return rest.InClusterConfig()
+}
@@ -1 +0,0 @@
-package helm_test
@@ -0,0 +1,140 @@
+package helm
+
+import "time"
+
+// DefaultTimeout is the time the helm operations wait for the kubernetes resources when no timeout is provided
+const DefaultTimeout = 5 * time.Minute
+
+// InstallChartOptions contains the options a helm chart is installed or upgraded with
+type InstallChartOptions struct {
+	// Version is the exact chart version or the semver constraint (e.g. "~1.2.0") the installed version must satisfy.
+	// The latest chart version is installed if empty.
+	Version string
+
+	// Values are the YAML values documents merged in the given order, later documents override the earlier ones
+	Values []string
+
+	// Set are the set expressions (e.g. "a.b=c,d=1") applied on top of the values documents, equivalent to --set
+	Set []string
+
+	// SetString are the set expressions whose values are always treated as strings, equivalent to --set-string
+	SetString []string
+
+	// SetFile maps the value keys to the file contents they are set to, equivalent to --set-file
+	SetFile map[string]string
+
+	// Atomic rolls back a failed upgrade or uninstalls a failed install. Atomic implies Wait.
+	Atomic bool
+
+	// Wait waits until all the resources of the release are ready before marking the release as successful
+	Wait bool
+
+	// Timeout is the time to wait for the kubernetes operations and the hooks, DefaultTimeout if zero
+	Timeout time.Duration
+
+	// DependencyUpdate updates the chart dependencies before installing the chart if they are missing
+	DependencyUpdate bool
+}
+
+// UninstallChartOptions contains the options a helm release is uninstalled with
+type UninstallChartOptions struct {
+	// DisableHooks prevents the pre-delete and post-delete hooks of the release from running
+	DisableHooks bool
+
+	// Timeout is the time to wait for the kubernetes operations and the hooks, DefaultTimeout if zero
+	Timeout time.Duration
+}
+
+// BundleChart identifies a chart packaged in an offline chart bundle
+type BundleChart struct {
+	// RepositoryName is the name the chart repository is registered with, the charts are resolved by it in offline mode
+	RepositoryName string `yaml:"repositoryName"`
+
+	// RepositoryURL is the URL of the chart repository or the oci:// reference of the OCI registry the chart is pulled from
+	RepositoryURL string `yaml:"repositoryURL"`
+
+	// Chart is the name of the chart in the chart repository
+	Chart string `yaml:"chart"`
+
+	// Version is the exact chart version or the semver constraint to export, always the exact version in the bundle manifest
+	Version string `yaml:"version"`
+}
+
+// RepositoryCredentials contains the credentials a private chart repository is accessed with. The credentials can be
+// provided directly, or read from the keys of a kubernetes secret: username, password, token, tls.crt, tls.key and
+// ca.crt. The secret values override the values provided directly.
+type RepositoryCredentials struct {
+	// Name is the name the chart repository is registered with
+	Name string `yaml:"name"`
+
+	// URL is the URL of the chart repository the credentials are sent to. The credentials are only used if the
+	// repository is registered with a URL of the same scheme and host, under the same path.
+	URL string `yaml:"url"`
+
+	// Username is the user name of the basic authentication
+	Username string `yaml:"username"`
+
+	// Password is the password of the basic authentication
+	Password string `yaml:"password"`
+
+	// BearerToken is sent in the Authorization header instead of the basic authentication credentials if provided
+	BearerToken string `yaml:"bearerToken"`
+
+	// CertFile is the path of the PEM encoded client certificate file
+	CertFile string `yaml:"certFile"`
+
+	// KeyFile is the path of the PEM encoded client certificate key file
+	KeyFile string `yaml:"keyFile"`
+
+	// CAFile is the path of the PEM encoded CA bundle file the repository server certificate is verified with
+	CAFile string `yaml:"caFile"`
+
+	// InsecureSkipTLSVerify skips the verification of the repository server certificate
+	InsecureSkipTLSVerify bool `yaml:"insecureSkipTLSVerify"`
+
+	// SecretName is the name of the kubernetes secret the credentials are read from
+	SecretName string `yaml:"secretName"`
+
+	// SecretNamespace is the namespace of the kubernetes secret, the configured default namespace if empty
+	SecretNamespace string `yaml:"secretNamespace"`
+}
+
+// RepositoryUpdateResult contains the result of downloading the latest index of a chart repository
+type RepositoryUpdateResult struct {
+	// Name is the name the chart repository is registered with
+	Name string
+
+	// URL is the URL of the chart repository
+	URL string
+
+	// Duration is the time it took to download the index or to fail
+	Duration time.Duration
+
+	// Err is the reason the index could not be downloaded, nil if the index is downloaded successfully
+	Err error
+}
+
+// RepositoryUpdateReport contains the results of downloading the latest index of every chart repository
+type RepositoryUpdateReport struct {
+	// StartTime is the time the update started
+	StartTime time.Time
+
+	// Duration is the time it took to update all the chart repositories
+	Duration time.Duration
+
+	// Repositories are the results of the chart repositories ordered by their names
+	Repositories []RepositoryUpdateResult
+}
+
+// Failed returns the results of the chart repositories whose index could not be downloaded
+func (report RepositoryUpdateReport) Failed() []RepositoryUpdateResult {
+	failed := []RepositoryUpdateResult{}
+
+	for _, result := range report.Repositories {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
@@ -7,6 +7,8 @@ package mock_helm
 import (
 	reflect "reflect"
 
+	models "github.com/decentralized-cloud/edge-cluster/models"
+	helm "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,28 +49,145 @@ func (mr *MockHelmHelperContractMockRecorder) AddRepository(name, url interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRepository", reflect.TypeOf((*MockHelmHelperContract)(nil).AddRepository), name, url)
 }
 
+// ExportBundle mocks base method.
+func (m *MockHelmHelperContract) ExportBundle(charts []helm.BundleChart, destination string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBundle", charts, destination)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBundle indicates an expected call of ExportBundle.
+func (mr *MockHelmHelperContractMockRecorder) ExportBundle(charts, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBundle", reflect.TypeOf((*MockHelmHelperContract)(nil).ExportBundle), charts, destination)
+}
+
+// GetReleaseHistory mocks base method.
+func (m *MockHelmHelperContract) GetReleaseHistory(kubeconfig, namespace, name string) ([]models.HelmRelease, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleaseHistory", kubeconfig, namespace, name)
+	ret0, _ := ret[0].([]models.HelmRelease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleaseHistory indicates an expected call of GetReleaseHistory.
+func (mr *MockHelmHelperContractMockRecorder) GetReleaseHistory(kubeconfig, namespace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleaseHistory", reflect.TypeOf((*MockHelmHelperContract)(nil).GetReleaseHistory), kubeconfig, namespace, name)
+}
+
 // InstallChart mocks base method.
-func (m *MockHelmHelperContract) InstallChart(kubeconfig, namespace, name, repo, chart string, args map[string]string) error {
+func (m *MockHelmHelperContract) InstallChart(kubeconfig, namespace, name, repo, chart string, options helm.InstallChartOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallChart", kubeconfig, namespace, name, repo, chart, args)
+	ret := m.ctrl.Call(m, "InstallChart", kubeconfig, namespace, name, repo, chart, options)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InstallChart indicates an expected call of InstallChart.
-func (mr *MockHelmHelperContractMockRecorder) InstallChart(kubeconfig, namespace, name, repo, chart, args interface{}) *gomock.Call {
+func (mr *MockHelmHelperContractMockRecorder) InstallChart(kubeconfig, namespace, name, repo, chart, options interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallChart", reflect.TypeOf((*MockHelmHelperContract)(nil).InstallChart), kubeconfig, namespace, name, repo, chart, args)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallChart", reflect.TypeOf((*MockHelmHelperContract)(nil).InstallChart), kubeconfig, namespace, name, repo, chart, options)
 }
 
-// UpdateCharts mocks base method.
-func (m *MockHelmHelperContract) UpdateCharts() error {
+// ListReleases mocks base method.
+func (m *MockHelmHelperContract) ListReleases(kubeconfig, namespace string) ([]models.HelmRelease, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCharts")
+	ret := m.ctrl.Call(m, "ListReleases", kubeconfig, namespace)
+	ret0, _ := ret[0].([]models.HelmRelease)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReleases indicates an expected call of ListReleases.
+func (mr *MockHelmHelperContractMockRecorder) ListReleases(kubeconfig, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockHelmHelperContract)(nil).ListReleases), kubeconfig, namespace)
+}
+
+// ListRepositories mocks base method.
+func (m *MockHelmHelperContract) ListRepositories() ([]models.HelmRepository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories")
+	ret0, _ := ret[0].([]models.HelmRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRepositories indicates an expected call of ListRepositories.
+func (mr *MockHelmHelperContractMockRecorder) ListRepositories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockHelmHelperContract)(nil).ListRepositories))
+}
+
+// PullChart mocks base method.
+func (m *MockHelmHelperContract) PullChart(repo, chart, version, destination string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullChart", repo, chart, version, destination)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullChart indicates an expected call of PullChart.
+func (mr *MockHelmHelperContractMockRecorder) PullChart(repo, chart, version, destination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullChart", reflect.TypeOf((*MockHelmHelperContract)(nil).PullChart), repo, chart, version, destination)
+}
+
+// RemoveRepository mocks base method.
+func (m *MockHelmHelperContract) RemoveRepository(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRepository", name)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RemoveRepository indicates an expected call of RemoveRepository.
+func (mr *MockHelmHelperContractMockRecorder) RemoveRepository(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRepository", reflect.TypeOf((*MockHelmHelperContract)(nil).RemoveRepository), name)
+}
+
+// RollbackRelease mocks base method.
+func (m *MockHelmHelperContract) RollbackRelease(kubeconfig, namespace, name string, revision int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRelease", kubeconfig, namespace, name, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackRelease indicates an expected call of RollbackRelease.
+func (mr *MockHelmHelperContractMockRecorder) RollbackRelease(kubeconfig, namespace, name, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRelease", reflect.TypeOf((*MockHelmHelperContract)(nil).RollbackRelease), kubeconfig, namespace, name, revision)
+}
+
+// UninstallChart mocks base method.
+func (m *MockHelmHelperContract) UninstallChart(kubeconfig, namespace, name string, options helm.UninstallChartOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallChart", kubeconfig, namespace, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallChart indicates an expected call of UninstallChart.
+func (mr *MockHelmHelperContractMockRecorder) UninstallChart(kubeconfig, namespace, name, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallChart", reflect.TypeOf((*MockHelmHelperContract)(nil).UninstallChart), kubeconfig, namespace, name, options)
+}
+
+// UpdateCharts mocks base method.
+func (m *MockHelmHelperContract) UpdateCharts() (helm.RepositoryUpdateReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCharts")
+	ret0, _ := ret[0].(helm.RepositoryUpdateReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateCharts indicates an expected call of UpdateCharts.
 func (mr *MockHelmHelperContractMockRecorder) UpdateCharts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -0,0 +1,302 @@
+package helm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/deislabs/oras/pkg/content"
+	"github.com/deislabs/oras/pkg/oras"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// OCIScheme is the scheme of the repository URLs that refer to an OCI registry, e.g. oci://registry.example.com/charts
+	OCIScheme = "oci://"
+
+	// HelmChartConfigMediaType is the media type of the helm chart metadata stored in an OCI registry
+	HelmChartConfigMediaType = "application/vnd.cncf.helm.config.v1+json"
+
+	// HelmChartContentLayerMediaType is the media type of the helm chart archive stored in an OCI registry
+	HelmChartContentLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	// legacyHelmChartContentLayerMediaType is the media type of the helm chart archives pushed by helm 3.6 and earlier
+	legacyHelmChartContentLayerMediaType = "application/tar+gzip"
+
+	// ociRepositoriesFileName is the name of the file next to the helm repositories file the names of the OCI
+	// registries are persisted to, as the helm repositories file only holds the chart repositories with an index
+	ociRepositoriesFileName = "oci-repositories.yaml"
+)
+
+// registryConfig is the subset of the docker config.json file that holds the OCI registry credentials
+type registryConfig struct {
+	Auths map[string]registryAuth `json:"auths"`
+}
+
+type registryAuth struct {
+	Auth          string `json:"auth"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	IdentityToken string `json:"identitytoken"`
+}
+
+// IsOCIReference returns whether the repository URL refers to an OCI registry
+// url: Mandatory. The repository URL, e.g. oci://registry.example.com/charts
+// Returns true if the repository URL refers to an OCI registry, otherwise false
+func IsOCIReference(url string) bool {
+	return strings.HasPrefix(url, OCIScheme)
+}
+
+// addOCIRepository registers the OCI registry under the given repository name. OCI registries have no index file, so
+// nothing is downloaded until a chart is pulled.
+func (service *helmHelper) addOCIRepository(name, url string) error {
+	registry := trimOCIReference(url)
+	if registry == "" || strings.HasPrefix(registry, "/") {
+		return commonErrors.NewArgumentError("url", "url must contain the OCI registry host, e.g. oci://registry.example.com/charts")
+	}
+
+	service.ociRepositoriesLock.Lock()
+	defer service.ociRepositoriesLock.Unlock()
+
+	service.ociRepositories[name] = registry
+	if err := service.saveOCIRepositories(); err != nil {
+		return err
+	}
+
+	service.logger.Info("OCI registry has been added to local helm repositories", zap.String("name", name))
+
+	return nil
+}
+
+// getOCIRepository returns the OCI registry the repository refers to, either directly using an oci:// reference or
+// using the name the registry is added with
+func (service *helmHelper) getOCIRepository(repo string) (string, bool) {
+	if IsOCIReference(repo) {
+		return trimOCIReference(repo), true
+	}
+
+	service.ociRepositoriesLock.RLock()
+	defer service.ociRepositoriesLock.RUnlock()
+
+	registry, ok := service.ociRepositories[repo]
+
+	return registry, ok
+}
+
+// removeOCIRepository removes the OCI registry registered under the given repository name
+// Returns whether the repository name refers to an OCI registry or error if something goes wrong
+func (service *helmHelper) removeOCIRepository(name string) (bool, error) {
+	service.ociRepositoriesLock.Lock()
+	defer service.ociRepositoriesLock.Unlock()
+
+	if _, ok := service.ociRepositories[name]; !ok {
+		return false, nil
+	}
+
+	delete(service.ociRepositories, name)
+
+	return true, service.saveOCIRepositories()
+}
+
+// listOCIRepositories returns the OCI registries the repository names are registered with
+func (service *helmHelper) listOCIRepositories() []models.HelmRepository {
+	service.ociRepositoriesLock.RLock()
+	defer service.ociRepositoriesLock.RUnlock()
+
+	repositories := make([]models.HelmRepository, 0, len(service.ociRepositories))
+	for name, registry := range service.ociRepositories {
+		repositories = append(repositories, models.HelmRepository{
+			Name: name,
+			URL:  OCIScheme + registry,
+		})
+	}
+
+	return repositories
+}
+
+// loadOCIRepositories reads the OCI registries registered before the service was restarted
+func (service *helmHelper) loadOCIRepositories() error {
+	content, err := ioutil.ReadFile(service.getOCIRepositoriesFilePath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	service.ociRepositoriesLock.Lock()
+	defer service.ociRepositoriesLock.Unlock()
+
+	if err = yaml.Unmarshal(content, &service.ociRepositories); err != nil {
+		return errors.Wrap(err, "failed to parse the OCI repositories file")
+	}
+
+	if service.ociRepositories == nil {
+		service.ociRepositories = map[string]string{}
+	}
+
+	return nil
+}
+
+// saveOCIRepositories persists the registered OCI registries, the caller must hold the OCI repositories lock
+func (service *helmHelper) saveOCIRepositories() error {
+	content, err := yaml.Marshal(service.ociRepositories)
+	if err != nil {
+		return err
+	}
+
+	path := service.getOCIRepositoriesFilePath()
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, content, 0644)
+}
+
+func (service *helmHelper) getOCIRepositoriesFilePath() string {
+	return filepath.Join(filepath.Dir(service.settings.RepositoryConfig), ociRepositoriesFileName)
+}
+
+// pullOCIChart pulls the chart archive from the OCI registry and saves it to the destination directory. OCI
+// registries cannot be searched, so the exact chart version is required.
+func (service *helmHelper) pullOCIChart(registry, chartName, version, destination string) (string, error) {
+	if strings.TrimSpace(version) == "" {
+		return "", commonErrors.NewArgumentError("version", "the exact chart version is required to pull a chart from an OCI registry")
+	}
+
+	if _, err := semver.NewVersion(version); err != nil {
+		return "", commonErrors.NewArgumentErrorWithError("version", "the exact chart version is required to pull a chart from an OCI registry", err)
+	}
+
+	reference := fmt.Sprintf("%s/%s:%s", registry, chartName, version)
+	resolver := docker.NewResolver(docker.ResolverOptions{
+		Hosts: docker.ConfigureDefaultRegistries(
+			docker.WithAuthorizer(docker.NewDockerAuthorizer(docker.WithAuthCreds(service.getRegistryCredentials))),
+			docker.WithPlainHTTP(func(string) (bool, error) {
+				return service.registryPlainHTTP, nil
+			})),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	store := content.NewMemoryStore()
+	_, layers, err := oras.Pull(
+		ctx,
+		resolver,
+		reference,
+		store,
+		oras.WithPullEmptyNameAllowed(),
+		oras.WithAllowedMediaTypes([]string{
+			HelmChartConfigMediaType,
+			HelmChartContentLayerMediaType,
+			legacyHelmChartContentLayerMediaType,
+		}))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to pull %q from the OCI registry", reference)
+	}
+
+	var contentLayer *ocispec.Descriptor
+	for i := range layers {
+		if layers[i].MediaType == HelmChartContentLayerMediaType || layers[i].MediaType == legacyHelmChartContentLayerMediaType {
+			contentLayer = &layers[i]
+
+			break
+		}
+	}
+
+	if contentLayer == nil {
+		return "", errors.Errorf("%q does not contain a helm chart layer", reference)
+	}
+
+	_, chartArchive, ok := store.Get(*contentLayer)
+	if !ok {
+		return "", errors.Errorf("failed to retrieve the helm chart layer %s of %q", contentLayer.Digest, reference)
+	}
+
+	if err = os.MkdirAll(destination, 0755); err != nil {
+		return "", err
+	}
+
+	chartPath := filepath.Join(destination, fmt.Sprintf("%s-%s.tgz", filepath.Base(chartName), version))
+	if err = ioutil.WriteFile(chartPath, chartArchive, 0644); err != nil {
+		return "", err
+	}
+
+	return chartPath, nil
+}
+
+// getRegistryCredentials returns the credentials of the OCI registry host from the registry config file. The file is
+// read on every call so rotated credentials are picked up without a restart.
+func (service *helmHelper) getRegistryCredentials(host string) (string, string, error) {
+	if service.registryConfigPath == "" {
+		return "", "", nil
+	}
+
+	configContent, err := ioutil.ReadFile(service.registryConfigPath)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to read the OCI registry config file")
+	}
+
+	var config registryConfig
+	if err = json.Unmarshal(configContent, &config); err != nil {
+		return "", "", errors.Wrap(err, "failed to parse the OCI registry config file")
+	}
+
+	for key, auth := range config.Auths {
+		if normalizeRegistryHost(key) != host {
+			continue
+		}
+
+		if auth.IdentityToken != "" {
+			// An empty user name makes the authorizer use the identity token as the refresh token
+			return "", auth.IdentityToken, nil
+		}
+
+		if auth.Auth == "" {
+			return auth.Username, auth.Password, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "failed to decode the credentials of %q", host)
+		}
+
+		parts := strings.SplitN(string(decoded), ":", 2)
+		if len(parts) != 2 {
+			return "", "", errors.Errorf("the credentials of %q must be in the username:password format", host)
+		}
+
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", nil
+}
+
+// trimOCIReference removes the oci:// scheme and the trailing slashes of the OCI registry reference
+func trimOCIReference(url string) string {
+	return strings.TrimRight(strings.TrimPrefix(url, OCIScheme), "/")
+}
+
+// normalizeRegistryHost returns the host of the registry config key, which can be either a host or a URL
+func normalizeRegistryHost(key string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+	if index := strings.Index(host, "/"); index >= 0 {
+		host = host[:index]
+	}
+
+	return host
+}
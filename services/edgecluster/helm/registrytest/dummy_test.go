@@ -0,0 +1 @@
+package registrytest_test
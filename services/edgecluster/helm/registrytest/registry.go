@@ -0,0 +1,187 @@
+// Package registrytest implements an in-memory OCI registry stand-in the helm charts can be pushed to and pulled
+// from in tests, in the same way net/http/httptest provides a stand-in HTTP server
+package registrytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+// Registry is an in-memory OCI registry served over plain HTTP on the loopback interface
+type Registry struct {
+	server    *httptest.Server
+	username  string
+	password  string
+	lock      sync.RWMutex
+	manifests map[string][]byte
+	blobs     map[digest.Digest][]byte
+}
+
+// NewRegistry starts a new in-memory OCI registry
+// username: Optional. The user name the clients must authenticate with using basic authentication, anonymous access
+// is allowed if empty
+// password: Optional. The password the clients must authenticate with using basic authentication
+// Returns the started registry, which must be closed when it is no longer required
+func NewRegistry(username, password string) *Registry {
+	registry := &Registry{
+		username:  username,
+		password:  password,
+		manifests: map[string][]byte{},
+		blobs:     map[digest.Digest][]byte{},
+	}
+
+	registry.server = httptest.NewServer(http.HandlerFunc(registry.serveHTTP))
+
+	return registry
+}
+
+// Host returns the host and the port the registry is listening on, e.g. 127.0.0.1:43567
+func (registry *Registry) Host() string {
+	return strings.TrimPrefix(registry.server.URL, "http://")
+}
+
+// Reference returns the oci:// reference of the given path of the registry
+// path: Optional. The path of the repository in the registry, e.g. charts
+// Returns the oci:// reference of the given path, e.g. oci://127.0.0.1:43567/charts
+func (registry *Registry) Reference(path string) string {
+	if path == "" {
+		return helm.OCIScheme + registry.Host()
+	}
+
+	return fmt.Sprintf("%s%s/%s", helm.OCIScheme, registry.Host(), path)
+}
+
+// Close shuts down the registry
+func (registry *Registry) Close() {
+	registry.server.Close()
+}
+
+// PushChart stores the chart archive in the registry the same way helm chart push does, using the chart version as the tag
+// repository: Mandatory. The repository of the chart in the registry, e.g. charts/nginx
+// chartArchive: Mandatory. The content of the packaged chart
+// Returns error if something goes wrong
+func (registry *Registry) PushChart(repository string, chartArchive []byte) error {
+	chart, err := loader.LoadArchive(bytes.NewReader(chartArchive))
+	if err != nil {
+		return err
+	}
+
+	config, err := json.Marshal(chart.Metadata)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		Config:    registry.addBlob(helm.HelmChartConfigMediaType, config),
+		Layers:    []ocispec.Descriptor{registry.addBlob(helm.HelmChartContentLayerMediaType, chartArchive)},
+	})
+	if err != nil {
+		return err
+	}
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	registry.manifests[repository+":"+chart.Metadata.Version] = manifest
+	registry.manifests[repository+"@"+digest.FromBytes(manifest).String()] = manifest
+
+	return nil
+}
+
+func (registry *Registry) addBlob(mediaType string, content []byte) ocispec.Descriptor {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	descriptor := ocispec.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest.FromBytes(content),
+		Size:      int64(len(content)),
+	}
+
+	registry.blobs[descriptor.Digest] = content
+
+	return descriptor
+}
+
+func (registry *Registry) serveHTTP(writer http.ResponseWriter, request *http.Request) {
+	if registry.username != "" {
+		if username, password, ok := request.BasicAuth(); !ok || username != registry.username || password != registry.password {
+			writer.Header().Set("WWW-Authenticate", `Basic realm="registrytest"`)
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+	}
+
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	path := strings.TrimPrefix(request.URL.Path, "/v2/")
+
+	if path == "" {
+		writer.WriteHeader(http.StatusOK)
+
+		return
+	}
+
+	if index := strings.LastIndex(path, "/manifests/"); index > 0 {
+		repository, reference := path[:index], path[index+len("/manifests/"):]
+		separator := ":"
+
+		if strings.Contains(reference, ":") {
+			separator = "@"
+		}
+
+		registry.lock.RLock()
+		manifest, ok := registry.manifests[repository+separator+reference]
+		registry.lock.RUnlock()
+
+		registry.writeContent(writer, request, ocispec.MediaTypeImageManifest, manifest, ok)
+
+		return
+	}
+
+	if index := strings.LastIndex(path, "/blobs/"); index > 0 {
+		registry.lock.RLock()
+		blob, ok := registry.blobs[digest.Digest(path[index+len("/blobs/"):])]
+		registry.lock.RUnlock()
+
+		registry.writeContent(writer, request, "application/octet-stream", blob, ok)
+
+		return
+	}
+
+	writer.WriteHeader(http.StatusNotFound)
+}
+
+func (registry *Registry) writeContent(writer http.ResponseWriter, request *http.Request, mediaType string, content []byte, ok bool) {
+	if !ok {
+		writer.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", mediaType)
+	writer.Header().Set("Content-Length", fmt.Sprint(len(content)))
+	writer.Header().Set("Docker-Content-Digest", digest.FromBytes(content).String())
+	writer.WriteHeader(http.StatusOK)
+
+	if request.Method == http.MethodGet {
+		_, _ = writer.Write(content)
+	}
+}
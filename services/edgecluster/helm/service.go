@@ -6,11 +6,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/Masterminds/semver/v3"
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
 	"github.com/gofrs/flock"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"github.com/pkg/errors"
@@ -19,47 +22,124 @@ import (
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/cli"
-	"helm.sh/helm/v3/pkg/cli/values"
 	"helm.sh/helm/v3/pkg/downloader"
-	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/repo"
 	"helm.sh/helm/v3/pkg/strvals"
+	"k8s.io/client-go/kubernetes"
 )
 
 type helmHelper struct {
-	logger   *zap.Logger
-	settings *cli.EnvSettings
+	logger              *zap.Logger
+	settings            *cli.EnvSettings
+	registryConfigPath  string
+	registryPlainHTTP   bool
+	ociRepositories     map[string]string
+	ociRepositoriesLock sync.RWMutex
+
+	// offlineBundleDirectory is the directory the charts are resolved from in offline mode, empty if online
+	offlineBundleDirectory string
+
+	repositoryCredentialsFilePath  string
+	repositoryCredentialsNamespace string
+	privateRepositories            map[string]privateRepository
+	privateRepositoriesLock        sync.RWMutex
+	clientset                      kubernetes.Interface
+	clientsetLock                  sync.Mutex
+
+	repositoryUpdateConcurrency int
+	repositoryUpdateTimeout     time.Duration
 }
 
 // NewHelmHelperService creates new instance of the helmHelper, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
 // Returns the new service or error if something goes wrong
 func NewHelmHelperService(
-	logger *zap.Logger) (HelmHelperContract, error) {
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract) (HelmHelperContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
 
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	registryConfigPath, err := configurationService.GetHelmRegistryConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	registryPlainHTTP, err := configurationService.GetHelmRegistryPlainHTTP()
+	if err != nil {
+		return nil, err
+	}
+
+	offlineBundlePath, err := configurationService.GetHelmOfflineBundlePath()
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryCredentialsFilePath, err := configurationService.GetHelmRepositoryCredentialsFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryCredentialsNamespace, err := configurationService.GetHelmRepositoryCredentialsNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryUpdateConcurrency, err := configurationService.GetHelmRepositoryUpdateConcurrency()
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryUpdateTimeout, err := configurationService.GetHelmRepositoryUpdateTimeout()
+	if err != nil {
+		return nil, err
+	}
+
+	if repositoryUpdateConcurrency < 1 {
+		return nil, commonErrors.NewArgumentError("repositoryUpdateConcurrency", "the helm repository update concurrency must be at least 1")
+	}
+
 	service := helmHelper{
-		logger:   logger,
-		settings: cli.New(),
+		logger:                         logger,
+		settings:                       cli.New(),
+		registryConfigPath:             registryConfigPath,
+		registryPlainHTTP:              registryPlainHTTP,
+		ociRepositories:                map[string]string{},
+		repositoryCredentialsFilePath:  repositoryCredentialsFilePath,
+		repositoryCredentialsNamespace: repositoryCredentialsNamespace,
+		privateRepositories:            map[string]privateRepository{},
+		repositoryUpdateConcurrency:    repositoryUpdateConcurrency,
+		repositoryUpdateTimeout:        repositoryUpdateTimeout,
 	}
 
-	if err := service.AddRepository("decentralized-cloud", "https://decentralized-cloud.github.io/helm"); err != nil {
-		return nil, types.NewUnknownErrorWithError("failed add helm repo", err)
+	if err = service.loadOCIRepositories(); err != nil {
+		return nil, err
 	}
 
-	if err := service.AddRepository("portainer", "https://portainer.github.io/k8s"); err != nil {
-		return nil, types.NewUnknownErrorWithError("failed add helm repo", err)
+	if strings.TrimSpace(offlineBundlePath) != "" {
+		if service.offlineBundleDirectory, err = prepareOfflineBundle(offlineBundlePath, service.settings.RepositoryCache); err != nil {
+			return nil, err
+		}
+
+		logger.Info("helm charts are resolved from the offline chart bundle", zap.String("directory", service.offlineBundleDirectory))
 	}
 
 	return &service, nil
 }
 
-// AddRepository adds the new repository to the local helm repo list
+// AddRepository adds the new repository to the local helm repo list. The repository is accessed with the credentials
+// configured for its name, and its index is downloaded again if the URL or the credentials have changed.
 // name: Mandaory. the helm repo name to add
-// url: Mandaory. the helm repo url to add
+// url: Mandaory. the helm repo url to add, either a chart repository URL or an OCI registry reference, e.g. oci://registry.example.com/charts
 // Returns error if something goes wrong
 func (service *helmHelper) AddRepository(name, url string) error {
 	if strings.TrimSpace(name) == "" {
@@ -70,63 +150,58 @@ func (service *helmHelper) AddRepository(name, url string) error {
 		return commonErrors.NewArgumentError("url", "url is required")
 	}
 
-	repoFile := service.settings.RepositoryConfig
+	if service.offlineBundleDirectory != "" {
+		service.logger.Info("offline mode, the repository charts are resolved from the offline chart bundle", zap.String("name", name))
 
-	// Ensure the file directory exists as it is required for file locking
-	err := os.MkdirAll(filepath.Dir(repoFile), os.ModePerm)
-	if err != nil && !os.IsExist(err) {
-		return err
+		return nil
 	}
 
-	// Acquire a file lock for process synchronization
-	fileLock := flock.New(strings.Replace(repoFile, filepath.Ext(repoFile), ".lock", 1))
-	lockCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	locked, err := fileLock.TryLockContext(lockCtx, time.Second)
-	if err == nil && locked {
-		defer func() {
-			_ = fileLock.Unlock()
-		}()
+	if IsOCIReference(url) {
+		return service.addOCIRepository(name, url)
 	}
 
+	unlock, err := service.lockRepositoryFile()
 	if err != nil {
 		return err
 	}
 
-	b, err := ioutil.ReadFile(repoFile)
-	if err != nil && !os.IsNotExist(err) {
+	defer unlock()
+
+	repositoryFile, err := service.readRepositoryFile()
+	if err != nil {
 		return err
 	}
 
-	var repositoryFile repo.File
-	if err := yaml.Unmarshal(b, &repositoryFile); err != nil {
+	config := repo.Entry{
+		Name: name,
+		URL:  url,
+	}
+
+	if _, err = service.applyRepositoryCredentials(&config); err != nil {
 		return err
 	}
 
-	if repositoryFile.Has(name) {
+	// The index is downloaded again if the URL or the credentials of an existing repository have changed
+	if existing := repositoryFile.Get(name); existing != nil && *existing == config {
 		service.logger.Info("repository already exists", zap.String("name", name))
 
 		return nil
 	}
 
-	config := repo.Entry{
-		Name: name,
-		URL:  url,
-	}
-
-	chartRepository, err := repo.NewChartRepository(&config, getter.All(service.settings))
+	chartRepository, err := repo.NewChartRepository(&config, service.getters())
 	if err != nil {
 		return err
 	}
 
+	chartRepository.CachePath = service.settings.RepositoryCache
+
 	if _, err := chartRepository.DownloadIndexFile(); err != nil {
 		return errors.Wrapf(err, "looks like %q is not a valid chart repository or cannot be reached", url)
 	}
 
 	repositoryFile.Update(&config)
 
-	if err := repositoryFile.WriteFile(repoFile, 0644); err != nil {
+	if err := repositoryFile.WriteFile(service.settings.RepositoryConfig, 0644); err != nil {
 		return err
 	}
 
@@ -135,66 +210,182 @@ func (service *helmHelper) AddRepository(name, url string) error {
 	return nil
 }
 
-// UpdateCharts updates the charts list for the local helm repo
-// Returns error if something goes wrong
-func (service *helmHelper) UpdateCharts() error {
-	repoFile, err := repo.LoadFile(service.settings.RepositoryConfig)
-	if os.IsNotExist(errors.Cause(err)) || len(repoFile.Repositories) == 0 {
-		return errors.New("no repositories found. You must add one before updating")
+// RemoveRepository removes the repository from the local helm repo list together with its cached index
+// name: Mandaory. the name of the helm repo to remove
+// Returns error if something goes wrong, NotFoundError if the repository does not exist
+func (service *helmHelper) RemoveRepository(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return commonErrors.NewArgumentError("name", "name is required")
 	}
 
-	var repositories []*repo.ChartRepository
-	for _, cfg := range repoFile.Repositories {
-		chartRepository, err := repo.NewChartRepository(cfg, getter.All(service.settings))
-		if err != nil {
+	if service.offlineBundleDirectory != "" {
+		return errors.New("repositories cannot be removed in offline mode, the charts are resolved from the offline chart bundle")
+	}
+
+	if removed, err := service.removeOCIRepository(name); err != nil || removed {
+		return err
+	}
+
+	unlock, err := service.lockRepositoryFile()
+	if err != nil {
+		return err
+	}
+
+	defer unlock()
+
+	repositoryFile, err := service.readRepositoryFile()
+	if err != nil {
+		return err
+	}
+
+	if !repositoryFile.Remove(name) {
+		return commonErrors.NewNotFoundErrorWithError(errors.Errorf("repository %q does not exist", name))
+	}
+
+	if err = repositoryFile.WriteFile(service.settings.RepositoryConfig, 0644); err != nil {
+		return err
+	}
+
+	for _, path := range []string{
+		filepath.Join(service.settings.RepositoryCache, helmpath.CacheIndexFile(name)),
+		filepath.Join(service.settings.RepositoryCache, helmpath.CacheChartsFile(name)),
+		filepath.Join(service.settings.RepositoryCache, "credentials", name),
+	} {
+		if err = os.RemoveAll(path); err != nil {
 			return err
 		}
+	}
 
-		repositories = append(repositories, chartRepository)
+	if err = service.setPrivateRepository(name, "", RepositoryCredentials{}); err != nil {
+		return err
 	}
 
-	if len(repositories) > 0 {
-		service.logger.Info("Hang tight while we grab the latest from your chart repositories...")
+	service.logger.Info("repository has been removed from local helm repositories", zap.String("name", name))
 
-		errorsChan := make(chan error)
-		waitGroupDoneChan := make(chan bool)
+	return nil
+}
 
-		var waitGroup sync.WaitGroup
-		for _, repository := range repositories {
-			waitGroup.Add(1)
+// ListRepositories lists the repositories of the local helm repo list, and the repositories of the offline chart
+// bundle in offline mode
+// Returns either the repositories ordered by their names or error if something goes wrong
+func (service *helmHelper) ListRepositories() ([]models.HelmRepository, error) {
+	if service.offlineBundleDirectory != "" {
+		return service.listBundleRepositories()
+	}
 
-			go func(re *repo.ChartRepository) {
-				defer waitGroup.Done()
+	repositoryFile, err := service.readRepositoryFile()
+	if err != nil {
+		return nil, err
+	}
 
-				if _, err := re.DownloadIndexFile(); err != nil {
-					errorsChan <- errors.Wrapf(err, "...Unable to get an update from the %q chart repository (%s):\n\t%s\n", re.Config.Name, re.Config.URL, err)
-				} else {
-					service.logger.Info("...Successfully got an update from the chart repository", zap.String("name", re.Config.Name))
-				}
-			}(repository)
-		}
+	repositories := service.listOCIRepositories()
+	for _, entry := range repositoryFile.Repositories {
+		repositories = append(repositories, models.HelmRepository{
+			Name:                 entry.Name,
+			URL:                  entry.URL,
+			LastIndexRefreshTime: getModificationTime(filepath.Join(service.settings.RepositoryCache, helmpath.CacheIndexFile(entry.Name))),
+		})
+	}
 
-		go func() {
-			waitGroup.Wait()
-			close(waitGroupDoneChan)
-		}()
+	sort.Slice(repositories, func(i, j int) bool {
+		return repositories[i].Name < repositories[j].Name
+	})
 
-		select {
-		case <-waitGroupDoneChan:
-			close(errorsChan)
+	return repositories, nil
+}
 
-			break
+// UpdateCharts updates the charts list for the local helm repo using the current credentials of the repositories.
+// The indexes are downloaded concurrently, and a repository that does not respond in time does not block the others.
+// It does nothing in offline mode.
+// Returns the result of every repository, and error if something goes wrong or any of the repositories failed
+func (service *helmHelper) UpdateCharts() (RepositoryUpdateReport, error) {
+	report := RepositoryUpdateReport{StartTime: time.Now(), Repositories: []RepositoryUpdateResult{}}
 
-		case err := <-errorsChan:
-			close(errorsChan)
+	if service.offlineBundleDirectory != "" {
+		service.logger.Info("offline mode, skipping updating the chart repositories")
 
-			return err
+		return report, nil
+	}
+
+	repositoryEntries, err := service.refreshRepositoryCredentials()
+	if err != nil {
+		return report, err
+	}
+
+	if len(repositoryEntries) == 0 {
+		return report, errors.New("no repositories found. You must add one before updating")
+	}
+
+	sort.Slice(repositoryEntries, func(i, j int) bool {
+		return repositoryEntries[i].Name < repositoryEntries[j].Name
+	})
+
+	service.logger.Info("Hang tight while we grab the latest from your chart repositories...")
+
+	report.Repositories = make([]RepositoryUpdateResult, len(repositoryEntries))
+	concurrency := make(chan struct{}, service.repositoryUpdateConcurrency)
+
+	var waitGroup sync.WaitGroup
+	for index, entry := range repositoryEntries {
+		waitGroup.Add(1)
+
+		// Every goroutine writes only its own result, so the results are not shared between the goroutines
+		go func(result *RepositoryUpdateResult, entry *repo.Entry) {
+			defer waitGroup.Done()
+
+			concurrency <- struct{}{}
+			defer func() { <-concurrency }()
+
+			result.Name = entry.Name
+			result.URL = entry.URL
+			startTime := time.Now()
+			result.Err = service.downloadIndexFile(entry)
+			result.Duration = time.Since(startTime)
+
+			if result.Err != nil {
+				service.logger.Error(
+					"...Unable to get an update from the chart repository",
+					zap.String("name", entry.Name),
+					zap.String("url", entry.URL),
+					zap.Error(result.Err))
+			} else {
+				service.logger.Info("...Successfully got an update from the chart repository", zap.String("name", entry.Name))
+			}
+		}(&report.Repositories[index], entry)
+	}
+
+	waitGroup.Wait()
+	report.Duration = time.Since(report.StartTime)
+
+	if failed := report.Failed(); len(failed) > 0 {
+		messages := make([]string, 0, len(failed))
+		for _, result := range failed {
+			messages = append(messages, fmt.Sprintf("%q chart repository (%s): %s", result.Name, result.URL, result.Err))
 		}
 
-		service.logger.Info("Update Complete. ⎈ Happy Helming!⎈")
+		return report, errors.Errorf(
+			"unable to get an update from %d of %d chart repositories: %s",
+			len(failed),
+			len(report.Repositories),
+			strings.Join(messages, "; "))
 	}
 
-	return nil
+	service.logger.Info("Update Complete. ⎈ Happy Helming!⎈")
+
+	return report, nil
+}
+
+// downloadIndexFile downloads the latest index of the chart repository, giving up after the repository update timeout
+func (service *helmHelper) downloadIndexFile(entry *repo.Entry) error {
+	chartRepository, err := repo.NewChartRepository(entry, service.gettersWithTimeout(service.repositoryUpdateTimeout))
+	if err != nil {
+		return err
+	}
+
+	chartRepository.CachePath = service.settings.RepositoryCache
+	_, err = chartRepository.DownloadIndexFile()
+
+	return err
 }
 
 // InstallChart installs chart on a remote cluster using the provided kubeconfig.
@@ -205,9 +396,9 @@ func (service *helmHelper) UpdateCharts() error {
 // name: Mandaory. the name of the helm chart release
 // chart: Mandaory. the name of the chart to install
 // repo: Mandaory. the name of the repo to install
-// args: Mandaory. extra arguments to install the helm chart with
+// options: Mandaory. the options to install the helm chart with, e.g. the chart version and the values
 // Returns error if something goes wrong
-func (service *helmHelper) InstallChart(kubeconfig, namespace, name, repo, chart string, args map[string]string) error {
+func (service *helmHelper) InstallChart(kubeconfig, namespace, name, repo, chart string, options InstallChartOptions) error {
 	if strings.TrimSpace(kubeconfig) == "" {
 		return commonErrors.NewArgumentError("kubeconfig", "kubeconfig is required")
 	}
@@ -228,82 +419,253 @@ func (service *helmHelper) InstallChart(kubeconfig, namespace, name, repo, chart
 		return commonErrors.NewArgumentError("chart", "chart is required")
 	}
 
+	if strings.TrimSpace(options.Version) != "" {
+		if _, err := semver.NewConstraint(options.Version); err != nil {
+			return commonErrors.NewArgumentErrorWithError("options", "version must be a valid version or semver constraint", err)
+		}
+	}
+
+	if options.Timeout < 0 {
+		return commonErrors.NewArgumentError("options", "timeout cannot be negative")
+	}
+
 	isDeployed, err := service.isHelmChartDeployed(kubeconfig, namespace, name)
 	if err != nil {
 		return err
 	}
 
 	if isDeployed {
-		return service.upgrade(kubeconfig, namespace, name, repo, chart, args)
+		return service.upgrade(kubeconfig, namespace, name, repo, chart, options)
 	}
 
-	return service.install(kubeconfig, namespace, name, repo, chart, args)
+	return service.install(kubeconfig, namespace, name, repo, chart, options)
 }
 
-func (service *helmHelper) install(kubeconfig, namespace, name, repo, chart string, args map[string]string) error {
-	restClient := newKubeconfigClientGetter(namespace, kubeconfig)
+// PullChart downloads the chart archive from the repository to the destination directory
+// repo: Mandaory. the name of the repo, or the oci:// reference of the OCI registry, to pull the chart from
+// chart: Mandaory. the name of the chart to pull
+// version: Optional. the exact chart version or the semver constraint, the latest version if empty. Charts in
+// OCI registries require the exact version.
+// destination: Mandatory. the directory the chart archive is saved to
+// Returns the path of the saved chart archive or error if something goes wrong
+func (service *helmHelper) PullChart(repo, chart, version, destination string) (string, error) {
+	if strings.TrimSpace(repo) == "" {
+		return "", commonErrors.NewArgumentError("repo", "repo is required")
+	}
 
-	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(restClient, namespace, os.Getenv("HELM_DRIVER"), service.debug); err != nil {
-		return err
+	if strings.TrimSpace(chart) == "" {
+		return "", commonErrors.NewArgumentError("chart", "chart is required")
 	}
 
-	client := action.NewInstall(actionConfig)
-	client.Namespace = namespace
-	client.CreateNamespace = true
+	if strings.TrimSpace(destination) == "" {
+		return "", commonErrors.NewArgumentError("destination", "destination is required")
+	}
 
-	if client.Version == "" && client.Devel {
-		client.Version = ">0.0.0-0"
+	if strings.TrimSpace(version) != "" {
+		if _, err := semver.NewConstraint(version); err != nil {
+			return "", commonErrors.NewArgumentErrorWithError("version", "version must be a valid version or semver constraint", err)
+		}
 	}
 
-	client.ReleaseName = name
-	cp, err := client.ChartPathOptions.LocateChart(fmt.Sprintf("%s/%s", repo, chart), service.settings)
+	if service.offlineBundleDirectory != "" {
+		return service.copyBundleChart(repo, chart, version, destination)
+	}
+
+	if registry, ok := service.getOCIRepository(repo); ok {
+		return service.pullOCIChart(registry, chart, version, destination)
+	}
+
+	return service.downloadChart(repo, chart, version, destination)
+}
+
+// UninstallChart uninstalls the helm release from a remote cluster using the provided kubeconfig
+// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+// namespace: Mandatory. the namespace the helm chart is installed to
+// name: Mandaory. the name of the helm chart release
+// options: Mandaory. the options to uninstall the helm release with, e.g. whether the hooks run and the timeout
+// Returns error if something goes wrong
+func (service *helmHelper) UninstallChart(kubeconfig, namespace, name string, options UninstallChartOptions) error {
+	if err := validateReleaseArguments(kubeconfig, namespace, name); err != nil {
+		return err
+	}
+
+	if options.Timeout < 0 {
+		return commonErrors.NewArgumentError("options", "timeout cannot be negative")
+	}
+
+	actionConfig, err := service.newActionConfiguration(kubeconfig, namespace)
 	if err != nil {
 		return err
 	}
 
-	p := getter.All(service.settings)
-	valueOpts := &values.Options{}
-	vals, err := valueOpts.MergeValues(p)
+	client := action.NewUninstall(actionConfig)
+	client.DisableHooks = options.DisableHooks
+	client.Timeout = getTimeout(options.Timeout)
+
+	if _, err = client.Run(name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RollbackRelease rolls the helm release on a remote cluster back to one of its previous revisions
+// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+// namespace: Mandatory. the namespace the helm chart is installed to
+// name: Mandaory. the name of the helm chart release
+// revision: Optional. the revision to roll back to, the previous revision if zero
+// Returns error if something goes wrong
+func (service *helmHelper) RollbackRelease(kubeconfig, namespace, name string, revision int) error {
+	if err := validateReleaseArguments(kubeconfig, namespace, name); err != nil {
+		return err
+	}
+
+	if revision < 0 {
+		return commonErrors.NewArgumentError("revision", "revision cannot be negative")
+	}
+
+	actionConfig, err := service.newActionConfiguration(kubeconfig, namespace)
 	if err != nil {
 		return err
 	}
 
-	// Add args
-	if err := strvals.ParseInto(args["set"], vals); err != nil {
-		return errors.Wrap(err, "failed parsing --set data")
+	client := action.NewRollback(actionConfig)
+	client.Version = revision
+
+	return client.Run(name)
+}
+
+// ListReleases lists the latest revision of the helm releases installed on a remote cluster
+// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+// namespace: Optional. the namespace to list the releases in, all namespaces if empty
+// Returns either the helm releases or error if something goes wrong
+func (service *helmHelper) ListReleases(kubeconfig, namespace string) ([]models.HelmRelease, error) {
+	if strings.TrimSpace(kubeconfig) == "" {
+		return nil, commonErrors.NewArgumentError("kubeconfig", "kubeconfig is required")
+	}
+
+	actionConfig, err := service.newActionConfiguration(kubeconfig, namespace)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check chart dependencies to make sure all are present in /charts
-	chartRequested, err := loader.Load(cp)
+	client := action.NewList(actionConfig)
+	client.All = true
+	client.AllNamespaces = namespace == ""
+	client.SetStateMask()
+
+	releases, err := client.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return mapReleases(releases), nil
+}
+
+// GetReleaseHistory returns the revisions of the helm release installed on a remote cluster
+// kubeconfig: Mandatory. string represents the kubeconfig of the remote cluster
+// namespace: Mandatory. the namespace the helm chart is installed to
+// name: Mandaory. the name of the helm chart release
+// Returns either the revisions of the helm release ordered from the oldest to the latest or error if something goes wrong
+func (service *helmHelper) GetReleaseHistory(kubeconfig, namespace, name string) ([]models.HelmRelease, error) {
+	if err := validateReleaseArguments(kubeconfig, namespace, name); err != nil {
+		return nil, err
+	}
+
+	actionConfig, err := service.newActionConfiguration(kubeconfig, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	releases, err := action.NewHistory(actionConfig).Run(name)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Version < releases[j].Version
+	})
+
+	return mapReleases(releases), nil
+}
+
+func (service *helmHelper) newActionConfiguration(kubeconfig, namespace string) (*action.Configuration, error) {
+	actionConfig := new(action.Configuration)
+	if err := actionConfig.Init(newKubeconfigClientGetter(namespace, kubeconfig), namespace, os.Getenv("HELM_DRIVER"), service.debug); err != nil {
+		return nil, err
+	}
+
+	return actionConfig, nil
+}
+
+func validateReleaseArguments(kubeconfig, namespace, name string) error {
+	if strings.TrimSpace(kubeconfig) == "" {
+		return commonErrors.NewArgumentError("kubeconfig", "kubeconfig is required")
+	}
+
+	if strings.TrimSpace(namespace) == "" {
+		return commonErrors.NewArgumentError("namespace", "namespace is required")
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return commonErrors.NewArgumentError("name", "name is required")
+	}
+
+	return nil
+}
+
+func mapReleases(releases []*release.Release) []models.HelmRelease {
+	mappedReleases := make([]models.HelmRelease, 0, len(releases))
+	for _, item := range releases {
+		mappedRelease := models.HelmRelease{
+			Name:      item.Name,
+			Namespace: item.Namespace,
+			Revision:  item.Version,
+		}
+
+		if item.Info != nil {
+			mappedRelease.Status = item.Info.Status.String()
+			mappedRelease.Description = item.Info.Description
+			mappedRelease.FirstDeployed = item.Info.FirstDeployed.Time
+			mappedRelease.LastDeployed = item.Info.LastDeployed.Time
+		}
+
+		if item.Chart != nil && item.Chart.Metadata != nil {
+			mappedRelease.ChartName = item.Chart.Metadata.Name
+			mappedRelease.ChartVersion = item.Chart.Metadata.Version
+			mappedRelease.AppVersion = item.Chart.Metadata.AppVersion
+		}
+
+		mappedReleases = append(mappedReleases, mappedRelease)
+	}
+
+	return mappedReleases
+}
+
+func (service *helmHelper) install(kubeconfig, namespace, name, repo, chart string, options InstallChartOptions) error {
+	actionConfig, err := service.newActionConfiguration(kubeconfig, namespace)
 	if err != nil {
 		return err
 	}
 
-	validInstallableChart, err := isChartInstallable(chartRequested)
-	if !validInstallableChart {
+	client := action.NewInstall(actionConfig)
+	client.Namespace = namespace
+	client.CreateNamespace = true
+	client.ReleaseName = name
+	client.Version = options.Version
+	client.Atomic = options.Atomic
+	client.Wait = options.Wait || options.Atomic
+	client.Timeout = getTimeout(options.Timeout)
+	client.DependencyUpdate = options.DependencyUpdate
+
+	chartRequested, err := service.loadChart(&client.ChartPathOptions, repo, chart, options.DependencyUpdate)
+	if err != nil {
 		return err
 	}
 
-	if req := chartRequested.Metadata.Dependencies; req != nil {
-		if err := action.CheckDependencies(chartRequested, req); err != nil {
-			if client.DependencyUpdate {
-				man := &downloader.Manager{
-					Out:              os.Stdout,
-					ChartPath:        cp,
-					Keyring:          client.ChartPathOptions.Keyring,
-					SkipUpdate:       false,
-					Getters:          p,
-					RepositoryConfig: service.settings.RepositoryConfig,
-					RepositoryCache:  service.settings.RepositoryCache,
-				}
-				if err := man.Update(); err != nil {
-					return err
-				}
-			} else {
-				return err
-			}
-		}
+	vals, err := getValues(options)
+	if err != nil {
+		return err
 	}
 
 	if _, err = client.Run(chartRequested, vals); err != nil {
@@ -313,53 +675,289 @@ func (service *helmHelper) install(kubeconfig, namespace, name, repo, chart stri
 	return nil
 }
 
-func (service *helmHelper) upgrade(kubeconfig, namespace, name, repo, chart string, args map[string]string) error {
-	restClient := newKubeconfigClientGetter(namespace, kubeconfig)
-	actionConfig := new(action.Configuration)
-	if err := actionConfig.Init(restClient, namespace, os.Getenv("HELM_DRIVER"), service.debug); err != nil {
+func (service *helmHelper) upgrade(kubeconfig, namespace, name, repo, chart string, options InstallChartOptions) error {
+	actionConfig, err := service.newActionConfiguration(kubeconfig, namespace)
+	if err != nil {
 		return err
 	}
 
 	client := action.NewUpgrade(actionConfig)
 	client.Namespace = namespace
+	client.Version = options.Version
+	client.Atomic = options.Atomic
+	client.Wait = options.Wait || options.Atomic
+	client.Timeout = getTimeout(options.Timeout)
 
-	if client.Version == "" && client.Devel {
-		client.Version = ">0.0.0-0"
+	chartRequested, err := service.loadChart(&client.ChartPathOptions, repo, chart, options.DependencyUpdate)
+	if err != nil {
+		return err
 	}
 
-	cp, err := client.ChartPathOptions.LocateChart(fmt.Sprintf("%s/%s", repo, chart), service.settings)
+	vals, err := getValues(options)
 	if err != nil {
 		return err
 	}
 
-	p := getter.All(service.settings)
-	valueOpts := &values.Options{}
-	vals, err := valueOpts.MergeValues(p)
-	if err != nil {
+	if _, err = client.Run(name, chartRequested, vals); err != nil {
 		return err
 	}
 
-	// Add args
-	if err := strvals.ParseInto(args["set"], vals); err != nil {
-		return errors.Wrap(err, "failed parsing --set data")
+	return nil
+}
+
+// loadChart locates the chart version that satisfies the version of the chart path options and loads it. The charts
+// of the OCI registries are pulled to the repository cache first, and the charts are resolved from the offline chart
+// bundle in offline mode. The missing chart dependencies are downloaded if
+// dependencyUpdate is set, otherwise they fail the load.
+func (service *helmHelper) loadChart(
+	chartPathOptions *action.ChartPathOptions,
+	repo string,
+	chartName string,
+	dependencyUpdate bool) (*chart.Chart, error) {
+	var chartPath string
+	var err error
+
+	if service.offlineBundleDirectory != "" {
+		chartPath, err = service.locateBundleChart(repo, chartName, chartPathOptions.Version)
+	} else if registry, ok := service.getOCIRepository(repo); ok {
+		chartPath, err = service.pullOCIChart(
+			registry,
+			chartName,
+			chartPathOptions.Version,
+			filepath.Join(service.settings.RepositoryCache, "oci"))
+	} else {
+		chartPath, err = service.downloadChart(repo, chartName, chartPathOptions.Version, service.settings.RepositoryCache)
 	}
 
-	// Check chart dependencies to make sure all are present in /charts
-	chartRequested, err := loader.Load(cp)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	chartRequested, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, err
 	}
 
 	validInstallableChart, err := isChartInstallable(chartRequested)
 	if !validInstallableChart {
-		return err
+		return nil, err
 	}
 
-	if _, err = client.Run(name, chartRequested, vals); err != nil {
-		return err
+	if req := chartRequested.Metadata.Dependencies; req != nil {
+		if err := action.CheckDependencies(chartRequested, req); err != nil {
+			if !dependencyUpdate {
+				return nil, err
+			}
+
+			manager := &downloader.Manager{
+				Out:              ioutil.Discard,
+				ChartPath:        chartPath,
+				Keyring:          chartPathOptions.Keyring,
+				SkipUpdate:       false,
+				Getters:          service.getters(),
+				RepositoryConfig: service.settings.RepositoryConfig,
+				RepositoryCache:  service.settings.RepositoryCache,
+			}
+
+			if err := manager.Update(); err != nil {
+				return nil, err
+			}
+
+			// Reload the chart so the downloaded dependencies are included
+			if chartRequested, err = loader.Load(chartPath); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	return nil
+	return chartRequested, nil
+}
+
+// downloadChart downloads the chart archive from the chart repository to the destination directory, authenticating
+// with the credentials of the repository
+func (service *helmHelper) downloadChart(repo, chart, version, destination string) (string, error) {
+	if err := os.MkdirAll(destination, 0755); err != nil {
+		return "", err
+	}
+
+	chartDownloader := downloader.ChartDownloader{
+		Out:              ioutil.Discard,
+		Getters:          service.getters(),
+		RepositoryConfig: service.settings.RepositoryConfig,
+		RepositoryCache:  service.settings.RepositoryCache,
+	}
+
+	chartPath, _, err := chartDownloader.DownloadTo(fmt.Sprintf("%s/%s", repo, chart), version, destination)
+	if err != nil {
+		return "", err
+	}
+
+	return chartPath, nil
+}
+
+// lockRepositoryFile acquires the file lock of the helm repositories file for process synchronization
+// Returns the function that releases the lock or error if something goes wrong
+func (service *helmHelper) lockRepositoryFile() (func(), error) {
+	repoFile := service.settings.RepositoryConfig
+
+	// Ensure the file directory exists as it is required for file locking
+	err := os.MkdirAll(filepath.Dir(repoFile), os.ModePerm)
+	if err != nil && !os.IsExist(err) {
+		return nil, err
+	}
+
+	fileLock := flock.New(strings.Replace(repoFile, filepath.Ext(repoFile), ".lock", 1))
+	lockCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	locked, err := fileLock.TryLockContext(lockCtx, time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	if !locked {
+		return nil, errors.New("failed to lock the helm repositories file")
+	}
+
+	return func() {
+		_ = fileLock.Unlock()
+	}, nil
+}
+
+// readRepositoryFile reads the helm repositories file, an empty file is returned if it does not exist yet
+func (service *helmHelper) readRepositoryFile() (*repo.File, error) {
+	b, err := ioutil.ReadFile(service.settings.RepositoryConfig)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var repositoryFile repo.File
+	if err := yaml.Unmarshal(b, &repositoryFile); err != nil {
+		return nil, err
+	}
+
+	return &repositoryFile, nil
+}
+
+// refreshRepositoryCredentials sets the credentials of the registered chart repositories to the current ones, so
+// the rotated credentials are used from the next update on
+// Returns the registered chart repositories or error if something goes wrong
+func (service *helmHelper) refreshRepositoryCredentials() ([]*repo.Entry, error) {
+	unlock, err := service.lockRepositoryFile()
+	if err != nil {
+		return nil, err
+	}
+
+	defer unlock()
+
+	repositoryFile, err := service.readRepositoryFile()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	for _, entry := range repositoryFile.Repositories {
+		entryChanged, err := service.applyRepositoryCredentials(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		changed = changed || entryChanged
+	}
+
+	if changed {
+		if err = repositoryFile.WriteFile(service.settings.RepositoryConfig, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	return repositoryFile.Repositories, nil
+}
+
+// getValues returns the values the chart is installed with. The values documents are merged first, then the set,
+// set-string and set-file expressions are applied on top of them in that order, the same way the helm CLI does.
+func getValues(options InstallChartOptions) (map[string]interface{}, error) {
+	vals := map[string]interface{}{}
+	for _, document := range options.Values {
+		documentValues, err := chartutil.ReadValues([]byte(document))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed parsing values data")
+		}
+
+		vals = mergeValues(vals, documentValues)
+	}
+
+	for _, expression := range options.Set {
+		if err := strvals.ParseInto(expression, vals); err != nil {
+			return nil, errors.Wrap(err, "failed parsing --set data")
+		}
+	}
+
+	for _, expression := range options.SetString {
+		if err := strvals.ParseIntoString(expression, vals); err != nil {
+			return nil, errors.Wrap(err, "failed parsing --set-string data")
+		}
+	}
+
+	keys := make([]string, 0, len(options.SetFile))
+	for key := range options.SetFile {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		content := options.SetFile[key]
+
+		// The value after the equal sign is ignored as the reader returns the provided file content
+		if err := strvals.ParseIntoFile(key+"=-", vals, func([]rune) (interface{}, error) {
+			return content, nil
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed parsing --set-file data")
+		}
+	}
+
+	return vals, nil
+}
+
+// mergeValues merges the source values into the destination values, the source values override the destination values
+func mergeValues(destination, source map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(destination))
+	for key, value := range destination {
+		merged[key] = value
+	}
+
+	for key, value := range source {
+		if sourceMap, ok := value.(map[string]interface{}); ok {
+			if destinationMap, ok := merged[key].(map[string]interface{}); ok {
+				merged[key] = mergeValues(destinationMap, sourceMap)
+
+				continue
+			}
+		}
+
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// getModificationTime returns the time the file was last modified, zero if the file does not exist
+func getModificationTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
+
+func getTimeout(timeout time.Duration) time.Duration {
+	if timeout == 0 {
+		return DefaultTimeout
+	}
+
+	return timeout
 }
 
 func (service *helmHelper) debug(format string, v ...interface{}) {
@@ -0,0 +1,850 @@
+package helm_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/registrytest"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/repo"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestHelmHelperService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Helm Helper Service Tests")
+}
+
+var _ = Describe("Helm Helper Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		logger                   *zap.Logger
+		directory                string
+		registryConfigPath       string
+		registry                 *registrytest.Registry
+		username                 string
+		password                 string
+		offlineBundlePath        string
+		credentialsFilePath      string
+		updateConcurrency        int
+		updateTimeout            time.Duration
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+
+		var err error
+		directory, err = ioutil.TempDir("", "helm-helper")
+		Ω(err).Should(BeNil())
+
+		// Isolate the helm repositories of the tests from the local helm configuration
+		Ω(os.Setenv("HELM_REPOSITORY_CONFIG", filepath.Join(directory, "repositories.yaml"))).Should(BeNil())
+		Ω(os.Setenv("HELM_REPOSITORY_CACHE", filepath.Join(directory, "cache"))).Should(BeNil())
+
+		username = cuid.New()
+		password = cuid.New()
+		registry = registrytest.NewRegistry(username, password)
+
+		registryConfigPath = filepath.Join(directory, "config.json")
+		writeRegistryConfig(registryConfigPath, registry.Host(), username, password)
+
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetHelmRegistryConfigPath().Return(registryConfigPath, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetHelmRegistryPlainHTTP().Return(true, nil).AnyTimes()
+
+		offlineBundlePath = ""
+		mockConfigurationService.
+			EXPECT().
+			GetHelmOfflineBundlePath().
+			DoAndReturn(func() (string, error) {
+				return offlineBundlePath, nil
+			}).
+			AnyTimes()
+
+		credentialsFilePath = ""
+		mockConfigurationService.
+			EXPECT().
+			GetHelmRepositoryCredentialsFilePath().
+			DoAndReturn(func() (string, error) {
+				return credentialsFilePath, nil
+			}).
+			AnyTimes()
+		mockConfigurationService.EXPECT().GetHelmRepositoryCredentialsNamespace().Return("edge-cluster", nil).AnyTimes()
+
+		updateConcurrency = 2
+		mockConfigurationService.
+			EXPECT().
+			GetHelmRepositoryUpdateConcurrency().
+			DoAndReturn(func() (int, error) {
+				return updateConcurrency, nil
+			}).
+			AnyTimes()
+
+		updateTimeout = time.Minute
+		mockConfigurationService.
+			EXPECT().
+			GetHelmRepositoryUpdateTimeout().
+			DoAndReturn(func() (time.Duration, error) {
+				return updateTimeout, nil
+			}).
+			AnyTimes()
+
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+		registry.Close()
+		_ = os.Unsetenv("KUBECONFIG")
+		_ = os.Unsetenv("HELM_REPOSITORY_CONFIG")
+		_ = os.Unsetenv("HELM_REPOSITORY_CACHE")
+		_ = os.RemoveAll(directory)
+	})
+
+	Context("user tries to instantiate HelmHelperService", func() {
+		When("logger is not provided and NewHelmHelperService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := helm.NewHelmHelperService(nil, mockConfigurationService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("logger", "", err)
+			})
+		})
+
+		When("configuration service is not provided and NewHelmHelperService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := helm.NewHelmHelperService(logger, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("the helm repository update concurrency is less than one", func() {
+			It("should return ArgumentError", func() {
+				updateConcurrency = 0
+
+				service, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the offline chart bundle does not exist", func() {
+			It("should return error", func() {
+				offlineBundlePath = filepath.Join(directory, cuid.New())
+
+				service, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+	})
+
+	Context("the charts are exported into an offline chart bundle", func() {
+		var (
+			bundlePath  string
+			destination string
+		)
+
+		BeforeEach(func() {
+			Ω(registry.PushChart("charts/nginx", packageChart(directory, "nginx", "1.2.3"))).Should(BeNil())
+			Ω(registry.PushChart("charts/nginx", packageChart(directory, "nginx", "1.3.0"))).Should(BeNil())
+			Ω(registry.PushChart("charts/redis", packageChart(directory, "redis", "6.0.0"))).Should(BeNil())
+
+			bundlePath = filepath.Join(directory, "bundle", "charts.tgz")
+			destination = filepath.Join(directory, "pulled")
+
+			service, _ := helm.NewHelmHelperService(logger, mockConfigurationService)
+			Ω(service.ExportBundle([]helm.BundleChart{
+				{RepositoryName: "oci-charts", RepositoryURL: registry.Reference("charts"), Chart: "nginx", Version: "1.2.3"},
+				{RepositoryName: "oci-charts", RepositoryURL: registry.Reference("charts"), Chart: "nginx", Version: "1.3.0"},
+				{RepositoryName: "oci-charts", RepositoryURL: registry.Reference("charts"), Chart: "redis", Version: "6.0.0"},
+				{RepositoryName: "oci-charts", RepositoryURL: registry.Reference("charts"), Chart: "redis", Version: "6.0.0"},
+			}, bundlePath)).Should(BeNil())
+
+			// The bundle must be usable without any network access
+			registry.Close()
+		})
+
+		It("should resolve the chart versions from the bundle tarball", func() {
+			offlineBundlePath = bundlePath
+			sut, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+			Ω(err).Should(BeNil())
+
+			chartPath, err := sut.PullChart("oci-charts", "nginx", "", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.3.0")
+
+			chartPath, err = sut.PullChart("oci-charts", "nginx", "~1.2.0", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+
+			chartPath, err = sut.PullChart("oci-charts", "redis", "6.0.0", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "redis", "6.0.0")
+		})
+
+		It("should list the exact chart versions in the bundle manifest", func() {
+			extractedDirectory := filepath.Join(directory, "extracted")
+			extractBundle(bundlePath, extractedDirectory)
+
+			manifest, err := ioutil.ReadFile(filepath.Join(extractedDirectory, helm.BundleManifestFileName))
+			Ω(err).Should(BeNil())
+			Ω(strings.Count(string(manifest), "chart: nginx")).Should(Equal(2))
+			Ω(strings.Count(string(manifest), "chart: redis")).Should(Equal(1))
+
+			offlineBundlePath = extractedDirectory
+			sut, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+			Ω(err).Should(BeNil())
+
+			chartPath, err := sut.PullChart("oci-charts", "nginx", "1.2.3", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should not access the network to add repositories or update the charts in offline mode", func() {
+			offlineBundlePath = bundlePath
+			sut, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+			Ω(err).Should(BeNil())
+
+			Ω(sut.AddRepository(cuid.New(), "https://"+cuid.New()+".invalid")).Should(BeNil())
+			report, err := sut.UpdateCharts()
+			Ω(err).Should(BeNil())
+			Ω(report.Repositories).Should(BeEmpty())
+		})
+
+		It("should return error when the chart version is not in the bundle", func() {
+			offlineBundlePath = bundlePath
+			sut, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+			Ω(err).Should(BeNil())
+
+			_, err = sut.PullChart("oci-charts", "nginx", "2.0.0", destination)
+			Ω(err).Should(HaveOccurred())
+
+			_, err = sut.PullChart(cuid.New(), "nginx", "", destination)
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("the chart is stored in an OCI registry", func() {
+		var (
+			sut         helm.HelmHelperContract
+			destination string
+		)
+
+		BeforeEach(func() {
+			Ω(registry.PushChart("charts/nginx", packageChart(directory, "nginx", "1.2.3"))).Should(BeNil())
+			Ω(registry.PushChart("charts/nginx", packageChart(directory, "nginx", "1.3.0"))).Should(BeNil())
+
+			destination = filepath.Join(directory, "pulled")
+			sut, _ = helm.NewHelmHelperService(logger, mockConfigurationService)
+		})
+
+		It("should pull the chart version using the oci:// reference of the registry", func() {
+			chartPath, err := sut.PullChart(registry.Reference("charts"), "nginx", "1.2.3", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should pull the chart using the name the registry is added with without downloading an index file", func() {
+			Ω(sut.AddRepository("oci-charts", registry.Reference("charts/"))).Should(BeNil())
+			_, err := os.Stat(filepath.Join(directory, "repositories.yaml"))
+			Ω(os.IsNotExist(err)).Should(BeTrue())
+
+			chartPath, err := sut.PullChart("oci-charts", "nginx", "1.3.0", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.3.0")
+		})
+
+		It("should pick up the rotated registry credentials", func() {
+			registry.Close()
+			password = cuid.New()
+			registry = registrytest.NewRegistry(username, password)
+			Ω(registry.PushChart("charts/nginx", packageChart(directory, "nginx", "1.2.3"))).Should(BeNil())
+			writeRegistryConfig(registryConfigPath, registry.Host(), username, password)
+
+			chartPath, err := sut.PullChart(registry.Reference("charts"), "nginx", "1.2.3", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should return error when the registry credentials are wrong", func() {
+			writeRegistryConfig(registryConfigPath, registry.Host(), username, cuid.New())
+
+			_, err := sut.PullChart(registry.Reference("charts"), "nginx", "1.2.3", destination)
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when the chart version does not exist", func() {
+			_, err := sut.PullChart(registry.Reference("charts"), "nginx", "9.9.9", destination)
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return ArgumentError when the exact chart version is not provided", func() {
+			for _, version := range []string{"", "~1.2.0"} {
+				_, err := sut.PullChart(registry.Reference("charts"), "nginx", version, destination)
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			}
+		})
+
+		It("should return ArgumentError when the OCI registry reference has no host", func() {
+			err := sut.AddRepository("oci-charts", helm.OCIScheme)
+			Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+		})
+	})
+
+	Context("the chart is stored in a private chart repository", func() {
+		var (
+			sut                 helm.HelmHelperContract
+			destination         string
+			repositoryDirectory string
+			server              *httptest.Server
+		)
+
+		BeforeEach(func() {
+			repositoryDirectory = filepath.Join(directory, "chart-repository")
+			Ω(os.MkdirAll(repositoryDirectory, 0755)).Should(BeNil())
+			packageChart(repositoryDirectory, "nginx", "1.2.3")
+			packageChart(repositoryDirectory, "nginx", "1.3.0")
+
+			index, err := repo.IndexDirectory(repositoryDirectory, "")
+			Ω(err).Should(BeNil())
+			Ω(index.WriteFile(filepath.Join(repositoryDirectory, "index.yaml"), 0644)).Should(BeNil())
+
+			credentialsFilePath = filepath.Join(directory, "credentials.yaml")
+			destination = filepath.Join(directory, "pulled")
+			sut, _ = helm.NewHelmHelperService(logger, mockConfigurationService)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should authenticate with the basic authentication credentials", func() {
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(request *http.Request) bool {
+				requestUsername, requestPassword, ok := request.BasicAuth()
+
+				return ok && requestUsername == username && requestPassword == password
+			}))
+			writeRepositoryCredentials(credentialsFilePath, server.URL, fmt.Sprintf("username: %s\n    password: %s", username, password))
+
+			Ω(sut.AddRepository("private", server.URL)).Should(BeNil())
+			chartPath, err := sut.PullChart("private", "nginx", "~1.2.0", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should pick up the rotated basic authentication credentials when the charts are updated", func() {
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(request *http.Request) bool {
+				requestUsername, requestPassword, ok := request.BasicAuth()
+
+				return ok && requestUsername == username && requestPassword == password
+			}))
+			writeRepositoryCredentials(credentialsFilePath, server.URL, fmt.Sprintf("username: %s\n    password: %s", username, password))
+			Ω(sut.AddRepository("private", server.URL)).Should(BeNil())
+
+			password = cuid.New()
+			_, err := sut.UpdateCharts()
+			Ω(err).Should(HaveOccurred())
+
+			writeRepositoryCredentials(credentialsFilePath, server.URL, fmt.Sprintf("username: %s\n    password: %s", username, password))
+			_, err = sut.UpdateCharts()
+			Ω(err).Should(BeNil())
+
+			chartPath, err := sut.PullChart("private", "nginx", "", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.3.0")
+		})
+
+		It("should not send the credentials to a URL other than the one they are configured for", func() {
+			requested := false
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				requested = true
+
+				return true
+			}))
+			writeRepositoryCredentials(credentialsFilePath, "http://"+cuid.New()+".example.com", "bearerToken: "+cuid.New())
+
+			Ω(sut.AddRepository("private", server.URL)).Should(HaveOccurred())
+			Ω(requested).Should(BeFalse())
+		})
+
+		It("should return error when the credentials do not specify the URL they are sent to", func() {
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				return true
+			}))
+			Ω(ioutil.WriteFile(
+				credentialsFilePath,
+				[]byte("repositories:\n  - name: private\n    bearerToken: "+cuid.New()+"\n"),
+				0600)).Should(BeNil())
+
+			Ω(sut.AddRepository("private", server.URL)).Should(HaveOccurred())
+		})
+
+		It("should return error when the repository requires credentials that are not provided", func() {
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(request *http.Request) bool {
+				_, _, ok := request.BasicAuth()
+
+				return ok
+			}))
+
+			Ω(sut.AddRepository("private", server.URL)).Should(HaveOccurred())
+		})
+
+		It("should authenticate with the bearer token", func() {
+			token := cuid.New()
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(request *http.Request) bool {
+				return request.Header.Get("Authorization") == "Bearer "+token
+			}))
+			writeRepositoryCredentials(credentialsFilePath, server.URL, "bearerToken: "+token)
+
+			Ω(sut.AddRepository("private", server.URL)).Should(BeNil())
+			chartPath, err := sut.PullChart("private", "nginx", "1.2.3", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should verify the repository server certificate with the CA bundle", func() {
+			server = httptest.NewTLSServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				return true
+			}))
+
+			Ω(sut.AddRepository("private", server.URL)).Should(HaveOccurred())
+
+			caFile := filepath.Join(directory, "ca.crt")
+			Ω(ioutil.WriteFile(caFile, encodeCertificate(server.Certificate().Raw), 0600)).Should(BeNil())
+			writeRepositoryCredentials(credentialsFilePath, server.URL, "caFile: "+caFile)
+
+			Ω(sut.AddRepository("private", server.URL)).Should(BeNil())
+			chartPath, err := sut.PullChart("private", "nginx", "1.2.3", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should skip verifying the repository server certificate if requested", func() {
+			server = httptest.NewTLSServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				return true
+			}))
+			writeRepositoryCredentials(credentialsFilePath, server.URL, "insecureSkipTLSVerify: true")
+
+			Ω(sut.AddRepository("private", server.URL)).Should(BeNil())
+			chartPath, err := sut.PullChart("private", "nginx", "1.2.3", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.2.3")
+		})
+
+		It("should authenticate with the client certificate read from the kubernetes secret", func() {
+			clientCertificate, clientKey := generateClientCertificate()
+			clientCAs := x509.NewCertPool()
+			Ω(clientCAs.AppendCertsFromPEM(clientCertificate)).Should(BeTrue())
+
+			server = httptest.NewUnstartedServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				return true
+			}))
+			server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+			server.StartTLS()
+
+			apiServer := httptest.NewServer(newSecretHandler("edge-cluster", "chartmuseum", map[string][]byte{
+				helm.SecretCertKey: clientCertificate,
+				helm.SecretKeyKey:  clientKey,
+				helm.SecretCAKey:   encodeCertificate(server.Certificate().Raw),
+			}))
+			defer apiServer.Close()
+
+			writeKubeconfig(filepath.Join(directory, "kubeconfig"), apiServer.URL)
+			writeRepositoryCredentials(credentialsFilePath, server.URL, "secretName: chartmuseum")
+
+			Ω(sut.AddRepository("private", server.URL)).Should(BeNil())
+			chartPath, err := sut.PullChart("private", "nginx", "1.3.0", destination)
+			Ω(err).Should(BeNil())
+			assertChart(chartPath, "nginx", "1.3.0")
+		})
+
+		It("should return error when the kubernetes secret does not exist", func() {
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				return true
+			}))
+
+			apiServer := httptest.NewServer(newSecretHandler("edge-cluster", "chartmuseum", map[string][]byte{}))
+			defer apiServer.Close()
+
+			writeKubeconfig(filepath.Join(directory, "kubeconfig"), apiServer.URL)
+			writeRepositoryCredentials(credentialsFilePath, server.URL, "secretName: "+cuid.New())
+
+			Ω(sut.AddRepository("private", server.URL)).Should(HaveOccurred())
+		})
+	})
+
+	Context("the charts are updated from several chart repositories", func() {
+		var (
+			sut                 helm.HelmHelperContract
+			repositoryDirectory string
+			servers             []*httptest.Server
+			hang                chan struct{}
+			hanging             int32
+		)
+
+		BeforeEach(func() {
+			repositoryDirectory = filepath.Join(directory, "chart-repository")
+			Ω(os.MkdirAll(repositoryDirectory, 0755)).Should(BeNil())
+			packageChart(repositoryDirectory, "nginx", "1.2.3")
+
+			index, err := repo.IndexDirectory(repositoryDirectory, "")
+			Ω(err).Should(BeNil())
+			Ω(index.WriteFile(filepath.Join(repositoryDirectory, "index.yaml"), 0644)).Should(BeNil())
+
+			hang = make(chan struct{})
+			atomic.StoreInt32(&hanging, 0)
+			servers = []*httptest.Server{
+				httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+					return true
+				})),
+				httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+					if atomic.LoadInt32(&hanging) == 1 {
+						<-hang
+					}
+
+					return true
+				})),
+				httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+					return atomic.LoadInt32(&hanging) == 0
+				})),
+			}
+
+			updateTimeout = time.Second
+			sut, _ = helm.NewHelmHelperService(logger, mockConfigurationService)
+
+			Ω(sut.AddRepository("c-healthy", servers[0].URL)).Should(BeNil())
+			Ω(sut.AddRepository("a-hanging", servers[1].URL)).Should(BeNil())
+			Ω(sut.AddRepository("b-unauthorized", servers[2].URL)).Should(BeNil())
+			atomic.StoreInt32(&hanging, 1)
+		})
+
+		AfterEach(func() {
+			close(hang)
+
+			for _, server := range servers {
+				server.Close()
+			}
+		})
+
+		It("should report the result of every chart repository without waiting for the hanging one", func() {
+			startTime := time.Now()
+			report, err := sut.UpdateCharts()
+			Ω(err).Should(HaveOccurred())
+			Ω(time.Since(startTime)).Should(BeNumerically("<", 30*time.Second))
+
+			Ω(report.Repositories).Should(HaveLen(3))
+			Ω(report.Repositories[0].Name).Should(Equal("a-hanging"))
+			Ω(report.Repositories[0].URL).Should(Equal(servers[1].URL))
+			Ω(report.Repositories[0].Err).Should(HaveOccurred())
+			Ω(report.Repositories[1].Name).Should(Equal("b-unauthorized"))
+			Ω(report.Repositories[1].Err).Should(HaveOccurred())
+			Ω(report.Repositories[2].Name).Should(Equal("c-healthy"))
+			Ω(report.Repositories[2].Err).Should(BeNil())
+			Ω(report.Failed()).Should(HaveLen(2))
+
+			Ω(err.Error()).Should(ContainSubstring("2 of 3"))
+			Ω(err.Error()).Should(ContainSubstring("a-hanging"))
+			Ω(err.Error()).Should(ContainSubstring("b-unauthorized"))
+		})
+
+		It("should not return error when all the chart repositories are updated", func() {
+			atomic.StoreInt32(&hanging, 0)
+
+			report, err := sut.UpdateCharts()
+			Ω(err).Should(BeNil())
+			Ω(report.Repositories).Should(HaveLen(3))
+			Ω(report.Failed()).Should(BeEmpty())
+			Ω(report.StartTime.IsZero()).Should(BeFalse())
+		})
+	})
+
+	Context("the chart repositories are managed at runtime", func() {
+		var (
+			sut    helm.HelmHelperContract
+			server *httptest.Server
+		)
+
+		BeforeEach(func() {
+			repositoryDirectory := filepath.Join(directory, "chart-repository")
+			Ω(os.MkdirAll(repositoryDirectory, 0755)).Should(BeNil())
+			packageChart(repositoryDirectory, "nginx", "1.2.3")
+
+			index, err := repo.IndexDirectory(repositoryDirectory, "")
+			Ω(err).Should(BeNil())
+			Ω(index.WriteFile(filepath.Join(repositoryDirectory, "index.yaml"), 0644)).Should(BeNil())
+
+			server = httptest.NewServer(newChartRepositoryHandler(repositoryDirectory, func(*http.Request) bool {
+				return true
+			}))
+
+			sut, _ = helm.NewHelmHelperService(logger, mockConfigurationService)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should list the chart repositories and the OCI registries ordered by their names", func() {
+			Ω(sut.AddRepository("stable", server.URL)).Should(BeNil())
+			Ω(sut.AddRepository("oci-charts", registry.Reference("charts"))).Should(BeNil())
+
+			repositories, err := sut.ListRepositories()
+			Ω(err).Should(BeNil())
+			Ω(repositories).Should(HaveLen(2))
+
+			Ω(repositories[0].Name).Should(Equal("oci-charts"))
+			Ω(repositories[0].LastIndexRefreshTime.IsZero()).Should(BeTrue())
+
+			Ω(repositories[1].Name).Should(Equal("stable"))
+			Ω(repositories[1].URL).Should(Equal(server.URL))
+			Ω(repositories[1].LastIndexRefreshTime.IsZero()).Should(BeFalse())
+		})
+
+		It("should keep the OCI registries when the service is restarted", func() {
+			Ω(sut.AddRepository("oci-charts", registry.Reference("charts"))).Should(BeNil())
+
+			restarted, err := helm.NewHelmHelperService(logger, mockConfigurationService)
+			Ω(err).Should(BeNil())
+
+			repositories, err := restarted.ListRepositories()
+			Ω(err).Should(BeNil())
+			Ω(repositories).Should(HaveLen(1))
+			Ω(repositories[0].Name).Should(Equal("oci-charts"))
+		})
+
+		It("should remove the chart repository together with its cached index", func() {
+			Ω(sut.AddRepository("stable", server.URL)).Should(BeNil())
+			indexPath := filepath.Join(directory, "cache", "stable-index.yaml")
+			_, err := os.Stat(indexPath)
+			Ω(err).Should(BeNil())
+
+			Ω(sut.RemoveRepository("stable")).Should(BeNil())
+			_, err = os.Stat(indexPath)
+			Ω(os.IsNotExist(err)).Should(BeTrue())
+
+			repositories, err := sut.ListRepositories()
+			Ω(err).Should(BeNil())
+			Ω(repositories).Should(BeEmpty())
+		})
+
+		It("should remove the OCI registry", func() {
+			Ω(sut.AddRepository("oci-charts", registry.Reference("charts"))).Should(BeNil())
+			Ω(sut.RemoveRepository("oci-charts")).Should(BeNil())
+
+			repositories, err := sut.ListRepositories()
+			Ω(err).Should(BeNil())
+			Ω(repositories).Should(BeEmpty())
+		})
+
+		It("should return NotFoundError when the repository does not exist", func() {
+			Ω(commonErrors.IsNotFoundError(sut.RemoveRepository(cuid.New()))).Should(BeTrue())
+		})
+
+		It("should return ArgumentError when the repository name is not provided", func() {
+			Ω(commonErrors.IsArgumentError(sut.RemoveRepository(""))).Should(BeTrue())
+		})
+	})
+})
+
+func packageChart(directory, name, version string) []byte {
+	chartPath, err := chartutil.Save(&chart.Chart{
+		Metadata: &chart.Metadata{
+			APIVersion: chart.APIVersionV2,
+			Name:       name,
+			Version:    version,
+		},
+	}, directory)
+	Ω(err).Should(BeNil())
+
+	chartArchive, err := ioutil.ReadFile(chartPath)
+	Ω(err).Should(BeNil())
+
+	return chartArchive
+}
+
+func writeRegistryConfig(registryConfigPath, host, username, password string) {
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	content := fmt.Sprintf(`{"auths": {"%s": {"auth": "%s"}}}`, host, auth)
+
+	Ω(ioutil.WriteFile(registryConfigPath, []byte(content), 0600)).Should(BeNil())
+}
+
+func assertChart(chartPath, expectedName, expectedVersion string) {
+	loadedChart, err := loader.Load(chartPath)
+	Ω(err).Should(BeNil())
+	Ω(loadedChart.Metadata.Name).Should(Equal(expectedName))
+	Ω(loadedChart.Metadata.Version).Should(Equal(expectedVersion))
+}
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
+
+func extractBundle(bundlePath, directory string) {
+	file, err := os.Open(bundlePath)
+	Ω(err).Should(BeNil())
+
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(file)
+	Ω(err).Should(BeNil())
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return
+		}
+
+		Ω(err).Should(BeNil())
+
+		path := filepath.Join(directory, header.Name)
+		Ω(os.MkdirAll(filepath.Dir(path), 0755)).Should(BeNil())
+
+		content, err := ioutil.ReadAll(tarReader)
+		Ω(err).Should(BeNil())
+		Ω(ioutil.WriteFile(path, content, 0644)).Should(BeNil())
+	}
+}
+
+func writeRepositoryCredentials(credentialsFilePath, repositoryURL, credentials string) {
+	content := fmt.Sprintf("repositories:\n  - name: private\n    url: %s\n    %s\n", repositoryURL, credentials)
+
+	Ω(ioutil.WriteFile(credentialsFilePath, []byte(content), 0600)).Should(BeNil())
+}
+
+// newChartRepositoryHandler serves the chart repository directory, rejecting the requests that are not authorized
+func newChartRepositoryHandler(repositoryDirectory string, authorize func(*http.Request) bool) http.Handler {
+	fileServer := http.FileServer(http.Dir(repositoryDirectory))
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !authorize(request) {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		fileServer.ServeHTTP(writer, request)
+	})
+}
+
+// newSecretHandler serves the secret the same way the kubernetes API server does
+func newSecretHandler(namespace, name string, data map[string][]byte) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", namespace, name) {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		content, err := json.Marshal(v1.Secret{
+			TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Data:       data,
+		})
+		Ω(err).Should(BeNil())
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write(content)
+	})
+}
+
+func writeKubeconfig(kubeconfigPath, server string) {
+	content := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+  - name: test
+    cluster:
+      server: %s
+contexts:
+  - name: test
+    context:
+      cluster: test
+      user: test
+current-context: test
+users:
+  - name: test
+    user: {}
+`, server)
+
+	Ω(ioutil.WriteFile(kubeconfigPath, []byte(content), 0600)).Should(BeNil())
+	Ω(os.Setenv("KUBECONFIG", kubeconfigPath)).Should(BeNil())
+}
+
+func generateClientCertificate() ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Ω(err).Should(BeNil())
+
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "edge-cluster"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	Ω(err).Should(BeNil())
+
+	keyContent, err := x509.MarshalECPrivateKey(key)
+	Ω(err).Should(BeNil())
+
+	return encodeCertificate(certificate), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyContent})
+}
+
+func encodeCertificate(certificate []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+}
@@ -0,0 +1 @@
+package k0s_test
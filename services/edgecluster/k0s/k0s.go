@@ -0,0 +1,486 @@
+// Package k0s provides functionality to provision a K0S edge cluster type and manage them
+package k0s
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/registry"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	containerName        = "k0scontroller"
+	k0sPort              = 6443
+	internalName         = "k0s"
+	configMapName        = "k0s-config"
+	configVolumeName     = "config"
+	configDirectoryPath  = "/etc/k0s"
+	configFileName       = "k0s.yaml"
+	dataVolumeName       = "data"
+	dataDirectoryPath    = "/var/lib/k0s"
+	kubeconfigFilePath   = "/var/lib/k0s/pki/admin.conf"
+	configHashAnnotation = "edge-cluster.decentralized-cloud.io/k0s-config-hash"
+)
+
+var privileged = true
+
+func init() {
+	registry.RegisterProvisioner(
+		models.ClusterTypeDescriptor{
+			ClusterType: models.K0S,
+			Name:        "K0S",
+			SupportedFeatures: []string{
+				registry.FeatureKubeconfig,
+				registry.FeatureHelmCharts,
+				registry.FeatureListNodes,
+				registry.FeatureListPods,
+				registry.FeatureListServices,
+			},
+		},
+		func(dependencies registry.ProvisionerDependencies) (types.EdgeClusterProvisionerContract, error) {
+			return NewK0SProvisioner(
+				dependencies.Logger,
+				dependencies.K8sRestConfig,
+				dependencies.ConfigurationService,
+				dependencies.HelmService,
+				dependencies.EventBus,
+				dependencies.ChartCatalogue,
+				dependencies.KubeconfigCache)
+		})
+}
+
+type k0sProvisioner struct {
+	logger         *zap.Logger
+	clientset      *kubernetes.Clientset
+	k0sDockerImage string
+	controlPlane   *provision.ControlPlane
+}
+
+// NewK0SProvisioner creates new instance of the k0sProvisioner, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// k8sRestConfig: Mandatory. Reference to the Rest config points to the running K8S cluster
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// helmService: Mandatory. Reference to the service that installs the helm charts on the provisioned edge cluster
+// eventBus: Mandatory. Reference to the event bus the provisioning events are published to
+// chartCatalogue: Mandatory. Reference to the service that provides the helm charts installed on the edge cluster
+// kubeconfigCache: Mandatory. Reference to the service that caches the kubeconfig of the edge cluster
+// Returns the new service or error if something goes wrong
+func NewK0SProvisioner(
+	logger *zap.Logger,
+	k8sRestConfig *rest.Config,
+	configurationService configuration.ConfigurationContract,
+	helmService helm.HelmHelperContract,
+	eventBus event.EventBusContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigCache kubeconfig.KubeconfigCacheContract) (types.EdgeClusterProvisionerContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if k8sRestConfig == nil {
+		return nil, commonErrors.NewArgumentNilError("k8sRestConfig", "k8sRestConfig is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	k0sDockerImage, err := configurationService.GetK0SDockerImage()
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to get the K0S docker image", err)
+	}
+
+	var clientset *kubernetes.Clientset
+	if clientset, err = kubernetes.NewForConfig(k8sRestConfig); err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to create client set", err)
+	}
+
+	controlPlane, err := provision.NewControlPlane(
+		logger,
+		clientset,
+		k8sRestConfig,
+		helmService,
+		eventBus,
+		chartCatalogue,
+		kubeconfigCache,
+		provision.ControlPlaneSpec{
+			ClusterTypeName:    "K0S",
+			Name:               internalName,
+			ContainerName:      containerName,
+			KubeconfigFilePath: kubeconfigFilePath,
+			ResolveEndpoint:    resolveEndpoint,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &k0sProvisioner{
+		logger:         logger,
+		clientset:      clientset,
+		k0sDockerImage: k0sDockerImage,
+		controlPlane:   controlPlane,
+	}, nil
+}
+
+// CreateProvision provisions a new edge cluster.
+// K0S generates its own join tokens, so the cluster secret of the edge cluster is not used.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to provision a new edge cluster
+// Returns either the result of provisioning new edge cluster or error if something goes wrong.
+func (service *k0sProvisioner) CreateProvision(
+	ctx context.Context,
+	request *types.CreateProvisionRequest) (*types.CreateProvisionResponse, error) {
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.K0S)
+
+	return service.controlPlane.CreateProvision(ctx, request, ownership, func(namespace string) error {
+		if err := service.controlPlane.CreateService(ctx, getServiceConfig(namespace, ownership)); err != nil {
+			return err
+		}
+
+		template, err := service.getPodTemplateSpec(ctx, request.EdgeClusterID, ownership)
+		if err != nil {
+			return err
+		}
+
+		return service.controlPlane.CreateDeployment(
+			ctx,
+			provision.GetDeploymentConfig(namespace, internalName, template, ownership))
+	})
+}
+
+// UpdateProvisionWithRetry updates an existing provision.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to update an existing provision
+// Returns either the result of updating an existing provision or error if something goes wrong.
+func (service *k0sProvisioner) UpdateProvisionWithRetry(
+	ctx context.Context,
+	request *types.UpdateProvisionRequest) (*types.UpdateProvisionResponse, error) {
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.K0S)
+
+	return service.controlPlane.UpdateProvision(ctx, request, ownership, func(deployment *appsv1.Deployment) error {
+		template, err := service.getPodTemplateSpec(ctx, request.EdgeClusterID, ownership)
+		if err != nil {
+			return err
+		}
+
+		deployment.Spec.Template = template
+
+		return nil
+	})
+}
+
+// DeleteProvision deletes an existing provision.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete an existing provision
+// Returns either the result of deleting an existing provision or error if something goes wrong.
+func (service *k0sProvisioner) DeleteProvision(
+	ctx context.Context,
+	request *types.DeleteProvisionRequest) (*types.DeleteProvisionResponse, error) {
+	return service.controlPlane.DeleteProvision(ctx, request)
+}
+
+// ReconcileProvision compares the namespace, service, cluster configuration, deployment and helm charts of an existing
+// provision with their desired state and repairs the resources that drifted from it. An event is published for every
+// repaired resource. The helm charts are only reconciled if the K0S controller was not repaired, as it is restarted by
+// the repair.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to reconcile an existing provision
+// Returns either the repaired resources or error if something goes wrong.
+func (service *k0sProvisioner) ReconcileProvision(
+	ctx context.Context,
+	request *types.ReconcileProvisionRequest) (*types.ReconcileProvisionResponse, error) {
+	namespace := provision.GetNamespace(request.EdgeClusterID)
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.K0S)
+
+	return service.controlPlane.ReconcileProvision(ctx, request.EdgeClusterID, func(repaired provision.DriftRepaired) error {
+		if err := provision.ReconcileNamespace(
+			ctx,
+			service.clientset,
+			provision.GetNamespaceConfig(namespace, ownership),
+			repaired); err != nil {
+			service.logger.Error("failed to reconcile the namespace", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		if err := provision.ReconcileService(ctx, service.clientset, getServiceConfig(namespace, ownership), repaired); err != nil {
+			service.logger.Error("failed to reconcile the service", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		advertiseAddress, err := service.controlPlane.WaitForLoadBalancerAddress(ctx, namespace)
+		if err != nil {
+			return err
+		}
+
+		clusterConfig := getClusterConfig(advertiseAddress)
+		if err = provision.ReconcileConfigMap(
+			ctx,
+			service.clientset,
+			getConfigMapConfig(namespace, clusterConfig, ownership),
+			repaired); err != nil {
+			service.logger.Error("failed to reconcile the K0S cluster config", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		if err = provision.ReconcileDeployment(
+			ctx,
+			service.clientset,
+			provision.GetDeploymentConfig(namespace, internalName, service.getPodTemplate(clusterConfig), ownership),
+			repaired); err != nil {
+			service.logger.Error("failed to reconcile the deployment", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// AdoptProvision checks the K0S controller of an existing provision still exists, so its edge cluster can be added
+// back to the repository. The K0S controller does not use a cluster secret, so none is returned.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to adopt an existing provision
+// Returns either the state of the provision or error if something goes wrong.
+func (service *k0sProvisioner) AdoptProvision(
+	ctx context.Context,
+	request *types.AdoptProvisionRequest) (response *types.AdoptProvisionResponse, err error) {
+	namespace := provision.GetNamespace(request.EdgeClusterID)
+
+	if _, err = service.clientset.AppsV1().Deployments(namespace).Get(ctx, internalName, metav1.GetOptions{}); err != nil {
+		service.logger.Error("failed to get the deployment", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	response = &types.AdoptProvisionResponse{}
+
+	return
+}
+
+// GetProvisionDetails retrieves information on an existing provision.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to retrieve information on an existing provision
+// Returns either the result of retrieving information on an provision or error if something goes wrong.
+func (service *k0sProvisioner) GetProvisionDetails(
+	ctx context.Context,
+	request *types.GetProvisionDetailsRequest) (*types.GetProvisionDetailsResponse, error) {
+	return service.controlPlane.GetProvisionDetails(ctx, request)
+}
+
+// ListNodes lists an existing edge cluster nodes details
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list an existing edge cluster nodes details
+// Returns an existing edge cluster nodes details or error if something goes wrong.
+func (service *k0sProvisioner) ListNodes(
+	ctx context.Context,
+	request *types.ListNodesRequest) (*types.ListNodesResponse, error) {
+	return service.controlPlane.ListNodes(ctx, request)
+}
+
+// ListPods lists an existing edge cluster pods that matchs the given search criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request that contains the search criteria to filter the pods
+// Returns the list of running pods that matchs the given search criteria or error if something goes wrong.
+func (service *k0sProvisioner) ListPods(
+	ctx context.Context,
+	request *types.ListPodsRequest) (*types.ListPodsResponse, error) {
+	return service.controlPlane.ListPods(ctx, request)
+}
+
+// ListServices lists an existing edge cluster services that matchs the given search criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request that contains the search criteria to filter the services
+// Returns the list of services that matches the given search criteria or error if something goes wrong.
+func (service *k0sProvisioner) ListServices(
+	ctx context.Context,
+	request *types.ListServicesRequest) (*types.ListServicesResponse, error) {
+	return service.controlPlane.ListServices(ctx, request)
+}
+
+// getPodTemplateSpec waits for the load balancer address of the edge cluster, stores the K0S cluster configuration
+// advertising that address and returns the pod template of the K0S controller. The pod template is annotated with
+// the hash of the configuration so the controller is restarted whenever the configuration changes.
+func (service *k0sProvisioner) getPodTemplateSpec(
+	ctx context.Context,
+	edgeClusterID string,
+	ownership models.ProvisionOwnership) (v1.PodTemplateSpec, error) {
+	namespace := provision.GetNamespace(edgeClusterID)
+
+	advertiseAddress, err := service.controlPlane.WaitForLoadBalancerAddress(ctx, namespace)
+	if err != nil {
+		return v1.PodTemplateSpec{}, err
+	}
+
+	service.controlPlane.PublishEvent(
+		ctx,
+		edgeClusterID,
+		models.EdgeClusterEventTypeLoadBalancerAddressAssigned,
+		models.ProvisioningStatusProvisioning,
+		advertiseAddress)
+
+	clusterConfig := getClusterConfig(advertiseAddress)
+	if err = service.createOrUpdateConfigMap(ctx, namespace, clusterConfig, ownership); err != nil {
+		return v1.PodTemplateSpec{}, err
+	}
+
+	return service.getPodTemplate(clusterConfig), nil
+}
+
+// getPodTemplate returns the pod template of the K0S controller that runs with the given cluster configuration
+func (service *k0sProvisioner) getPodTemplate(clusterConfig string) v1.PodTemplateSpec {
+	return v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				configHashAnnotation: fmt.Sprintf("%x", sha256.Sum256([]byte(clusterConfig))),
+			},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name:  containerName,
+					Image: service.k0sDockerImage,
+					Command: []string{
+						"k0s",
+						"controller",
+						"--single",
+						fmt.Sprintf("--config=%s/%s", configDirectoryPath, configFileName),
+					},
+					SecurityContext: &v1.SecurityContext{
+						Privileged: &privileged,
+					},
+					Ports: []v1.ContainerPort{
+						{
+							Name:          internalName,
+							ContainerPort: k0sPort,
+						},
+					},
+					VolumeMounts: []v1.VolumeMount{
+						{Name: configVolumeName, MountPath: configDirectoryPath},
+						{Name: dataVolumeName, MountPath: dataDirectoryPath},
+					},
+				},
+			},
+			Volumes: []v1.Volume{
+				{
+					Name: configVolumeName,
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: v1.LocalObjectReference{Name: configMapName},
+						},
+					},
+				},
+				{
+					Name: dataVolumeName,
+					VolumeSource: v1.VolumeSource{
+						EmptyDir: &v1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (service *k0sProvisioner) createOrUpdateConfigMap(
+	ctx context.Context,
+	namespace string,
+	clusterConfig string,
+	ownership models.ProvisionOwnership) (err error) {
+	client := service.clientset.CoreV1().ConfigMaps(namespace)
+	configMap := getConfigMapConfig(namespace, clusterConfig, ownership)
+
+	if _, err = client.Create(ctx, configMap, metav1.CreateOptions{}); apierrors.IsAlreadyExists(err) {
+		_, err = client.Update(ctx, configMap, metav1.UpdateOptions{})
+	}
+
+	if err != nil {
+		service.logger.Error("failed to store K0S cluster config", zap.Error(err), zap.String("namespace", namespace))
+	}
+
+	return
+}
+
+// getClusterConfig returns the K0S cluster configuration that advertises the API server on the given address
+func getClusterConfig(advertiseAddress string) string {
+	return fmt.Sprintf(`apiVersion: k0s.k0sproject.io/v1beta1
+kind: ClusterConfig
+metadata:
+  name: k0s
+spec:
+  api:
+    externalAddress: %s
+    port: %d
+    sans:
+      - %s
+`, advertiseAddress, k0sPort, advertiseAddress)
+}
+
+// resolveEndpoint returns the load balancer address and port the K0S controller is exposed on, as the admin
+// kubeconfig generated by K0S refers to localhost
+func resolveEndpoint(_ context.Context, _ string, serviceDetails *v1.Service) (string, int32, error) {
+	port := int32(k0sPort)
+	for _, item := range serviceDetails.Spec.Ports {
+		port = item.Port
+	}
+
+	return provision.GetLoadBalancerAddress(serviceDetails), port, nil
+}
+
+// getServiceConfig returns the desired state of the service the K0S controller is exposed on
+func getServiceConfig(namespace string, ownership models.ProvisionOwnership) *v1.Service {
+	return provision.GetServiceConfig(namespace, internalName, k0sPort, k0sPort, v1.ServiceTypeLoadBalancer, ownership)
+}
+
+// getConfigMapConfig returns the desired state of the config map that stores the K0S cluster configuration
+func getConfigMapConfig(
+	namespace string,
+	clusterConfig string,
+	ownership models.ProvisionOwnership) *v1.ConfigMap {
+	configMapConfig := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			configFileName: clusterConfig,
+		},
+	}
+
+	provision.SetOwnership(&configMapConfig.ObjectMeta, ownership)
+
+	return configMapConfig
+}
@@ -0,0 +1,304 @@
+package k3s
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ingressPort = 443
+
+	// sslPassthroughKey is the annotation that makes the NGINX ingress controller pass the TLS connections through to
+	// the K3S server, as the clients authenticate with their client certificates. The ingress controller must be
+	// started with the --enable-ssl-passthrough flag.
+	sslPassthroughKey = "nginx.ingress.kubernetes.io/ssl-passthrough"
+
+	// advertisedEndpointKey is the pod template annotation that records the endpoint the K3S server is advertised on,
+	// so the kubeconfig can point to it. It is not recorded for the edge clusters exposed through a load balancer, as
+	// their endpoint is read from the service.
+	advertisedEndpointKey = "edge-cluster.decentralized-cloud.io/advertised-endpoint"
+)
+
+// advertiseEndpoint is the endpoint the K3S server is reachable on from outside the host cluster
+type advertiseEndpoint struct {
+	address string
+	port    int32
+}
+
+// applyServiceExposure brings the service and the ingress the K3S server is exposed on to the desired state of the
+// given exposure. The ingress is deleted unless the K3S server is exposed through it. The address is verified again
+// before it is applied, as the allowed addresses and the addresses claimed by the other services can change after the
+// edge cluster is created.
+func (service *k3sProvisioner) applyServiceExposure(
+	ctx context.Context,
+	namespace string,
+	serviceExposure models.ServiceExposure,
+	ownership models.ProvisionOwnership,
+	repaired provision.DriftRepaired) (err error) {
+	if err = service.exposurePolicy.VerifyAddress(serviceExposure); err != nil {
+		service.logger.Error("the service exposure is not allowed", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	if err = provision.VerifyServiceExposureClaims(ctx, service.clientset, namespace, serviceExposure); err != nil {
+		service.logger.Error("failed to verify the service exposure", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	if err = provision.ReconcileService(
+		ctx,
+		service.clientset,
+		getServiceConfig(namespace, serviceExposure, ownership),
+		repaired); err != nil {
+		service.logger.Error("failed to apply the service", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	if serviceExposure.Mode == models.ServiceExposureModeIngress {
+		err = provision.ReconcileIngress(ctx, service.clientset, getIngressConfig(namespace, serviceExposure, ownership), repaired)
+	} else {
+		err = provision.DeleteIngress(ctx, service.clientset, namespace, internalName, repaired)
+	}
+
+	if err != nil {
+		service.logger.Error("failed to apply the ingress", zap.Error(err), zap.String("namespace", namespace))
+	}
+
+	return
+}
+
+// getAdvertiseEndpoint returns the endpoint the K3S server is advertised on for the given exposure:
+// - LoadBalancer: the address assigned to the load balancer, which is waited for
+// - NodePort: the address of a host cluster node and the node port allocated to the service
+// - Ingress: the host name of the ingress and the HTTPS port
+// - ExternalAddress: the external address and the K3S port
+func (service *k3sProvisioner) getAdvertiseEndpoint(
+	ctx context.Context,
+	namespace string,
+	serviceExposure models.ServiceExposure) (advertiseEndpoint, error) {
+	switch serviceExposure.Mode {
+	case models.ServiceExposureModeNodePort:
+		serviceDetails, err := service.controlPlane.GetServiceDetails(ctx, namespace)
+		if err != nil {
+			return advertiseEndpoint{}, err
+		}
+
+		if len(serviceDetails.Spec.Ports) == 0 || serviceDetails.Spec.Ports[0].NodePort == 0 {
+			return advertiseEndpoint{}, types.NewUnknownError("no node port is allocated to the service")
+		}
+
+		address, err := provision.GetNodeAddress(ctx, service.clientset)
+		if err != nil {
+			service.logger.Error("failed to retrieve the node address", zap.Error(err))
+
+			return advertiseEndpoint{}, err
+		}
+
+		return advertiseEndpoint{address: address, port: serviceDetails.Spec.Ports[0].NodePort}, nil
+
+	case models.ServiceExposureModeIngress:
+		return advertiseEndpoint{address: serviceExposure.Address, port: ingressPort}, nil
+
+	case models.ServiceExposureModeExternalAddress:
+		return advertiseEndpoint{address: serviceExposure.Address, port: k3sPort}, nil
+
+	default:
+		address, err := service.controlPlane.WaitForLoadBalancerAddress(ctx, namespace)
+		if err != nil {
+			return advertiseEndpoint{}, err
+		}
+
+		return advertiseEndpoint{address: address, port: k3sPort}, nil
+	}
+}
+
+// getAdvertisedEndpoint returns the endpoint the K3S server running in the given namespace is advertised on
+func (service *k3sProvisioner) getAdvertisedEndpoint(
+	ctx context.Context,
+	namespace string,
+	serviceDetails *v1.Service) (advertiseEndpoint, error) {
+	deployment, err := service.clientset.AppsV1().Deployments(namespace).Get(ctx, internalName, metav1.GetOptions{})
+	if err != nil {
+		service.logger.Error("failed to get the deployment", zap.Error(err), zap.String("namespace", namespace))
+
+		return advertiseEndpoint{}, err
+	}
+
+	endpoint, ok := deployment.Spec.Template.Annotations[advertisedEndpointKey]
+	if !ok {
+		if len(serviceDetails.Spec.Ports) == 0 {
+			return advertiseEndpoint{}, types.NewUnknownError("the service has no port")
+		}
+
+		return advertiseEndpoint{
+			address: provision.GetLoadBalancerAddress(serviceDetails),
+			port:    serviceDetails.Spec.Ports[0].Port,
+		}, nil
+	}
+
+	address, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return advertiseEndpoint{}, types.NewUnknownErrorWithError("failed to parse the advertised endpoint", err)
+	}
+
+	portNumber, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return advertiseEndpoint{}, types.NewUnknownErrorWithError("failed to parse the advertised endpoint", err)
+	}
+
+	return advertiseEndpoint{address: address, port: int32(portNumber)}, nil
+}
+
+// readServiceExposure reads the exposure the K3S server running in the given namespace is provisioned with back from
+// the service, the ingress and the advertised endpoint, so the exposure of an adopted edge cluster is preserved:
+// - NodePort or LoadBalancer: the type of the service
+// - Ingress: the host name of the ingress, if the service is exposed through one
+// - ExternalAddress: the address of the advertised endpoint, as it can be a host name that is not routed to the service
+func (service *k3sProvisioner) readServiceExposure(ctx context.Context, namespace string) (models.ServiceExposure, error) {
+	serviceDetails, err := service.controlPlane.GetServiceDetails(ctx, namespace)
+	if err != nil {
+		return models.ServiceExposure{}, err
+	}
+
+	switch serviceDetails.Spec.Type {
+	case v1.ServiceTypeNodePort:
+		return models.ServiceExposure{Mode: models.ServiceExposureModeNodePort}, nil
+	case v1.ServiceTypeLoadBalancer:
+		return models.ServiceExposure{Mode: models.ServiceExposureModeLoadBalancer}, nil
+	}
+
+	ingress, err := service.clientset.NetworkingV1().Ingresses(namespace).Get(ctx, internalName, metav1.GetOptions{})
+	if err == nil && len(ingress.Spec.Rules) > 0 {
+		return models.ServiceExposure{Mode: models.ServiceExposureModeIngress, Address: ingress.Spec.Rules[0].Host}, nil
+	}
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		service.logger.Error("failed to get the ingress", zap.Error(err), zap.String("namespace", namespace))
+
+		return models.ServiceExposure{}, err
+	}
+
+	endpoint, err := service.getAdvertisedEndpoint(ctx, namespace, serviceDetails)
+	if err != nil {
+		return models.ServiceExposure{}, err
+	}
+
+	return models.ServiceExposure{Mode: models.ServiceExposureModeExternalAddress, Address: endpoint.address}, nil
+}
+
+// getAdvertiseArgs returns the arguments of the K3S server that advertise it on the given endpoint. K3S only accepts
+// an IP as the advertise address, so the host names are only added to the certificate of the K3S server.
+func getAdvertiseArgs(serviceExposure models.ServiceExposure, endpoint advertiseEndpoint) []string {
+	if net.ParseIP(endpoint.address) == nil {
+		return []string{fmt.Sprintf("--tls-san=%s", endpoint.address)}
+	}
+
+	args := []string{fmt.Sprintf("--advertise-address=%s", endpoint.address)}
+	if serviceExposure.Mode == models.ServiceExposureModeNodePort {
+		args = append(args, fmt.Sprintf("--advertise-port=%d", endpoint.port))
+	}
+
+	return args
+}
+
+// getServiceType returns the type of the service the K3S server is exposed on for the given exposure mode
+func getServiceType(mode models.ServiceExposureMode) v1.ServiceType {
+	switch mode {
+	case models.ServiceExposureModeNodePort:
+		return v1.ServiceTypeNodePort
+	case models.ServiceExposureModeIngress, models.ServiceExposureModeExternalAddress:
+		return v1.ServiceTypeClusterIP
+	default:
+		return v1.ServiceTypeLoadBalancer
+	}
+}
+
+// getExternalIPs returns the external IPs of the service the K3S server is exposed on. Only an external address that
+// is an IP is routed to the service, the host names are expected to be routed by the owner of the edge cluster.
+func getExternalIPs(serviceExposure models.ServiceExposure) []string {
+	if serviceExposure.Mode != models.ServiceExposureModeExternalAddress || net.ParseIP(serviceExposure.Address) == nil {
+		return nil
+	}
+
+	return []string{serviceExposure.Address}
+}
+
+// getIngressConfig returns the desired state of the ingress that passes the TLS connections to the host name of the
+// edge cluster through to the K3S server
+func getIngressConfig(
+	namespace string,
+	serviceExposure models.ServiceExposure,
+	ownership models.ProvisionOwnership) *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	ingressConfig := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      internalName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"k8s-app": internalName,
+			},
+			Annotations: map[string]string{
+				sslPassthroughKey: "true",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: serviceExposure.Address,
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{
+									Path:     "/",
+									PathType: &pathType,
+									Backend: networkingv1.IngressBackend{
+										Service: &networkingv1.IngressServiceBackend{
+											Name: internalName,
+											Port: networkingv1.ServiceBackendPort{Number: k3sPort},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	provision.SetOwnership(&ingressConfig.ObjectMeta, ownership)
+
+	return ingressConfig
+}
+
+// setAdvertisedEndpoint records the endpoint the K3S server is advertised on on its pod template
+func setAdvertisedEndpoint(
+	template *v1.PodTemplateSpec,
+	serviceExposure models.ServiceExposure,
+	endpoint advertiseEndpoint) {
+	if serviceExposure.Mode == models.ServiceExposureModeLoadBalancer {
+		delete(template.Annotations, advertisedEndpointKey)
+
+		return
+	}
+
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+
+	template.Annotations[advertisedEndpointKey] = net.JoinHostPort(endpoint.address, strconv.Itoa(int(endpoint.port)))
+}
@@ -2,31 +2,24 @@
 package k3s
 
 import (
-	"bytes"
 	"context"
-	"crypto/sha256"
-	"fmt"
-	"net/http"
-	"strings"
-	"sync"
-	"time"
 
 	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
 	"github.com/decentralized-cloud/edge-cluster/services/configuration"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/registry"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp" // register GCP auth provider
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/tools/remotecommand"
-	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -34,28 +27,60 @@ const (
 	k3sPort            = 6443
 	internalName       = "k3s"
 	kubeconfigFilePath = "/etc/rancher/k3s/k3s.yaml"
+
+	clusterSecretEnvName = "K3S_CLUSTER_SECRET"
+	clusterSecretName    = "k3s-cluster-secret"
+	clusterSecretKey     = "clusterSecret"
 )
 
-var deploymentReplica int32 = 1
-var waitForDeploymentToBeReadyTimeout int64 = 120
+func init() {
+	registry.RegisterProvisioner(
+		models.ClusterTypeDescriptor{
+			ClusterType: models.K3S,
+			Name:        "K3S",
+			SupportedFeatures: []string{
+				registry.FeatureKubeconfig,
+				registry.FeatureHelmCharts,
+				registry.FeatureListNodes,
+				registry.FeatureListPods,
+				registry.FeatureListServices,
+			},
+		},
+		func(dependencies registry.ProvisionerDependencies) (types.EdgeClusterProvisionerContract, error) {
+			return NewK3SProvisioner(
+				dependencies.Logger,
+				dependencies.K8sRestConfig,
+				dependencies.ConfigurationService,
+				dependencies.HelmService,
+				dependencies.EventBus,
+				dependencies.ChartCatalogue,
+				dependencies.KubeconfigCache)
+		})
+}
 
 type k3sProvisioner struct {
 	logger         *zap.Logger
 	clientset      *kubernetes.Clientset
-	k8sRestConfig  *rest.Config
 	k3sDockerImage string
-	helmService    helm.HelmHelperContract
+	exposurePolicy provision.ServiceExposurePolicy
+	controlPlane   *provision.ControlPlane
 }
 
 // NewK3SProvisioner creates new instance of the k3sProvisioner, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
 // k8sRestConfig: Mandatory. Reference to the Rest config points to the running K8S cluster
+// eventBus: Mandatory. Reference to the event bus the provisioning events are published to
+// chartCatalogue: Mandatory. Reference to the service that provides the helm charts installed on the edge cluster
+// kubeconfigCache: Mandatory. Reference to the service that caches the kubeconfig of the edge cluster
 // Returns the new service or error if something goes wrong
 func NewK3SProvisioner(
 	logger *zap.Logger,
 	k8sRestConfig *rest.Config,
 	configurationService configuration.ConfigurationContract,
-	helmService helm.HelmHelperContract) (types.EdgeClusterProvisionerContract, error) {
+	helmService helm.HelmHelperContract,
+	eventBus event.EventBusContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigCache kubeconfig.KubeconfigCacheContract) (types.EdgeClusterProvisionerContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
@@ -68,27 +93,57 @@ func NewK3SProvisioner(
 		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
 	}
 
-	if helmService == nil {
-		return nil, commonErrors.NewArgumentNilError("helmService", "helmService is required")
-	}
-
 	k3sDockerImage, err := configurationService.GetK3SDockerImage()
 	if err != nil {
 		return nil, types.NewUnknownErrorWithError("failed to get the database name", err)
 	}
 
+	allowedCIDRs, err := configurationService.GetServiceExposureAllowedCIDRs()
+	if err != nil {
+		return nil, err
+	}
+
+	allowedDomains, err := configurationService.GetServiceExposureAllowedDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	exposurePolicy, err := provision.NewServiceExposurePolicy(allowedCIDRs, allowedDomains)
+	if err != nil {
+		return nil, err
+	}
+
 	var clientset *kubernetes.Clientset
 	if clientset, err = kubernetes.NewForConfig(k8sRestConfig); err != nil {
 		return nil, types.NewUnknownErrorWithError("failed to create client set", err)
 	}
 
-	return &k3sProvisioner{
+	provisioner := &k3sProvisioner{
 		logger:         logger,
 		clientset:      clientset,
-		k8sRestConfig:  k8sRestConfig,
 		k3sDockerImage: k3sDockerImage,
-		helmService:    helmService,
-	}, nil
+		exposurePolicy: exposurePolicy,
+	}
+
+	if provisioner.controlPlane, err = provision.NewControlPlane(
+		logger,
+		clientset,
+		k8sRestConfig,
+		helmService,
+		eventBus,
+		chartCatalogue,
+		kubeconfigCache,
+		provision.ControlPlaneSpec{
+			ClusterTypeName:    "K3S",
+			Name:               internalName,
+			ContainerName:      containerName,
+			KubeconfigFilePath: kubeconfigFilePath,
+			ResolveEndpoint:    provisioner.resolveEndpoint,
+		}); err != nil {
+		return nil, err
+	}
+
+	return provisioner, nil
 }
 
 // CreateProvision provisions a new edge cluster.
@@ -97,50 +152,31 @@ func NewK3SProvisioner(
 // Returns either the result of provisioning new edge cluster or error if something goes wrong.
 func (service *k3sProvisioner) CreateProvision(
 	ctx context.Context,
-	request *types.CreateProvisionRequest) (response *types.CreateProvisionResponse, err error) {
-	namespace := getNamespace(request.EdgeClusterID)
-
-	if err = service.createProvisionNameSpace(ctx, namespace); err != nil {
-		return
-	}
-
-	if err = service.createService(ctx, namespace); err != nil {
-		_, _ = service.DeleteProvision(ctx, &types.DeleteProvisionRequest{EdgeClusterID: request.EdgeClusterID})
-
-		return
-	}
-
-	if err = service.createDeployment(
-		ctx,
-		namespace,
-		request.ClusterSecret); err != nil {
-		_, _ = service.DeleteProvision(ctx, &types.DeleteProvisionRequest{EdgeClusterID: request.EdgeClusterID})
-
-		return
-	}
-
-	response = &types.CreateProvisionResponse{}
-
-	isReady, err := service.isK3SPodReady(ctx, request.EdgeClusterID)
-	if err != nil {
-		service.logger.Error("K3S pod status is not ready", zap.Error(err))
-
-		return
-	}
-
-	if !isReady {
-		service.logger.Error("K3S pod status is not ready")
-
-		return
-	}
-
-	if err = service.deployHelmChart(ctx, request.EdgeClusterID); err != nil {
-		service.logger.Error("failed to install the helm charts", zap.Error(err))
-
-		return
-	}
+	request *types.CreateProvisionRequest) (*types.CreateProvisionResponse, error) {
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.K3S)
+
+	return service.controlPlane.CreateProvision(ctx, request, ownership, func(namespace string) error {
+		if err := service.applyServiceExposure(
+			ctx,
+			namespace,
+			request.ServiceExposure,
+			ownership,
+			provision.IgnoreRepairs); err != nil {
+			return err
+		}
 
-	return
+		return service.createDeployment(
+			ctx,
+			request.EdgeClusterID,
+			request.ClusterSecret,
+			request.ServiceExposure,
+			ownership)
+	})
 }
 
 // UpdateProvisionWithRetry updates an existing provision.
@@ -149,57 +185,46 @@ func (service *k3sProvisioner) CreateProvision(
 // Returns either the result of updating an existing provision or error if something goes wrong.
 func (service *k3sProvisioner) UpdateProvisionWithRetry(
 	ctx context.Context,
-	request *types.UpdateProvisionRequest) (response *types.UpdateProvisionResponse, err error) {
-	namespace := getNamespace(request.EdgeClusterID)
-
-	err = retry.RetryOnConflict(
-		retry.DefaultRetry,
-		func() (err error) {
-			client := service.clientset.AppsV1().Deployments(namespace)
-
-			deployment, err := client.Get(ctx, internalName, metav1.GetOptions{})
-			if err != nil {
-				service.logger.Error("failed to update the edge cluster", zap.Error(err))
-
-				return
-			}
-
-			deployment.Spec.Template.Spec, err = service.getDeploymentSpec(ctx, namespace, request.ClusterSecret)
-			if err != nil {
-				return err
-			}
-
-			if _, err = client.Update(ctx, deployment, metav1.UpdateOptions{}); err != nil {
-				service.logger.Error("failed to update the edge custer", zap.Error(err))
-
-				return
-			}
-
-			return
-		})
-
-	response = &types.UpdateProvisionResponse{}
-
-	isReady, internalErr := service.isK3SPodReady(ctx, request.EdgeClusterID)
-	if internalErr != nil {
-		service.logger.Error("K3S pod status is not ready", zap.Error(internalErr))
-
-		return
+	request *types.UpdateProvisionRequest) (*types.UpdateProvisionResponse, error) {
+	namespace := provision.GetNamespace(request.EdgeClusterID)
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.K3S)
+
+	// Rotating the cluster secret changes its checksum recorded on the pod template, which rolls the K3S server
+	clusterSecret := getClusterSecretConfig(namespace, request.ClusterSecret, ownership)
+	if err := service.controlPlane.CreateClusterSecret(ctx, clusterSecret); err != nil {
+		return nil, err
 	}
 
-	if !isReady {
-		service.logger.Error("K3S pod status is not ready")
-
-		return
+	// Changing the exposure changes the endpoint the K3S server is advertised on, which rolls the K3S server
+	if err := service.applyServiceExposure(
+		ctx,
+		namespace,
+		request.ServiceExposure,
+		ownership,
+		provision.IgnoreRepairs); err != nil {
+		return nil, err
 	}
 
-	if internalErr = service.deployHelmChart(ctx, request.EdgeClusterID); internalErr != nil {
-		service.logger.Error("failed to install the helm charts", zap.Error(internalErr))
+	return service.controlPlane.UpdateProvision(ctx, request, ownership, func(deployment *appsv1.Deployment) (err error) {
+		var endpoint advertiseEndpoint
+		deployment.Spec.Template.Spec, endpoint, err = service.getDeploymentSpec(
+			ctx,
+			request.EdgeClusterID,
+			request.ServiceExposure)
+		if err != nil {
+			return
+		}
 
-		return
-	}
+		provision.SetSecretChecksum(&deployment.Spec.Template, clusterSecret)
+		setAdvertisedEndpoint(&deployment.Spec.Template, request.ServiceExposure, endpoint)
 
-	return
+		return
+	})
 }
 
 // DeleteProvision deletes an existing provision.
@@ -208,71 +233,105 @@ func (service *k3sProvisioner) UpdateProvisionWithRetry(
 // Returns either the result of deleting an existing provision or error if something goes wrong.
 func (service *k3sProvisioner) DeleteProvision(
 	ctx context.Context,
-	request *types.DeleteProvisionRequest) (response *types.DeleteProvisionResponse, err error) {
-	namespace := getNamespace(request.EdgeClusterID)
+	request *types.DeleteProvisionRequest) (*types.DeleteProvisionResponse, error) {
+	return service.controlPlane.DeleteProvision(ctx, request)
+}
 
-	deletePolicy := metav1.DeletePropagationForeground
+// ReconcileProvision compares the namespace, service, ingress, deployment and helm charts of an existing provision with their
+// desired state and repairs the resources that drifted from it. An event is published for every repaired resource.
+// The helm charts are only reconciled if the K3S server was not repaired, as it is restarted by the repair.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to reconcile an existing provision
+// Returns either the repaired resources or error if something goes wrong.
+func (service *k3sProvisioner) ReconcileProvision(
+	ctx context.Context,
+	request *types.ReconcileProvisionRequest) (*types.ReconcileProvisionResponse, error) {
+	namespace := provision.GetNamespace(request.EdgeClusterID)
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.K3S)
+
+	return service.controlPlane.ReconcileProvision(ctx, request.EdgeClusterID, func(repaired provision.DriftRepaired) error {
+		if err := provision.ReconcileNamespace(
+			ctx,
+			service.clientset,
+			provision.GetNamespaceConfig(namespace, ownership),
+			repaired); err != nil {
+			service.logger.Error("failed to reconcile the namespace", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
 
-	if err = service.clientset.CoreV1().Namespaces().Delete(
-		ctx,
-		namespace,
-		metav1.DeleteOptions{
-			PropagationPolicy: &deletePolicy,
-		}); err != nil {
-		service.logger.Error("failed to delete namespace", zap.Error(err))
+		if err := service.applyServiceExposure(ctx, namespace, request.ServiceExposure, ownership, repaired); err != nil {
+			return err
+		}
 
-		return
-	}
+		clusterSecret := getClusterSecretConfig(namespace, request.ClusterSecret, ownership)
+		if err := provision.ReconcileSecret(ctx, service.clientset, clusterSecret, repaired); err != nil {
+			service.logger.Error("failed to reconcile the cluster secret", zap.Error(err), zap.String("namespace", namespace))
 
-	response = &types.DeleteProvisionResponse{}
+			return err
+		}
+
+		endpoint, err := service.getAdvertiseEndpoint(ctx, namespace, request.ServiceExposure)
+		if err != nil {
+			return err
+		}
+
+		deploymentConfig := getDeploymentConfig(
+			namespace,
+			service.getPodSpec(request.ServiceExposure, endpoint),
+			clusterSecret,
+			ownership)
+		setAdvertisedEndpoint(&deploymentConfig.Spec.Template, request.ServiceExposure, endpoint)
+
+		if err = provision.ReconcileDeployment(ctx, service.clientset, deploymentConfig, repaired); err != nil {
+			service.logger.Error("failed to reconcile the deployment", zap.Error(err), zap.String("namespace", namespace))
 
-	return
+			return err
+		}
+
+		return nil
+	})
 }
 
-// GetProvisionDetails retrieves information on an existing provision.
+// AdoptProvision reads the cluster secret the K3S server of an existing provision is running with and the exposure it
+// is provisioned with, so its edge cluster can be added back to the repository. The secret is read from the
+// Kubernetes secret the K3S server refers to, or from the environment of the K3S servers provisioned before the secret
+// was introduced. The provision itself is left untouched.
 // ctx: Mandatory The reference to the context
-// request: Mandatory. The request to retrieve information on an existing provision
-// Returns either the result of retrieving information on an provision or error if something goes wrong.
-func (service *k3sProvisioner) GetProvisionDetails(
+// request: Mandatory. The request to adopt an existing provision
+// Returns either the state of the provision or error if something goes wrong.
+func (service *k3sProvisioner) AdoptProvision(
 	ctx context.Context,
-	request *types.GetProvisionDetailsRequest) (response *types.GetProvisionDetailsResponse, err error) {
-	namespace := getNamespace(request.EdgeClusterID)
-
-	serviceDetails, err := service.getProvvisionedServiceDetails(ctx, namespace)
+	request *types.AdoptProvisionRequest) (*types.AdoptProvisionResponse, error) {
+	clusterSecret, err := service.controlPlane.ReadClusterSecret(ctx, request.EdgeClusterID, clusterSecretEnvName)
 	if err != nil {
-		service.logger.Error("failed to get service details", zap.Error(err))
-
-		return
+		return nil, err
 	}
 
-	kubeconfigContent, err := service.getProvisionDetailsKubeConfigContent(ctx, namespace)
+	serviceExposure, err := service.readServiceExposure(ctx, provision.GetNamespace(request.EdgeClusterID))
 	if err != nil {
-		service.logger.Error("failed to get kubeconfig content", zap.Error(err))
-
-		return
-	}
-
-	for _, item := range serviceDetails.Status.LoadBalancer.Ingress {
-		if item.IP != "" {
-			kubeconfigContent = strings.Replace(kubeconfigContent, "127.0.0.1", item.IP, -1)
-		} else if item.Hostname != "" {
-			kubeconfigContent = strings.Replace(kubeconfigContent, "127.0.0.1", item.Hostname, -1)
-		} else {
-			kubeconfigContent = strings.Replace(kubeconfigContent, "127.0.0.1", "BLANK", -1)
-		}
-	}
-
-	for _, item := range serviceDetails.Spec.Ports {
-		kubeconfigContent = strings.Replace(kubeconfigContent, fmt.Sprintf("%d", k3sPort), fmt.Sprintf("%d", item.Port), -1)
+		return nil, err
 	}
 
-	response = &types.GetProvisionDetailsResponse{
-		ProvisionDetails: models.ProvisionDetails{
-			Service:           serviceDetails,
-			KubeconfigContent: kubeconfigContent,
-		}}
+	return &types.AdoptProvisionResponse{
+		ClusterSecret:   clusterSecret,
+		ServiceExposure: serviceExposure,
+	}, nil
+}
 
-	return
+// GetProvisionDetails retrieves information on an existing provision.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to retrieve information on an existing provision
+// Returns either the result of retrieving information on an provision or error if something goes wrong.
+func (service *k3sProvisioner) GetProvisionDetails(
+	ctx context.Context,
+	request *types.GetProvisionDetailsRequest) (*types.GetProvisionDetailsResponse, error) {
+	return service.controlPlane.GetProvisionDetails(ctx, request)
 }
 
 // ListNodes lists an existing edge cluster nodes details
@@ -281,25 +340,8 @@ func (service *k3sProvisioner) GetProvisionDetails(
 // Returns an existing edge cluster nodes details or error if something goes wrong.
 func (service *k3sProvisioner) ListNodes(
 	ctx context.Context,
-	request *types.ListNodesRequest) (response *types.ListNodesResponse, err error) {
-	clientset, err := service.createClientsetForEdgeCluster(ctx, request.EdgeClusterID)
-	if err != nil {
-		return nil, err
-	}
-
-	var nodeList *v1.NodeList
-	if nodeList, err = clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{}); err != nil {
-		return nil, types.NewUnknownErrorWithError("failed to retreive node list", err)
-	}
-
-	response = &types.ListNodesResponse{Nodes: []models.EdgeClusterNode{}}
-	for _, node := range nodeList.Items {
-		response.Nodes = append(response.Nodes, models.EdgeClusterNode{
-			Node: node,
-		})
-	}
-
-	return
+	request *types.ListNodesRequest) (*types.ListNodesResponse, error) {
+	return service.controlPlane.ListNodes(ctx, request)
 }
 
 // ListPods lists an existing edge cluster pods that matchs the given search criteria
@@ -308,25 +350,8 @@ func (service *k3sProvisioner) ListNodes(
 // Returns the list of running pods that matchs the given search criteria or error if something goes wrong.
 func (service *k3sProvisioner) ListPods(
 	ctx context.Context,
-	request *types.ListPodsRequest) (response *types.ListPodsResponse, err error) {
-	clientset, err := service.createClientsetForEdgeCluster(ctx, request.EdgeClusterID)
-	if err != nil {
-		return nil, err
-	}
-
-	var pods *v1.PodList
-	if pods, err = clientset.CoreV1().Pods(request.Namespace).List(ctx, metav1.ListOptions{}); err != nil {
-		return nil, types.NewUnknownErrorWithError("failed to retreive pod list", err)
-	}
-
-	response = &types.ListPodsResponse{Pods: []models.EdgeClusterPod{}}
-	for _, pod := range pods.Items {
-		response.Pods = append(response.Pods, models.EdgeClusterPod{
-			Pod: pod,
-		})
-	}
-
-	return
+	request *types.ListPodsRequest) (*types.ListPodsResponse, error) {
+	return service.controlPlane.ListPods(ctx, request)
 }
 
 // ListServices lists an existing edge cluster services that matchs the given search criteria
@@ -335,161 +360,61 @@ func (service *k3sProvisioner) ListPods(
 // Returns the list of services that matches the given search criteria or error if something goes wrong.
 func (service *k3sProvisioner) ListServices(
 	ctx context.Context,
-	request *types.ListServicesRequest) (response *types.ListServicesResponse, err error) {
-	clientset, err := service.createClientsetForEdgeCluster(ctx, request.EdgeClusterID)
-	if err != nil {
-		return nil, err
-	}
-
-	var services *v1.ServiceList
-	if services, err = clientset.CoreV1().Services(request.Namespace).List(ctx, metav1.ListOptions{}); err != nil {
-		return nil, types.NewUnknownErrorWithError("failed to retreive service list", err)
-	}
-
-	response = &types.ListServicesResponse{Services: []models.EdgeClusterService{}}
-	for _, service := range services.Items {
-		response.Services = append(response.Services, models.EdgeClusterService{
-			Service: service,
-		})
-	}
-
-	return
-}
-
-func (service *k3sProvisioner) createProvisionNameSpace(ctx context.Context, namespace string) (err error) {
-	ns, err := service.clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
-	if err != nil && strings.Contains(err.Error(), "not found") {
-		namespaceConfig := &v1.Namespace{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: namespace,
-			},
-		}
-
-		if _, err = service.clientset.CoreV1().Namespaces().Create(ctx, namespaceConfig, metav1.CreateOptions{}); err != nil {
-			service.logger.Error(
-				"failed to create namespace",
-				zap.Error(err),
-				zap.String("namespace", namespace))
-
-			return
-		}
-
-	} else if err != nil {
-		service.logger.Error("failed to validate the requested namespace", zap.Error(err), zap.String("namespace", namespace))
-
-		return
-	}
-
-	if ns != nil && ns.GetName() == namespace {
-		service.logger.Info("namespace already exists", zap.String("namespace", namespace))
-
-		return
-	}
-
-	return
+	request *types.ListServicesRequest) (*types.ListServicesResponse, error) {
+	return service.controlPlane.ListServices(ctx, request)
 }
 
 func (service *k3sProvisioner) createDeployment(
 	ctx context.Context,
-	namespace string,
-	k3SClusterSecret string) (err error) {
-	spec, err := service.getDeploymentSpec(ctx, namespace, k3SClusterSecret)
-	if err != nil {
+	edgeClusterID string,
+	k3SClusterSecret string,
+	serviceExposure models.ServiceExposure,
+	ownership models.ProvisionOwnership) error {
+	namespace := provision.GetNamespace(edgeClusterID)
+	clusterSecret := getClusterSecretConfig(namespace, k3SClusterSecret, ownership)
+	if err := service.controlPlane.CreateClusterSecret(ctx, clusterSecret); err != nil {
 		return err
 	}
 
-	client := service.clientset.AppsV1().Deployments(namespace)
-	deploymentConfig := &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      internalName,
-			Namespace: namespace,
-		},
-		Spec: appsv1.DeploymentSpec{
-			Replicas: &deploymentReplica,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					internalName: internalName,
-				},
-			},
-			Template: v1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{
-						internalName: internalName,
-					},
-				},
-				Spec: spec,
-			},
-		},
+	spec, endpoint, err := service.getDeploymentSpec(ctx, edgeClusterID, serviceExposure)
+	if err != nil {
+		return err
 	}
 
-	if _, err := client.Create(ctx, deploymentConfig, metav1.CreateOptions{}); err != nil {
-		service.logger.Error("failed to create edge cluster", zap.Error(err), zap.Any("Config", deploymentConfig))
-	}
+	deploymentConfig := getDeploymentConfig(namespace, spec, clusterSecret, ownership)
+	setAdvertisedEndpoint(&deploymentConfig.Spec.Template, serviceExposure, endpoint)
 
-	return
+	return service.controlPlane.CreateDeployment(ctx, deploymentConfig)
 }
 
-func (service *k3sProvisioner) createService(ctx context.Context, namespace string) (err error) {
-	serviceDeployment := service.clientset.CoreV1().Services(namespace)
-
-	servicePorts := []v1.ServicePort{
-		{
-			Name:       internalName,
-			Protocol:   v1.ProtocolTCP,
-			Port:       k3sPort,
-			TargetPort: intstr.FromInt(k3sPort),
-		},
-	}
-
-	serviceSelector := map[string]string{
-		internalName: internalName,
-	}
-
-	serviceConfig := &v1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      internalName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"k8s-app": internalName,
-			},
-		},
-		Spec: v1.ServiceSpec{
-			Ports:    servicePorts,
-			Selector: serviceSelector,
-			Type:     v1.ServiceTypeLoadBalancer,
-		},
-	}
-
-	if _, err = serviceDeployment.Create(ctx, serviceConfig, metav1.CreateOptions{}); err != nil {
-		service.logger.Error("failed to create service", zap.Error(err), zap.Any("Config", serviceConfig))
-
-		return
+func (service *k3sProvisioner) getDeploymentSpec(
+	ctx context.Context,
+	edgeClusterID string,
+	serviceExposure models.ServiceExposure) (v1.PodSpec, advertiseEndpoint, error) {
+	endpoint, err := service.getAdvertiseEndpoint(ctx, provision.GetNamespace(edgeClusterID), serviceExposure)
+	if err != nil {
+		return v1.PodSpec{}, advertiseEndpoint{}, err
 	}
 
-	return
-}
+	service.controlPlane.PublishEvent(
+		ctx,
+		edgeClusterID,
+		models.EdgeClusterEventTypeLoadBalancerAddressAssigned,
+		models.ProvisioningStatusProvisioning,
+		endpoint.address)
 
-func getNamespace(edgeClusterID string) string {
-	return fmt.Sprintf("%x", sha256.Sum224([]byte(edgeClusterID)))
+	return service.getPodSpec(serviceExposure, endpoint), endpoint, nil
 }
 
-func (service *k3sProvisioner) getDeploymentSpec(ctx context.Context, namespace string, k3SClusterSecret string) (v1.PodSpec, error) {
-	advertiseAddress, err := service.getAdvertiseAddress(ctx, namespace)
-	if err != nil {
-		return v1.PodSpec{}, err
-	}
-
+func (service *k3sProvisioner) getPodSpec(serviceExposure models.ServiceExposure, endpoint advertiseEndpoint) v1.PodSpec {
 	return v1.PodSpec{
 		Containers: []v1.Container{
 			{
 				Name:  containerName,
 				Image: service.k3sDockerImage,
-				Args: []string{
-					"server",
-					fmt.Sprintf("--advertise-address=%s", advertiseAddress),
-				},
+				Args:  append([]string{"server"}, getAdvertiseArgs(serviceExposure, endpoint)...),
 				Env: []v1.EnvVar{
-					{Name: "K3S_CLUSTER_SECRET", Value: k3SClusterSecret},
+					provision.GetSecretEnvVar(clusterSecretEnvName, clusterSecretName, clusterSecretKey),
 				},
 				Ports: []v1.ContainerPort{
 					{
@@ -499,222 +424,54 @@ func (service *k3sProvisioner) getDeploymentSpec(ctx context.Context, namespace
 				},
 			},
 		},
-	}, nil
-}
-
-func (service *k3sProvisioner) getProvvisionedServiceDetails(
-	ctx context.Context,
-	namespace string) (*v1.Service, error) {
-
-	var serviceInfo *v1.Service
-	var err error
-	if serviceInfo, err = service.clientset.CoreV1().Services(namespace).Get(ctx, internalName, metav1.GetOptions{}); err != nil {
-		service.logger.Error("failed to fetch service info", zap.Error(err))
-
-		return nil, err
-	}
-
-	return serviceInfo, nil
-}
-
-func (service *k3sProvisioner) getProvisionDetailsKubeConfigContent(
-	ctx context.Context,
-	namespace string) (kubeconfigContent string, err error) {
-	pods, err := service.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return
-	}
-
-	if len(pods.Items) <= 0 {
-		return "", types.NewUnknownError("Pod is not ready yet")
-	}
-
-	execRequest := service.clientset.CoreV1().RESTClient().
-		Post().
-		Resource("pods").
-		Name(pods.Items[0].ObjectMeta.Name).
-		Namespace(pods.Items[0].ObjectMeta.Namespace).
-		SubResource("exec").
-		Param("stdout", "true").
-		Param("command", "cat").
-		Param("command", kubeconfigFilePath)
-
-	executor, err := remotecommand.NewSPDYExecutor(service.k8sRestConfig, http.MethodPost, execRequest.URL())
-	if err != nil {
-		err = types.NewUnknownErrorWithError("failed to retrieve KubeConfig content.", err)
-
-		return
 	}
-
-	output := &bytes.Buffer{}
-
-	if err = executor.Stream(remotecommand.StreamOptions{Stdout: output}); err != nil {
-		err = types.NewUnknownErrorWithError("failed to retrieve KubeConfig content", err)
-
-		return
-	}
-
-	kubeconfigContent = output.String()
-
-	return
 }
 
-func (service *k3sProvisioner) getAdvertiseAddress(
+// resolveEndpoint returns the endpoint the K3S server running in the given namespace is advertised on, as the
+// kubeconfig written by K3S refers to the loopback address
+func (service *k3sProvisioner) resolveEndpoint(
 	ctx context.Context,
-	namespace string) (string, error) {
-	watch, err := service.clientset.CoreV1().Services(namespace).Watch(ctx, metav1.ListOptions{
-		Watch:          true,
-		TimeoutSeconds: &waitForDeploymentToBeReadyTimeout,
-	})
+	namespace string,
+	serviceDetails *v1.Service) (string, int32, error) {
+	endpoint, err := service.getAdvertisedEndpoint(ctx, namespace, serviceDetails)
 	if err != nil {
-		service.logger.Error("failed to retrieve advertise address", zap.Error(err))
-
-		return "", err
+		return "", 0, err
 	}
 
-	for event := range watch.ResultChan() {
-		if service, ok := event.Object.(*v1.Service); ok {
-			for _, item := range service.Status.LoadBalancer.Ingress {
-				if item.IP != "" {
-					watch.Stop()
-
-					return item.IP, nil
-				} else if item.Hostname != "" {
-					watch.Stop()
-
-					return item.Hostname, nil
-				}
-			}
-		}
-	}
-
-	return "", types.NewUnknownError("failed to retrieve advertise address")
+	return endpoint.address, endpoint.port, nil
 }
 
-func (service *k3sProvisioner) createClientsetForEdgeCluster(
-	ctx context.Context,
-	edgeClusterID string) (clientset *kubernetes.Clientset, err error) {
-	getProvisionDetailsResponse, err := service.GetProvisionDetails(
-		ctx,
-		&types.GetProvisionDetailsRequest{
-			EdgeClusterID: edgeClusterID,
-		})
-	if err != nil {
-		return
-	}
-
-	var restConfig *rest.Config
-	if restConfig, err = clientcmd.RESTConfigFromKubeConfig(
-		[]byte(getProvisionDetailsResponse.ProvisionDetails.KubeconfigContent)); err != nil {
-		service.logger.Error("failed to create Rest config from the given kube config", zap.Error(err))
-
-		return
-	}
-
-	if clientset, err = kubernetes.NewForConfig(restConfig); err != nil {
-		service.logger.Error("failed to create client set", zap.Error(err))
-
-		return
-	}
-
-	return
+// getServiceConfig returns the desired state of the service the K3S server is exposed on
+func getServiceConfig(
+	namespace string,
+	serviceExposure models.ServiceExposure,
+	ownership models.ProvisionOwnership) *v1.Service {
+	serviceConfig := provision.GetServiceConfig(
+		namespace,
+		internalName,
+		k3sPort,
+		k3sPort,
+		getServiceType(serviceExposure.Mode),
+		ownership)
+	serviceConfig.Spec.ExternalIPs = getExternalIPs(serviceExposure)
+
+	return serviceConfig
 }
 
-func (service *k3sProvisioner) isK3SPodReady(
-	ctx context.Context,
-	edgeClusterID string) (bool, error) {
-	namespace := getNamespace(edgeClusterID)
-	watch, err := service.clientset.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{
-		Watch:          true,
-		TimeoutSeconds: &waitForDeploymentToBeReadyTimeout,
-	})
-	if err != nil {
-		service.logger.Error("failed to retrieve K3S pod status", zap.Error(err))
-
-		return false, err
-	}
-
-	for event := range watch.ResultChan() {
-		if pod, ok := event.Object.(*v1.Pod); ok {
-			for _, item := range pod.Status.Conditions {
-				if item.Type == v1.ContainersReady {
-					watch.Stop()
-
-					for i := 0; i < 60; i++ {
-						if _, err := service.GetProvisionDetails(ctx, &types.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID}); err == nil {
-							return true, nil
-						}
-
-						time.Sleep(1 * time.Second)
-					}
-
-					return false, nil
-				}
-			}
-		}
-	}
-
-	return false, types.NewUnknownError("failed to retrieve K3S pod status")
+// getClusterSecretConfig returns the desired state of the secret that holds the K3S cluster secret
+func getClusterSecretConfig(namespace string, k3SClusterSecret string, ownership models.ProvisionOwnership) *v1.Secret {
+	return provision.GetClusterSecretConfig(namespace, clusterSecretName, clusterSecretKey, k3SClusterSecret, ownership)
 }
 
-func (service *k3sProvisioner) deployHelmChart(ctx context.Context, edgeClusterID string) error {
-	provisionDetails, err := service.GetProvisionDetails(ctx, &types.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID})
-	if err != nil {
-		return err
-	}
-
-	errorsChan := make(chan error)
-	waitGroupDoneChan := make(chan bool)
-
-	var waitGroup sync.WaitGroup
-	waitGroup.Add(2)
-
-	go func() {
-		defer waitGroup.Done()
-
-		if err := service.helmService.InstallChart(
-			provisionDetails.ProvisionDetails.KubeconfigContent,
-			"portainer",
-			"portainer",
-			"portainer",
-			"portainer",
-			map[string]string{
-				"set": "service.type=LoadBalancer",
-			}); err != nil {
-			errorsChan <- err
-		}
-	}()
-
-	go func() {
-		defer waitGroup.Done()
-
-		if err := service.helmService.InstallChart(
-			provisionDetails.ProvisionDetails.KubeconfigContent,
-			"edgecluster",
-			"edge-core",
-			"decentralized-cloud",
-			"edge-core",
-			map[string]string{
-				"set": "pod.edgeClusterType=K3S",
-			}); err != nil {
-			errorsChan <- err
-		}
-	}()
-
-	go func() {
-		waitGroup.Wait()
-		close(waitGroupDoneChan)
-	}()
-
-	select {
-	case <-waitGroupDoneChan:
-		close(errorsChan)
-
-		return nil
-
-	case err := <-errorsChan:
-		close(errorsChan)
+// getDeploymentConfig returns the desired state of the deployment that runs the K3S server. The checksum of the
+// cluster secret is recorded on the pod template, so changing the cluster secret restarts the K3S server.
+func getDeploymentConfig(
+	namespace string,
+	spec v1.PodSpec,
+	clusterSecret *v1.Secret,
+	ownership models.ProvisionOwnership) *appsv1.Deployment {
+	deploymentConfig := provision.GetDeploymentConfig(namespace, internalName, v1.PodTemplateSpec{Spec: spec}, ownership)
+	provision.SetSecretChecksum(&deploymentConfig.Spec.Template, clusterSecret)
 
-		return err
-	}
+	return deploymentConfig
 }
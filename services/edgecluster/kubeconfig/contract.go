@@ -0,0 +1,29 @@
+// Package kubeconfig keeps the kubeconfigs written by the control planes of the edge clusters, so they are not read
+// from the control plane pods on every call
+package kubeconfig
+
+import "context"
+
+// KubeconfigCacheContract declares the methods to be implemented by the service that caches the kubeconfigs of the
+// edge clusters. The kubeconfigs are cached in memory and persisted in a secret in the namespace of the edge cluster,
+// and are only read from the control plane pod if missing or stale.
+type KubeconfigCacheContract interface {
+	// GetKubeconfig returns the kubeconfig written by the control plane of the edge cluster. The kubeconfig is read from
+	// the control plane pod if it is not cached yet, or was read from another pod than the one currently running, as
+	// the control plane generates new certificates when its pod is replaced.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to get the kubeconfig of the edge cluster
+	// Returns either the kubeconfig of the edge cluster or error if something goes wrong.
+	GetKubeconfig(
+		ctx context.Context,
+		request *GetKubeconfigRequest) (*GetKubeconfigResponse, error)
+
+	// InvalidateKubeconfig removes the kubeconfig of the edge cluster from memory, so the next call checks the persisted
+	// kubeconfig against the control plane pod currently running
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to invalidate the kubeconfig of the edge cluster
+	// Returns either the result of invalidating the kubeconfig or error if something goes wrong.
+	InvalidateKubeconfig(
+		ctx context.Context,
+		request *InvalidateKubeconfigRequest) (*InvalidateKubeconfigResponse, error)
+}
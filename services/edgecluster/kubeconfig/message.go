@@ -0,0 +1,53 @@
+package kubeconfig
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SecretName is the name of the secret the kubeconfig is persisted in, in the namespace of the edge cluster
+	SecretName = "edge-cluster-kubeconfig"
+
+	// SourcePodUIDKey is the secret annotation that records the UID of the control plane pod the kubeconfig was read from
+	SourcePodUIDKey = "edge-cluster.decentralized-cloud.io/source-pod-uid"
+)
+
+// KubeconfigReader reads the kubeconfig from the given control plane pod
+type KubeconfigReader func(ctx context.Context, pod *v1.Pod) (string, error)
+
+// SecretMetadataWriter records the metadata of the edge cluster, e.g. its ownership, on the given metadata of the
+// secret the kubeconfig is persisted in
+type SecretMetadataWriter func(ctx context.Context, objectMeta *metav1.ObjectMeta) error
+
+// GetKubeconfigRequest contains the request to get the kubeconfig of an edge cluster
+type GetKubeconfigRequest struct {
+	// Namespace is the namespace the control plane of the edge cluster runs in
+	Namespace string
+
+	// ContainerName is the name of the control plane container, used to find the control plane pod
+	ContainerName string
+
+	// ReadKubeconfig reads the kubeconfig from the control plane pod if it is missing or stale
+	ReadKubeconfig KubeconfigReader
+
+	// WriteSecretMetadata is optional, and records the metadata of the edge cluster on the secret the kubeconfig is
+	// persisted in, so the secret is linked back to the edge cluster like the other provisioned resources
+	WriteSecretMetadata SecretMetadataWriter
+}
+
+// GetKubeconfigResponse contains the kubeconfig written by the control plane of an edge cluster
+type GetKubeconfigResponse struct {
+	KubeconfigContent string
+}
+
+// InvalidateKubeconfigRequest contains the request to invalidate the cached kubeconfig of an edge cluster
+type InvalidateKubeconfigRequest struct {
+	Namespace string
+}
+
+// InvalidateKubeconfigResponse contains the result of invalidating the cached kubeconfig of an edge cluster
+type InvalidateKubeconfigResponse struct {
+}
@@ -0,0 +1,301 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	kubeconfigKey       = "kubeconfig"
+	keyIDKey            = "keyID"
+	encryptedDataKeyKey = "encryptedDataKey"
+	ciphertextKey       = "ciphertext"
+)
+
+type cachedKubeconfig struct {
+	content      string
+	sourcePodUID k8sTypes.UID
+	checkedAt    time.Time
+}
+
+type kubeconfigCacheService struct {
+	logger            *zap.Logger
+	clientset         kubernetes.Interface
+	encryptionService encryption.EncryptionContract
+	ttl               time.Duration
+	lock              sync.Mutex
+	kubeconfigs       map[string]cachedKubeconfig
+}
+
+// NewKubeconfigCacheService creates new instance of the kubeconfigCacheService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// clientset: Mandatory. Reference to the client of the host cluster the edge clusters are provisioned in
+// encryptionService: Optional. Reference to the service that encrypts the persisted kubeconfigs. The kubeconfigs are
+// persisted in plain text if not provided.
+// Returns the new service or error if something goes wrong
+func NewKubeconfigCacheService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	clientset kubernetes.Interface,
+	encryptionService encryption.EncryptionContract) (KubeconfigCacheContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if clientset == nil {
+		return nil, commonErrors.NewArgumentNilError("clientset", "clientset is required")
+	}
+
+	ttl, err := configurationService.GetKubeconfigCacheTTL()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the kubeconfig cache TTL", err)
+	}
+
+	return &kubeconfigCacheService{
+		logger:            logger,
+		clientset:         clientset,
+		encryptionService: encryptionService,
+		ttl:               ttl,
+		kubeconfigs:       map[string]cachedKubeconfig{},
+	}, nil
+}
+
+// GetKubeconfig returns the kubeconfig written by the control plane of the edge cluster. The kubeconfig is read from
+// the control plane pod if it is not cached yet, or was read from another pod than the one currently running, as
+// the control plane generates new certificates when its pod is replaced.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to get the kubeconfig of the edge cluster
+// Returns either the kubeconfig of the edge cluster or error if something goes wrong.
+func (service *kubeconfigCacheService) GetKubeconfig(
+	ctx context.Context,
+	request *GetKubeconfigRequest) (*GetKubeconfigResponse, error) {
+	cached, found := service.getCachedKubeconfig(request.Namespace)
+	if found && time.Since(cached.checkedAt) < service.ttl {
+		return &GetKubeconfigResponse{KubeconfigContent: cached.content}, nil
+	}
+
+	pod, err := service.getControlPlanePod(ctx, request.Namespace, request.ContainerName)
+	if err != nil {
+		service.removeCachedKubeconfig(request.Namespace)
+
+		return nil, err
+	}
+
+	if found && cached.sourcePodUID == pod.UID {
+		service.setCachedKubeconfig(request.Namespace, cached.content, pod.UID)
+
+		return &GetKubeconfigResponse{KubeconfigContent: cached.content}, nil
+	}
+
+	content, err := service.readPersistedKubeconfig(ctx, request.Namespace, pod.UID)
+	if err != nil {
+		// The persisted kubeconfig is only a cache, it is replaced by the one read from the pod
+		service.logger.Warn(
+			"failed to read the persisted kubeconfig",
+			zap.Error(err),
+			zap.String("namespace", request.Namespace))
+	}
+
+	if content == "" {
+		if content, err = request.ReadKubeconfig(ctx, pod); err != nil {
+			return nil, err
+		}
+
+		if err = service.persistKubeconfig(ctx, request, content, pod.UID); err != nil {
+			service.logger.Warn(
+				"failed to persist the kubeconfig",
+				zap.Error(err),
+				zap.String("namespace", request.Namespace))
+		}
+	}
+
+	service.setCachedKubeconfig(request.Namespace, content, pod.UID)
+
+	return &GetKubeconfigResponse{KubeconfigContent: content}, nil
+}
+
+// InvalidateKubeconfig removes the kubeconfig of the edge cluster from memory, so the next call checks the persisted
+// kubeconfig against the control plane pod currently running
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to invalidate the kubeconfig of the edge cluster
+// Returns either the result of invalidating the kubeconfig or error if something goes wrong.
+func (service *kubeconfigCacheService) InvalidateKubeconfig(
+	ctx context.Context,
+	request *InvalidateKubeconfigRequest) (*InvalidateKubeconfigResponse, error) {
+	service.removeCachedKubeconfig(request.Namespace)
+
+	return &InvalidateKubeconfigResponse{}, nil
+}
+
+func (service *kubeconfigCacheService) getCachedKubeconfig(namespace string) (cachedKubeconfig, bool) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	cached, found := service.kubeconfigs[namespace]
+
+	return cached, found
+}
+
+func (service *kubeconfigCacheService) setCachedKubeconfig(namespace string, content string, sourcePodUID k8sTypes.UID) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	service.kubeconfigs[namespace] = cachedKubeconfig{
+		content:      content,
+		sourcePodUID: sourcePodUID,
+		checkedAt:    time.Now(),
+	}
+}
+
+func (service *kubeconfigCacheService) removeCachedKubeconfig(namespace string) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	delete(service.kubeconfigs, namespace)
+}
+
+// getControlPlanePod returns the newest running pod of the given namespace that runs the control plane container,
+// which is the pod that replaces the others while the control plane is being rolled
+func (service *kubeconfigCacheService) getControlPlanePod(
+	ctx context.Context,
+	namespace string,
+	containerName string) (*v1.Pod, error) {
+	pods, err := service.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to list the pods", err)
+	}
+
+	var controlPlanePod *v1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != v1.PodRunning || !hasContainer(pod, containerName) {
+			continue
+		}
+
+		if controlPlanePod == nil || controlPlanePod.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			controlPlanePod = pod
+		}
+	}
+
+	if controlPlanePod == nil {
+		return nil, types.NewUnknownError("Pod is not ready yet")
+	}
+
+	return controlPlanePod, nil
+}
+
+// readPersistedKubeconfig returns the persisted kubeconfig if it was read from the given pod, or empty string if it
+// is missing or was read from another pod
+func (service *kubeconfigCacheService) readPersistedKubeconfig(
+	ctx context.Context,
+	namespace string,
+	sourcePodUID k8sTypes.UID) (string, error) {
+	secret, err := service.clientset.CoreV1().Secrets(namespace).Get(ctx, SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if secret.Annotations[SourcePodUIDKey] != string(sourcePodUID) {
+		return "", nil
+	}
+
+	if _, ok := secret.Data[ciphertextKey]; !ok {
+		return string(secret.Data[kubeconfigKey]), nil
+	}
+
+	if service.encryptionService == nil {
+		return "", fmt.Errorf("the persisted kubeconfig is encrypted but no encryption key provider is configured")
+	}
+
+	content, err := service.encryptionService.Decrypt(ctx, encryption.EncryptedValue{
+		KeyID:            string(secret.Data[keyIDKey]),
+		EncryptedDataKey: secret.Data[encryptedDataKeyKey],
+		Ciphertext:       secret.Data[ciphertextKey],
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// persistKubeconfig stores the kubeconfig read from the given pod in the secret of the namespace, encrypted if the
+// encryption is configured
+func (service *kubeconfigCacheService) persistKubeconfig(
+	ctx context.Context,
+	request *GetKubeconfigRequest,
+	content string,
+	sourcePodUID k8sTypes.UID) error {
+	namespace := request.Namespace
+	data := map[string][]byte{kubeconfigKey: []byte(content)}
+
+	if service.encryptionService != nil {
+		value, err := service.encryptionService.Encrypt(ctx, []byte(content))
+		if err != nil {
+			return err
+		}
+
+		data = map[string][]byte{
+			keyIDKey:            []byte(value.KeyID),
+			encryptedDataKeyKey: value.EncryptedDataKey,
+			ciphertextKey:       value.Ciphertext,
+		}
+	}
+
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        SecretName,
+			Namespace:   namespace,
+			Annotations: map[string]string{SourcePodUIDKey: string(sourcePodUID)},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: data,
+	}
+
+	if request.WriteSecretMetadata != nil {
+		if err := request.WriteSecretMetadata(ctx, &secret.ObjectMeta); err != nil {
+			return err
+		}
+	}
+
+	client := service.clientset.CoreV1().Secrets(namespace)
+
+	_, err := client.Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = client.Update(ctx, secret, metav1.UpdateOptions{})
+	}
+
+	return err
+}
+
+func hasContainer(pod *v1.Pod, containerName string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName {
+			return true
+		}
+	}
+
+	return false
+}
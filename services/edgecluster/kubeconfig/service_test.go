@@ -0,0 +1,262 @@
+package kubeconfig_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	encryptionMock "github.com/decentralized-cloud/edge-cluster/services/encryption/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestKubeconfigCacheService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kubeconfig Cache Service Tests")
+}
+
+var _ = Describe("Kubeconfig Cache Service Tests", func() {
+	const containerName = "control-plane"
+
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		logger                   *zap.Logger
+		clientset                *fake.Clientset
+		ctx                      context.Context
+		namespace                string
+		readCount                int
+		readPodUIDs              []k8sTypes.UID
+		sut                      kubeconfig.KubeconfigCacheContract
+	)
+
+	newPod := func(uid string, createdAt time.Time) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         namespace,
+				Name:              uid,
+				UID:               k8sTypes.UID(uid),
+				CreationTimestamp: metav1.NewTime(createdAt),
+			},
+			Spec:   v1.PodSpec{Containers: []v1.Container{{Name: containerName}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+
+	newRequest := func() *kubeconfig.GetKubeconfigRequest {
+		return &kubeconfig.GetKubeconfigRequest{
+			Namespace:     namespace,
+			ContainerName: containerName,
+			ReadKubeconfig: func(ctx context.Context, pod *v1.Pod) (string, error) {
+				readCount++
+				readPodUIDs = append(readPodUIDs, pod.UID)
+
+				return "kubeconfig of " + string(pod.UID), nil
+			},
+		}
+	}
+
+	newService := func(encryptionService encryption.EncryptionContract) kubeconfig.KubeconfigCacheContract {
+		service, err := kubeconfig.NewKubeconfigCacheService(logger, mockConfigurationService, clientset, encryptionService)
+		Ω(err).Should(BeNil())
+
+		return service
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetKubeconfigCacheTTL().Return(time.Hour, nil).AnyTimes()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		ctx = context.Background()
+		namespace = cuid.New()
+		readCount = 0
+		readPodUIDs = nil
+		clientset = fake.NewSimpleClientset(newPod("first", time.Now().Add(-time.Hour)))
+		sut = newService(nil)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate KubeconfigCacheService", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := kubeconfig.NewKubeconfigCacheService(nil, mockConfigurationService, clientset, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := kubeconfig.NewKubeconfigCacheService(logger, nil, clientset, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("clientset is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := kubeconfig.NewKubeconfigCacheService(logger, mockConfigurationService, nil, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("KubeconfigCacheService is instantiated", func() {
+		Describe("GetKubeconfig", func() {
+			It("should read the kubeconfig from the pod once and persist it", func() {
+				for i := 0; i < 3; i++ {
+					response, err := sut.GetKubeconfig(ctx, newRequest())
+					Ω(err).Should(BeNil())
+					Ω(response.KubeconfigContent).Should(Equal("kubeconfig of first"))
+				}
+
+				Ω(readCount).Should(Equal(1))
+
+				secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, kubeconfig.SecretName, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(secret.Annotations[kubeconfig.SourcePodUIDKey]).Should(Equal("first"))
+				Ω(string(secret.Data["kubeconfig"])).Should(Equal("kubeconfig of first"))
+			})
+
+			It("should serve the persisted kubeconfig after a restart", func() {
+				_, err := sut.GetKubeconfig(ctx, newRequest())
+				Ω(err).Should(BeNil())
+
+				response, err := newService(nil).GetKubeconfig(ctx, newRequest())
+				Ω(err).Should(BeNil())
+				Ω(response.KubeconfigContent).Should(Equal("kubeconfig of first"))
+				Ω(readCount).Should(Equal(1))
+			})
+
+			It("should read the kubeconfig again once the pod is replaced", func() {
+				_, err := sut.GetKubeconfig(ctx, newRequest())
+				Ω(err).Should(BeNil())
+
+				Ω(clientset.CoreV1().Pods(namespace).Delete(ctx, "first", metav1.DeleteOptions{})).Should(BeNil())
+				_, err = clientset.CoreV1().Pods(namespace).Create(ctx, newPod("second", time.Now()), metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+
+				_, err = sut.InvalidateKubeconfig(ctx, &kubeconfig.InvalidateKubeconfigRequest{Namespace: namespace})
+				Ω(err).Should(BeNil())
+
+				response, err := sut.GetKubeconfig(ctx, newRequest())
+				Ω(err).Should(BeNil())
+				Ω(response.KubeconfigContent).Should(Equal("kubeconfig of second"))
+				Ω(readPodUIDs).Should(Equal([]k8sTypes.UID{"first", "second"}))
+			})
+
+			It("should read the kubeconfig from the newest pod while the control plane is rolled", func() {
+				_, err := clientset.CoreV1().Pods(namespace).Create(ctx, newPod("second", time.Now()), metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+
+				response, err := sut.GetKubeconfig(ctx, newRequest())
+				Ω(err).Should(BeNil())
+				Ω(response.KubeconfigContent).Should(Equal("kubeconfig of second"))
+			})
+
+			It("should return the error when no control plane pod is running", func() {
+				Ω(clientset.CoreV1().Pods(namespace).Delete(ctx, "first", metav1.DeleteOptions{})).Should(BeNil())
+
+				response, err := sut.GetKubeconfig(ctx, newRequest())
+				Ω(response).Should(BeNil())
+				Ω(err).Should(HaveOccurred())
+				Ω(readCount).Should(Equal(0))
+			})
+
+			It("should return the error when the kubeconfig cannot be read from the pod", func() {
+				expectedError := errors.New(cuid.New())
+				request := newRequest()
+				request.ReadKubeconfig = func(ctx context.Context, pod *v1.Pod) (string, error) {
+					return "", expectedError
+				}
+
+				response, err := sut.GetKubeconfig(ctx, request)
+				Ω(response).Should(BeNil())
+				Ω(err).Should(Equal(expectedError))
+			})
+
+			It("should record the metadata of the edge cluster on the persisted secret", func() {
+				request := newRequest()
+				request.WriteSecretMetadata = func(ctx context.Context, objectMeta *metav1.ObjectMeta) error {
+					objectMeta.Labels = map[string]string{"owner": namespace}
+
+					return nil
+				}
+
+				_, err := sut.GetKubeconfig(ctx, request)
+				Ω(err).Should(BeNil())
+
+				secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, kubeconfig.SecretName, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(secret.Labels).Should(HaveKeyWithValue("owner", namespace))
+				Ω(secret.Annotations[kubeconfig.SourcePodUIDKey]).Should(Equal("first"))
+			})
+
+			It("should serve the kubeconfig even if the metadata of the edge cluster cannot be recorded", func() {
+				request := newRequest()
+				request.WriteSecretMetadata = func(ctx context.Context, objectMeta *metav1.ObjectMeta) error {
+					return errors.New(cuid.New())
+				}
+
+				response, err := sut.GetKubeconfig(ctx, request)
+				Ω(err).Should(BeNil())
+				Ω(response.KubeconfigContent).Should(Equal("kubeconfig of first"))
+			})
+
+			When("encryption is configured", func() {
+				It("should persist the kubeconfig encrypted", func() {
+					encryptedValue := encryption.EncryptedValue{
+						KeyID:            cuid.New(),
+						EncryptedDataKey: []byte(cuid.New()),
+						Ciphertext:       []byte(cuid.New()),
+					}
+
+					mockEncryptionService := encryptionMock.NewMockEncryptionContract(mockCtrl)
+					mockEncryptionService.
+						EXPECT().
+						Encrypt(gomock.Any(), []byte("kubeconfig of first")).
+						Return(encryptedValue, nil)
+					mockEncryptionService.
+						EXPECT().
+						Decrypt(gomock.Any(), encryptedValue).
+						Return([]byte("kubeconfig of first"), nil)
+
+					_, err := newService(mockEncryptionService).GetKubeconfig(ctx, newRequest())
+					Ω(err).Should(BeNil())
+
+					secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, kubeconfig.SecretName, metav1.GetOptions{})
+					Ω(err).Should(BeNil())
+					Ω(secret.Data).ShouldNot(HaveKey("kubeconfig"))
+					Ω(secret.Data["ciphertext"]).Should(Equal(encryptedValue.Ciphertext))
+
+					response, err := newService(mockEncryptionService).GetKubeconfig(ctx, newRequest())
+					Ω(err).Should(BeNil())
+					Ω(response.KubeconfigContent).Should(Equal("kubeconfig of first"))
+					Ω(readCount).Should(Equal(1))
+				})
+			})
+		})
+	})
+})
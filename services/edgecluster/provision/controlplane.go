@@ -0,0 +1,978 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+)
+
+var controlPlaneReplica int32 = 1
+var waitForControlPlaneTimeout int64 = 120
+
+// kubeconfigReadAttempts is the number of times the kubeconfig is read once the control plane container is ready, a
+// second apart, as the kubeconfig is only written once the API server is up
+const kubeconfigReadAttempts = 60
+
+// deploymentRevisionAnnotation is the annotation the deployment controller records the revision of the deployment
+// a replica set is created for with
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// ControlPlaneEndpointResolver returns the address and the port the control plane running in the given namespace is
+// reachable on, which the kubeconfig of the edge cluster is pointed to
+type ControlPlaneEndpointResolver func(
+	ctx context.Context,
+	namespace string,
+	serviceDetails *v1.Service) (address string, port int32, err error)
+
+// ControlPlaneSpec describes the control plane a provisioner runs in the namespace of an edge cluster
+type ControlPlaneSpec struct {
+	// ClusterTypeName is the type name of the edge cluster the catalogue charts are resolved for, e.g. K3S
+	ClusterTypeName string
+
+	// Name is the name of the service and the deployment of the control plane
+	Name string
+
+	// ContainerName is the name of the control plane container that writes the kubeconfig
+	ContainerName string
+
+	// KubeconfigFilePath is the path of the kubeconfig file in the control plane container
+	KubeconfigFilePath string
+
+	// ResolveEndpoint returns the endpoint the kubeconfig of the edge cluster is pointed to
+	ResolveEndpoint ControlPlaneEndpointResolver
+}
+
+// ControlPlane implements the provisioning flow shared by the provisioners that run the control plane of the edge
+// cluster in a deployment of the host cluster. The provisioners only provide the resources specific to their
+// distribution and the endpoint the kubeconfig is pointed to.
+type ControlPlane struct {
+	logger          *zap.Logger
+	clientset       kubernetes.Interface
+	restConfig      *rest.Config
+	helmService     helm.HelmHelperContract
+	eventBus        event.EventBusContract
+	chartCatalogue  catalogue.ChartCatalogueContract
+	kubeconfigCache kubeconfig.KubeconfigCacheContract
+	spec            ControlPlaneSpec
+}
+
+// NewControlPlane creates new instance of the ControlPlane, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// clientset: Mandatory. The client set of the host cluster
+// restConfig: Mandatory. The rest config of the host cluster used to execute commands in the control plane pod
+// helmService: Mandatory. Reference to the service that installs the helm charts on the provisioned edge cluster
+// eventBus: Mandatory. Reference to the event bus the provisioning events are published to
+// chartCatalogue: Mandatory. Reference to the service that provides the helm charts installed on the edge cluster
+// kubeconfigCache: Mandatory. Reference to the service that caches the kubeconfig of the edge cluster
+// spec: Mandatory. The description of the control plane
+// Returns the new control plane or error if something goes wrong
+func NewControlPlane(
+	logger *zap.Logger,
+	clientset kubernetes.Interface,
+	restConfig *rest.Config,
+	helmService helm.HelmHelperContract,
+	eventBus event.EventBusContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigCache kubeconfig.KubeconfigCacheContract,
+	spec ControlPlaneSpec) (*ControlPlane, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if clientset == nil {
+		return nil, commonErrors.NewArgumentNilError("clientset", "clientset is required")
+	}
+
+	if restConfig == nil {
+		return nil, commonErrors.NewArgumentNilError("restConfig", "restConfig is required")
+	}
+
+	if helmService == nil {
+		return nil, commonErrors.NewArgumentNilError("helmService", "helmService is required")
+	}
+
+	if eventBus == nil {
+		return nil, commonErrors.NewArgumentNilError("eventBus", "eventBus is required")
+	}
+
+	if chartCatalogue == nil {
+		return nil, commonErrors.NewArgumentNilError("chartCatalogue", "chartCatalogue is required")
+	}
+
+	if kubeconfigCache == nil {
+		return nil, commonErrors.NewArgumentNilError("kubeconfigCache", "kubeconfigCache is required")
+	}
+
+	if spec.ResolveEndpoint == nil {
+		return nil, commonErrors.NewArgumentNilError("spec.ResolveEndpoint", "spec.ResolveEndpoint is required")
+	}
+
+	return &ControlPlane{
+		logger:          logger,
+		clientset:       clientset,
+		restConfig:      restConfig,
+		helmService:     helmService,
+		eventBus:        eventBus,
+		chartCatalogue:  chartCatalogue,
+		kubeconfigCache: kubeconfigCache,
+		spec:            spec,
+	}, nil
+}
+
+// CreateProvision creates the namespace of the edge cluster, creates the resources of the control plane in it and
+// installs the catalogue charts once the control plane is ready. The provision is deleted if the resources cannot be
+// created.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to provision a new edge cluster
+// ownership: Mandatory. The ownership recorded on the namespace
+// createResources: Mandatory. Creates the resources of the control plane in the given namespace
+// Returns either the result of provisioning new edge cluster or error if something goes wrong.
+func (controlPlane *ControlPlane) CreateProvision(
+	ctx context.Context,
+	request *types.CreateProvisionRequest,
+	ownership models.ProvisionOwnership,
+	createResources func(namespace string) error) (response *types.CreateProvisionResponse, err error) {
+	namespace := GetNamespace(request.EdgeClusterID)
+
+	if err = controlPlane.createNamespace(ctx, namespace, ownership); err != nil {
+		return
+	}
+
+	controlPlane.PublishEvent(
+		ctx,
+		request.EdgeClusterID,
+		models.EdgeClusterEventTypeNamespaceCreated,
+		models.ProvisioningStatusProvisioning,
+		namespace)
+
+	if err = createResources(namespace); err != nil {
+		_, _ = controlPlane.DeleteProvision(ctx, &types.DeleteProvisionRequest{EdgeClusterID: request.EdgeClusterID})
+
+		return
+	}
+
+	response = &types.CreateProvisionResponse{}
+
+	err = controlPlane.waitForControlPlaneAndInstallCharts(ctx, request.EdgeClusterID, request.StatusReporter)
+
+	return
+}
+
+// UpdateProvision brings the deployment of the control plane up to date, retrying if it is changed concurrently, and
+// installs the catalogue charts once the updated control plane is ready
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to update an existing provision
+// ownership: Mandatory. The ownership recorded on the deployment
+// updateDeployment: Mandatory. Brings the pod template of the given deployment of the control plane to its desired state
+// Returns either the result of updating an existing provision or error if something goes wrong.
+func (controlPlane *ControlPlane) UpdateProvision(
+	ctx context.Context,
+	request *types.UpdateProvisionRequest,
+	ownership models.ProvisionOwnership,
+	updateDeployment func(deployment *appsv1.Deployment) error) (response *types.UpdateProvisionResponse, err error) {
+	namespace := GetNamespace(request.EdgeClusterID)
+
+	err = retry.RetryOnConflict(
+		retry.DefaultRetry,
+		func() (err error) {
+			client := controlPlane.clientset.AppsV1().Deployments(namespace)
+
+			deployment, err := client.Get(ctx, controlPlane.spec.Name, metav1.GetOptions{})
+			if err != nil {
+				controlPlane.logger.Error("failed to update the edge cluster", zap.Error(err))
+
+				return
+			}
+
+			if err = updateDeployment(deployment); err != nil {
+				return
+			}
+
+			SetOwnership(&deployment.ObjectMeta, ownership)
+			setControlPlaneLabel(&deployment.Spec.Template, controlPlane.spec.Name)
+
+			if _, err = client.Update(ctx, deployment, metav1.UpdateOptions{}); err != nil {
+				controlPlane.logger.Error("failed to update the edge custer", zap.Error(err))
+
+				return
+			}
+
+			return
+		})
+
+	if err != nil {
+		return
+	}
+
+	// The updated control plane pod replaces the one the cached kubeconfig was read from
+	InvalidateKubeconfig(ctx, controlPlane.kubeconfigCache, namespace)
+
+	response = &types.UpdateProvisionResponse{}
+
+	err = controlPlane.waitForControlPlaneAndInstallCharts(ctx, request.EdgeClusterID, request.StatusReporter)
+
+	return
+}
+
+// DeleteProvision deletes the namespace of the edge cluster with all the resources in it, uninstalling the catalogue
+// charts first if requested
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete an existing provision
+// Returns either the result of deleting an existing provision or error if something goes wrong.
+func (controlPlane *ControlPlane) DeleteProvision(
+	ctx context.Context,
+	request *types.DeleteProvisionRequest) (response *types.DeleteProvisionResponse, err error) {
+	namespace := GetNamespace(request.EdgeClusterID)
+
+	failedCleanups := []models.CleanupFailure{}
+	if request.UninstallCharts {
+		failedCleanups = controlPlane.uninstallHelmCharts(ctx, request)
+	}
+
+	deletePolicy := metav1.DeletePropagationForeground
+
+	if err = controlPlane.clientset.CoreV1().Namespaces().Delete(
+		ctx,
+		namespace,
+		metav1.DeleteOptions{
+			PropagationPolicy: &deletePolicy,
+		}); apierrors.IsNotFound(err) {
+		// The namespace is already deleted, e.g. by a previous attempt
+		err = nil
+	}
+
+	if err != nil {
+		controlPlane.logger.Error("failed to delete namespace", zap.Error(err))
+
+		return
+	}
+
+	InvalidateKubeconfig(ctx, controlPlane.kubeconfigCache, namespace)
+
+	response = &types.DeleteProvisionResponse{
+		FailedCleanups: failedCleanups,
+	}
+
+	return
+}
+
+// ReconcileProvision repairs the resources of the control plane that drifted from their desired state, publishing an
+// event for every repaired resource. The helm charts are only reconciled if no resource was repaired, as the control
+// plane is restarted by the repair.
+// ctx: Mandatory The reference to the context
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// reconcileResources: Mandatory. Repairs the resources of the control plane, calling repaired for every repair
+// Returns either the repaired resources or error if something goes wrong.
+func (controlPlane *ControlPlane) ReconcileProvision(
+	ctx context.Context,
+	edgeClusterID string,
+	reconcileResources func(repaired DriftRepaired) error) (response *types.ReconcileProvisionResponse, err error) {
+	repairs := []models.DriftRepair{}
+	repaired := func(repair models.DriftRepair) {
+		repairs = append(repairs, repair)
+		controlPlane.PublishEvent(
+			ctx,
+			edgeClusterID,
+			models.EdgeClusterEventTypeDriftRepaired,
+			models.ProvisioningStatusReady,
+			fmt.Sprintf("%s: %s", repair.Resource, repair.Message))
+	}
+
+	if err = reconcileResources(repaired); err != nil {
+		return
+	}
+
+	if len(repairs) == 0 {
+		if err = controlPlane.reconcileHelmCharts(ctx, edgeClusterID, repaired); err != nil {
+			controlPlane.logger.Error("failed to reconcile the helm charts", zap.Error(err))
+
+			return
+		}
+	}
+
+	response = &types.ReconcileProvisionResponse{
+		Repairs: repairs,
+	}
+
+	return
+}
+
+// ReadClusterSecret reads the cluster secret the control plane of an existing provision is running with from the
+// given environment variable of the control plane container. The secret is read from the Kubernetes secret the
+// variable refers to, or from the variable itself for the control planes provisioned before the secret was introduced.
+// ctx: Mandatory The reference to the context
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// envName: Mandatory. The name of the environment variable that holds the cluster secret
+// Returns either the cluster secret or error if something goes wrong
+func (controlPlane *ControlPlane) ReadClusterSecret(
+	ctx context.Context,
+	edgeClusterID string,
+	envName string) (string, error) {
+	namespace := GetNamespace(edgeClusterID)
+
+	deployment, err := controlPlane.clientset.AppsV1().Deployments(namespace).Get(ctx, controlPlane.spec.Name, metav1.GetOptions{})
+	if err != nil {
+		controlPlane.logger.Error("failed to get the deployment", zap.Error(err), zap.String("namespace", namespace))
+
+		return "", err
+	}
+
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name != controlPlane.spec.ContainerName {
+			continue
+		}
+
+		for _, env := range container.Env {
+			if env.Name != envName {
+				continue
+			}
+
+			if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+				return env.Value, nil
+			}
+
+			clusterSecret, err := ReadSecretKeyRef(ctx, controlPlane.clientset, namespace, env.ValueFrom.SecretKeyRef)
+			if err != nil {
+				controlPlane.logger.Error("failed to read the cluster secret", zap.Error(err), zap.String("namespace", namespace))
+
+				return "", err
+			}
+
+			return clusterSecret, nil
+		}
+	}
+
+	return "", types.NewUnknownError(fmt.Sprintf("the deployment does not contain the %s cluster secret", controlPlane.spec.ClusterTypeName))
+}
+
+// GetProvisionDetails returns the service the control plane is exposed on and the kubeconfig of the edge cluster,
+// pointed to the endpoint the control plane is reachable on
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to retrieve information on an existing provision
+// Returns either the result of retrieving information on an provision or error if something goes wrong.
+func (controlPlane *ControlPlane) GetProvisionDetails(
+	ctx context.Context,
+	request *types.GetProvisionDetailsRequest) (response *types.GetProvisionDetailsResponse, err error) {
+	namespace := GetNamespace(request.EdgeClusterID)
+
+	serviceDetails, err := controlPlane.GetServiceDetails(ctx, namespace)
+	if err != nil {
+		controlPlane.logger.Error("failed to get service details", zap.Error(err))
+
+		return
+	}
+
+	address, port, err := controlPlane.spec.ResolveEndpoint(ctx, namespace, serviceDetails)
+	if err != nil {
+		return
+	}
+
+	kubeconfigContent, err := GetKubeconfig(
+		ctx,
+		controlPlane.kubeconfigCache,
+		controlPlane.clientset,
+		controlPlane.restConfig,
+		namespace,
+		controlPlane.spec.ContainerName,
+		controlPlane.spec.KubeconfigFilePath)
+	if err != nil {
+		controlPlane.logger.Error("failed to get kubeconfig content", zap.Error(err))
+
+		return
+	}
+
+	if kubeconfigContent, err = RewriteKubeconfigServer(kubeconfigContent, address, port); err != nil {
+		controlPlane.logger.Error("failed to rewrite kubeconfig server address", zap.Error(err))
+
+		return
+	}
+
+	response = &types.GetProvisionDetailsResponse{
+		ProvisionDetails: models.ProvisionDetails{
+			Service:           serviceDetails,
+			KubeconfigContent: kubeconfigContent,
+		}}
+
+	return
+}
+
+// ListNodes lists an existing edge cluster nodes details
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list an existing edge cluster nodes details
+// Returns an existing edge cluster nodes details or error if something goes wrong.
+func (controlPlane *ControlPlane) ListNodes(
+	ctx context.Context,
+	request *types.ListNodesRequest) (response *types.ListNodesResponse, err error) {
+	clientset, err := controlPlane.createClientsetForEdgeCluster(ctx, request.EdgeClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := ListNodes(ctx, clientset)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &types.ListNodesResponse{Nodes: nodes}
+
+	return
+}
+
+// ListPods lists an existing edge cluster pods that matchs the given search criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request that contains the search criteria to filter the pods
+// Returns the list of running pods that matchs the given search criteria or error if something goes wrong.
+func (controlPlane *ControlPlane) ListPods(
+	ctx context.Context,
+	request *types.ListPodsRequest) (response *types.ListPodsResponse, err error) {
+	clientset, err := controlPlane.createClientsetForEdgeCluster(ctx, request.EdgeClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := ListPods(ctx, clientset, request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &types.ListPodsResponse{Pods: pods}
+
+	return
+}
+
+// ListServices lists an existing edge cluster services that matchs the given search criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request that contains the search criteria to filter the services
+// Returns the list of services that matches the given search criteria or error if something goes wrong.
+func (controlPlane *ControlPlane) ListServices(
+	ctx context.Context,
+	request *types.ListServicesRequest) (response *types.ListServicesResponse, err error) {
+	clientset, err := controlPlane.createClientsetForEdgeCluster(ctx, request.EdgeClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := ListServices(ctx, clientset, request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	response = &types.ListServicesResponse{Services: services}
+
+	return
+}
+
+// CreateService creates the service the control plane is exposed on, leaving the service untouched if it already
+// exists
+// ctx: Mandatory The reference to the context
+// serviceConfig: Mandatory. The desired state of the service
+// Returns error if something goes wrong
+func (controlPlane *ControlPlane) CreateService(ctx context.Context, serviceConfig *v1.Service) error {
+	if _, err := controlPlane.clientset.CoreV1().Services(serviceConfig.Namespace).Create(
+		ctx,
+		serviceConfig,
+		metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		controlPlane.logger.Error("failed to create service", zap.Error(err), zap.Any("Config", serviceConfig))
+
+		return err
+	}
+
+	return nil
+}
+
+// CreateDeployment creates the deployment that runs the control plane, or brings it up to date if it is left behind
+// by an interrupted provisioning
+// ctx: Mandatory The reference to the context
+// deploymentConfig: Mandatory. The desired state of the deployment
+// Returns error if something goes wrong
+func (controlPlane *ControlPlane) CreateDeployment(ctx context.Context, deploymentConfig *appsv1.Deployment) (err error) {
+	client := controlPlane.clientset.AppsV1().Deployments(deploymentConfig.Namespace)
+
+	if _, err = client.Create(ctx, deploymentConfig, metav1.CreateOptions{}); apierrors.IsAlreadyExists(err) {
+		_, err = client.Update(ctx, deploymentConfig, metav1.UpdateOptions{})
+	}
+
+	if err != nil {
+		controlPlane.logger.Error("failed to create edge cluster", zap.Error(err), zap.Any("Config", deploymentConfig))
+	}
+
+	return
+}
+
+// CreateClusterSecret creates the secret that holds the cluster secret of the control plane, or replaces it if it
+// already exists
+// ctx: Mandatory The reference to the context
+// clusterSecret: Mandatory. The desired state of the secret
+// Returns error if something goes wrong
+func (controlPlane *ControlPlane) CreateClusterSecret(ctx context.Context, clusterSecret *v1.Secret) (err error) {
+	if err = ApplySecret(ctx, controlPlane.clientset, clusterSecret); err != nil {
+		controlPlane.logger.Error(
+			"failed to create the cluster secret",
+			zap.Error(err),
+			zap.String("namespace", clusterSecret.Namespace))
+	}
+
+	return
+}
+
+// GetServiceDetails returns the service the control plane running in the given namespace is exposed on
+// ctx: Mandatory The reference to the context
+// namespace: Mandatory. The namespace of the edge cluster
+// Returns either the service or error if something goes wrong
+func (controlPlane *ControlPlane) GetServiceDetails(ctx context.Context, namespace string) (*v1.Service, error) {
+	serviceDetails, err := controlPlane.clientset.CoreV1().Services(namespace).Get(ctx, controlPlane.spec.Name, metav1.GetOptions{})
+	if err != nil {
+		controlPlane.logger.Error("failed to fetch service info", zap.Error(err))
+
+		return nil, err
+	}
+
+	return serviceDetails, nil
+}
+
+// WaitForLoadBalancerAddress waits for an address to be assigned to the load balancer of the service the control
+// plane running in the given namespace is exposed on
+// ctx: Mandatory The reference to the context
+// namespace: Mandatory. The namespace of the edge cluster
+// Returns either the load balancer address or error if no address is assigned in time
+func (controlPlane *ControlPlane) WaitForLoadBalancerAddress(ctx context.Context, namespace string) (string, error) {
+	watch, err := controlPlane.clientset.CoreV1().Services(namespace).Watch(ctx, metav1.ListOptions{
+		Watch:          true,
+		TimeoutSeconds: &waitForControlPlaneTimeout,
+	})
+	if err != nil {
+		controlPlane.logger.Error("failed to retrieve advertise address", zap.Error(err))
+
+		return "", err
+	}
+
+	defer watch.Stop()
+
+	for event := range watch.ResultChan() {
+		if serviceDetails, ok := event.Object.(*v1.Service); ok {
+			if address := GetLoadBalancerAddress(serviceDetails); address != "" {
+				return address, nil
+			}
+		}
+	}
+
+	return "", types.NewUnknownError("failed to retrieve advertise address")
+}
+
+// PublishEvent publishes an event of the given edge cluster. Failing to publish the event is logged and ignored.
+// ctx: Mandatory The reference to the context
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// eventType: Mandatory. The type of the event
+// status: Mandatory. The provisioning status of the edge cluster
+// message: Optional. The details of the event
+func (controlPlane *ControlPlane) PublishEvent(
+	ctx context.Context,
+	edgeClusterID string,
+	eventType models.EdgeClusterEventType,
+	status models.ProvisioningStatus,
+	message string) {
+	PublishEvent(ctx, controlPlane.logger, controlPlane.eventBus, models.EdgeClusterEvent{
+		EdgeClusterID: edgeClusterID,
+		Type:          eventType,
+		Status:        status,
+		Message:       message,
+	})
+}
+
+// GetNamespaceConfig returns the desired state of the namespace that hosts the edge cluster
+// namespace: Mandatory. The namespace of the edge cluster
+// ownership: Mandatory. The ownership recorded on the namespace
+// Returns the desired state of the namespace
+func GetNamespaceConfig(namespace string, ownership models.ProvisionOwnership) *v1.Namespace {
+	namespaceConfig := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}
+
+	SetOwnership(&namespaceConfig.ObjectMeta, ownership)
+
+	return namespaceConfig
+}
+
+// GetServiceConfig returns the desired state of the service the control plane is exposed on. The service selects the
+// pods of the deployment returned by GetDeploymentConfig with the same name.
+// namespace: Mandatory. The namespace of the edge cluster
+// name: Mandatory. The name of the control plane
+// port: Mandatory. The port the service is exposed on
+// targetPort: Mandatory. The port the control plane listens on
+// serviceType: Mandatory. The type of the service
+// ownership: Mandatory. The ownership recorded on the service
+// Returns the desired state of the service
+func GetServiceConfig(
+	namespace string,
+	name string,
+	port int32,
+	targetPort int,
+	serviceType v1.ServiceType,
+	ownership models.ProvisionOwnership) *v1.Service {
+	serviceConfig := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"k8s-app": name,
+			},
+		},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{
+					Name:       name,
+					Protocol:   v1.ProtocolTCP,
+					Port:       port,
+					TargetPort: intstr.FromInt(targetPort),
+				},
+			},
+			Selector: map[string]string{
+				name: name,
+			},
+			Type: serviceType,
+		},
+	}
+
+	SetOwnership(&serviceConfig.ObjectMeta, ownership)
+
+	return serviceConfig
+}
+
+// GetDeploymentConfig returns the desired state of the deployment that runs the control plane with the given pod
+// template. The ownership is only recorded on the deployment, so recording it does not restart the control plane.
+// namespace: Mandatory. The namespace of the edge cluster
+// name: Mandatory. The name of the control plane
+// template: Mandatory. The pod template of the control plane, labelled so the deployment and the service select it
+// ownership: Mandatory. The ownership recorded on the deployment
+// Returns the desired state of the deployment
+func GetDeploymentConfig(
+	namespace string,
+	name string,
+	template v1.PodTemplateSpec,
+	ownership models.ProvisionOwnership) *appsv1.Deployment {
+	setControlPlaneLabel(&template, name)
+
+	deploymentConfig := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &controlPlaneReplica,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					name: name,
+				},
+			},
+			Template: template,
+		},
+	}
+
+	SetOwnership(&deploymentConfig.ObjectMeta, ownership)
+
+	return deploymentConfig
+}
+
+// IsPodOfLatestRevision returns whether the pod is created by the replica set of the latest revision of the
+// deployment, so the pods of the previous revisions still running while the deployment is rolled are told apart. The
+// latest revision is only known once the deployment controller observed the latest change to the deployment.
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the deployment runs on
+// namespace: Mandatory. The namespace of the deployment
+// deploymentName: Mandatory. The name of the deployment
+// pod: Mandatory. The pod to check
+// Returns whether the pod is created by the latest revision or error if something goes wrong
+func IsPodOfLatestRevision(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+	deploymentName string,
+	pod *v1.Pod) (bool, error) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	if deployment.Status.ObservedGeneration < deployment.Generation {
+		return false, nil
+	}
+
+	replicaSets, err := clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	var latestReplicaSet *appsv1.ReplicaSet
+	var latestRevision int64 = -1
+
+	for i := range replicaSets.Items {
+		replicaSet := &replicaSets.Items[i]
+		if !metav1.IsControlledBy(replicaSet, deployment) {
+			continue
+		}
+
+		revision, err := strconv.ParseInt(replicaSet.Annotations[deploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if revision > latestRevision {
+			latestReplicaSet = replicaSet
+			latestRevision = revision
+		}
+	}
+
+	return latestReplicaSet != nil && metav1.IsControlledBy(pod, latestReplicaSet), nil
+}
+
+// setControlPlaneLabel labels the pod template of the control plane, so the deployment and the service select its pods
+func setControlPlaneLabel(template *v1.PodTemplateSpec, name string) {
+	if template.Labels == nil {
+		template.Labels = map[string]string{}
+	}
+
+	template.Labels[name] = name
+}
+
+func (controlPlane *ControlPlane) createNamespace(
+	ctx context.Context,
+	namespace string,
+	ownership models.ProvisionOwnership) error {
+	if _, err := controlPlane.clientset.CoreV1().Namespaces().Create(
+		ctx,
+		GetNamespaceConfig(namespace, ownership),
+		metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		controlPlane.logger.Error("failed to create namespace", zap.Error(err), zap.String("namespace", namespace))
+
+		return err
+	}
+
+	return nil
+}
+
+func (controlPlane *ControlPlane) waitForControlPlaneAndInstallCharts(
+	ctx context.Context,
+	edgeClusterID string,
+	statusReporter types.ProvisioningStatusReporter) error {
+	isReady, err := controlPlane.isControlPlanePodReady(ctx, edgeClusterID)
+	if err != nil {
+		controlPlane.logger.Error("control plane pod status is not ready", zap.Error(err))
+
+		return err
+	}
+
+	if !isReady {
+		controlPlane.logger.Error("control plane pod status is not ready")
+
+		return types.NewUnknownError(fmt.Sprintf("%s pod status is not ready", controlPlane.spec.ClusterTypeName))
+	}
+
+	controlPlane.PublishEvent(
+		ctx,
+		edgeClusterID,
+		models.EdgeClusterEventTypeServerPodReady,
+		models.ProvisioningStatusProvisioning,
+		"")
+
+	ReportStatus(statusReporter, models.ProvisioningStatusInstallingCharts)
+
+	if err = controlPlane.installHelmCharts(ctx, edgeClusterID); err != nil {
+		controlPlane.logger.Error("failed to install the helm charts", zap.Error(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (controlPlane *ControlPlane) isControlPlanePodReady(ctx context.Context, edgeClusterID string) (bool, error) {
+	namespace := GetNamespace(edgeClusterID)
+	podWatch, err := controlPlane.clientset.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector:  fmt.Sprintf("%s=%s", controlPlane.spec.Name, controlPlane.spec.Name),
+		Watch:          true,
+		TimeoutSeconds: &waitForControlPlaneTimeout,
+	})
+	if err != nil {
+		controlPlane.logger.Error("failed to retrieve control plane pod status", zap.Error(err))
+
+		return false, err
+	}
+
+	defer podWatch.Stop()
+
+	for event := range podWatch.ResultChan() {
+		pod, ok := event.Object.(*v1.Pod)
+		if !ok || event.Type == watch.Deleted || !isPodContainersReady(pod) {
+			continue
+		}
+
+		// The pods of the previous revision keep running while the control plane is rolled, and the kubeconfig must
+		// be read from the pod of the latest revision
+		isLatest, err := IsPodOfLatestRevision(ctx, controlPlane.clientset, namespace, controlPlane.spec.Name, pod)
+		if err != nil {
+			controlPlane.logger.Warn(
+				"failed to find the latest revision of the control plane",
+				zap.Error(err),
+				zap.String("edgeClusterID", edgeClusterID))
+
+			continue
+		}
+
+		if !isLatest {
+			continue
+		}
+
+		podWatch.Stop()
+
+		for i := 0; i < kubeconfigReadAttempts; i++ {
+			if _, err := controlPlane.GetProvisionDetails(ctx, &types.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID}); err == nil {
+				return true, nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return false, ctx.Err()
+			case <-time.After(time.Second):
+			}
+		}
+
+		return false, nil
+	}
+
+	return false, types.NewUnknownError("failed to retrieve control plane pod status")
+}
+
+// isPodContainersReady returns whether all the containers of the pod are ready
+func isPodContainersReady(pod *v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.ContainersReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func (controlPlane *ControlPlane) createClientsetForEdgeCluster(
+	ctx context.Context,
+	edgeClusterID string) (clientset *kubernetes.Clientset, err error) {
+	getProvisionDetailsResponse, err := controlPlane.GetProvisionDetails(
+		ctx,
+		&types.GetProvisionDetailsRequest{
+			EdgeClusterID: edgeClusterID,
+		})
+	if err != nil {
+		return
+	}
+
+	if clientset, err = NewClientsetForKubeconfig(
+		getProvisionDetailsResponse.ProvisionDetails.KubeconfigContent); err != nil {
+		controlPlane.logger.Error("failed to create client set", zap.Error(err))
+
+		return
+	}
+
+	return
+}
+
+func (controlPlane *ControlPlane) installHelmCharts(ctx context.Context, edgeClusterID string) error {
+	provisionDetails, err := controlPlane.GetProvisionDetails(ctx, &types.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID})
+	if err != nil {
+		return err
+	}
+
+	controlPlane.PublishEvent(
+		ctx,
+		edgeClusterID,
+		models.EdgeClusterEventTypeKubeconfigFetched,
+		models.ProvisioningStatusInstallingCharts,
+		"")
+
+	return InstallCatalogueCharts(
+		ctx,
+		controlPlane.helmService,
+		controlPlane.chartCatalogue,
+		provisionDetails.ProvisionDetails.KubeconfigContent,
+		edgeClusterID,
+		controlPlane.spec.ClusterTypeName,
+		func(releaseName string) {
+			controlPlane.PublishEvent(
+				ctx,
+				edgeClusterID,
+				models.EdgeClusterEventTypeHelmChartInstalled,
+				models.ProvisioningStatusInstallingCharts,
+				releaseName)
+		})
+}
+
+func (controlPlane *ControlPlane) reconcileHelmCharts(
+	ctx context.Context,
+	edgeClusterID string,
+	repaired DriftRepaired) error {
+	provisionDetails, err := controlPlane.GetProvisionDetails(ctx, &types.GetProvisionDetailsRequest{EdgeClusterID: edgeClusterID})
+	if err != nil {
+		return err
+	}
+
+	return ReconcileCatalogueCharts(
+		ctx,
+		controlPlane.helmService,
+		controlPlane.chartCatalogue,
+		provisionDetails.ProvisionDetails.KubeconfigContent,
+		edgeClusterID,
+		controlPlane.spec.ClusterTypeName,
+		repaired)
+}
+
+// uninstallHelmCharts uninstalls the catalogue charts installed on the edge cluster. The edge cluster is expected to be
+// partially provisioned or already gone, so failing to reach it is reported as a failed cleanup rather than an error.
+func (controlPlane *ControlPlane) uninstallHelmCharts(
+	ctx context.Context,
+	request *types.DeleteProvisionRequest) []models.CleanupFailure {
+	provisionDetails, err := controlPlane.GetProvisionDetails(ctx, &types.GetProvisionDetailsRequest{EdgeClusterID: request.EdgeClusterID})
+	if err != nil {
+		return []models.CleanupFailure{{Resource: "kubeconfig", Message: err.Error()}}
+	}
+
+	if provisionDetails.ProvisionDetails.KubeconfigContent == "" {
+		return []models.CleanupFailure{{Resource: "kubeconfig", Message: "the edge cluster kubeconfig is not available"}}
+	}
+
+	return UninstallCatalogueCharts(
+		ctx,
+		controlPlane.helmService,
+		controlPlane.chartCatalogue,
+		provisionDetails.ProvisionDetails.KubeconfigContent,
+		request.EdgeClusterID,
+		controlPlane.spec.ClusterTypeName,
+		request.UninstallChartOptions,
+		func(releaseName string) {
+			controlPlane.PublishEvent(
+				ctx,
+				request.EdgeClusterID,
+				models.EdgeClusterEventTypeHelmChartUninstalled,
+				models.ProvisioningStatusDeleting,
+				releaseName)
+		})
+}
@@ -0,0 +1,389 @@
+package provision_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	catalogueMock "github.com/decentralized-cloud/edge-cluster/services/catalogue/mock"
+	helmMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	kubeconfigMock "github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	eventMock "github.com/decentralized-cloud/edge-cluster/services/event/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Control Plane Tests", func() {
+	const (
+		controlPlaneName = "control-plane"
+		envName          = "CLUSTER_SECRET"
+	)
+
+	var (
+		mockCtrl                *gomock.Controller
+		mockHelmService         *helmMock.MockHelmHelperContract
+		mockEventBus            *eventMock.MockEventBusContract
+		mockChartCatalogue      *catalogueMock.MockChartCatalogueContract
+		mockKubeconfigCache     *kubeconfigMock.MockKubeconfigCacheContract
+		logger                  *zap.Logger
+		clientset               *fake.Clientset
+		restConfig              *rest.Config
+		spec                    provision.ControlPlaneSpec
+		ctx                     context.Context
+		edgeClusterID           string
+		namespace               string
+		ownership               models.ProvisionOwnership
+		sut                     *provision.ControlPlane
+		newControlPlaneWithSpec func(spec provision.ControlPlaneSpec) (*provision.ControlPlane, error)
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockHelmService = helmMock.NewMockHelmHelperContract(mockCtrl)
+		mockEventBus = eventMock.NewMockEventBusContract(mockCtrl)
+		mockChartCatalogue = catalogueMock.NewMockChartCatalogueContract(mockCtrl)
+		mockKubeconfigCache = kubeconfigMock.NewMockKubeconfigCacheContract(mockCtrl)
+		logger = zap.NewNop()
+		clientset = fake.NewSimpleClientset()
+		restConfig = &rest.Config{}
+		ctx = context.Background()
+		edgeClusterID = cuid.New()
+		namespace = provision.GetNamespace(edgeClusterID)
+		ownership = provision.NewOwnership(edgeClusterID, cuid.New(), cuid.New()+"@test.com", cuid.New(), models.K3S)
+		spec = provision.ControlPlaneSpec{
+			ClusterTypeName:    "K3S",
+			Name:               controlPlaneName,
+			ContainerName:      controlPlaneName,
+			KubeconfigFilePath: "/kubeconfig.yaml",
+			ResolveEndpoint: func(ctx context.Context, namespace string, serviceDetails *v1.Service) (string, int32, error) {
+				return "127.0.0.1", 6443, nil
+			},
+		}
+
+		newControlPlaneWithSpec = func(spec provision.ControlPlaneSpec) (*provision.ControlPlane, error) {
+			return provision.NewControlPlane(
+				logger,
+				clientset,
+				restConfig,
+				mockHelmService,
+				mockEventBus,
+				mockChartCatalogue,
+				mockKubeconfigCache,
+				spec)
+		}
+
+		mockEventBus.
+			EXPECT().
+			Publish(gomock.Any(), gomock.Any()).
+			AnyTimes()
+
+		mockKubeconfigCache.
+			EXPECT().
+			InvalidateKubeconfig(gomock.Any(), gomock.Any()).
+			AnyTimes()
+
+		var err error
+		sut, err = newControlPlaneWithSpec(spec)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate ControlPlane", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				logger = nil
+
+				controlPlane, err := newControlPlaneWithSpec(spec)
+				Ω(controlPlane).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("kubeconfig cache is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				controlPlane, err := provision.NewControlPlane(
+					logger,
+					clientset,
+					restConfig,
+					mockHelmService,
+					mockEventBus,
+					mockChartCatalogue,
+					nil,
+					spec)
+				Ω(controlPlane).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the endpoint resolver is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				spec.ResolveEndpoint = nil
+
+				controlPlane, err := newControlPlaneWithSpec(spec)
+				Ω(controlPlane).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("ControlPlane is instantiated", func() {
+		Describe("CreateProvision", func() {
+			It("should create the namespace with the ownership and delete it if the resources cannot be created", func() {
+				expectedError := errors.New(cuid.New())
+				createdIn := ""
+
+				response, err := sut.CreateProvision(
+					ctx,
+					&types.CreateProvisionRequest{EdgeClusterID: edgeClusterID},
+					ownership,
+					func(namespace string) error {
+						createdIn = namespace
+						namespaceDetails, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+						Ω(err).Should(BeNil())
+
+						recorded, ok := provision.GetOwnership(namespaceDetails.ObjectMeta)
+						Ω(ok).Should(BeTrue())
+						Ω(recorded.EdgeClusterID).Should(Equal(edgeClusterID))
+
+						return expectedError
+					})
+				Ω(response).Should(BeNil())
+				Ω(err).Should(Equal(expectedError))
+				Ω(createdIn).Should(Equal(namespace))
+
+				_, err = clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+
+		Describe("UpdateProvision", func() {
+			It("should return the error and leave the deployment untouched if the pod template cannot be built", func() {
+				deploymentConfig := provision.GetDeploymentConfig(namespace, controlPlaneName, v1.PodTemplateSpec{}, ownership)
+				_, err := clientset.AppsV1().Deployments(namespace).Create(ctx, deploymentConfig, metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+
+				expectedError := errors.New(cuid.New())
+				response, err := sut.UpdateProvision(
+					ctx,
+					&types.UpdateProvisionRequest{EdgeClusterID: edgeClusterID},
+					ownership,
+					func(deployment *appsv1.Deployment) error {
+						deployment.Spec.Template.Annotations = map[string]string{"changed": "true"}
+
+						return expectedError
+					})
+				Ω(response).Should(BeNil())
+				Ω(err).Should(Equal(expectedError))
+
+				deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, controlPlaneName, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(deployment.Spec.Template.Annotations).Should(BeEmpty())
+			})
+
+			It("should return the error if the deployment does not exist", func() {
+				response, err := sut.UpdateProvision(
+					ctx,
+					&types.UpdateProvisionRequest{EdgeClusterID: edgeClusterID},
+					ownership,
+					func(deployment *appsv1.Deployment) error { return nil })
+				Ω(response).Should(BeNil())
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+
+		Describe("DeleteProvision", func() {
+			It("should delete the namespace and succeed if it is already deleted", func() {
+				_, err := clientset.CoreV1().Namespaces().Create(
+					ctx,
+					provision.GetNamespaceConfig(namespace, ownership),
+					metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+
+				for i := 0; i < 2; i++ {
+					response, err := sut.DeleteProvision(ctx, &types.DeleteProvisionRequest{EdgeClusterID: edgeClusterID})
+					Ω(err).Should(BeNil())
+					Ω(response.FailedCleanups).Should(BeEmpty())
+				}
+
+				_, err = clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+
+		Describe("ReconcileProvision", func() {
+			It("should return the repairs and skip the helm charts if any resource is repaired", func() {
+				repair := models.DriftRepair{Resource: cuid.New(), Message: cuid.New()}
+
+				response, err := sut.ReconcileProvision(ctx, edgeClusterID, func(repaired provision.DriftRepaired) error {
+					repaired(repair)
+
+					return nil
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Repairs).Should(Equal([]models.DriftRepair{repair}))
+			})
+
+			It("should return the error if the resources cannot be reconciled", func() {
+				expectedError := errors.New(cuid.New())
+
+				response, err := sut.ReconcileProvision(ctx, edgeClusterID, func(repaired provision.DriftRepaired) error {
+					return expectedError
+				})
+				Ω(response).Should(BeNil())
+				Ω(err).Should(Equal(expectedError))
+			})
+		})
+
+		Describe("ReadClusterSecret", func() {
+			createDeployment := func(env v1.EnvVar) {
+				deploymentConfig := provision.GetDeploymentConfig(
+					namespace,
+					controlPlaneName,
+					v1.PodTemplateSpec{
+						Spec: v1.PodSpec{
+							Containers: []v1.Container{{Name: controlPlaneName, Env: []v1.EnvVar{env}}},
+						},
+					},
+					ownership)
+
+				_, err := clientset.AppsV1().Deployments(namespace).Create(ctx, deploymentConfig, metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+			}
+
+			It("should read the cluster secret from the secret the environment variable refers to", func() {
+				clusterSecret := cuid.New()
+				secret := provision.GetClusterSecretConfig(namespace, "cluster-secret", "key", clusterSecret, ownership)
+				_, err := clientset.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+
+				createDeployment(provision.GetSecretEnvVar(envName, secret.Name, "key"))
+
+				value, err := sut.ReadClusterSecret(ctx, edgeClusterID, envName)
+				Ω(err).Should(BeNil())
+				Ω(value).Should(Equal(clusterSecret))
+			})
+
+			It("should read the cluster secret from the environment variable provisioned before the secret was introduced", func() {
+				clusterSecret := cuid.New()
+				createDeployment(v1.EnvVar{Name: envName, Value: clusterSecret})
+
+				value, err := sut.ReadClusterSecret(ctx, edgeClusterID, envName)
+				Ω(err).Should(BeNil())
+				Ω(value).Should(Equal(clusterSecret))
+			})
+
+			It("should return the error if the control plane has no cluster secret", func() {
+				createDeployment(v1.EnvVar{Name: cuid.New(), Value: cuid.New()})
+
+				_, err := sut.ReadClusterSecret(ctx, edgeClusterID, envName)
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+	})
+
+	Context("IsPodOfLatestRevision is called", func() {
+		var (
+			deployment *appsv1.Deployment
+			newPod     func(replicaSet *appsv1.ReplicaSet) *v1.Pod
+		)
+
+		createReplicaSet := func(revision string) *appsv1.ReplicaSet {
+			replicaSet := &appsv1.ReplicaSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            controlPlaneName + "-" + revision,
+					Namespace:       namespace,
+					UID:             k8sTypes.UID(cuid.New()),
+					Annotations:     map[string]string{"deployment.kubernetes.io/revision": revision},
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment"))},
+				},
+			}
+
+			_, err := clientset.AppsV1().ReplicaSets(namespace).Create(ctx, replicaSet, metav1.CreateOptions{})
+			Ω(err).Should(BeNil())
+
+			return replicaSet
+		}
+
+		BeforeEach(func() {
+			deployment = provision.GetDeploymentConfig(namespace, controlPlaneName, v1.PodTemplateSpec{}, ownership)
+			deployment.UID = k8sTypes.UID(cuid.New())
+			deployment.Generation = 2
+			deployment.Status.ObservedGeneration = 2
+
+			var err error
+			deployment, err = clientset.AppsV1().Deployments(namespace).Create(ctx, deployment, metav1.CreateOptions{})
+			Ω(err).Should(BeNil())
+
+			newPod = func(replicaSet *appsv1.ReplicaSet) *v1.Pod {
+				return &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            cuid.New(),
+						Namespace:       namespace,
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(replicaSet, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+					},
+				}
+			}
+		})
+
+		It("should only accept the pods of the replica set of the latest revision", func() {
+			previousReplicaSet := createReplicaSet("9")
+			latestReplicaSet := createReplicaSet("10")
+
+			isLatest, err := provision.IsPodOfLatestRevision(ctx, clientset, namespace, controlPlaneName, newPod(latestReplicaSet))
+			Ω(err).Should(BeNil())
+			Ω(isLatest).Should(BeTrue())
+
+			isLatest, err = provision.IsPodOfLatestRevision(ctx, clientset, namespace, controlPlaneName, newPod(previousReplicaSet))
+			Ω(err).Should(BeNil())
+			Ω(isLatest).Should(BeFalse())
+		})
+
+		It("should not accept any pod until the deployment controller observed the latest change", func() {
+			latestReplicaSet := createReplicaSet("1")
+
+			deployment.Generation = 3
+			_, err := clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+			Ω(err).Should(BeNil())
+
+			isLatest, err := provision.IsPodOfLatestRevision(ctx, clientset, namespace, controlPlaneName, newPod(latestReplicaSet))
+			Ω(err).Should(BeNil())
+			Ω(isLatest).Should(BeFalse())
+		})
+
+		It("should return the error if the deployment does not exist", func() {
+			_, err := provision.IsPodOfLatestRevision(ctx, clientset, namespace, cuid.New(), &v1.Pod{})
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("GetServiceConfig and GetDeploymentConfig are called", func() {
+		It("should make the service select the pods of the deployment", func() {
+			serviceConfig := provision.GetServiceConfig(namespace, controlPlaneName, 443, 6443, v1.ServiceTypeClusterIP, ownership)
+			deploymentConfig := provision.GetDeploymentConfig(namespace, controlPlaneName, v1.PodTemplateSpec{}, ownership)
+
+			Ω(deploymentConfig.Spec.Selector.MatchLabels).Should(Equal(serviceConfig.Spec.Selector))
+			Ω(deploymentConfig.Spec.Template.Labels).Should(Equal(serviceConfig.Spec.Selector))
+			Ω(serviceConfig.Spec.Ports[0].TargetPort.IntValue()).Should(Equal(6443))
+
+			_, ok := provision.GetOwnership(deploymentConfig.ObjectMeta)
+			Ω(ok).Should(BeTrue())
+			Ω(deploymentConfig.Spec.Template.Annotations).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ServiceExposurePolicy restricts the addresses the edge clusters can be exposed on to the networks and the domains
+// the operator allows, as the addresses are applied to the objects of the host cluster
+type ServiceExposurePolicy struct {
+	allowedNetworks []*net.IPNet
+	allowedDomains  []string
+}
+
+// NewServiceExposurePolicy creates the policy that allows the given networks and domains
+// allowedCIDRs: Optional. The CIDRs of the networks the external IPs must belong to, no external IP is allowed if empty
+// allowedDomains: Optional. The domains the host names must belong to, no host name is allowed if empty
+// Returns either the policy or error if any of the CIDRs is invalid
+func NewServiceExposurePolicy(allowedCIDRs []string, allowedDomains []string) (ServiceExposurePolicy, error) {
+	policy := ServiceExposurePolicy{}
+
+	for _, cidr := range allowedCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return ServiceExposurePolicy{}, commonErrors.NewArgumentErrorWithError(
+				"allowedCIDRs",
+				fmt.Sprintf("%s is not a valid CIDR", cidr),
+				err)
+		}
+
+		policy.allowedNetworks = append(policy.allowedNetworks, network)
+	}
+
+	for _, domain := range allowedDomains {
+		policy.allowedDomains = append(policy.allowedDomains, normalizeHost(domain))
+	}
+
+	return policy, nil
+}
+
+// VerifyAddress verifies the address the edge cluster is exposed on is allowed. The edge clusters exposed through a
+// load balancer or a node port have no address to verify.
+// serviceExposure: Mandatory. The exposure of the edge cluster
+// Returns ArgumentError if the address is not allowed
+func (policy ServiceExposurePolicy) VerifyAddress(serviceExposure models.ServiceExposure) error {
+	if serviceExposure.Mode != models.ServiceExposureModeIngress &&
+		serviceExposure.Mode != models.ServiceExposureModeExternalAddress {
+		return nil
+	}
+
+	if ip := net.ParseIP(serviceExposure.Address); ip != nil {
+		for _, network := range policy.allowedNetworks {
+			if network.Contains(ip) {
+				return nil
+			}
+		}
+
+		return commonErrors.NewArgumentError(
+			"serviceExposure",
+			fmt.Sprintf("the edge clusters cannot be exposed on %s", serviceExposure.Address))
+	}
+
+	host := normalizeHost(serviceExposure.Address)
+	for _, domain := range policy.allowedDomains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return nil
+		}
+	}
+
+	return commonErrors.NewArgumentError(
+		"serviceExposure",
+		fmt.Sprintf("the edge clusters cannot be exposed on %s", serviceExposure.Address))
+}
+
+// VerifyServiceExposureClaims verifies the address the edge cluster is exposed on is not already claimed by an
+// ingress host, an external IP or a load balancer IP of a service in any other namespace of the host cluster
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the host cluster
+// namespace: Optional. The namespace of the edge cluster whose own claims are ignored, all claims count if empty
+// serviceExposure: Mandatory. The exposure of the edge cluster
+// Returns ArgumentError if the address is already claimed, or error if something goes wrong
+func VerifyServiceExposureClaims(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+	serviceExposure models.ServiceExposure) error {
+	if serviceExposure.Mode != models.ServiceExposureModeIngress &&
+		serviceExposure.Mode != models.ServiceExposureModeExternalAddress {
+		return nil
+	}
+
+	claimed := commonErrors.NewArgumentError(
+		"serviceExposure",
+		fmt.Sprintf("%s is already claimed by another service", serviceExposure.Address))
+
+	if ip := net.ParseIP(serviceExposure.Address); ip != nil {
+		services, err := clientset.CoreV1().Services("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+
+		for _, service := range services.Items {
+			if service.Namespace == namespace {
+				continue
+			}
+
+			for _, externalIP := range service.Spec.ExternalIPs {
+				if ip.Equal(net.ParseIP(externalIP)) {
+					return claimed
+				}
+			}
+
+			for _, item := range service.Status.LoadBalancer.Ingress {
+				if ip.Equal(net.ParseIP(item.IP)) {
+					return claimed
+				}
+			}
+		}
+
+		return nil
+	}
+
+	ingresses, err := clientset.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	host := normalizeHost(serviceExposure.Address)
+	for _, ingress := range ingresses.Items {
+		if ingress.Namespace == namespace {
+			continue
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			if normalizeHost(rule.Host) == host {
+				return claimed
+			}
+		}
+	}
+
+	return nil
+}
+
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
@@ -0,0 +1,134 @@
+package provision_test
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Exposure Tests", func() {
+	Context("NewServiceExposurePolicy is called", func() {
+		It("should return ArgumentError when a CIDR is invalid", func() {
+			_, err := provision.NewServiceExposurePolicy([]string{"203.0.113.1"}, nil)
+			Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+		})
+	})
+
+	Context("VerifyAddress is called", func() {
+		var policy provision.ServiceExposurePolicy
+
+		BeforeEach(func() {
+			var err error
+			policy, err = provision.NewServiceExposurePolicy([]string{"203.0.113.0/24"}, []string{"Edge.Example.com."})
+			Ω(err).Should(BeNil())
+		})
+
+		It("should allow the edge clusters exposed through a load balancer or a node port", func() {
+			Ω(policy.VerifyAddress(models.ServiceExposure{Mode: models.ServiceExposureModeLoadBalancer})).Should(BeNil())
+			Ω(policy.VerifyAddress(models.ServiceExposure{Mode: models.ServiceExposureModeNodePort})).Should(BeNil())
+		})
+
+		It("should allow the addresses in the allowed networks and domains", func() {
+			for _, serviceExposure := range []models.ServiceExposure{
+				{Mode: models.ServiceExposureModeExternalAddress, Address: "203.0.113.10"},
+				{Mode: models.ServiceExposureModeExternalAddress, Address: "edge.example.com"},
+				{Mode: models.ServiceExposureModeIngress, Address: "cluster.EDGE.example.com"},
+			} {
+				Ω(policy.VerifyAddress(serviceExposure)).Should(BeNil())
+			}
+		})
+
+		It("should return ArgumentError for the addresses outside the allowed networks and domains", func() {
+			for _, serviceExposure := range []models.ServiceExposure{
+				{Mode: models.ServiceExposureModeExternalAddress, Address: "8.8.8.8"},
+				{Mode: models.ServiceExposureModeExternalAddress, Address: "example.com"},
+				{Mode: models.ServiceExposureModeIngress, Address: "notedge.example.com"},
+				{Mode: models.ServiceExposureModeIngress, Address: "203.0.113.10.example.org"},
+			} {
+				Ω(commonErrors.IsArgumentError(policy.VerifyAddress(serviceExposure))).Should(BeTrue())
+			}
+		})
+
+		It("should not allow any address when nothing is allowed", func() {
+			policy, err := provision.NewServiceExposurePolicy(nil, nil)
+			Ω(err).Should(BeNil())
+
+			Ω(policy.VerifyAddress(models.ServiceExposure{
+				Mode:    models.ServiceExposureModeIngress,
+				Address: "edge.example.com",
+			})).ShouldNot(BeNil())
+		})
+	})
+
+	Context("VerifyServiceExposureClaims is called", func() {
+		var (
+			ctx       context.Context
+			namespace string
+			clientset *fake.Clientset
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			namespace = provision.GetNamespace(cuid.New())
+			clientset = fake.NewSimpleClientset(
+				&networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Name: "k3s", Namespace: namespace},
+					Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "own.edge.example.com"}}},
+				},
+				&networkingv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "edge-cluster"},
+					Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "api.edge.example.com"}}},
+				},
+				&v1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+					Spec:       v1.ServiceSpec{ExternalIPs: []string{"203.0.113.1"}},
+					Status: v1.ServiceStatus{
+						LoadBalancer: v1.LoadBalancerStatus{Ingress: []v1.LoadBalancerIngress{{IP: "203.0.113.2"}}},
+					},
+				})
+		})
+
+		It("should return ArgumentError when another namespace claims the address", func() {
+			for _, serviceExposure := range []models.ServiceExposure{
+				{Mode: models.ServiceExposureModeIngress, Address: "API.edge.example.com"},
+				{Mode: models.ServiceExposureModeExternalAddress, Address: "203.0.113.1"},
+				{Mode: models.ServiceExposureModeExternalAddress, Address: "203.0.113.2"},
+			} {
+				err := provision.VerifyServiceExposureClaims(ctx, clientset, namespace, serviceExposure)
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			}
+		})
+
+		It("should ignore the claims of the edge cluster itself", func() {
+			Ω(provision.VerifyServiceExposureClaims(ctx, clientset, namespace, models.ServiceExposure{
+				Mode:    models.ServiceExposureModeIngress,
+				Address: "own.edge.example.com",
+			})).Should(BeNil())
+		})
+
+		It("should count the claims of every namespace when no namespace is given", func() {
+			err := provision.VerifyServiceExposureClaims(ctx, clientset, "", models.ServiceExposure{
+				Mode:    models.ServiceExposureModeIngress,
+				Address: "own.edge.example.com",
+			})
+			Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+		})
+
+		It("should allow the unclaimed addresses", func() {
+			Ω(provision.VerifyServiceExposureClaims(ctx, clientset, namespace, models.ServiceExposure{
+				Mode:    models.ServiceExposureModeExternalAddress,
+				Address: "203.0.113.3",
+			})).Should(BeNil())
+		})
+	})
+})
@@ -0,0 +1,210 @@
+package provision
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/registry"
+	"github.com/micro-business/go-core/pkg/util"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// ManagedByLabel is the well known label that records the tool that manages a resource
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+
+	// ManagedByValue is the value of the ManagedByLabel of the resources provisioned by the service
+	ManagedByValue = "edge-cluster"
+
+	// EdgeClusterIDKey is the label and annotation that records the identifier of the edge cluster
+	EdgeClusterIDKey = "edge-cluster.decentralized-cloud.io/edge-cluster-id"
+
+	// ProjectIDKey is the label and annotation that records the identifier of the project of the edge cluster
+	ProjectIDKey = "edge-cluster.decentralized-cloud.io/project-id"
+
+	// ClusterTypeKey is the label and annotation that records the type name of the edge cluster
+	ClusterTypeKey = "edge-cluster.decentralized-cloud.io/cluster-type"
+
+	// UserEmailKey is the annotation that records the email of the user the edge cluster belongs to
+	UserEmailKey = "edge-cluster.decentralized-cloud.io/user-email"
+
+	// EdgeClusterNameKey is the annotation that records the name of the edge cluster
+	EdgeClusterNameKey = "edge-cluster.decentralized-cloud.io/edge-cluster-name"
+
+	// ServiceVersionKey is the annotation that records the version of the service that provisioned the resource
+	ServiceVersionKey = "edge-cluster.decentralized-cloud.io/service-version"
+)
+
+// NewOwnership returns the ownership of the resources provisioned for the given edge cluster by this version of the
+// service
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// projectID: Optional. The identifier of the project of the edge cluster
+// userEmail: Optional. The email of the user the edge cluster belongs to
+// edgeClusterName: Optional. The name of the edge cluster
+// clusterType: Mandatory. The type of the edge cluster
+// Returns the ownership of the provisioned resources
+func NewOwnership(
+	edgeClusterID string,
+	projectID string,
+	userEmail string,
+	edgeClusterName string,
+	clusterType models.ClusterType) models.ProvisionOwnership {
+	return models.ProvisionOwnership{
+		EdgeClusterID:   edgeClusterID,
+		ProjectID:       projectID,
+		UserEmail:       userEmail,
+		EdgeClusterName: edgeClusterName,
+		ClusterType:     clusterType,
+		ServiceVersion:  util.GetVersion().Version,
+	}
+}
+
+// GetOwnershipMetadata returns the labels and annotations that record the given ownership. Only the fields that are
+// valid label values are recorded as labels, so the resources can be selected by them, while all the fields are
+// recorded as annotations.
+// ownership: Mandatory. The ownership to record
+// Returns the object metadata that only contains the ownership labels and annotations
+func GetOwnershipMetadata(ownership models.ProvisionOwnership) metav1.ObjectMeta {
+	clusterTypeName := getClusterTypeName(ownership.ClusterType)
+	labels := map[string]string{ManagedByLabel: ManagedByValue}
+	annotations := map[string]string{}
+
+	for key, value := range map[string]string{
+		EdgeClusterIDKey: ownership.EdgeClusterID,
+		ProjectIDKey:     ownership.ProjectID,
+		ClusterTypeKey:   clusterTypeName,
+	} {
+		if value != "" && len(validation.IsValidLabelValue(value)) == 0 {
+			labels[key] = value
+		}
+	}
+
+	for key, value := range map[string]string{
+		EdgeClusterIDKey:   ownership.EdgeClusterID,
+		ProjectIDKey:       ownership.ProjectID,
+		ClusterTypeKey:     clusterTypeName,
+		UserEmailKey:       ownership.UserEmail,
+		EdgeClusterNameKey: ownership.EdgeClusterName,
+		ServiceVersionKey:  ownership.ServiceVersion,
+	} {
+		if value != "" {
+			annotations[key] = value
+		}
+	}
+
+	return metav1.ObjectMeta{
+		Labels:      labels,
+		Annotations: annotations,
+	}
+}
+
+// SetOwnership records the given ownership on the object metadata, overwriting the ownership it already records
+// objectMeta: Mandatory. The object metadata to record the ownership on
+// ownership: Mandatory. The ownership to record
+func SetOwnership(objectMeta *metav1.ObjectMeta, ownership models.ProvisionOwnership) {
+	ownershipMetadata := GetOwnershipMetadata(ownership)
+	objectMeta.Labels = mergeMaps(objectMeta.Labels, ownershipMetadata.Labels)
+	objectMeta.Annotations = mergeMaps(objectMeta.Annotations, ownershipMetadata.Annotations)
+}
+
+// GetOwnership reads the ownership recorded on the object metadata
+// objectMeta: Mandatory. The object metadata to read the ownership from
+// Returns the recorded ownership and true, or false if the object does not record the edge cluster identifier and a
+// registered cluster type
+func GetOwnership(objectMeta metav1.ObjectMeta) (models.ProvisionOwnership, bool) {
+	annotations := objectMeta.Annotations
+	if annotations[EdgeClusterIDKey] == "" {
+		return models.ProvisionOwnership{}, false
+	}
+
+	clusterType, ok := getClusterType(annotations[ClusterTypeKey])
+	if !ok {
+		return models.ProvisionOwnership{}, false
+	}
+
+	return models.ProvisionOwnership{
+		EdgeClusterID:   annotations[EdgeClusterIDKey],
+		ProjectID:       annotations[ProjectIDKey],
+		UserEmail:       annotations[UserEmailKey],
+		EdgeClusterName: annotations[EdgeClusterNameKey],
+		ClusterType:     clusterType,
+		ServiceVersion:  annotations[ServiceVersionKey],
+	}, true
+}
+
+// ListOrphanedNamespaces lists the namespaces that have the shape of the namespaces returned by GetNamespace, but do
+// not host any of the given edge clusters, ordered by their names. Only the namespaces labelled as managed by the
+// service are listed, so a namespace created by anyone else is never reported. The namespaces that are being deleted
+// are skipped.
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge clusters are provisioned in
+// edgeClusterIDs: Mandatory. The identifiers of all the edge clusters that exist in the repository
+// Returns either the orphaned namespaces or error if something goes wrong
+func ListOrphanedNamespaces(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	edgeClusterIDs []string) ([]models.OrphanedNamespace, error) {
+	namespaces, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: ManagedByLabel + "=" + ManagedByValue,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inUseNamespaces := map[string]bool{}
+	for _, edgeClusterID := range edgeClusterIDs {
+		inUseNamespaces[GetNamespace(edgeClusterID)] = true
+	}
+
+	orphanedNamespaces := []models.OrphanedNamespace{}
+	for _, namespace := range namespaces.Items {
+		if !IsEdgeClusterNamespace(namespace.Name) ||
+			inUseNamespaces[namespace.Name] ||
+			namespace.Status.Phase == v1.NamespaceTerminating {
+			continue
+		}
+
+		orphanedNamespace := models.OrphanedNamespace{
+			Name:         namespace.Name,
+			CreationTime: namespace.CreationTimestamp.Time,
+		}
+
+		if ownership, ok := GetOwnership(namespace.ObjectMeta); ok {
+			orphanedNamespace.Ownership = &ownership
+			orphanedNamespace.Adoptable = ownership.UserEmail != "" && GetNamespace(ownership.EdgeClusterID) == namespace.Name
+		}
+
+		orphanedNamespaces = append(orphanedNamespaces, orphanedNamespace)
+	}
+
+	sort.Slice(orphanedNamespaces, func(i, j int) bool {
+		return orphanedNamespaces[i].Name < orphanedNamespaces[j].Name
+	})
+
+	return orphanedNamespaces, nil
+}
+
+func getClusterTypeName(clusterType models.ClusterType) string {
+	for _, registration := range registry.GetRegistrations() {
+		if registration.Descriptor.ClusterType == clusterType {
+			return registration.Descriptor.Name
+		}
+	}
+
+	return ""
+}
+
+func getClusterType(clusterTypeName string) (models.ClusterType, bool) {
+	for _, registration := range registry.GetRegistrations() {
+		if strings.EqualFold(registration.Descriptor.Name, clusterTypeName) {
+			return registration.Descriptor.ClusterType, true
+		}
+	}
+
+	return 0, false
+}
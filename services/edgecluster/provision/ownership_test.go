@@ -0,0 +1,145 @@
+package provision_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	_ "github.com/decentralized-cloud/edge-cluster/services/edgecluster/k3s" // register the K3S cluster type
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/lucsky/cuid"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ownership Tests", func() {
+	var (
+		ownership models.ProvisionOwnership
+	)
+
+	BeforeEach(func() {
+		ownership = provision.NewOwnership(
+			cuid.New(),
+			cuid.New(),
+			cuid.New()+"@test.com",
+			cuid.New()+" "+cuid.New(),
+			models.K3S)
+		ownership.ServiceVersion = "v1.0.0"
+	})
+
+	Context("SetOwnership is called", func() {
+		It("should record all the fields as annotations and only the valid label values as labels", func() {
+			objectMeta := metav1.ObjectMeta{Labels: map[string]string{"k8s-app": "k3s"}}
+
+			provision.SetOwnership(&objectMeta, ownership)
+
+			Ω(objectMeta.Labels).Should(Equal(map[string]string{
+				"k8s-app":                  "k3s",
+				provision.ManagedByLabel:   provision.ManagedByValue,
+				provision.EdgeClusterIDKey: ownership.EdgeClusterID,
+				provision.ProjectIDKey:     ownership.ProjectID,
+				provision.ClusterTypeKey:   "K3S",
+			}))
+			Ω(objectMeta.Annotations).Should(Equal(map[string]string{
+				provision.EdgeClusterIDKey:   ownership.EdgeClusterID,
+				provision.ProjectIDKey:       ownership.ProjectID,
+				provision.ClusterTypeKey:     "K3S",
+				provision.UserEmailKey:       ownership.UserEmail,
+				provision.EdgeClusterNameKey: ownership.EdgeClusterName,
+				provision.ServiceVersionKey:  ownership.ServiceVersion,
+			}))
+		})
+
+		It("should be read back by GetOwnership", func() {
+			objectMeta := metav1.ObjectMeta{}
+			provision.SetOwnership(&objectMeta, ownership)
+
+			recorded, ok := provision.GetOwnership(objectMeta)
+			Ω(ok).Should(BeTrue())
+			Ω(recorded).Should(Equal(ownership))
+		})
+	})
+
+	Context("GetOwnership is called", func() {
+		It("should return false when the edge cluster identifier is not recorded", func() {
+			_, ok := provision.GetOwnership(metav1.ObjectMeta{
+				Annotations: map[string]string{provision.ClusterTypeKey: "K3S"},
+			})
+			Ω(ok).Should(BeFalse())
+		})
+
+		It("should return false when the cluster type is not registered", func() {
+			_, ok := provision.GetOwnership(metav1.ObjectMeta{
+				Annotations: map[string]string{
+					provision.EdgeClusterIDKey: cuid.New(),
+					provision.ClusterTypeKey:   cuid.New(),
+				},
+			})
+			Ω(ok).Should(BeFalse())
+		})
+	})
+
+	Context("ListOrphanedNamespaces is called", func() {
+		It("should only return the managed edge cluster namespaces that are not in use or being deleted", func() {
+			ctx := context.Background()
+			inUseEdgeClusterID := cuid.New()
+			creationTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+			adoptable := v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:              provision.GetNamespace(ownership.EdgeClusterID),
+				CreationTimestamp: creationTime,
+			}}
+			provision.SetOwnership(&adoptable.ObjectMeta, ownership)
+
+			misplaced := v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: provision.GetNamespace(cuid.New())}}
+			provision.SetOwnership(&misplaced.ObjectMeta, ownership)
+
+			unowned := v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+				Name:   provision.GetNamespace(cuid.New()),
+				Labels: map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+			}}
+
+			unlabelled := v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: provision.GetNamespace(cuid.New())}}
+
+			clientset := fake.NewSimpleClientset(
+				&adoptable,
+				&misplaced,
+				&unowned,
+				&unlabelled,
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:   provision.GetNamespace(inUseEdgeClusterID),
+					Labels: map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+				}},
+				&v1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   provision.GetNamespace(cuid.New()),
+						Labels: map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+					},
+					Status: v1.NamespaceStatus{Phase: v1.NamespaceTerminating},
+				},
+				&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}})
+
+			orphanedNamespaces, err := provision.ListOrphanedNamespaces(ctx, clientset, []string{inUseEdgeClusterID})
+			Ω(err).Should(BeNil())
+			Ω(orphanedNamespaces).Should(HaveLen(3))
+
+			byName := map[string]models.OrphanedNamespace{}
+			for _, orphanedNamespace := range orphanedNamespaces {
+				byName[orphanedNamespace.Name] = orphanedNamespace
+			}
+
+			Ω(byName[adoptable.Name].Adoptable).Should(BeTrue())
+			Ω(*byName[adoptable.Name].Ownership).Should(Equal(ownership))
+			Ω(byName[adoptable.Name].CreationTime.Equal(creationTime.Time)).Should(BeTrue())
+			Ω(byName[misplaced.Name].Adoptable).Should(BeFalse())
+			Ω(byName[misplaced.Name].Ownership).ShouldNot(BeNil())
+			Ω(byName[unowned.Name].Adoptable).Should(BeFalse())
+			Ω(byName[unowned.Name].Ownership).Should(BeNil())
+			Ω(byName).ShouldNot(HaveKey(unlabelled.Name))
+		})
+	})
+})
@@ -0,0 +1,521 @@
+// Package provision implements the building blocks shared by the edge cluster provisioners
+package provision
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+var edgeClusterNamespaceRegexp = regexp.MustCompile(fmt.Sprintf("^[0-9a-f]{%d}$", sha256.Size224*2))
+
+// GetNamespace returns the namespace that hosts the resources of the given edge cluster
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// Returns the namespace name
+func GetNamespace(edgeClusterID string) string {
+	return fmt.Sprintf("%x", sha256.Sum224([]byte(edgeClusterID)))
+}
+
+// IsEdgeClusterNamespace returns whether the given namespace has the shape of the namespaces returned by GetNamespace
+// name: Mandatory. The namespace name
+// Returns true if the namespace can host the resources of an edge cluster
+func IsEdgeClusterNamespace(name string) bool {
+	return edgeClusterNamespaceRegexp.MatchString(name)
+}
+
+// GetHostRestConfig returns the configuration of the cluster the edge clusters are provisioned in. The configuration
+// is read from the KUBECONFIG file, then from the kube config file in the home directory and finally from the
+// service account the service runs with.
+// Returns either the rest config of the host cluster or error if something goes wrong
+func GetHostRestConfig() (*rest.Config, error) {
+	if kubeConfig := os.Getenv("KUBECONFIG"); kubeConfig != "" {
+		return clientcmd.BuildConfigFromFlags("", kubeConfig)
+	}
+
+	homePath, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeConfigFilePath := filepath.Join(homePath, ".kube", "config")
+	if _, err = os.Stat(kubeConfigFilePath); !os.IsNotExist(err) {
+		return clientcmd.BuildConfigFromFlags("", kubeConfigFilePath)
+	}
+
+	return rest.InClusterConfig()
+}
+
+// GetLoadBalancerAddress returns the first address assigned to the load balancer of the given service
+// service: Mandatory. The service to return its load balancer address
+// Returns the IP or host name assigned to the load balancer or empty string if no address is assigned yet
+func GetLoadBalancerAddress(service *v1.Service) string {
+	for _, item := range service.Status.LoadBalancer.Ingress {
+		if item.IP != "" {
+			return item.IP
+		}
+
+		if item.Hostname != "" {
+			return item.Hostname
+		}
+	}
+
+	return ""
+}
+
+// GetNodeAddress returns the address the node ports of the host cluster are reachable on. The first ready node by
+// name is picked, so the same node is picked as long as the nodes do not change, and its external IP is preferred over
+// its internal IP.
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the host cluster
+// Returns either the node address or error if something goes wrong, including no ready node having an address
+func GetNodeAddress(ctx context.Context, clientset kubernetes.Interface) (string, error) {
+	nodeList, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", types.NewUnknownErrorWithError("failed to retreive node list", err)
+	}
+
+	nodes := nodeList.Items
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	for _, addressType := range []v1.NodeAddressType{v1.NodeExternalIP, v1.NodeInternalIP} {
+		for _, node := range nodes {
+			if !isNodeReady(node) {
+				continue
+			}
+
+			for _, address := range node.Status.Addresses {
+				if address.Type == addressType && address.Address != "" {
+					return address.Address, nil
+				}
+			}
+		}
+	}
+
+	return "", types.NewUnknownError("no ready node has an address assigned")
+}
+
+// GetKubeconfig returns the kubeconfig written by the control plane running in the given namespace. The kubeconfig is
+// served by the kubeconfig cache and is only read from the control plane pod if it is missing or stale. The ownership
+// recorded on the namespace is recorded on the secret the kubeconfig is persisted in.
+// ctx: Mandatory The reference to the context
+// kubeconfigCache: Mandatory. The service that caches the kubeconfigs of the edge clusters
+// clientset: Mandatory. The client set of the host cluster
+// restConfig: Mandatory. The rest config of the host cluster used to execute commands in the control plane pod
+// namespace: Mandatory. The namespace the control plane pod is running in
+// containerName: Mandatory. The name of the control plane container
+// kubeconfigFilePath: Mandatory. The path of the kubeconfig file in the control plane container
+// Returns either the kubeconfig content or error if something goes wrong
+func GetKubeconfig(
+	ctx context.Context,
+	kubeconfigCache kubeconfig.KubeconfigCacheContract,
+	clientset kubernetes.Interface,
+	restConfig *rest.Config,
+	namespace string,
+	containerName string,
+	kubeconfigFilePath string) (string, error) {
+	response, err := kubeconfigCache.GetKubeconfig(ctx, &kubeconfig.GetKubeconfigRequest{
+		Namespace:     namespace,
+		ContainerName: containerName,
+		ReadKubeconfig: func(ctx context.Context, pod *v1.Pod) (string, error) {
+			return ReadKubeconfigFromPod(ctx, clientset, restConfig, pod, containerName, kubeconfigFilePath)
+		},
+		WriteSecretMetadata: func(ctx context.Context, objectMeta *metav1.ObjectMeta) error {
+			namespaceDetails, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+
+			if ownership, ok := GetOwnership(namespaceDetails.ObjectMeta); ok {
+				SetOwnership(objectMeta, ownership)
+			}
+
+			return nil
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return response.KubeconfigContent, nil
+}
+
+// InvalidateKubeconfig removes the cached kubeconfig of the control plane running in the given namespace from memory,
+// e.g. once the control plane is updated or deleted
+// ctx: Mandatory The reference to the context
+// kubeconfigCache: Mandatory. The service that caches the kubeconfigs of the edge clusters
+// namespace: Mandatory. The namespace the control plane pod is running in
+func InvalidateKubeconfig(ctx context.Context, kubeconfigCache kubeconfig.KubeconfigCacheContract, namespace string) {
+	_, _ = kubeconfigCache.InvalidateKubeconfig(ctx, &kubeconfig.InvalidateKubeconfigRequest{Namespace: namespace})
+}
+
+// ReadKubeconfigFromPod reads the kubeconfig file written by the control plane running in the given pod
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the host cluster
+// restConfig: Mandatory. The rest config of the host cluster used to execute commands in the control plane pod
+// pod: Mandatory. The control plane pod
+// containerName: Mandatory. The name of the control plane container
+// kubeconfigFilePath: Mandatory. The path of the kubeconfig file in the control plane container
+// Returns either the kubeconfig content or error if something goes wrong
+func ReadKubeconfigFromPod(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	restConfig *rest.Config,
+	pod *v1.Pod,
+	containerName string,
+	kubeconfigFilePath string) (string, error) {
+	execRequest := clientset.CoreV1().RESTClient().
+		Post().
+		Resource("pods").
+		Name(pod.Name).
+		Namespace(pod.Namespace).
+		SubResource("exec").
+		Param("container", containerName).
+		Param("stdout", "true").
+		Param("command", "cat").
+		Param("command", kubeconfigFilePath)
+
+	executor, err := remotecommand.NewSPDYExecutor(restConfig, http.MethodPost, execRequest.URL())
+	if err != nil {
+		return "", types.NewUnknownErrorWithError("failed to retrieve KubeConfig content.", err)
+	}
+
+	output := &bytes.Buffer{}
+
+	if err = executor.Stream(remotecommand.StreamOptions{Stdout: output}); err != nil {
+		return "", types.NewUnknownErrorWithError("failed to retrieve KubeConfig content", err)
+	}
+
+	return output.String(), nil
+}
+
+// RewriteKubeconfigServer points all the clusters of the given kubeconfig to the given address and port. The kubeconfig
+// written by the control plane refers to the loopback address that is not reachable from outside the control plane pod.
+// kubeconfigContent: Mandatory. The kubeconfig content to rewrite
+// address: Mandatory. The address the control plane is reachable on
+// port: Mandatory. The port the control plane is reachable on
+// Returns either the rewritten kubeconfig content or error if something goes wrong
+func RewriteKubeconfigServer(kubeconfigContent string, address string, port int32) (string, error) {
+	if address == "" {
+		return "", types.NewUnknownError("edge cluster address is not assigned yet")
+	}
+
+	config, err := clientcmd.Load([]byte(kubeconfigContent))
+	if err != nil {
+		return "", types.NewUnknownErrorWithError("failed to parse the kubeconfig content", err)
+	}
+
+	for _, cluster := range config.Clusters {
+		cluster.Server = "https://" + net.JoinHostPort(address, strconv.Itoa(int(port)))
+	}
+
+	content, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", types.NewUnknownErrorWithError("failed to serialize the kubeconfig content", err)
+	}
+
+	return string(content), nil
+}
+
+// NewClientsetForKubeconfig creates a client set that connects to the edge cluster the given kubeconfig points to
+// kubeconfigContent: Mandatory. The kubeconfig of the edge cluster
+// Returns either the client set or error if something goes wrong
+func NewClientsetForKubeconfig(kubeconfigContent string) (*kubernetes.Clientset, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfigContent))
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to create Rest config from the given kube config", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to create client set", err)
+	}
+
+	return clientset, nil
+}
+
+// ListNodes lists the nodes of the edge cluster the given client set connects to
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the edge cluster
+// Returns either the edge cluster nodes or error if something goes wrong
+func ListNodes(ctx context.Context, clientset kubernetes.Interface) ([]models.EdgeClusterNode, error) {
+	nodeList, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to retreive node list", err)
+	}
+
+	nodes := []models.EdgeClusterNode{}
+	for _, node := range nodeList.Items {
+		nodes = append(nodes, models.EdgeClusterNode{
+			Node: node,
+		})
+	}
+
+	return nodes, nil
+}
+
+// ListPods lists the pods of the edge cluster the given client set connects to
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the edge cluster
+// namespace: Optional. The namespace to list the pods in, all namespaces if empty
+// Returns either the edge cluster pods or error if something goes wrong
+func ListPods(ctx context.Context, clientset kubernetes.Interface, namespace string) ([]models.EdgeClusterPod, error) {
+	podList, err := clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to retreive pod list", err)
+	}
+
+	pods := []models.EdgeClusterPod{}
+	for _, pod := range podList.Items {
+		pods = append(pods, models.EdgeClusterPod{
+			Pod: pod,
+		})
+	}
+
+	return pods, nil
+}
+
+// ListServices lists the services of the edge cluster the given client set connects to
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the edge cluster
+// namespace: Optional. The namespace to list the services in, all namespaces if empty
+// Returns either the edge cluster services or error if something goes wrong
+func ListServices(ctx context.Context, clientset kubernetes.Interface, namespace string) ([]models.EdgeClusterService, error) {
+	serviceList, err := clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to retreive service list", err)
+	}
+
+	services := []models.EdgeClusterService{}
+	for _, service := range serviceList.Items {
+		services = append(services, models.EdgeClusterService{
+			Service: service,
+		})
+	}
+
+	return services, nil
+}
+
+// InstallCatalogueCharts installs the charts of the chart catalogue that apply to the type of the edge cluster, in
+// the order that satisfies their dependencies. The chart repositories are registered before their charts are installed.
+// ctx: Mandatory The reference to the context
+// helmService: Mandatory. Reference to the service that installs the helm charts
+// chartCatalogue: Mandatory. Reference to the service that provides the charts to install
+// kubeconfigContent: Mandatory. The kubeconfig of the edge cluster to install the charts on
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// clusterTypeName: Mandatory. The type name of the edge cluster
+// chartInstalled: Optional. Called with the release name after every chart is installed
+// Returns error if something goes wrong
+func InstallCatalogueCharts(
+	ctx context.Context,
+	helmService helm.HelmHelperContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigContent string,
+	edgeClusterID string,
+	clusterTypeName string,
+	chartInstalled func(releaseName string)) error {
+	listChartsResponse, err := chartCatalogue.ListCharts(ctx, &catalogue.ListChartsRequest{})
+	if err != nil {
+		return err
+	}
+
+	charts, err := catalogue.ResolveInstallOrder(listChartsResponse.Charts, clusterTypeName)
+	if err != nil {
+		return err
+	}
+
+	for _, chart := range charts {
+		if err = installCatalogueChart(helmService, chart, kubeconfigContent, edgeClusterID, clusterTypeName); err != nil {
+			return err
+		}
+
+		if chartInstalled != nil {
+			chartInstalled(chart.ReleaseName)
+		}
+	}
+
+	return nil
+}
+
+// UninstallCatalogueCharts uninstalls the charts of the chart catalogue that apply to the type of the edge cluster and
+// are installed on it, in the reverse of their install order. Failing to uninstall a chart does not stop the rest of
+// the charts from being uninstalled.
+// ctx: Mandatory The reference to the context
+// helmService: Mandatory. Reference to the service that uninstalls the helm charts
+// chartCatalogue: Mandatory. Reference to the service that provides the installed charts
+// kubeconfigContent: Mandatory. The kubeconfig of the edge cluster to uninstall the charts from
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// clusterTypeName: Mandatory. The type name of the edge cluster
+// options: Mandatory. The options the charts are uninstalled with
+// chartUninstalled: Optional. Called with the release name after every chart is uninstalled
+// Returns the resources that failed to clean up
+func UninstallCatalogueCharts(
+	ctx context.Context,
+	helmService helm.HelmHelperContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigContent string,
+	edgeClusterID string,
+	clusterTypeName string,
+	options helm.UninstallChartOptions,
+	chartUninstalled func(releaseName string)) []models.CleanupFailure {
+	listChartsResponse, err := chartCatalogue.ListCharts(ctx, &catalogue.ListChartsRequest{})
+	if err != nil {
+		return []models.CleanupFailure{{Resource: "chart-catalogue", Message: err.Error()}}
+	}
+
+	charts, err := catalogue.ResolveInstallOrder(listChartsResponse.Charts, clusterTypeName)
+	if err != nil {
+		return []models.CleanupFailure{{Resource: "chart-catalogue", Message: err.Error()}}
+	}
+
+	releases, err := helmService.ListReleases(kubeconfigContent, "")
+	if err != nil {
+		return []models.CleanupFailure{{Resource: "helm-releases", Message: err.Error()}}
+	}
+
+	installedReleases := map[string]bool{}
+	for _, release := range releases {
+		installedReleases[getReleaseResource(release.Namespace, release.Name)] = true
+	}
+
+	failures := []models.CleanupFailure{}
+
+	for i := len(charts) - 1; i >= 0; i-- {
+		chart := charts[i]
+		resource := getReleaseResource(chart.Namespace, chart.ReleaseName)
+
+		if !installedReleases[resource] {
+			continue
+		}
+
+		if err = helmService.UninstallChart(kubeconfigContent, chart.Namespace, chart.ReleaseName, options); err != nil {
+			failures = append(failures, models.CleanupFailure{Resource: resource, Message: err.Error()})
+
+			continue
+		}
+
+		if chartUninstalled != nil {
+			chartUninstalled(chart.ReleaseName)
+		}
+	}
+
+	return failures
+}
+
+// PublishEvent publishes an event of the given edge cluster. Failing to publish the event is logged and ignored.
+// ctx: Mandatory The reference to the context
+// logger: Mandatory. Reference to the logger service
+// eventBus: Mandatory. Reference to the event bus the event is published to
+// edgeClusterEvent: Mandatory. The event to publish
+func PublishEvent(
+	ctx context.Context,
+	logger *zap.Logger,
+	eventBus event.EventBusContract,
+	edgeClusterEvent models.EdgeClusterEvent) {
+	if _, err := eventBus.Publish(ctx, &event.PublishRequest{Event: edgeClusterEvent}); err != nil {
+		logger.Error(
+			"failed to publish the edge cluster event",
+			zap.Error(err),
+			zap.String("edgeClusterID", edgeClusterEvent.EdgeClusterID))
+	}
+}
+
+// ReportStatus reports the new provisioning status if the status reporter is provided
+// statusReporter: Optional. The status reporter provided by the caller of the provisioner
+// status: Mandatory. The new provisioning status
+func ReportStatus(statusReporter types.ProvisioningStatusReporter, status models.ProvisioningStatus) {
+	if statusReporter != nil {
+		statusReporter(status)
+	}
+}
+
+// GetResource returns the identifier of a resource of an edge cluster, e.g. deployment/<namespace>/k3s
+// kind: Mandatory. The kind of the resource
+// namespace: Mandatory. The namespace of the resource, empty for the cluster scoped resources
+// name: Mandatory. The name of the resource
+// Returns the identifier of the resource
+func GetResource(kind, namespace, name string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s/%s", kind, name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+// installCatalogueChart registers the repository of the chart and installs or upgrades its release
+func installCatalogueChart(
+	helmService helm.HelmHelperContract,
+	chart models.ChartCatalogueEntry,
+	kubeconfigContent string,
+	edgeClusterID string,
+	clusterTypeName string) error {
+	if err := helmService.AddRepository(chart.RepositoryName, chart.RepositoryURL); err != nil {
+		return err
+	}
+
+	set := make([]string, 0, len(chart.Set))
+	for _, expression := range chart.Set {
+		set = append(set, catalogue.ExpandPlaceholders(expression, edgeClusterID, clusterTypeName))
+	}
+
+	setString := make([]string, 0, len(chart.SetString))
+	for _, expression := range chart.SetString {
+		setString = append(setString, catalogue.ExpandPlaceholders(expression, edgeClusterID, clusterTypeName))
+	}
+
+	values := []string{}
+	if chart.Values != "" {
+		values = append(values, catalogue.ExpandPlaceholders(chart.Values, edgeClusterID, clusterTypeName))
+	}
+
+	return helmService.InstallChart(
+		kubeconfigContent,
+		chart.Namespace,
+		chart.ReleaseName,
+		chart.RepositoryName,
+		chart.Chart,
+		helm.InstallChartOptions{
+			Version:   chart.Version,
+			Values:    values,
+			Set:       set,
+			SetString: setString,
+			Atomic:    chart.Atomic,
+			Wait:      chart.Wait,
+			Timeout:   chart.Timeout,
+		})
+}
+
+func isNodeReady(node v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func getReleaseResource(namespace, name string) string {
+	return GetResource("helm-release", namespace, name)
+}
@@ -0,0 +1,416 @@
+package provision_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	catalogueMemory "github.com/decentralized-cloud/edge-cluster/services/catalogue/memory"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const kubeconfigContent = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: ZHVtbXk=
+    server: https://127.0.0.1:6443
+  name: default
+contexts:
+- context:
+    cluster: default
+    user: default
+  name: default
+current-context: default
+users:
+- name: default
+  user:
+    token: dummy
+`
+
+func TestProvision(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provision Tests")
+}
+
+var _ = Describe("Provision Tests", func() {
+	Context("GetNamespace is called", func() {
+		It("should return the same valid namespace name for the same edge cluster", func() {
+			edgeClusterID := cuid.New()
+
+			namespace := provision.GetNamespace(edgeClusterID)
+			Ω(namespace).Should(Equal(provision.GetNamespace(edgeClusterID)))
+			Ω(namespace).Should(MatchRegexp("^[a-f0-9]{56}$"))
+			Ω(namespace).ShouldNot(Equal(provision.GetNamespace(cuid.New())))
+		})
+	})
+
+	Context("IsEdgeClusterNamespace is called", func() {
+		It("should only accept the namespaces returned by GetNamespace", func() {
+			Ω(provision.IsEdgeClusterNamespace(provision.GetNamespace(cuid.New()))).Should(BeTrue())
+			Ω(provision.IsEdgeClusterNamespace("kube-system")).Should(BeFalse())
+			Ω(provision.IsEdgeClusterNamespace(provision.GetNamespace(cuid.New()) + "0")).Should(BeFalse())
+		})
+	})
+
+	Context("GetLoadBalancerAddress is called", func() {
+		It("should return empty string when no address is assigned", func() {
+			Ω(provision.GetLoadBalancerAddress(&v1.Service{})).Should(BeEmpty())
+		})
+
+		It("should return the IP address when assigned", func() {
+			service := &v1.Service{}
+			service.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{IP: "10.0.0.1"}}
+
+			Ω(provision.GetLoadBalancerAddress(service)).Should(Equal("10.0.0.1"))
+		})
+
+		It("should return the host name when no IP address is assigned", func() {
+			service := &v1.Service{}
+			service.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{}, {Hostname: "edge.example.com"}}
+
+			Ω(provision.GetLoadBalancerAddress(service)).Should(Equal("edge.example.com"))
+		})
+	})
+
+	Context("GetNodeAddress is called", func() {
+		getNode := func(name string, ready v1.ConditionStatus, addresses ...v1.NodeAddress) *v1.Node {
+			return &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: ready}},
+					Addresses:  addresses,
+				},
+			}
+		}
+
+		It("should return error when no ready node has an address", func() {
+			clientset := fake.NewSimpleClientset(
+				getNode("node-a", v1.ConditionFalse, v1.NodeAddress{Type: v1.NodeExternalIP, Address: "203.0.113.1"}))
+
+			_, err := provision.GetNodeAddress(context.Background(), clientset)
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return the internal IP of the first ready node when no node has an external IP", func() {
+			clientset := fake.NewSimpleClientset(
+				getNode("node-b", v1.ConditionTrue, v1.NodeAddress{Type: v1.NodeInternalIP, Address: "10.0.0.2"}),
+				getNode("node-a", v1.ConditionTrue, v1.NodeAddress{Type: v1.NodeInternalIP, Address: "10.0.0.1"}))
+
+			address, err := provision.GetNodeAddress(context.Background(), clientset)
+			Ω(err).Should(BeNil())
+			Ω(address).Should(Equal("10.0.0.1"))
+		})
+
+		It("should prefer the external IP of the ready nodes", func() {
+			clientset := fake.NewSimpleClientset(
+				getNode("node-a", v1.ConditionTrue, v1.NodeAddress{Type: v1.NodeInternalIP, Address: "10.0.0.1"}),
+				getNode("node-b", v1.ConditionFalse, v1.NodeAddress{Type: v1.NodeExternalIP, Address: "203.0.113.2"}),
+				getNode(
+					"node-c",
+					v1.ConditionTrue,
+					v1.NodeAddress{Type: v1.NodeInternalIP, Address: "10.0.0.3"},
+					v1.NodeAddress{Type: v1.NodeExternalIP, Address: "203.0.113.3"}))
+
+			address, err := provision.GetNodeAddress(context.Background(), clientset)
+			Ω(err).Should(BeNil())
+			Ω(address).Should(Equal("203.0.113.3"))
+		})
+	})
+
+	Context("RewriteKubeconfigServer is called", func() {
+		It("should return error when address is not provided", func() {
+			_, err := provision.RewriteKubeconfigServer(kubeconfigContent, "", 443)
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when kubeconfig content is invalid", func() {
+			_, err := provision.RewriteKubeconfigServer("{", "10.0.0.1", 443)
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should point the clusters to the given address and port", func() {
+			content, err := provision.RewriteKubeconfigServer(kubeconfigContent, "edge.example.com", 8443)
+			Ω(err).Should(BeNil())
+
+			config, err := clientcmd.Load([]byte(content))
+			Ω(err).Should(BeNil())
+			Ω(config.Clusters["default"].Server).Should(Equal("https://edge.example.com:8443"))
+			Ω(config.AuthInfos["default"].Token).Should(Equal("dummy"))
+		})
+	})
+
+	Context("List functions are called", func() {
+		var (
+			ctx       context.Context
+			namespace string
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			namespace = cuid.New()
+		})
+
+		It("should return the nodes of the edge cluster", func() {
+			clientset := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}})
+
+			nodes, err := provision.ListNodes(ctx, clientset)
+			Ω(err).Should(BeNil())
+			Ω(nodes).Should(HaveLen(1))
+			Ω(nodes[0].Node.Name).Should(Equal("node"))
+		})
+
+		It("should return the pods of the given namespace", func() {
+			clientset := fake.NewSimpleClientset(
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: namespace}},
+				&v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: cuid.New()}})
+
+			pods, err := provision.ListPods(ctx, clientset, namespace)
+			Ω(err).Should(BeNil())
+			Ω(pods).Should(HaveLen(1))
+			Ω(pods[0].Pod.Name).Should(Equal("pod"))
+		})
+
+		It("should return the services of the given namespace", func() {
+			clientset := fake.NewSimpleClientset(
+				&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service", Namespace: namespace}},
+				&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: cuid.New()}})
+
+			services, err := provision.ListServices(ctx, clientset, namespace)
+			Ω(err).Should(BeNil())
+			Ω(services).Should(HaveLen(1))
+			Ω(services[0].Service.Name).Should(Equal("service"))
+		})
+	})
+
+	Context("InstallCatalogueCharts or UninstallCatalogueCharts is called", func() {
+		var (
+			mockCtrl        *gomock.Controller
+			mockHelmService *mock_helm.MockHelmHelperContract
+			ctx             context.Context
+			kubeconfig      string
+			edgeClusterID   string
+			charts          []models.ChartCatalogueEntry
+		)
+
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockHelmService = mock_helm.NewMockHelmHelperContract(mockCtrl)
+			ctx = context.Background()
+			kubeconfig = cuid.New()
+			edgeClusterID = cuid.New()
+			charts = []models.ChartCatalogueEntry{
+				{
+					ReleaseName:    "dependant",
+					RepositoryName: "second-repo",
+					RepositoryURL:  "https://second.example.com",
+					Chart:          "second-chart",
+					Namespace:      "second-namespace",
+					Values:         "cluster: ${EDGE_CLUSTER_ID}\n",
+					Set:            []string{"type=${EDGE_CLUSTER_TYPE}", "replicas=1"},
+					SetString:      []string{"id=${EDGE_CLUSTER_ID}"},
+					Wait:           true,
+					DependsOn:      []string{"dependency"},
+				},
+				{
+					ReleaseName:    "dependency",
+					RepositoryName: "first-repo",
+					RepositoryURL:  "https://first.example.com",
+					Chart:          "first-chart",
+					Version:        "1.2.3",
+					Namespace:      "first-namespace",
+					Atomic:         true,
+					Timeout:        10 * time.Minute,
+					Order:          10,
+				},
+				{
+					ReleaseName:    "other-type",
+					RepositoryName: "first-repo",
+					RepositoryURL:  "https://first.example.com",
+					Chart:          "other-chart",
+					Namespace:      "other-namespace",
+					ClusterTypes:   []string{"K0S"},
+				},
+			}
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		It("should install the charts of the cluster type in the dependency order and report every installed chart", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+
+			gomock.InOrder(
+				mockHelmService.
+					EXPECT().
+					AddRepository("first-repo", "https://first.example.com").
+					Return(nil),
+				mockHelmService.
+					EXPECT().
+					InstallChart(kubeconfig, "first-namespace", "dependency", "first-repo", "first-chart", helm.InstallChartOptions{
+						Version:   "1.2.3",
+						Values:    []string{},
+						Set:       []string{},
+						SetString: []string{},
+						Atomic:    true,
+						Timeout:   10 * time.Minute,
+					}).
+					Return(nil),
+				mockHelmService.
+					EXPECT().
+					AddRepository("second-repo", "https://second.example.com").
+					Return(nil),
+				mockHelmService.
+					EXPECT().
+					InstallChart(kubeconfig, "second-namespace", "dependant", "second-repo", "second-chart", helm.InstallChartOptions{
+						Values:    []string{"cluster: " + edgeClusterID + "\n"},
+						Set:       []string{"type=K3S", "replicas=1"},
+						SetString: []string{"id=" + edgeClusterID},
+						Wait:      true,
+					}).
+					Return(nil))
+
+			installedCharts := []string{}
+			err := provision.InstallCatalogueCharts(
+				ctx,
+				mockHelmService,
+				chartCatalogue,
+				kubeconfig,
+				edgeClusterID,
+				"K3S",
+				func(releaseName string) {
+					installedCharts = append(installedCharts, releaseName)
+				})
+
+			Ω(err).Should(BeNil())
+			Ω(installedCharts).Should(Equal([]string{"dependency", "dependant"}))
+		})
+
+		It("should stop and return the error when installing a chart fails", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+			expectedErr := errors.New(cuid.New())
+
+			mockHelmService.
+				EXPECT().
+				AddRepository(gomock.Any(), gomock.Any()).
+				Return(nil)
+
+			mockHelmService.
+				EXPECT().
+				InstallChart(kubeconfig, "first-namespace", "dependency", "first-repo", "first-chart", gomock.Any()).
+				Return(expectedErr)
+
+			err := provision.InstallCatalogueCharts(ctx, mockHelmService, chartCatalogue, kubeconfig, edgeClusterID, "K3S", nil)
+			Ω(err).Should(Equal(expectedErr))
+		})
+
+		It("should uninstall the installed charts of the cluster type in the reverse dependency order", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+			options := helm.UninstallChartOptions{DisableHooks: true, Timeout: time.Minute}
+
+			gomock.InOrder(
+				mockHelmService.
+					EXPECT().
+					ListReleases(kubeconfig, "").
+					Return([]models.HelmRelease{
+						{Name: "dependency", Namespace: "first-namespace"},
+						{Name: "dependant", Namespace: "second-namespace"},
+						{Name: "other-type", Namespace: "other-namespace"},
+					}, nil),
+				mockHelmService.
+					EXPECT().
+					UninstallChart(kubeconfig, "second-namespace", "dependant", options).
+					Return(nil),
+				mockHelmService.
+					EXPECT().
+					UninstallChart(kubeconfig, "first-namespace", "dependency", options).
+					Return(nil))
+
+			uninstalledCharts := []string{}
+			failures := provision.UninstallCatalogueCharts(
+				ctx,
+				mockHelmService,
+				chartCatalogue,
+				kubeconfig,
+				edgeClusterID,
+				"K3S",
+				options,
+				func(releaseName string) {
+					uninstalledCharts = append(uninstalledCharts, releaseName)
+				})
+
+			Ω(failures).Should(BeEmpty())
+			Ω(uninstalledCharts).Should(Equal([]string{"dependant", "dependency"}))
+		})
+
+		It("should skip the charts that are not installed", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+
+			mockHelmService.
+				EXPECT().
+				ListReleases(kubeconfig, "").
+				Return([]models.HelmRelease{{Name: "dependency", Namespace: "another-namespace"}}, nil)
+
+			failures := provision.UninstallCatalogueCharts(
+				ctx, mockHelmService, chartCatalogue, kubeconfig, edgeClusterID, "K3S", helm.UninstallChartOptions{}, nil)
+			Ω(failures).Should(BeEmpty())
+		})
+
+		It("should continue and report every chart that fails to uninstall", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+			expectedErr := errors.New(cuid.New())
+
+			mockHelmService.
+				EXPECT().
+				ListReleases(kubeconfig, "").
+				Return([]models.HelmRelease{
+					{Name: "dependency", Namespace: "first-namespace"},
+					{Name: "dependant", Namespace: "second-namespace"},
+				}, nil)
+
+			gomock.InOrder(
+				mockHelmService.
+					EXPECT().
+					UninstallChart(kubeconfig, "second-namespace", "dependant", gomock.Any()).
+					Return(expectedErr),
+				mockHelmService.
+					EXPECT().
+					UninstallChart(kubeconfig, "first-namespace", "dependency", gomock.Any()).
+					Return(nil))
+
+			failures := provision.UninstallCatalogueCharts(
+				ctx, mockHelmService, chartCatalogue, kubeconfig, edgeClusterID, "K3S", helm.UninstallChartOptions{}, nil)
+			Ω(failures).Should(Equal([]models.CleanupFailure{
+				{Resource: "helm-release/second-namespace/dependant", Message: expectedErr.Error()},
+			}))
+		})
+
+		It("should report the failure when listing the installed releases fails", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+			expectedErr := errors.New(cuid.New())
+
+			mockHelmService.
+				EXPECT().
+				ListReleases(kubeconfig, "").
+				Return(nil, expectedErr)
+
+			failures := provision.UninstallCatalogueCharts(
+				ctx, mockHelmService, chartCatalogue, kubeconfig, edgeClusterID, "K3S", helm.UninstallChartOptions{}, nil)
+			Ω(failures).Should(Equal([]models.CleanupFailure{{Resource: "helm-releases", Message: expectedErr.Error()}}))
+		})
+	})
+})
@@ -0,0 +1,653 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"helm.sh/helm/v3/pkg/release"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DriftRepaired is called with every resource of an edge cluster that drifted from its desired state and was repaired
+type DriftRepaired func(repair models.DriftRepair)
+
+// IgnoreRepairs is passed when the resources are brought to their desired state while provisioning, as the resources
+// are expected to change then
+func IgnoreRepairs(models.DriftRepair) {}
+
+// ReconcileNamespace creates the namespace that hosts the edge cluster if it no longer exists, or brings its labels
+// and annotations back to their desired state
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the namespace that hosts the edge cluster
+// repaired: Mandatory. Called if the namespace is repaired
+// Returns error if something goes wrong, including the namespace being deleted
+func ReconcileNamespace(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	desired *v1.Namespace,
+	repaired DriftRepaired) error {
+	client := clientset.CoreV1().Namespaces()
+	resource := GetResource("namespace", "", desired.Name)
+
+	current, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err = client.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: "the namespace was missing and is created"})
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if current.Status.Phase == v1.NamespaceTerminating {
+		return fmt.Errorf("the namespace %s is being deleted", desired.Name)
+	}
+
+	drifts := getMetadataDrifts(current.ObjectMeta, desired.ObjectMeta)
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	mergeMetadata(&updated.ObjectMeta, desired.ObjectMeta)
+
+	if _, err = client.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{Resource: resource, Message: strings.Join(drifts, ", ")})
+
+	return nil
+}
+
+// ReconcileService creates the service of the edge cluster if it no longer exists, or brings its type, ports,
+// selector, external IPs, labels and annotations back to their desired state. The node ports already allocated to the
+// service are kept.
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the service
+// repaired: Mandatory. Called if the service is repaired
+// Returns error if something goes wrong
+func ReconcileService(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	desired *v1.Service,
+	repaired DriftRepaired) error {
+	client := clientset.CoreV1().Services(desired.Namespace)
+	resource := GetResource("service", desired.Namespace, desired.Name)
+
+	current, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err = client.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: "the service was missing and is created"})
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	drifts := []string{}
+	if current.Spec.Type != desired.Spec.Type {
+		drifts = append(drifts, fmt.Sprintf("the type changed from %s to %s", desired.Spec.Type, current.Spec.Type))
+	}
+
+	if !equality.Semantic.DeepEqual(current.Spec.Selector, desired.Spec.Selector) {
+		drifts = append(drifts, "the selector changed")
+	}
+
+	if !isServicePortsInDesiredState(current.Spec.Ports, desired.Spec.Ports) {
+		drifts = append(drifts, "the ports changed")
+	}
+
+	if !isStringsEqual(current.Spec.ExternalIPs, desired.Spec.ExternalIPs) {
+		drifts = append(drifts, "the external IPs changed")
+	}
+
+	drifts = append(drifts, getMetadataDrifts(current.ObjectMeta, desired.ObjectMeta)...)
+
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	mergeMetadata(&updated.ObjectMeta, desired.ObjectMeta)
+	updated.Spec.Selector = desired.Spec.Selector
+	updated.Spec.Ports = getDesiredServicePorts(current, desired)
+	updated.Spec.Type = desired.Spec.Type
+	updated.Spec.ExternalIPs = desired.Spec.ExternalIPs
+
+	if _, err = client.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{Resource: resource, Message: strings.Join(drifts, ", ")})
+
+	return nil
+}
+
+// ReconcileIngress creates the ingress of the edge cluster if it no longer exists, or brings its rules, TLS, labels and
+// annotations back to their desired state
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the ingress
+// repaired: Mandatory. Called if the ingress is repaired
+// Returns error if something goes wrong
+func ReconcileIngress(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	desired *networkingv1.Ingress,
+	repaired DriftRepaired) error {
+	client := clientset.NetworkingV1().Ingresses(desired.Namespace)
+	resource := GetResource("ingress", desired.Namespace, desired.Name)
+
+	current, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err = client.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: "the ingress was missing and is created"})
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	drifts := []string{}
+	if !equality.Semantic.DeepEqual(current.Spec.Rules, desired.Spec.Rules) {
+		drifts = append(drifts, "the rules changed")
+	}
+
+	if !equality.Semantic.DeepEqual(current.Spec.TLS, desired.Spec.TLS) {
+		drifts = append(drifts, "the TLS changed")
+	}
+
+	drifts = append(drifts, getMetadataDrifts(current.ObjectMeta, desired.ObjectMeta)...)
+
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	mergeMetadata(&updated.ObjectMeta, desired.ObjectMeta)
+	updated.Spec.Rules = desired.Spec.Rules
+	updated.Spec.TLS = desired.Spec.TLS
+
+	if _, err = client.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{Resource: resource, Message: strings.Join(drifts, ", ")})
+
+	return nil
+}
+
+// DeleteIngress deletes the ingress of the edge cluster if it still exists, e.g. after the edge cluster stopped being
+// exposed through the ingress
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// namespace: Mandatory. The namespace of the ingress
+// name: Mandatory. The name of the ingress
+// repaired: Mandatory. Called if the ingress is deleted
+// Returns error if something goes wrong
+func DeleteIngress(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+	name string,
+	repaired DriftRepaired) error {
+	err := clientset.NetworkingV1().Ingresses(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{
+		Resource: GetResource("ingress", namespace, name),
+		Message:  "the ingress is no longer needed and is deleted",
+	})
+
+	return nil
+}
+
+// ReconcileDeployment creates the deployment of the edge cluster if it no longer exists, or brings its labels,
+// annotations, replicas and pod template back to their desired state. Updating the pod template restarts the edge
+// cluster server, while updating the labels and annotations of the deployment does not.
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the deployment
+// repaired: Mandatory. Called if the deployment is repaired
+// Returns error if something goes wrong
+func ReconcileDeployment(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	desired *appsv1.Deployment,
+	repaired DriftRepaired) error {
+	client := clientset.AppsV1().Deployments(desired.Namespace)
+	resource := GetResource("deployment", desired.Namespace, desired.Name)
+
+	current, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err = client.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: "the deployment was missing and is created"})
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	drifts := []string{}
+	if current.Spec.Replicas == nil || *current.Spec.Replicas != *desired.Spec.Replicas {
+		drifts = append(drifts, "the replicas changed")
+	}
+
+	drifts = append(drifts, getMetadataDrifts(current.ObjectMeta, desired.ObjectMeta)...)
+	podTemplateDrifts := getPodTemplateDrifts(current.Spec.Template, desired.Spec.Template)
+	drifts = append(drifts, podTemplateDrifts...)
+
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	mergeMetadata(&updated.ObjectMeta, desired.ObjectMeta)
+	updated.Spec.Replicas = desired.Spec.Replicas
+
+	if len(podTemplateDrifts) != 0 {
+		updated.Spec.Template = desired.Spec.Template
+	}
+
+	if _, err = client.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{Resource: resource, Message: strings.Join(drifts, ", ")})
+
+	return nil
+}
+
+// ReconcileConfigMap creates the config map of the edge cluster if it no longer exists, or brings its data, labels and
+// annotations back to their desired state
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the config map
+// repaired: Mandatory. Called if the config map is repaired
+// Returns error if something goes wrong
+func ReconcileConfigMap(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	desired *v1.ConfigMap,
+	repaired DriftRepaired) error {
+	client := clientset.CoreV1().ConfigMaps(desired.Namespace)
+	resource := GetResource("configmap", desired.Namespace, desired.Name)
+
+	current, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err = client.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: "the config map was missing and is created"})
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	drifts := []string{}
+	if !equality.Semantic.DeepEqual(current.Data, desired.Data) {
+		drifts = append(drifts, "the data changed")
+	}
+
+	drifts = append(drifts, getMetadataDrifts(current.ObjectMeta, desired.ObjectMeta)...)
+
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	mergeMetadata(&updated.ObjectMeta, desired.ObjectMeta)
+	updated.Data = desired.Data
+
+	if _, err = client.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{Resource: resource, Message: strings.Join(drifts, ", ")})
+
+	return nil
+}
+
+// ReconcileSecret creates the secret of the edge cluster if it no longer exists, or brings its data, labels and
+// annotations back to their desired state. The data is not reported as it contains the secret values.
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the secret
+// repaired: Mandatory. Called if the secret is repaired
+// Returns error if something goes wrong
+func ReconcileSecret(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	desired *v1.Secret,
+	repaired DriftRepaired) error {
+	client := clientset.CoreV1().Secrets(desired.Namespace)
+	resource := GetResource("secret", desired.Namespace, desired.Name)
+
+	current, err := client.Get(ctx, desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err = client.Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: "the secret was missing and is created"})
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	drifts := []string{}
+	if !equality.Semantic.DeepEqual(current.Data, desired.Data) {
+		drifts = append(drifts, "the data changed")
+	}
+
+	drifts = append(drifts, getMetadataDrifts(current.ObjectMeta, desired.ObjectMeta)...)
+
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	mergeMetadata(&updated.ObjectMeta, desired.ObjectMeta)
+	updated.Data = desired.Data
+
+	if _, err = client.Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	repaired(models.DriftRepair{Resource: resource, Message: strings.Join(drifts, ", ")})
+
+	return nil
+}
+
+// ReconcileCatalogueCharts installs the charts of the chart catalogue that apply to the type of the edge cluster and
+// are either not installed on it or whose latest release is not deployed, in the order that satisfies their
+// dependencies. The releases that are deployed are left untouched.
+// ctx: Mandatory The reference to the context
+// helmService: Mandatory. Reference to the service that installs the helm charts
+// chartCatalogue: Mandatory. Reference to the service that provides the charts to install
+// kubeconfigContent: Mandatory. The kubeconfig of the edge cluster to install the charts on
+// edgeClusterID: Mandatory. The unique edge cluster identifier
+// clusterTypeName: Mandatory. The type name of the edge cluster
+// repaired: Mandatory. Called with every release that is repaired
+// Returns error if something goes wrong
+func ReconcileCatalogueCharts(
+	ctx context.Context,
+	helmService helm.HelmHelperContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigContent string,
+	edgeClusterID string,
+	clusterTypeName string,
+	repaired DriftRepaired) error {
+	listChartsResponse, err := chartCatalogue.ListCharts(ctx, &catalogue.ListChartsRequest{})
+	if err != nil {
+		return err
+	}
+
+	charts, err := catalogue.ResolveInstallOrder(listChartsResponse.Charts, clusterTypeName)
+	if err != nil {
+		return err
+	}
+
+	releases, err := helmService.ListReleases(kubeconfigContent, "")
+	if err != nil {
+		return err
+	}
+
+	releaseStatuses := map[string]string{}
+	for _, installedRelease := range releases {
+		releaseStatuses[getReleaseResource(installedRelease.Namespace, installedRelease.Name)] = installedRelease.Status
+	}
+
+	for _, chart := range charts {
+		resource := getReleaseResource(chart.Namespace, chart.ReleaseName)
+
+		status, installed := releaseStatuses[resource]
+		if installed && status == release.StatusDeployed.String() {
+			continue
+		}
+
+		if err = installCatalogueChart(helmService, chart, kubeconfigContent, edgeClusterID, clusterTypeName); err != nil {
+			return err
+		}
+
+		message := "the release was missing and is installed"
+		if installed {
+			message = fmt.Sprintf("the release was in %s status and is upgraded", status)
+		}
+
+		repaired(models.DriftRepair{Resource: resource, Message: message})
+	}
+
+	return nil
+}
+
+// getMetadataDrifts compares the labels and annotations of a resource. The annotations are only expected to exist, so
+// the annotations that change with every release of the service, e.g. the service version, do not drift.
+func getMetadataDrifts(current metav1.ObjectMeta, desired metav1.ObjectMeta) []string {
+	drifts := []string{}
+
+	if !containsAll(current.Labels, desired.Labels) {
+		drifts = append(drifts, "the labels changed")
+	}
+
+	if !containsAllKeys(current.Annotations, desired.Annotations) {
+		drifts = append(drifts, "the annotations changed")
+	}
+
+	return drifts
+}
+
+// mergeMetadata brings the labels of a resource back to their desired state and adds its missing annotations
+func mergeMetadata(current *metav1.ObjectMeta, desired metav1.ObjectMeta) {
+	current.Labels = mergeMaps(current.Labels, desired.Labels)
+	current.Annotations = mergeMaps(desired.Annotations, current.Annotations)
+}
+
+// getPodTemplateDrifts compares the fields of the pod template the provisioners set. The fields defaulted by the API
+// server are ignored.
+func getPodTemplateDrifts(current v1.PodTemplateSpec, desired v1.PodTemplateSpec) []string {
+	drifts := []string{}
+
+	if !containsAll(current.Labels, desired.Labels) {
+		drifts = append(drifts, "the pod labels changed")
+	}
+
+	if !containsAll(current.Annotations, desired.Annotations) {
+		drifts = append(drifts, "the pod annotations changed")
+	}
+
+	if current.Spec.ServiceAccountName != desired.Spec.ServiceAccountName {
+		drifts = append(drifts, "the service account changed")
+	}
+
+	if len(current.Spec.Containers) != len(desired.Spec.Containers) {
+		return append(drifts, "the containers changed")
+	}
+
+	for _, desiredContainer := range desired.Spec.Containers {
+		var currentContainer *v1.Container
+		for i := range current.Spec.Containers {
+			if current.Spec.Containers[i].Name == desiredContainer.Name {
+				currentContainer = &current.Spec.Containers[i]
+			}
+		}
+
+		if currentContainer == nil {
+			drifts = append(drifts, fmt.Sprintf("the container %s is missing", desiredContainer.Name))
+
+			continue
+		}
+
+		if currentContainer.Image != desiredContainer.Image {
+			drifts = append(drifts, fmt.Sprintf("the image of the container %s changed", desiredContainer.Name))
+		}
+
+		if !isStringsEqual(currentContainer.Command, desiredContainer.Command) ||
+			!isStringsEqual(currentContainer.Args, desiredContainer.Args) {
+			drifts = append(drifts, fmt.Sprintf("the arguments of the container %s changed", desiredContainer.Name))
+		}
+
+		// The values are not reported as they may contain the cluster secret
+		if !equality.Semantic.DeepEqual(normalizeEnv(currentContainer.Env), normalizeEnv(desiredContainer.Env)) {
+			drifts = append(drifts, fmt.Sprintf("the environment of the container %s changed", desiredContainer.Name))
+		}
+
+		if !isContainerPortsInDesiredState(currentContainer.Ports, desiredContainer.Ports) {
+			drifts = append(drifts, fmt.Sprintf("the ports of the container %s changed", desiredContainer.Name))
+		}
+	}
+
+	return drifts
+}
+
+// getDesiredServicePorts returns the desired ports of the service, keeping the node ports the current ports are
+// allocated unless the service no longer needs node ports
+func getDesiredServicePorts(current *v1.Service, desired *v1.Service) []v1.ServicePort {
+	ports := make([]v1.ServicePort, 0, len(desired.Spec.Ports))
+	for _, desiredPort := range desired.Spec.Ports {
+		if desired.Spec.Type != v1.ServiceTypeClusterIP && current.Spec.Type != v1.ServiceTypeClusterIP {
+			for _, currentPort := range current.Spec.Ports {
+				if currentPort.Name == desiredPort.Name && desiredPort.NodePort == 0 {
+					desiredPort.NodePort = currentPort.NodePort
+				}
+			}
+		}
+
+		ports = append(ports, desiredPort)
+	}
+
+	return ports
+}
+
+func isServicePortsInDesiredState(current []v1.ServicePort, desired []v1.ServicePort) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	for i := range desired {
+		if current[i].Name != desired[i].Name ||
+			current[i].Protocol != desired[i].Protocol ||
+			current[i].Port != desired[i].Port ||
+			current[i].TargetPort != desired[i].TargetPort ||
+			(desired[i].NodePort != 0 && current[i].NodePort != desired[i].NodePort) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isContainerPortsInDesiredState(current []v1.ContainerPort, desired []v1.ContainerPort) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	for i := range desired {
+		if current[i].Name != desired[i].Name || current[i].ContainerPort != desired[i].ContainerPort {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isStringsEqual(current []string, desired []string) bool {
+	if len(current) != len(desired) {
+		return false
+	}
+
+	for i := range desired {
+		if current[i] != desired[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeEnv(env []v1.EnvVar) []v1.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+
+	return env
+}
+
+func containsAll(current map[string]string, desired map[string]string) bool {
+	for key, value := range desired {
+		if currentValue, ok := current[key]; !ok || currentValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsAllKeys(current map[string]string, desired map[string]string) bool {
+	for key := range desired {
+		if _, ok := current[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mergeMaps(current map[string]string, desired map[string]string) map[string]string {
+	merged := map[string]string{}
+	for key, value := range current {
+		merged[key] = value
+	}
+
+	for key, value := range desired {
+		merged[key] = value
+	}
+
+	return merged
+}
@@ -0,0 +1,534 @@
+package provision_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	catalogueMemory "github.com/decentralized-cloud/edge-cluster/services/catalogue/memory"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reconcile Tests", func() {
+	var (
+		ctx       context.Context
+		namespace string
+		repairs   []models.DriftRepair
+		repaired  provision.DriftRepaired
+		replicas  int32
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		namespace = provision.GetNamespace(cuid.New())
+		repairs = []models.DriftRepair{}
+		repaired = func(repair models.DriftRepair) {
+			repairs = append(repairs, repair)
+		}
+		replicas = 1
+	})
+
+	getDesiredNamespace := func() *v1.Namespace {
+		return &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        namespace,
+				Labels:      map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+				Annotations: map[string]string{provision.ServiceVersionKey: "v1.0.0"},
+			},
+		}
+	}
+
+	getDesiredService := func() *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "k3s",
+				Namespace: namespace,
+				Labels:    map[string]string{"k8s-app": "k3s"},
+			},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{Name: "k3s", Protocol: v1.ProtocolTCP, Port: 6443, TargetPort: intstr.FromInt(6443)},
+				},
+				Selector: map[string]string{"k3s": "k3s"},
+				Type:     v1.ServiceTypeLoadBalancer,
+			},
+		}
+	}
+
+	getDesiredIngress := func() *networkingv1.Ingress {
+		pathType := networkingv1.PathTypePrefix
+
+		return &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "k3s",
+				Namespace:   namespace,
+				Labels:      map[string]string{"k8s-app": "k3s"},
+				Annotations: map[string]string{"nginx.ingress.kubernetes.io/ssl-passthrough": "true"},
+			},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{
+					{
+						Host: "edge.example.com",
+						IngressRuleValue: networkingv1.IngressRuleValue{
+							HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{
+									{
+										Path:     "/",
+										PathType: &pathType,
+										Backend: networkingv1.IngressBackend{
+											Service: &networkingv1.IngressServiceBackend{
+												Name: "k3s",
+												Port: networkingv1.ServiceBackendPort{Number: 6443},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	getDesiredDeployment := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "k3s",
+				Namespace: namespace,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"k3s": "k3s"}},
+				Template: v1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"k3s": "k3s"}},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name:  "k3sserver",
+								Image: "rancher/k3s",
+								Args:  []string{"server", "--advertise-address=10.0.0.1"},
+								Env:   []v1.EnvVar{{Name: "K3S_CLUSTER_SECRET", Value: "secret"}},
+								Ports: []v1.ContainerPort{{Name: "k3s", ContainerPort: 6443}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	Context("ReconcileNamespace is called", func() {
+		It("should create the missing namespace and report the repair", func() {
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.ReconcileNamespace(ctx, clientset, getDesiredNamespace(), repaired)).Should(BeNil())
+
+			_, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("namespace/" + namespace))
+		})
+
+		It("should not report any repair when the namespace is in its desired state", func() {
+			current := getDesiredNamespace()
+			current.Annotations[provision.ServiceVersionKey] = "v0.9.0"
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileNamespace(ctx, clientset, getDesiredNamespace(), repaired)).Should(BeNil())
+			Ω(repairs).Should(BeEmpty())
+		})
+
+		It("should add the missing labels and annotations and report the repair", func() {
+			clientset := fake.NewSimpleClientset(&v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: map[string]string{"extra": "label"}},
+			})
+
+			Ω(provision.ReconcileNamespace(ctx, clientset, getDesiredNamespace(), repaired)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Labels).Should(HaveKeyWithValue("extra", "label"))
+			Ω(updated.Labels).Should(HaveKeyWithValue(provision.ManagedByLabel, provision.ManagedByValue))
+			Ω(updated.Annotations).Should(HaveKeyWithValue(provision.ServiceVersionKey, "v1.0.0"))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(Equal("the labels changed, the annotations changed"))
+		})
+
+		It("should return error when the namespace is being deleted", func() {
+			clientset := fake.NewSimpleClientset(&v1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace},
+				Status:     v1.NamespaceStatus{Phase: v1.NamespaceTerminating},
+			})
+
+			Ω(provision.ReconcileNamespace(ctx, clientset, getDesiredNamespace(), repaired)).ShouldNot(BeNil())
+			Ω(repairs).Should(BeEmpty())
+		})
+	})
+
+	Context("ReconcileService is called", func() {
+		It("should create the missing service and report the repair", func() {
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.ReconcileService(ctx, clientset, getDesiredService(), repaired)).Should(BeNil())
+
+			_, err := clientset.CoreV1().Services(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("service/" + namespace + "/k3s"))
+		})
+
+		It("should not report any repair when the service is in its desired state", func() {
+			current := getDesiredService()
+			current.Labels["extra"] = "label"
+			current.Spec.Ports[0].NodePort = 30000
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileService(ctx, clientset, getDesiredService(), repaired)).Should(BeNil())
+			Ω(repairs).Should(BeEmpty())
+		})
+
+		It("should restore the drifted type, ports and selector and keep the allocated node port", func() {
+			current := getDesiredService()
+			current.Spec.Type = v1.ServiceTypeNodePort
+			current.Spec.Selector = map[string]string{"other": "other"}
+			current.Spec.Ports[0].Port = 8443
+			current.Spec.Ports[0].NodePort = 30000
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileService(ctx, clientset, getDesiredService(), repaired)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().Services(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Spec.Type).Should(Equal(v1.ServiceTypeLoadBalancer))
+			Ω(updated.Spec.Selector).Should(Equal(map[string]string{"k3s": "k3s"}))
+			Ω(updated.Spec.Ports[0].Port).Should(Equal(int32(6443)))
+			Ω(updated.Spec.Ports[0].NodePort).Should(Equal(int32(30000)))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(ContainSubstring("type"))
+			Ω(repairs[0].Message).Should(ContainSubstring("selector"))
+			Ω(repairs[0].Message).Should(ContainSubstring("ports"))
+		})
+
+		It("should restore the drifted external IPs", func() {
+			desired := getDesiredService()
+			desired.Spec.Type = v1.ServiceTypeClusterIP
+			desired.Spec.ExternalIPs = []string{"203.0.113.1"}
+			current := desired.DeepCopy()
+			current.Spec.ExternalIPs = nil
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileService(ctx, clientset, desired, repaired)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().Services(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Spec.ExternalIPs).Should(Equal([]string{"203.0.113.1"}))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(Equal("the external IPs changed"))
+		})
+	})
+
+	Context("ReconcileIngress is called", func() {
+		It("should create the missing ingress and report the repair", func() {
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.ReconcileIngress(ctx, clientset, getDesiredIngress(), repaired)).Should(BeNil())
+
+			_, err := clientset.NetworkingV1().Ingresses(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("ingress/" + namespace + "/k3s"))
+		})
+
+		It("should not report any repair when the ingress is in its desired state", func() {
+			current := getDesiredIngress()
+			current.Labels["extra"] = "label"
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileIngress(ctx, clientset, getDesiredIngress(), repaired)).Should(BeNil())
+			Ω(repairs).Should(BeEmpty())
+		})
+
+		It("should restore the drifted rules and annotations and report the repair", func() {
+			current := getDesiredIngress()
+			current.Annotations = nil
+			current.Spec.Rules[0].Host = "other.example.com"
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileIngress(ctx, clientset, getDesiredIngress(), repaired)).Should(BeNil())
+
+			updated, err := clientset.NetworkingV1().Ingresses(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Spec.Rules[0].Host).Should(Equal("edge.example.com"))
+			Ω(updated.Annotations).Should(HaveKeyWithValue("nginx.ingress.kubernetes.io/ssl-passthrough", "true"))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(Equal("the rules changed, the annotations changed"))
+		})
+	})
+
+	Context("DeleteIngress is called", func() {
+		It("should delete the ingress and report the repair", func() {
+			clientset := fake.NewSimpleClientset(getDesiredIngress())
+
+			Ω(provision.DeleteIngress(ctx, clientset, namespace, "k3s", repaired)).Should(BeNil())
+
+			ingresses, err := clientset.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+			Ω(err).Should(BeNil())
+			Ω(ingresses.Items).Should(BeEmpty())
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("ingress/" + namespace + "/k3s"))
+		})
+
+		It("should not report any repair when the ingress does not exist", func() {
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.DeleteIngress(ctx, clientset, namespace, "k3s", repaired)).Should(BeNil())
+			Ω(repairs).Should(BeEmpty())
+		})
+	})
+
+	Context("ReconcileDeployment is called", func() {
+		It("should create the missing deployment and report the repair", func() {
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.ReconcileDeployment(ctx, clientset, getDesiredDeployment(), repaired)).Should(BeNil())
+
+			_, err := clientset.AppsV1().Deployments(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("deployment/" + namespace + "/k3s"))
+		})
+
+		It("should not report any repair when the deployment is in its desired state", func() {
+			current := getDesiredDeployment()
+			current.Spec.Template.Spec.Containers[0].ImagePullPolicy = v1.PullIfNotPresent
+			current.Spec.Template.Spec.Containers[0].Ports[0].Protocol = v1.ProtocolTCP
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileDeployment(ctx, clientset, getDesiredDeployment(), repaired)).Should(BeNil())
+			Ω(repairs).Should(BeEmpty())
+		})
+
+		It("should restore the drifted replicas, image, arguments and environment without reporting the secret", func() {
+			current := getDesiredDeployment()
+			var scaledDown int32
+			current.Spec.Replicas = &scaledDown
+			current.Spec.Template.Spec.Containers[0].Image = "rancher/k3s:other"
+			current.Spec.Template.Spec.Containers[0].Args = []string{"server", "--advertise-address=10.0.0.2"}
+			current.Spec.Template.Spec.Containers[0].Env = []v1.EnvVar{{Name: "K3S_CLUSTER_SECRET", Value: "other-secret"}}
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileDeployment(ctx, clientset, getDesiredDeployment(), repaired)).Should(BeNil())
+
+			updated, err := clientset.AppsV1().Deployments(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(*updated.Spec.Replicas).Should(Equal(int32(1)))
+			Ω(updated.Spec.Template.Spec.Containers[0].Image).Should(Equal("rancher/k3s"))
+			Ω(updated.Spec.Template.Spec.Containers[0].Args).Should(Equal([]string{"server", "--advertise-address=10.0.0.1"}))
+			Ω(updated.Spec.Template.Spec.Containers[0].Env[0].Value).Should(Equal("secret"))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(ContainSubstring("replicas"))
+			Ω(repairs[0].Message).Should(ContainSubstring("image"))
+			Ω(repairs[0].Message).Should(ContainSubstring("arguments"))
+			Ω(repairs[0].Message).Should(ContainSubstring("environment"))
+			Ω(repairs[0].Message).ShouldNot(ContainSubstring("secret"))
+		})
+
+		It("should add the missing labels of the deployment without updating the pod template", func() {
+			current := getDesiredDeployment()
+			current.Spec.Template.Spec.Containers[0].ImagePullPolicy = v1.PullIfNotPresent
+			desired := getDesiredDeployment()
+			desired.Labels = map[string]string{provision.ManagedByLabel: provision.ManagedByValue}
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileDeployment(ctx, clientset, desired, repaired)).Should(BeNil())
+
+			updated, err := clientset.AppsV1().Deployments(namespace).Get(ctx, "k3s", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Labels).Should(HaveKeyWithValue(provision.ManagedByLabel, provision.ManagedByValue))
+			Ω(updated.Spec.Template.Spec.Containers[0].ImagePullPolicy).Should(Equal(v1.PullIfNotPresent))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(Equal("the labels changed"))
+		})
+	})
+
+	Context("ReconcileConfigMap is called", func() {
+		It("should restore the drifted data", func() {
+			desired := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace},
+				Data:       map[string]string{"config.yaml": cuid.New()},
+			}
+			current := desired.DeepCopy()
+			current.Data["config.yaml"] = cuid.New()
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileConfigMap(ctx, clientset, desired, repaired)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, "config", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Data).Should(Equal(desired.Data))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("configmap/" + namespace + "/config"))
+		})
+
+		It("should restore the missing labels", func() {
+			desired := &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config",
+					Namespace: namespace,
+					Labels:    map[string]string{provision.ManagedByLabel: provision.ManagedByValue},
+				},
+				Data: map[string]string{"config.yaml": cuid.New()},
+			}
+			current := desired.DeepCopy()
+			current.Labels = nil
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileConfigMap(ctx, clientset, desired, repaired)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().ConfigMaps(namespace).Get(ctx, "config", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Labels).Should(HaveKeyWithValue(provision.ManagedByLabel, provision.ManagedByValue))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Message).Should(Equal("the labels changed"))
+		})
+	})
+
+	Context("ReconcileSecret is called", func() {
+		It("should restore the drifted data without reporting it", func() {
+			desired := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Data:       map[string][]byte{"clusterSecret": []byte(cuid.New())},
+			}
+			current := desired.DeepCopy()
+			current.Data["clusterSecret"] = []byte(cuid.New())
+			clientset := fake.NewSimpleClientset(current)
+
+			Ω(provision.ReconcileSecret(ctx, clientset, desired, repaired)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().Secrets(namespace).Get(ctx, "secret", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Data).Should(Equal(desired.Data))
+			Ω(repairs).Should(HaveLen(1))
+			Ω(repairs[0].Resource).Should(Equal("secret/" + namespace + "/secret"))
+			Ω(repairs[0].Message).Should(Equal("the data changed"))
+		})
+
+		It("should create the missing secret and report the repair", func() {
+			desired := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+				Data:       map[string][]byte{"clusterSecret": []byte(cuid.New())},
+			}
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.ReconcileSecret(ctx, clientset, desired, repaired)).Should(BeNil())
+
+			_, err := clientset.CoreV1().Secrets(namespace).Get(ctx, "secret", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(repairs).Should(HaveLen(1))
+		})
+	})
+
+	Context("ReconcileCatalogueCharts is called", func() {
+		var (
+			mockCtrl        *gomock.Controller
+			mockHelmService *mock_helm.MockHelmHelperContract
+			kubeconfig      string
+			edgeClusterID   string
+			charts          []models.ChartCatalogueEntry
+		)
+
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockHelmService = mock_helm.NewMockHelmHelperContract(mockCtrl)
+			kubeconfig = cuid.New()
+			edgeClusterID = cuid.New()
+			charts = []models.ChartCatalogueEntry{
+				{
+					ReleaseName:    "deployed",
+					RepositoryName: "repo",
+					RepositoryURL:  "https://example.com",
+					Chart:          "deployed-chart",
+					Namespace:      "deployed-namespace",
+				},
+				{
+					ReleaseName:    "failed",
+					RepositoryName: "repo",
+					RepositoryURL:  "https://example.com",
+					Chart:          "failed-chart",
+					Namespace:      "failed-namespace",
+				},
+				{
+					ReleaseName:    "missing",
+					RepositoryName: "repo",
+					RepositoryURL:  "https://example.com",
+					Chart:          "missing-chart",
+					Namespace:      "missing-namespace",
+				},
+			}
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		It("should install the missing and the not deployed releases and report every repaired release", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+
+			mockHelmService.
+				EXPECT().
+				ListReleases(kubeconfig, "").
+				Return([]models.HelmRelease{
+					{Name: "deployed", Namespace: "deployed-namespace", Status: "deployed"},
+					{Name: "failed", Namespace: "failed-namespace", Status: "failed"},
+				}, nil)
+
+			mockHelmService.
+				EXPECT().
+				AddRepository("repo", "https://example.com").
+				Return(nil).
+				Times(2)
+
+			mockHelmService.
+				EXPECT().
+				InstallChart(kubeconfig, "failed-namespace", "failed", "repo", "failed-chart", gomock.Any()).
+				Return(nil)
+
+			mockHelmService.
+				EXPECT().
+				InstallChart(kubeconfig, "missing-namespace", "missing", "repo", "missing-chart", gomock.Any()).
+				Return(nil)
+
+			Ω(provision.ReconcileCatalogueCharts(ctx, mockHelmService, chartCatalogue, kubeconfig, edgeClusterID, "K3S", repaired)).Should(BeNil())
+			Ω(repairs).Should(HaveLen(2))
+			Ω(repairs[0].Resource).Should(Equal("helm-release/failed-namespace/failed"))
+			Ω(repairs[0].Message).Should(ContainSubstring("failed"))
+			Ω(repairs[1].Resource).Should(Equal("helm-release/missing-namespace/missing"))
+		})
+
+		It("should return the error when listing the installed releases fails", func() {
+			chartCatalogue, _ := catalogueMemory.NewMemoryChartCatalogueService(charts)
+			expectedErr := errors.New(cuid.New())
+
+			mockHelmService.
+				EXPECT().
+				ListReleases(kubeconfig, "").
+				Return(nil, expectedErr)
+
+			Ω(provision.ReconcileCatalogueCharts(ctx, mockHelmService, chartCatalogue, kubeconfig, edgeClusterID, "K3S", repaired)).Should(Equal(expectedErr))
+			Ω(repairs).Should(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package provision
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SecretChecksumKey is the pod template annotation that records the checksum of the secret the pods read their
+// environment from. Changing the secret changes the annotation, which rolls the pods so they pick up the new value.
+const SecretChecksumKey = "edge-cluster.decentralized-cloud.io/secret-checksum"
+
+// GetSecretChecksum returns the checksum of the data of the given secret
+// secret: Mandatory. The secret to calculate the checksum of
+// Returns the hex encoded SHA256 checksum of the secret data
+func GetSecretChecksum(secret *v1.Secret) string {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		_, _ = fmt.Fprintf(hash, "%d:%s%d:", len(key), key, len(secret.Data[key]))
+		_, _ = hash.Write(secret.Data[key])
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// GetClusterSecretConfig returns the desired state of the secret that holds the cluster secret of the control plane
+// namespace: Mandatory. The namespace of the edge cluster
+// name: Mandatory. The name of the secret
+// key: Mandatory. The key the cluster secret is stored under
+// clusterSecret: Mandatory. The cluster secret of the edge cluster
+// ownership: Mandatory. The ownership recorded on the secret
+// Returns the desired state of the secret
+func GetClusterSecretConfig(
+	namespace string,
+	name string,
+	key string,
+	clusterSecret string,
+	ownership models.ProvisionOwnership) *v1.Secret {
+	secretConfig := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			key: []byte(clusterSecret),
+		},
+	}
+
+	SetOwnership(&secretConfig.ObjectMeta, ownership)
+
+	return secretConfig
+}
+
+// GetSecretEnvVar returns the environment variable that reads its value from the given key of the given secret
+// name: Mandatory. The name of the environment variable
+// secretName: Mandatory. The name of the secret the value is read from
+// key: Mandatory. The key of the secret the value is read from
+// Returns the environment variable
+func GetSecretEnvVar(name string, secretName string, key string) v1.EnvVar {
+	return v1.EnvVar{
+		Name: name,
+		ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+// SetSecretChecksum records the checksum of the given secret on the given pod template, so changing the secret rolls
+// the pods
+// template: Mandatory. The pod template to record the checksum on
+// secret: Mandatory. The secret the pods read their environment from
+func SetSecretChecksum(template *v1.PodTemplateSpec, secret *v1.Secret) {
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+
+	template.Annotations[SecretChecksumKey] = GetSecretChecksum(secret)
+}
+
+// ApplySecret creates the given secret, or replaces the secret with the same name if it already exists
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// desired: Mandatory. The desired state of the secret
+// Returns error if something goes wrong
+func ApplySecret(ctx context.Context, clientset kubernetes.Interface, desired *v1.Secret) error {
+	client := clientset.CoreV1().Secrets(desired.Namespace)
+
+	_, err := client.Create(ctx, desired, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = client.Update(ctx, desired, metav1.UpdateOptions{})
+	}
+
+	return err
+}
+
+// ReadSecretKeyRef reads the value the given secret key selector refers to
+// ctx: Mandatory The reference to the context
+// clientset: Mandatory. The client set of the cluster the edge cluster is provisioned in
+// namespace: Mandatory. The namespace of the secret
+// selector: Mandatory. The secret key selector to read the value of
+// Returns either the value or error if something goes wrong, including the key not being found
+func ReadSecretKeyRef(
+	ctx context.Context,
+	clientset kubernetes.Interface,
+	namespace string,
+	selector *v1.SecretKeySelector) (string, error) {
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("the secret %s/%s does not contain the key %s", namespace, selector.Name, selector.Key)
+	}
+
+	return string(value), nil
+}
@@ -0,0 +1,90 @@
+package provision_test
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/lucsky/cuid"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret Tests", func() {
+	var (
+		ctx       context.Context
+		namespace string
+		secret    *v1.Secret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		namespace = provision.GetNamespace(cuid.New())
+		secret = &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+			Data:       map[string][]byte{"clusterSecret": []byte(cuid.New())},
+		}
+	})
+
+	Context("GetSecretChecksum is called", func() {
+		It("should return the same checksum for the same data", func() {
+			Ω(provision.GetSecretChecksum(secret)).Should(Equal(provision.GetSecretChecksum(secret.DeepCopy())))
+		})
+
+		It("should return a different checksum when the data changes", func() {
+			rotated := secret.DeepCopy()
+			rotated.Data["clusterSecret"] = []byte(cuid.New())
+
+			Ω(provision.GetSecretChecksum(secret)).ShouldNot(Equal(provision.GetSecretChecksum(rotated)))
+		})
+	})
+
+	Context("ApplySecret is called", func() {
+		It("should create the missing secret", func() {
+			clientset := fake.NewSimpleClientset()
+
+			Ω(provision.ApplySecret(ctx, clientset, secret)).Should(BeNil())
+
+			created, err := clientset.CoreV1().Secrets(namespace).Get(ctx, "secret", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(created.Data).Should(Equal(secret.Data))
+		})
+
+		It("should replace the data of the existing secret", func() {
+			clientset := fake.NewSimpleClientset(secret.DeepCopy())
+			secret.Data["clusterSecret"] = []byte(cuid.New())
+
+			Ω(provision.ApplySecret(ctx, clientset, secret)).Should(BeNil())
+
+			updated, err := clientset.CoreV1().Secrets(namespace).Get(ctx, "secret", metav1.GetOptions{})
+			Ω(err).Should(BeNil())
+			Ω(updated.Data).Should(Equal(secret.Data))
+		})
+	})
+
+	Context("ReadSecretKeyRef is called", func() {
+		It("should return the value of the key", func() {
+			clientset := fake.NewSimpleClientset(secret)
+
+			value, err := provision.ReadSecretKeyRef(ctx, clientset, namespace, &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  "clusterSecret",
+			})
+			Ω(err).Should(BeNil())
+			Ω(value).Should(Equal(string(secret.Data["clusterSecret"])))
+		})
+
+		It("should return error when the key does not exist", func() {
+			clientset := fake.NewSimpleClientset(secret)
+
+			_, err := provision.ReadSecretKeyRef(ctx, clientset, namespace, &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: "secret"},
+				Key:                  cuid.New(),
+			})
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,100 @@
+// Package registry keeps the edge cluster provisioners that are available to the edge cluster factory service.
+// Provisioners register themselves by their cluster type when their package is initialized.
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// FeatureKubeconfig indicates the provisioner exposes the kubeconfig of the provisioned edge cluster
+	FeatureKubeconfig = "Kubeconfig"
+
+	// FeatureHelmCharts indicates the provisioner installs helm charts on the provisioned edge cluster
+	FeatureHelmCharts = "HelmCharts"
+
+	// FeatureListNodes indicates the provisioner lists the nodes of the provisioned edge cluster
+	FeatureListNodes = "ListNodes"
+
+	// FeatureListPods indicates the provisioner lists the pods of the provisioned edge cluster
+	FeatureListPods = "ListPods"
+
+	// FeatureListServices indicates the provisioner lists the services of the provisioned edge cluster
+	FeatureListServices = "ListServices"
+)
+
+// ProvisionerDependencies contains the services shared by all the edge cluster provisioners
+type ProvisionerDependencies struct {
+	Logger               *zap.Logger
+	K8sRestConfig        *rest.Config
+	ConfigurationService configuration.ConfigurationContract
+	HelmService          helm.HelmHelperContract
+	EventBus             event.EventBusContract
+	ChartCatalogue       catalogue.ChartCatalogueContract
+	KubeconfigCache      kubeconfig.KubeconfigCacheContract
+}
+
+// ProvisionerConstructor instantiates a new edge cluster provisioner using the given dependencies
+type ProvisionerConstructor func(dependencies ProvisionerDependencies) (types.EdgeClusterProvisionerContract, error)
+
+// Registration contains the descriptor of a registered edge cluster type and the constructor of its provisioner
+type Registration struct {
+	Descriptor  models.ClusterTypeDescriptor
+	Constructor ProvisionerConstructor
+}
+
+var (
+	lock          sync.RWMutex
+	registrations = map[models.ClusterType]Registration{}
+)
+
+// RegisterProvisioner registers the provisioner of an edge cluster type. It is meant to be called from the init
+// function of the provisioner package and panics if the cluster type is already registered.
+// descriptor: Mandatory. The descriptor of the edge cluster type
+// constructor: Mandatory. The constructor that instantiates the provisioner of the edge cluster type
+func RegisterProvisioner(descriptor models.ClusterTypeDescriptor, constructor ProvisionerConstructor) {
+	if constructor == nil {
+		panic(fmt.Sprintf("registry: constructor of the edge cluster type %s is nil", descriptor.Name))
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if _, ok := registrations[descriptor.ClusterType]; ok {
+		panic(fmt.Sprintf("registry: edge cluster type %s is already registered", descriptor.Name))
+	}
+
+	registrations[descriptor.ClusterType] = Registration{
+		Descriptor:  descriptor,
+		Constructor: constructor,
+	}
+}
+
+// GetRegistrations returns all the registered edge cluster types ordered by their cluster type
+func GetRegistrations() []Registration {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	result := make([]Registration, 0, len(registrations))
+	for _, registration := range registrations {
+		result = append(result, registration)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Descriptor.ClusterType < result[j].Descriptor.ClusterType
+	})
+
+	return result
+}
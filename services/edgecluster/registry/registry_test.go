@@ -0,0 +1,83 @@
+package registry_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/registry"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/lucsky/cuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRegistry(t *testing.T) {
+	rand.Seed(time.Now().UTC().UnixNano())
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Provisioner Registry Tests")
+}
+
+var _ = Describe("Provisioner Registry Tests", func() {
+	var (
+		descriptor  models.ClusterTypeDescriptor
+		constructor registry.ProvisionerConstructor
+	)
+
+	BeforeEach(func() {
+		descriptor = models.ClusterTypeDescriptor{
+			ClusterType:       models.ClusterType(rand.Int31()),
+			Name:              cuid.New(),
+			SupportedFeatures: []string{registry.FeatureHelmCharts},
+			DefaultCharts:     []string{cuid.New()},
+		}
+
+		constructor = func(dependencies registry.ProvisionerDependencies) (types.EdgeClusterProvisionerContract, error) {
+			return nil, nil
+		}
+	})
+
+	When("a provisioner is registered", func() {
+		It("should return the registration", func() {
+			registry.RegisterProvisioner(descriptor, constructor)
+
+			var found *registry.Registration
+			for _, registration := range registry.GetRegistrations() {
+				if registration.Descriptor.ClusterType == descriptor.ClusterType {
+					registration := registration
+					found = &registration
+				}
+			}
+
+			Ω(found).ShouldNot(BeNil())
+			Ω(found.Descriptor).Should(Equal(descriptor))
+			Ω(found.Constructor).ShouldNot(BeNil())
+		})
+
+		It("should return the registrations ordered by cluster type", func() {
+			registry.RegisterProvisioner(descriptor, constructor)
+
+			registrations := registry.GetRegistrations()
+			for idx := 1; idx < len(registrations); idx++ {
+				Ω(registrations[idx-1].Descriptor.ClusterType).Should(BeNumerically("<", registrations[idx].Descriptor.ClusterType))
+			}
+		})
+	})
+
+	When("a provisioner is registered twice for the same cluster type", func() {
+		It("should panic", func() {
+			registry.RegisterProvisioner(descriptor, constructor)
+
+			Ω(func() { registry.RegisterProvisioner(descriptor, constructor) }).Should(Panic())
+		})
+	})
+
+	When("a provisioner is registered without constructor", func() {
+		It("should panic", func() {
+			Ω(func() { registry.RegisterProvisioner(descriptor, nil) }).Should(Panic())
+		})
+	})
+})
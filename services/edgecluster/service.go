@@ -3,14 +3,22 @@ package edgecluster
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
 	"github.com/decentralized-cloud/edge-cluster/services/configuration"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
-	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/k3s"
+	_ "github.com/decentralized-cloud/edge-cluster/services/edgecluster/k0s" // register K0S provisioner
+	_ "github.com/decentralized-cloud/edge-cluster/services/edgecluster/k3s" // register K3S provisioner
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/registry"
 	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	_ "github.com/decentralized-cloud/edge-cluster/services/edgecluster/vcluster" // register VCluster provisioner
+	"github.com/decentralized-cloud/edge-cluster/services/event"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"github.com/savsgio/go-logger"
 	"go.uber.org/zap"
@@ -23,15 +31,25 @@ type edgeClusterFactoryService struct {
 	k8sRestConfig        *rest.Config
 	configurationService configuration.ConfigurationContract
 	helmService          helm.HelmHelperContract
+	eventBus             event.EventBusContract
+	chartCatalogue       catalogue.ChartCatalogueContract
+	kubeconfigCache      kubeconfig.KubeconfigCacheContract
+	enabledProvisioners  []registry.Registration
 }
 
 // NewEdgeClusterFactoryService creates new instance of the edgeClusterFactoryService, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
+// eventBus: Mandatory. Reference to the event bus the provisioning events are published to
+// chartCatalogue: Mandatory. Reference to the service that provides the helm charts installed on the edge clusters
+// kubeconfigCache: Mandatory. Reference to the service that caches the kubeconfigs of the edge clusters
 // Returns the new service or error if something goes wrong
 func NewEdgeClusterFactoryService(
 	logger *zap.Logger,
 	configurationService configuration.ConfigurationContract,
-	helmService helm.HelmHelperContract) (types.EdgeClusterFactoryContract, error) {
+	helmService helm.HelmHelperContract,
+	eventBus event.EventBusContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigCache kubeconfig.KubeconfigCacheContract) (types.EdgeClusterFactoryContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
@@ -44,10 +62,25 @@ func NewEdgeClusterFactoryService(
 		return nil, commonErrors.NewArgumentNilError("helmService", "helmService is required")
 	}
 
+	if eventBus == nil {
+		return nil, commonErrors.NewArgumentNilError("eventBus", "eventBus is required")
+	}
+
+	if chartCatalogue == nil {
+		return nil, commonErrors.NewArgumentNilError("chartCatalogue", "chartCatalogue is required")
+	}
+
+	if kubeconfigCache == nil {
+		return nil, commonErrors.NewArgumentNilError("kubeconfigCache", "kubeconfigCache is required")
+	}
+
 	service := edgeClusterFactoryService{
 		logger:               logger,
 		configurationService: configurationService,
 		helmService:          helmService,
+		eventBus:             eventBus,
+		chartCatalogue:       chartCatalogue,
+		kubeconfigCache:      kubeconfigCache,
 	}
 
 	k8sRestConfig, err := service.getRestConfig()
@@ -57,6 +90,10 @@ func NewEdgeClusterFactoryService(
 
 	service.k8sRestConfig = k8sRestConfig
 
+	if service.enabledProvisioners, err = service.getEnabledProvisioners(); err != nil {
+		return nil, err
+	}
+
 	return &service, nil
 }
 
@@ -68,17 +105,95 @@ func NewEdgeClusterFactoryService(
 func (service *edgeClusterFactoryService) Create(
 	ctx context.Context,
 	clusterType models.ClusterType) (types.EdgeClusterProvisionerContract, error) {
-	if clusterType == models.K3S {
-		return k3s.NewK3SProvisioner(
-			service.logger,
-			service.k8sRestConfig,
-			service.configurationService,
-			service.helmService)
+	for _, registration := range service.enabledProvisioners {
+		if registration.Descriptor.ClusterType == clusterType {
+			return registration.Constructor(registry.ProvisionerDependencies{
+				Logger:               service.logger,
+				K8sRestConfig:        service.k8sRestConfig,
+				ConfigurationService: service.configurationService,
+				HelmService:          service.helmService,
+				EventBus:             service.eventBus,
+				ChartCatalogue:       service.chartCatalogue,
+				KubeconfigCache:      service.kubeconfigCache,
+			})
+		}
 	}
 
 	return nil, types.NewEdgeClusterTypeNotSupportedError(clusterType)
 }
 
+// ListSupportedClusterTypes returns the descriptors of the edge cluster types that are enabled in this deployment.
+// The default charts of every type are the release names of the catalogue charts installed on that type.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the supported edge cluster types
+// Returns either the list of the supported edge cluster types or error if something goes wrong.
+func (service *edgeClusterFactoryService) ListSupportedClusterTypes(
+	ctx context.Context,
+	request *types.ListSupportedClusterTypesRequest) (*types.ListSupportedClusterTypesResponse, error) {
+	listChartsResponse, err := service.chartCatalogue.ListCharts(ctx, &catalogue.ListChartsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	clusterTypes := make([]models.ClusterTypeDescriptor, 0, len(service.enabledProvisioners))
+	for _, registration := range service.enabledProvisioners {
+		descriptor := registration.Descriptor
+
+		charts, err := catalogue.ResolveInstallOrder(listChartsResponse.Charts, descriptor.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		descriptor.DefaultCharts = make([]string, 0, len(charts))
+		for _, chart := range charts {
+			descriptor.DefaultCharts = append(descriptor.DefaultCharts, chart.ReleaseName)
+		}
+
+		clusterTypes = append(clusterTypes, descriptor)
+	}
+
+	return &types.ListSupportedClusterTypesResponse{
+		ClusterTypes: clusterTypes,
+	}, nil
+}
+
+// getEnabledProvisioners returns the registered provisioners that are enabled through the configuration. All the
+// registered provisioners are enabled if the configuration does not list any edge cluster type.
+func (service *edgeClusterFactoryService) getEnabledProvisioners() ([]registry.Registration, error) {
+	enabledClusterTypes, err := service.configurationService.GetEnabledClusterTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	registrations := registry.GetRegistrations()
+	if len(enabledClusterTypes) == 0 {
+		return registrations, nil
+	}
+
+	registeredNames := map[string]bool{}
+	for _, registration := range registrations {
+		registeredNames[strings.ToUpper(registration.Descriptor.Name)] = true
+	}
+
+	enabledNames := map[string]bool{}
+	for _, enabledClusterType := range enabledClusterTypes {
+		if !registeredNames[strings.ToUpper(enabledClusterType)] {
+			return nil, types.NewUnknownError(fmt.Sprintf("enabled edge cluster type %s is not registered", enabledClusterType))
+		}
+
+		enabledNames[strings.ToUpper(enabledClusterType)] = true
+	}
+
+	enabledProvisioners := []registry.Registration{}
+	for _, registration := range registrations {
+		if enabledNames[strings.ToUpper(registration.Descriptor.Name)] {
+			enabledProvisioners = append(enabledProvisioners, registration)
+		}
+	}
+
+	return enabledProvisioners, nil
+}
+
 func (service *edgeClusterFactoryService) getRestConfig() (*rest.Config, error) {
 	if kubeConfig := os.Getenv("KUBECONFIG"); kubeConfig != "" {
 		service.logger.Info("path ", zap.String("KUBECONFIG", kubeConfig))
@@ -18,6 +18,14 @@ type EdgeClusterFactoryContract interface {
 	Create(
 		ctx context.Context,
 		clusterType models.ClusterType) (EdgeClusterProvisionerContract, error)
+
+	// ListSupportedClusterTypes returns the descriptors of the edge cluster types that are enabled in this deployment
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the supported edge cluster types
+	// Returns either the list of the supported edge cluster types or error if something goes wrong.
+	ListSupportedClusterTypes(
+		ctx context.Context,
+		request *ListSupportedClusterTypesRequest) (*ListSupportedClusterTypesResponse, error)
 }
 
 // EdgeClusterProvisionerContract defines the methods that are required to provision a supported
@@ -47,6 +55,24 @@ type EdgeClusterProvisionerContract interface {
 		ctx context.Context,
 		request *DeleteProvisionRequest) (*DeleteProvisionResponse, error)
 
+	// ReconcileProvision compares the resources of an existing provision with their desired state and repairs the
+	// resources that drifted from it. An event is published for every repaired resource.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to reconcile an existing provision
+	// Returns either the repaired resources or error if something goes wrong.
+	ReconcileProvision(
+		ctx context.Context,
+		request *ReconcileProvisionRequest) (*ReconcileProvisionResponse, error)
+
+	// AdoptProvision reads the state of an existing provision whose edge cluster no longer exists in the repository,
+	// so the edge cluster can be added back to it. The provision itself is left untouched.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to adopt an existing provision
+	// Returns either the state of the provision or error if something goes wrong.
+	AdoptProvision(
+		ctx context.Context,
+		request *AdoptProvisionRequest) (*AdoptProvisionResponse, error)
+
 	// GetProvisionDetails retrieves information on an existing provision.
 	// ctx: Mandatory The reference to the context
 	// request: Mandatory. The request to retrieve information on an existing provision
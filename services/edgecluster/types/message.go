@@ -1,12 +1,28 @@
 // Package types defines the contracts that are used to provision a supported edge cluster and managing them
 package types
 
-import "github.com/decentralized-cloud/edge-cluster/models"
+import (
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+)
+
+// ProvisioningStatusReporter is called by the provisioner when the provision moves to a new provisioning status
+type ProvisioningStatusReporter func(status models.ProvisioningStatus)
 
 // CreateProvisionRequest contains the request to provision a new supported edge cluser
 type CreateProvisionRequest struct {
-	EdgeClusterID string
-	ClusterSecret string
+	EdgeClusterID  string
+	ClusterSecret  string
+	StatusReporter ProvisioningStatusReporter
+
+	// ProjectID, UserEmail and EdgeClusterName are recorded on the provisioned resources to link them back to the
+	// edge cluster
+	ProjectID       string
+	UserEmail       string
+	EdgeClusterName string
+
+	// ServiceExposure determines how the API server of the edge cluster is exposed outside the host cluster
+	ServiceExposure models.ServiceExposure
 }
 
 // CreateProvisionResponse contains the result of provisioning a new supported edge cliuster
@@ -15,8 +31,18 @@ type CreateProvisionResponse struct {
 
 // UpdateProvisionRequest contains the request to update an existing provision
 type UpdateProvisionRequest struct {
-	EdgeClusterID string
-	ClusterSecret string
+	EdgeClusterID  string
+	ClusterSecret  string
+	StatusReporter ProvisioningStatusReporter
+
+	// ProjectID, UserEmail and EdgeClusterName are recorded on the provisioned resources to link them back to the
+	// edge cluster
+	ProjectID       string
+	UserEmail       string
+	EdgeClusterName string
+
+	// ServiceExposure determines how the API server of the edge cluster is exposed outside the host cluster
+	ServiceExposure models.ServiceExposure
 }
 
 // UpdateProvisionResponse contains the result of updating an existing provision
@@ -26,10 +52,50 @@ type UpdateProvisionResponse struct {
 // DeleteProvisionRequest contains the request to delete an existing provision
 type DeleteProvisionRequest struct {
 	EdgeClusterID string
+
+	// UninstallCharts uninstalls the catalogue charts installed on the edge cluster before its namespace is deleted
+	UninstallCharts bool
+
+	// UninstallChartOptions are the options the catalogue charts are uninstalled with, e.g. the hooks and the timeout
+	UninstallChartOptions helm.UninstallChartOptions
 }
 
 // DeleteProvisionResponse contains the result of deleting an existing provision
 type DeleteProvisionResponse struct {
+	// FailedCleanups are the resources that failed to clean up before the namespace was deleted
+	FailedCleanups []models.CleanupFailure
+}
+
+// ReconcileProvisionRequest contains the request to reconcile an existing provision with its desired state
+type ReconcileProvisionRequest struct {
+	EdgeClusterID   string
+	ClusterSecret   string
+	ProjectID       string
+	UserEmail       string
+	EdgeClusterName string
+	ServiceExposure models.ServiceExposure
+}
+
+// ReconcileProvisionResponse contains the result of reconciling an existing provision
+type ReconcileProvisionResponse struct {
+	// Repairs are the resources that drifted from their desired state and were repaired
+	Repairs []models.DriftRepair
+}
+
+// AdoptProvisionRequest contains the request to read the state of an existing provision whose edge cluster no longer
+// exists in the repository, so the edge cluster can be added back to it
+type AdoptProvisionRequest struct {
+	EdgeClusterID string
+}
+
+// AdoptProvisionResponse contains the result of reading the state of an existing provision
+type AdoptProvisionResponse struct {
+	// ClusterSecret is the cluster secret the provision is running with, empty if the edge cluster type does not
+	// use a cluster secret
+	ClusterSecret string
+
+	// ServiceExposure is the exposure the provision is running with
+	ServiceExposure models.ServiceExposure
 }
 
 // GetProvisionDetailsRequest contains the request to retrieve an existing provision details
@@ -74,3 +140,12 @@ type ListServicesRequest struct {
 type ListServicesResponse struct {
 	Services []models.EdgeClusterService
 }
+
+// ListSupportedClusterTypesRequest contains the request to list the supported edge cluster types
+type ListSupportedClusterTypesRequest struct {
+}
+
+// ListSupportedClusterTypesResponse contains the result of listing the supported edge cluster types
+type ListSupportedClusterTypesResponse struct {
+	ClusterTypes []models.ClusterTypeDescriptor
+}
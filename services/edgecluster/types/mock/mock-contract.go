@@ -51,6 +51,21 @@ func (mr *MockEdgeClusterFactoryContractMockRecorder) Create(ctx, clusterType in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEdgeClusterFactoryContract)(nil).Create), ctx, clusterType)
 }
 
+// ListSupportedClusterTypes mocks base method.
+func (m *MockEdgeClusterFactoryContract) ListSupportedClusterTypes(ctx context.Context, request *types.ListSupportedClusterTypesRequest) (*types.ListSupportedClusterTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSupportedClusterTypes", ctx, request)
+	ret0, _ := ret[0].(*types.ListSupportedClusterTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSupportedClusterTypes indicates an expected call of ListSupportedClusterTypes.
+func (mr *MockEdgeClusterFactoryContractMockRecorder) ListSupportedClusterTypes(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedClusterTypes", reflect.TypeOf((*MockEdgeClusterFactoryContract)(nil).ListSupportedClusterTypes), ctx, request)
+}
+
 // MockEdgeClusterProvisionerContract is a mock of EdgeClusterProvisionerContract interface.
 type MockEdgeClusterProvisionerContract struct {
 	ctrl     *gomock.Controller
@@ -74,6 +89,21 @@ func (m *MockEdgeClusterProvisionerContract) EXPECT() *MockEdgeClusterProvisione
 	return m.recorder
 }
 
+// AdoptProvision mocks base method.
+func (m *MockEdgeClusterProvisionerContract) AdoptProvision(ctx context.Context, request *types.AdoptProvisionRequest) (*types.AdoptProvisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptProvision", ctx, request)
+	ret0, _ := ret[0].(*types.AdoptProvisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdoptProvision indicates an expected call of AdoptProvision.
+func (mr *MockEdgeClusterProvisionerContractMockRecorder) AdoptProvision(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptProvision", reflect.TypeOf((*MockEdgeClusterProvisionerContract)(nil).AdoptProvision), ctx, request)
+}
+
 // CreateProvision mocks base method.
 func (m *MockEdgeClusterProvisionerContract) CreateProvision(ctx context.Context, request *types.CreateProvisionRequest) (*types.CreateProvisionResponse, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +194,21 @@ func (mr *MockEdgeClusterProvisionerContractMockRecorder) ListServices(ctx, requ
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockEdgeClusterProvisionerContract)(nil).ListServices), ctx, request)
 }
 
+// ReconcileProvision mocks base method.
+func (m *MockEdgeClusterProvisionerContract) ReconcileProvision(ctx context.Context, request *types.ReconcileProvisionRequest) (*types.ReconcileProvisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileProvision", ctx, request)
+	ret0, _ := ret[0].(*types.ReconcileProvisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileProvision indicates an expected call of ReconcileProvision.
+func (mr *MockEdgeClusterProvisionerContractMockRecorder) ReconcileProvision(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileProvision", reflect.TypeOf((*MockEdgeClusterProvisionerContract)(nil).ReconcileProvision), ctx, request)
+}
+
 // UpdateProvisionWithRetry mocks base method.
 func (m *MockEdgeClusterProvisionerContract) UpdateProvisionWithRetry(ctx context.Context, request *types.UpdateProvisionRequest) (*types.UpdateProvisionResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1 @@
+package vcluster_test
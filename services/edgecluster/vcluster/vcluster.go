@@ -0,0 +1,599 @@
+// Package vcluster provides functionality to provision a lightweight virtual edge cluster and manage them. The
+// virtual cluster runs a K3S API server without an agent inside the host namespace, while a syncer copies the
+// workloads created in the virtual cluster to the host namespace, so no nodes are provisioned for the edge cluster.
+package vcluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/catalogue"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/helm"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/kubeconfig"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/provision"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/registry"
+	"github.com/decentralized-cloud/edge-cluster/services/edgecluster/types"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	internalName              = "vcluster"
+	controlPlaneContainerName = "vcluster"
+	syncerContainerName       = "syncer"
+	apiServerPort             = 6443
+	servicePort               = 443
+	dataVolumeName            = "data"
+	dataDirectoryPath         = "/data"
+	kubeconfigVolumeName      = "kubeconfig"
+	kubeconfigDirectoryPath   = "/k3s-config"
+	kubeconfigFilePath        = "/k3s-config/kube-config.yaml"
+	clusterSecretEnvName      = "K3S_TOKEN"
+	clusterSecretName         = "vcluster-cluster-secret"
+	clusterSecretKey          = "clusterSecret"
+)
+
+func init() {
+	registry.RegisterProvisioner(
+		models.ClusterTypeDescriptor{
+			ClusterType: models.VCluster,
+			Name:        "VCLUSTER",
+			SupportedFeatures: []string{
+				registry.FeatureKubeconfig,
+				registry.FeatureHelmCharts,
+				registry.FeatureListNodes,
+				registry.FeatureListPods,
+				registry.FeatureListServices,
+			},
+		},
+		func(dependencies registry.ProvisionerDependencies) (types.EdgeClusterProvisionerContract, error) {
+			return NewVClusterProvisioner(
+				dependencies.Logger,
+				dependencies.K8sRestConfig,
+				dependencies.ConfigurationService,
+				dependencies.HelmService,
+				dependencies.EventBus,
+				dependencies.ChartCatalogue,
+				dependencies.KubeconfigCache)
+		})
+}
+
+type vclusterProvisioner struct {
+	logger            *zap.Logger
+	clientset         *kubernetes.Clientset
+	k3sDockerImage    string
+	syncerDockerImage string
+	serviceCIDR       string
+	controlPlane      *provision.ControlPlane
+}
+
+// NewVClusterProvisioner creates new instance of the vclusterProvisioner, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// k8sRestConfig: Mandatory. Reference to the Rest config points to the running K8S cluster
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// helmService: Mandatory. Reference to the service that installs the helm charts on the provisioned edge cluster
+// eventBus: Mandatory. Reference to the event bus the provisioning events are published to
+// chartCatalogue: Mandatory. Reference to the service that provides the helm charts installed on the edge cluster
+// kubeconfigCache: Mandatory. Reference to the service that caches the kubeconfig of the edge cluster
+// Returns the new service or error if something goes wrong
+func NewVClusterProvisioner(
+	logger *zap.Logger,
+	k8sRestConfig *rest.Config,
+	configurationService configuration.ConfigurationContract,
+	helmService helm.HelmHelperContract,
+	eventBus event.EventBusContract,
+	chartCatalogue catalogue.ChartCatalogueContract,
+	kubeconfigCache kubeconfig.KubeconfigCacheContract) (types.EdgeClusterProvisionerContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if k8sRestConfig == nil {
+		return nil, commonErrors.NewArgumentNilError("k8sRestConfig", "k8sRestConfig is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	k3sDockerImage, err := configurationService.GetK3SDockerImage()
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to get the K3S docker image", err)
+	}
+
+	syncerDockerImage, err := configurationService.GetVClusterSyncerDockerImage()
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to get the virtual cluster syncer docker image", err)
+	}
+
+	serviceCIDR, err := configurationService.GetVClusterServiceCIDR()
+	if err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to get the virtual cluster service CIDR", err)
+	}
+
+	var clientset *kubernetes.Clientset
+	if clientset, err = kubernetes.NewForConfig(k8sRestConfig); err != nil {
+		return nil, types.NewUnknownErrorWithError("failed to create client set", err)
+	}
+
+	controlPlane, err := provision.NewControlPlane(
+		logger,
+		clientset,
+		k8sRestConfig,
+		helmService,
+		eventBus,
+		chartCatalogue,
+		kubeconfigCache,
+		provision.ControlPlaneSpec{
+			ClusterTypeName:    "VCLUSTER",
+			Name:               internalName,
+			ContainerName:      controlPlaneContainerName,
+			KubeconfigFilePath: kubeconfigFilePath,
+			ResolveEndpoint:    resolveEndpoint,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vclusterProvisioner{
+		logger:            logger,
+		clientset:         clientset,
+		k3sDockerImage:    k3sDockerImage,
+		syncerDockerImage: syncerDockerImage,
+		serviceCIDR:       serviceCIDR,
+		controlPlane:      controlPlane,
+	}, nil
+}
+
+// CreateProvision provisions a new edge cluster.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to provision a new edge cluster
+// Returns either the result of provisioning new edge cluster or error if something goes wrong.
+func (service *vclusterProvisioner) CreateProvision(
+	ctx context.Context,
+	request *types.CreateProvisionRequest) (*types.CreateProvisionResponse, error) {
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.VCluster)
+
+	return service.controlPlane.CreateProvision(ctx, request, ownership, func(namespace string) error {
+		if err := service.createSyncerAccess(ctx, namespace, ownership); err != nil {
+			return err
+		}
+
+		if err := service.controlPlane.CreateService(ctx, getServiceConfig(namespace, ownership)); err != nil {
+			return err
+		}
+
+		clusterSecret := getClusterSecretConfig(namespace, request.ClusterSecret, ownership)
+		if err := service.controlPlane.CreateClusterSecret(ctx, clusterSecret); err != nil {
+			return err
+		}
+
+		return service.controlPlane.CreateDeployment(
+			ctx,
+			provision.GetDeploymentConfig(
+				namespace,
+				internalName,
+				service.getPodTemplateSpec(namespace, clusterSecret),
+				ownership))
+	})
+}
+
+// UpdateProvisionWithRetry updates an existing provision.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to update an existing provision
+// Returns either the result of updating an existing provision or error if something goes wrong.
+func (service *vclusterProvisioner) UpdateProvisionWithRetry(
+	ctx context.Context,
+	request *types.UpdateProvisionRequest) (*types.UpdateProvisionResponse, error) {
+	namespace := provision.GetNamespace(request.EdgeClusterID)
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.VCluster)
+
+	if err := service.createSyncerAccess(ctx, namespace, ownership); err != nil {
+		return nil, err
+	}
+
+	// Rotating the cluster secret changes its checksum recorded on the pod template, which rolls the virtual cluster
+	clusterSecret := getClusterSecretConfig(namespace, request.ClusterSecret, ownership)
+	if err := service.controlPlane.CreateClusterSecret(ctx, clusterSecret); err != nil {
+		return nil, err
+	}
+
+	return service.controlPlane.UpdateProvision(ctx, request, ownership, func(deployment *appsv1.Deployment) error {
+		deployment.Spec.Template = service.getPodTemplateSpec(namespace, clusterSecret)
+
+		return nil
+	})
+}
+
+// DeleteProvision deletes an existing provision. The workloads synced to the host namespace are removed with it.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to delete an existing provision
+// Returns either the result of deleting an existing provision or error if something goes wrong.
+func (service *vclusterProvisioner) DeleteProvision(
+	ctx context.Context,
+	request *types.DeleteProvisionRequest) (*types.DeleteProvisionResponse, error) {
+	return service.controlPlane.DeleteProvision(ctx, request)
+}
+
+// ReconcileProvision compares the namespace, syncer access, service, deployment and helm charts of an existing
+// provision with their desired state and repairs the resources that drifted from it. An event is published for every
+// repaired resource. The helm charts are only reconciled if the virtual cluster was not repaired, as it is restarted
+// by the repair.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to reconcile an existing provision
+// Returns either the repaired resources or error if something goes wrong.
+func (service *vclusterProvisioner) ReconcileProvision(
+	ctx context.Context,
+	request *types.ReconcileProvisionRequest) (*types.ReconcileProvisionResponse, error) {
+	namespace := provision.GetNamespace(request.EdgeClusterID)
+	ownership := provision.NewOwnership(
+		request.EdgeClusterID,
+		request.ProjectID,
+		request.UserEmail,
+		request.EdgeClusterName,
+		models.VCluster)
+
+	return service.controlPlane.ReconcileProvision(ctx, request.EdgeClusterID, func(repaired provision.DriftRepaired) error {
+		if err := provision.ReconcileNamespace(
+			ctx,
+			service.clientset,
+			provision.GetNamespaceConfig(namespace, ownership),
+			repaired); err != nil {
+			service.logger.Error("failed to reconcile the namespace", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		if err := service.reconcileSyncerAccess(ctx, namespace, ownership, repaired); err != nil {
+			return err
+		}
+
+		if err := provision.ReconcileService(ctx, service.clientset, getServiceConfig(namespace, ownership), repaired); err != nil {
+			service.logger.Error("failed to reconcile the service", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		clusterSecret := getClusterSecretConfig(namespace, request.ClusterSecret, ownership)
+		if err := provision.ReconcileSecret(ctx, service.clientset, clusterSecret, repaired); err != nil {
+			service.logger.Error("failed to reconcile the cluster secret", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		if err := provision.ReconcileDeployment(
+			ctx,
+			service.clientset,
+			provision.GetDeploymentConfig(
+				namespace,
+				internalName,
+				service.getPodTemplateSpec(namespace, clusterSecret),
+				ownership),
+			repaired); err != nil {
+			service.logger.Error("failed to reconcile the deployment", zap.Error(err), zap.String("namespace", namespace))
+
+			return err
+		}
+
+		return nil
+	})
+}
+
+// AdoptProvision reads the token the virtual cluster of an existing provision is running with, so its edge cluster
+// can be added back to the repository. The provision itself is left untouched.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to adopt an existing provision
+// Returns either the state of the provision or error if something goes wrong.
+func (service *vclusterProvisioner) AdoptProvision(
+	ctx context.Context,
+	request *types.AdoptProvisionRequest) (*types.AdoptProvisionResponse, error) {
+	clusterSecret, err := service.controlPlane.ReadClusterSecret(ctx, request.EdgeClusterID, clusterSecretEnvName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AdoptProvisionResponse{ClusterSecret: clusterSecret}, nil
+}
+
+// GetProvisionDetails retrieves information on an existing provision.
+// The returned kubeconfig points to the in-cluster DNS name of the virtual cluster service, so it is only usable from
+// within the host cluster, which is where the developer sandboxes and CI jobs run.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to retrieve information on an existing provision
+// Returns either the result of retrieving information on an provision or error if something goes wrong.
+func (service *vclusterProvisioner) GetProvisionDetails(
+	ctx context.Context,
+	request *types.GetProvisionDetailsRequest) (*types.GetProvisionDetailsResponse, error) {
+	return service.controlPlane.GetProvisionDetails(ctx, request)
+}
+
+// ListNodes lists an existing edge cluster nodes details. The virtual cluster reports the host nodes its workloads
+// are scheduled on.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list an existing edge cluster nodes details
+// Returns an existing edge cluster nodes details or error if something goes wrong.
+func (service *vclusterProvisioner) ListNodes(
+	ctx context.Context,
+	request *types.ListNodesRequest) (*types.ListNodesResponse, error) {
+	return service.controlPlane.ListNodes(ctx, request)
+}
+
+// ListPods lists an existing edge cluster pods that matchs the given search criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request that contains the search criteria to filter the pods
+// Returns the list of running pods that matchs the given search criteria or error if something goes wrong.
+func (service *vclusterProvisioner) ListPods(
+	ctx context.Context,
+	request *types.ListPodsRequest) (*types.ListPodsResponse, error) {
+	return service.controlPlane.ListPods(ctx, request)
+}
+
+// ListServices lists an existing edge cluster services that matchs the given search criteria
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request that contains the search criteria to filter the services
+// Returns the list of services that matches the given search criteria or error if something goes wrong.
+func (service *vclusterProvisioner) ListServices(
+	ctx context.Context,
+	request *types.ListServicesRequest) (*types.ListServicesResponse, error) {
+	return service.controlPlane.ListServices(ctx, request)
+}
+
+// createSyncerAccess creates the service account the virtual cluster runs as, granting the syncer access to the
+// resources of the host namespace it copies the workloads of the virtual cluster to
+func (service *vclusterProvisioner) createSyncerAccess(
+	ctx context.Context,
+	namespace string,
+	ownership models.ProvisionOwnership) (err error) {
+	serviceAccount := &v1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      internalName,
+			Namespace: namespace,
+		},
+	}
+
+	provision.SetOwnership(&serviceAccount.ObjectMeta, ownership)
+
+	if _, err = service.clientset.CoreV1().ServiceAccounts(namespace).Create(ctx, serviceAccount, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		service.logger.Error("failed to create service account", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	roleClient := service.clientset.RbacV1().Roles(namespace)
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      internalName,
+			Namespace: namespace,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{
+					"configmaps",
+					"secrets",
+					"services",
+					"pods",
+					"pods/attach",
+					"pods/portforward",
+					"pods/exec",
+					"pods/log",
+					"endpoints",
+					"persistentvolumeclaims",
+					"events",
+					"serviceaccounts",
+				},
+				Verbs: []string{"*"},
+			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"statefulsets", "replicasets", "deployments"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+		},
+	}
+
+	provision.SetOwnership(&role.ObjectMeta, ownership)
+
+	if _, err = roleClient.Create(ctx, role, metav1.CreateOptions{}); apierrors.IsAlreadyExists(err) {
+		_, err = roleClient.Update(ctx, role, metav1.UpdateOptions{})
+	}
+
+	if err != nil {
+		service.logger.Error("failed to create role", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      internalName,
+			Namespace: namespace,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      internalName,
+				Namespace: namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     internalName,
+		},
+	}
+
+	provision.SetOwnership(&roleBinding.ObjectMeta, ownership)
+
+	if _, err = service.clientset.RbacV1().RoleBindings(namespace).Create(ctx, roleBinding, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		service.logger.Error("failed to create role binding", zap.Error(err), zap.String("namespace", namespace))
+
+		return
+	}
+
+	return nil
+}
+
+// reconcileSyncerAccess creates the service account, role and role binding of the virtual cluster again if any of
+// them no longer exists
+func (service *vclusterProvisioner) reconcileSyncerAccess(
+	ctx context.Context,
+	namespace string,
+	ownership models.ProvisionOwnership,
+	repaired provision.DriftRepaired) error {
+	missingResources := []string{}
+
+	if _, err := service.clientset.CoreV1().ServiceAccounts(namespace).Get(ctx, internalName, metav1.GetOptions{}); apierrors.IsNotFound(err) {
+		missingResources = append(missingResources, provision.GetResource("serviceaccount", namespace, internalName))
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := service.clientset.RbacV1().Roles(namespace).Get(ctx, internalName, metav1.GetOptions{}); apierrors.IsNotFound(err) {
+		missingResources = append(missingResources, provision.GetResource("role", namespace, internalName))
+	} else if err != nil {
+		return err
+	}
+
+	if _, err := service.clientset.RbacV1().RoleBindings(namespace).Get(ctx, internalName, metav1.GetOptions{}); apierrors.IsNotFound(err) {
+		missingResources = append(missingResources, provision.GetResource("rolebinding", namespace, internalName))
+	} else if err != nil {
+		return err
+	}
+
+	if len(missingResources) == 0 {
+		return nil
+	}
+
+	if err := service.createSyncerAccess(ctx, namespace, ownership); err != nil {
+		return err
+	}
+
+	for _, resource := range missingResources {
+		repaired(models.DriftRepair{Resource: resource, Message: "the resource was missing and is created"})
+	}
+
+	return nil
+}
+
+// getPodTemplateSpec returns the pod template of the virtual cluster. The K3S API server runs without an agent,
+// scheduler and the controllers that manage nodes and volumes, as the syncer hands the workloads over to the host.
+// The token of the virtual cluster is read from the cluster secret, whose checksum is recorded on the pod template so
+// changing the cluster secret rolls the virtual cluster.
+func (service *vclusterProvisioner) getPodTemplateSpec(namespace string, clusterSecret *v1.Secret) v1.PodTemplateSpec {
+	serviceHostName := getServiceHostName(namespace)
+
+	return v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				provision.SecretChecksumKey: provision.GetSecretChecksum(clusterSecret),
+			},
+		},
+		Spec: v1.PodSpec{
+			ServiceAccountName: internalName,
+			Containers: []v1.Container{
+				{
+					Name:  controlPlaneContainerName,
+					Image: service.k3sDockerImage,
+					Args: []string{
+						"server",
+						fmt.Sprintf("--write-kubeconfig=%s", kubeconfigFilePath),
+						fmt.Sprintf("--data-dir=%s", dataDirectoryPath),
+						"--disable=traefik,servicelb,metrics-server,local-storage",
+						"--disable-network-policy",
+						"--disable-agent",
+						"--disable-scheduler",
+						"--disable-cloud-controller",
+						"--flannel-backend=none",
+						"--kube-controller-manager-arg=controllers=*,-nodeipam,-nodelifecycle,-persistentvolume-binder,-attachdetach,-persistentvolume-expander,-cloud-node-lifecycle",
+						fmt.Sprintf("--service-cidr=%s", service.serviceCIDR),
+						fmt.Sprintf("--tls-san=%s", serviceHostName),
+						fmt.Sprintf("--tls-san=%s.%s", internalName, namespace),
+					},
+					Env: []v1.EnvVar{
+						provision.GetSecretEnvVar(clusterSecretEnvName, clusterSecretName, clusterSecretKey),
+					},
+					Ports: []v1.ContainerPort{
+						{
+							Name:          internalName,
+							ContainerPort: apiServerPort,
+						},
+					},
+					VolumeMounts: []v1.VolumeMount{
+						{Name: dataVolumeName, MountPath: dataDirectoryPath},
+						{Name: kubeconfigVolumeName, MountPath: kubeconfigDirectoryPath},
+					},
+				},
+				{
+					Name:  syncerContainerName,
+					Image: service.syncerDockerImage,
+					Args: []string{
+						fmt.Sprintf("--name=%s", internalName),
+						fmt.Sprintf("--out-kube-config-server=https://%s", serviceHostName),
+						fmt.Sprintf("--tls-san=%s", serviceHostName),
+					},
+					VolumeMounts: []v1.VolumeMount{
+						{Name: dataVolumeName, MountPath: dataDirectoryPath, ReadOnly: true},
+					},
+				},
+			},
+			Volumes: []v1.Volume{
+				{
+					Name: dataVolumeName,
+					VolumeSource: v1.VolumeSource{
+						EmptyDir: &v1.EmptyDirVolumeSource{},
+					},
+				},
+				{
+					Name: kubeconfigVolumeName,
+					VolumeSource: v1.VolumeSource{
+						EmptyDir: &v1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resolveEndpoint returns the in-cluster DNS name and port of the service the virtual cluster API server is exposed on
+func resolveEndpoint(_ context.Context, namespace string, _ *v1.Service) (string, int32, error) {
+	return getServiceHostName(namespace), servicePort, nil
+}
+
+// getServiceHostName returns the in-cluster DNS name of the service the virtual cluster API server is exposed on
+func getServiceHostName(namespace string) string {
+	return fmt.Sprintf("%s.%s.svc", internalName, namespace)
+}
+
+// getServiceConfig returns the desired state of the service the virtual cluster API server is exposed on
+func getServiceConfig(namespace string, ownership models.ProvisionOwnership) *v1.Service {
+	return provision.GetServiceConfig(namespace, internalName, servicePort, apiServerPort, v1.ServiceTypeClusterIP, ownership)
+}
+
+// getClusterSecretConfig returns the desired state of the secret that holds the token of the virtual cluster
+func getClusterSecretConfig(
+	namespace string,
+	vclusterClusterSecret string,
+	ownership models.ProvisionOwnership) *v1.Secret {
+	return provision.GetClusterSecretConfig(namespace, clusterSecretName, clusterSecretKey, vclusterClusterSecret, ownership)
+}
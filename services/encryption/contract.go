@@ -0,0 +1,34 @@
+// Package encryption implements the envelope encryption of the sensitive fields the edge-cluster service persists
+package encryption
+
+import "context"
+
+// KeyProviderContract declares the methods to be implemented by a service that provides the key encryption keys
+type KeyProviderContract interface {
+	// GetKeyRing returns the key encryption keys and the identifier of the primary key the new values are encrypted with
+	// ctx: Mandatory The reference to the context
+	// Returns either the key ring or error if something goes wrong
+	GetKeyRing(ctx context.Context) (KeyRing, error)
+}
+
+// EncryptionContract declares the methods to be implemented by the service that encrypts the sensitive fields. Every
+// value is encrypted with its own data key, which is in turn encrypted with the primary key encryption key.
+type EncryptionContract interface {
+	// Encrypt encrypts the given value with a new data key and encrypts the data key with the primary key
+	// ctx: Mandatory The reference to the context
+	// plaintext: Mandatory. The value to encrypt
+	// Returns either the encrypted value or error if something goes wrong
+	Encrypt(ctx context.Context, plaintext []byte) (EncryptedValue, error)
+
+	// Decrypt decrypts the given value using the key encryption key it was encrypted with
+	// ctx: Mandatory The reference to the context
+	// value: Mandatory. The value to decrypt
+	// Returns either the decrypted value or error if something goes wrong, including the key being no longer available
+	Decrypt(ctx context.Context, value EncryptedValue) ([]byte, error)
+
+	// GetPrimaryKeyID returns the identifier of the key encryption key the new values are encrypted with. The values
+	// encrypted with any other key are re-encrypted when the keys are rotated.
+	// ctx: Mandatory The reference to the context
+	// Returns either the identifier of the primary key or error if something goes wrong
+	GetPrimaryKeyID(ctx context.Context) (string, error)
+}
@@ -0,0 +1,199 @@
+// Package envelope implements the envelope encryption of the sensitive fields using AES-256-GCM. Every value is
+// encrypted with its own random data key, and the data key is encrypted with the key encryption key of the key ring.
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type envelopeEncryptionService struct {
+	keyProvider     encryption.KeyProviderContract
+	refreshInterval time.Duration
+	keyRingLock     sync.Mutex
+	keyRing         *encryption.KeyRing
+	keyRingLoadedAt time.Time
+}
+
+// NewEnvelopeEncryptionService creates new instance of the envelopeEncryptionService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// keyProvider: Mandatory. Reference to the service that provides the key encryption keys
+// Returns the new service or error if something goes wrong
+func NewEnvelopeEncryptionService(
+	configurationService configuration.ConfigurationContract,
+	keyProvider encryption.KeyProviderContract) (encryption.EncryptionContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if keyProvider == nil {
+		return nil, commonErrors.NewArgumentNilError("keyProvider", "keyProvider is required")
+	}
+
+	refreshInterval, err := configurationService.GetEncryptionKeyRefreshInterval()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the encryption key refresh interval", err)
+	}
+
+	return &envelopeEncryptionService{
+		keyProvider:     keyProvider,
+		refreshInterval: refreshInterval,
+	}, nil
+}
+
+// Encrypt encrypts the given value with a new data key and encrypts the data key with the primary key
+// ctx: Mandatory The reference to the context
+// plaintext: Mandatory. The value to encrypt
+// Returns either the encrypted value or error if something goes wrong
+func (service *envelopeEncryptionService) Encrypt(
+	ctx context.Context,
+	plaintext []byte) (encryption.EncryptedValue, error) {
+	keyRing, err := service.getKeyRing(ctx, false)
+	if err != nil {
+		return encryption.EncryptedValue{}, err
+	}
+
+	dataKey := make([]byte, encryption.KeySize)
+	if _, err = rand.Read(dataKey); err != nil {
+		return encryption.EncryptedValue{}, commonErrors.NewUnknownErrorWithError("failed to generate the data key", err)
+	}
+
+	ciphertext, err := seal(dataKey, plaintext, nil)
+	if err != nil {
+		return encryption.EncryptedValue{}, commonErrors.NewUnknownErrorWithError("failed to encrypt the value", err)
+	}
+
+	// The key identifier is authenticated with the data key, so an encrypted data key cannot be passed off as being
+	// encrypted with another key encryption key
+	encryptedDataKey, err := seal(keyRing.Keys[keyRing.PrimaryKeyID], dataKey, []byte(keyRing.PrimaryKeyID))
+	if err != nil {
+		return encryption.EncryptedValue{}, commonErrors.NewUnknownErrorWithError("failed to encrypt the data key", err)
+	}
+
+	return encryption.EncryptedValue{
+		KeyID:            keyRing.PrimaryKeyID,
+		EncryptedDataKey: encryptedDataKey,
+		Ciphertext:       ciphertext,
+	}, nil
+}
+
+// Decrypt decrypts the given value using the key encryption key it was encrypted with
+// ctx: Mandatory The reference to the context
+// value: Mandatory. The value to decrypt
+// Returns either the decrypted value or error if something goes wrong, including the key being no longer available
+func (service *envelopeEncryptionService) Decrypt(
+	ctx context.Context,
+	value encryption.EncryptedValue) ([]byte, error) {
+	keyRing, err := service.getKeyRing(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := keyRing.Keys[value.KeyID]
+	if !ok {
+		// The value might have been encrypted by another replica that already picked up a new key
+		if keyRing, err = service.getKeyRing(ctx, true); err != nil {
+			return nil, err
+		}
+
+		if key, ok = keyRing.Keys[value.KeyID]; !ok {
+			return nil, commonErrors.NewUnknownError(fmt.Sprintf("the key %s is not in the key ring", value.KeyID))
+		}
+	}
+
+	dataKey, err := open(key, value.EncryptedDataKey, []byte(value.KeyID))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to decrypt the data key", err)
+	}
+
+	plaintext, err := open(dataKey, value.Ciphertext, nil)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to decrypt the value", err)
+	}
+
+	return plaintext, nil
+}
+
+// GetPrimaryKeyID returns the identifier of the key encryption key the new values are encrypted with. The values
+// encrypted with any other key are re-encrypted when the keys are rotated.
+// ctx: Mandatory The reference to the context
+// Returns either the identifier of the primary key or error if something goes wrong
+func (service *envelopeEncryptionService) GetPrimaryKeyID(ctx context.Context) (string, error) {
+	keyRing, err := service.getKeyRing(ctx, false)
+	if err != nil {
+		return "", err
+	}
+
+	return keyRing.PrimaryKeyID, nil
+}
+
+func (service *envelopeEncryptionService) getKeyRing(ctx context.Context, refresh bool) (encryption.KeyRing, error) {
+	service.keyRingLock.Lock()
+	defer service.keyRingLock.Unlock()
+
+	if !refresh && service.keyRing != nil && time.Since(service.keyRingLoadedAt) < service.refreshInterval {
+		return *service.keyRing, nil
+	}
+
+	keyRing, err := service.keyProvider.GetKeyRing(ctx)
+	if err != nil {
+		return encryption.KeyRing{}, commonErrors.NewUnknownErrorWithError("failed to get the encryption key ring", err)
+	}
+
+	if _, ok := keyRing.Keys[keyRing.PrimaryKeyID]; !ok {
+		return encryption.KeyRing{}, commonErrors.NewUnknownError(
+			fmt.Sprintf("the encryption key ring does not contain the primary key %s", keyRing.PrimaryKeyID))
+	}
+
+	service.keyRing = &keyRing
+	service.keyRingLoadedAt = time.Now()
+
+	return keyRing, nil
+}
+
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("the ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, sealed, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,201 @@
+package envelope_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/envelope"
+	encryptionMock "github.com/decentralized-cloud/edge-cluster/services/encryption/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvelopeEncryptionService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Envelope Encryption Service Tests")
+}
+
+var _ = Describe("Envelope Encryption Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockKeyProviderService   *encryptionMock.MockKeyProviderContract
+		sut                      encryption.EncryptionContract
+		ctx                      context.Context
+		oldKeyRing               encryption.KeyRing
+		newKeyRing               encryption.KeyRing
+	)
+
+	newKey := func() []byte {
+		key := make([]byte, encryption.KeySize)
+		_, err := rand.Read(key)
+		Ω(err).Should(BeNil())
+
+		return key
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+
+		oldKey := newKey()
+		oldKeyRing = encryption.KeyRing{
+			PrimaryKeyID: "old",
+			Keys:         map[string][]byte{"old": oldKey},
+		}
+
+		newKeyRing = encryption.KeyRing{
+			PrimaryKeyID: "new",
+			Keys:         map[string][]byte{"old": oldKey, "new": newKey()},
+		}
+
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetEncryptionKeyRefreshInterval().Return(time.Hour, nil).AnyTimes()
+
+		mockKeyProviderService = encryptionMock.NewMockKeyProviderContract(mockCtrl)
+
+		var err error
+		sut, err = envelope.NewEnvelopeEncryptionService(mockConfigurationService, mockKeyProviderService)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate EnvelopeEncryptionService", func() {
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := envelope.NewEnvelopeEncryptionService(nil, mockKeyProviderService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("key provider service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := envelope.NewEnvelopeEncryptionService(mockConfigurationService, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("keyProvider", "", err)
+			})
+		})
+	})
+
+	Context("EnvelopeEncryptionService is instantiated", func() {
+		It("should encrypt the value with the primary key and decrypt it back", func() {
+			mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(oldKeyRing, nil).Times(1)
+
+			plaintext := []byte(cuid.New())
+			value, err := sut.Encrypt(ctx, plaintext)
+			Ω(err).Should(BeNil())
+			Ω(value.KeyID).Should(Equal("old"))
+			Ω(value.Ciphertext).ShouldNot(ContainSubstring(string(plaintext)))
+
+			decrypted, err := sut.Decrypt(ctx, value)
+			Ω(err).Should(BeNil())
+			Ω(decrypted).Should(Equal(plaintext))
+		})
+
+		It("should encrypt every value with its own data key", func() {
+			mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(oldKeyRing, nil).Times(1)
+
+			plaintext := []byte(cuid.New())
+			first, err := sut.Encrypt(ctx, plaintext)
+			Ω(err).Should(BeNil())
+
+			second, err := sut.Encrypt(ctx, plaintext)
+			Ω(err).Should(BeNil())
+			Ω(second.EncryptedDataKey).ShouldNot(Equal(first.EncryptedDataKey))
+			Ω(second.Ciphertext).ShouldNot(Equal(first.Ciphertext))
+		})
+
+		It("should read the key ring again when the value is encrypted with an unknown key", func() {
+			otherSut, err := envelope.NewEnvelopeEncryptionService(mockConfigurationService, mockKeyProviderService)
+			Ω(err).Should(BeNil())
+
+			gomock.InOrder(
+				mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(oldKeyRing, nil),
+				mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(newKeyRing, nil),
+				mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(newKeyRing, nil))
+
+			primaryKeyID, err := sut.GetPrimaryKeyID(ctx)
+			Ω(err).Should(BeNil())
+			Ω(primaryKeyID).Should(Equal("old"))
+
+			plaintext := []byte(cuid.New())
+			value, err := otherSut.Encrypt(ctx, plaintext)
+			Ω(err).Should(BeNil())
+			Ω(value.KeyID).Should(Equal("new"))
+
+			decrypted, err := sut.Decrypt(ctx, value)
+			Ω(err).Should(BeNil())
+			Ω(decrypted).Should(Equal(plaintext))
+
+			primaryKeyID, err = sut.GetPrimaryKeyID(ctx)
+			Ω(err).Should(BeNil())
+			Ω(primaryKeyID).Should(Equal("new"))
+		})
+
+		It("should decrypt the values encrypted with a retired key", func() {
+			gomock.InOrder(
+				mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(oldKeyRing, nil),
+				mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(newKeyRing, nil))
+
+			plaintext := []byte(cuid.New())
+			value, err := sut.Encrypt(ctx, plaintext)
+			Ω(err).Should(BeNil())
+
+			value.KeyID = "unknown"
+			_, err = sut.Decrypt(ctx, value)
+			Ω(err).Should(HaveOccurred())
+
+			value.KeyID = "old"
+			decrypted, err := sut.Decrypt(ctx, value)
+			Ω(err).Should(BeNil())
+			Ω(decrypted).Should(Equal(plaintext))
+		})
+
+		It("should return error when the encrypted value is tampered with", func() {
+			mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(oldKeyRing, nil).Times(1)
+
+			value, err := sut.Encrypt(ctx, []byte(cuid.New()))
+			Ω(err).Should(BeNil())
+
+			value.Ciphertext[len(value.Ciphertext)-1] ^= 0xff
+			_, err = sut.Decrypt(ctx, value)
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when the key ring cannot be read", func() {
+			mockKeyProviderService.EXPECT().GetKeyRing(gomock.Any()).Return(encryption.KeyRing{}, errors.New(cuid.New()))
+
+			_, err := sut.Encrypt(ctx, []byte(cuid.New()))
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
@@ -0,0 +1,82 @@
+// Package k8ssecret implements an encryption key provider that reads the key ring from a Kubernetes secret on the
+// host cluster. The secret is read on every request, so rotated keys are picked up without restarting the service.
+package k8ssecret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type secretKeyProviderService struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+	key       string
+}
+
+// NewSecretKeyProviderService creates new instance of the secretKeyProviderService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// clientset: Mandatory. Reference to the client of the host cluster that keeps the secret
+// Returns the new service or error if something goes wrong
+func NewSecretKeyProviderService(
+	configurationService configuration.ConfigurationContract,
+	clientset kubernetes.Interface) (encryption.KeyProviderContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if clientset == nil {
+		return nil, commonErrors.NewArgumentNilError("clientset", "clientset is required")
+	}
+
+	namespace, err := configurationService.GetEncryptionKeySecretNamespace()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the encryption key secret namespace", err)
+	}
+
+	name, err := configurationService.GetEncryptionKeySecretName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the encryption key secret name", err)
+	}
+
+	key, err := configurationService.GetEncryptionKeySecretKey()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the encryption key secret key", err)
+	}
+
+	return &secretKeyProviderService{
+		clientset: clientset,
+		namespace: namespace,
+		name:      name,
+		key:       key,
+	}, nil
+}
+
+// GetKeyRing returns the key encryption keys and the identifier of the primary key the new values are encrypted with
+// ctx: Mandatory The reference to the context
+// Returns either the key ring or error if something goes wrong
+func (service *secretKeyProviderService) GetKeyRing(ctx context.Context) (encryption.KeyRing, error) {
+	secret, err := service.clientset.CoreV1().Secrets(service.namespace).Get(ctx, service.name, metav1.GetOptions{})
+	if err != nil {
+		return encryption.KeyRing{}, commonErrors.NewUnknownErrorWithError("failed to read the encryption key secret", err)
+	}
+
+	content, ok := secret.Data[service.key]
+	if !ok {
+		return encryption.KeyRing{}, commonErrors.NewUnknownError(
+			fmt.Sprintf("the encryption key secret %s/%s does not contain the key %s", service.namespace, service.name, service.key))
+	}
+
+	keyRing, err := encryption.ParseKeyRing(content)
+	if err != nil {
+		return encryption.KeyRing{}, commonErrors.NewUnknownErrorWithError("failed to parse the encryption key secret", err)
+	}
+
+	return keyRing, nil
+}
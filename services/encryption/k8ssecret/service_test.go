@@ -0,0 +1,151 @@
+package k8ssecret_test
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/k8ssecret"
+	"github.com/golang/mock/gomock"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecretKeyProviderService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kubernetes Secret Key Provider Service Tests")
+}
+
+var _ = Describe("Kubernetes Secret Key Provider Service Tests", func() {
+	const (
+		namespace = "edge-cluster"
+		name      = "encryption-keys"
+		key       = "keyring.json"
+	)
+
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		clientset                *fake.Clientset
+		sut                      encryption.KeyProviderContract
+	)
+
+	newSecret := func(data map[string][]byte) *v1.Secret {
+		return &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Data:       data,
+		}
+	}
+
+	newKeyRing := func(primaryKeyID string) []byte {
+		encodedKey := make([]byte, encryption.KeySize)
+		_, err := rand.Read(encodedKey)
+		Ω(err).Should(BeNil())
+
+		return []byte(fmt.Sprintf(
+			`{"primaryKeyID": "%s", "keys": {"%s": "%s"}}`,
+			primaryKeyID,
+			primaryKeyID,
+			base64.StdEncoding.EncodeToString(encodedKey)))
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetEncryptionKeySecretNamespace().Return(namespace, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetEncryptionKeySecretName().Return(name, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetEncryptionKeySecretKey().Return(key, nil).AnyTimes()
+
+		clientset = fake.NewSimpleClientset()
+
+		var err error
+		sut, err = k8ssecret.NewSecretKeyProviderService(mockConfigurationService, clientset)
+		Ω(err).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Context("user tries to instantiate SecretKeyProviderService", func() {
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := k8ssecret.NewSecretKeyProviderService(nil, clientset)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("clientset is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := k8ssecret.NewSecretKeyProviderService(mockConfigurationService, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("clientset", "", err)
+			})
+		})
+	})
+
+	Context("GetKeyRing is called", func() {
+		It("should return the key ring stored in the secret and pick up the rotated keys", func() {
+			secret, err := clientset.CoreV1().Secrets(namespace).Create(
+				context.Background(),
+				newSecret(map[string][]byte{key: newKeyRing("old")}),
+				metav1.CreateOptions{})
+			Ω(err).Should(BeNil())
+
+			keyRing, err := sut.GetKeyRing(context.Background())
+			Ω(err).Should(BeNil())
+			Ω(keyRing.PrimaryKeyID).Should(Equal("old"))
+
+			secret.Data[key] = newKeyRing("new")
+			_, err = clientset.CoreV1().Secrets(namespace).Update(context.Background(), secret, metav1.UpdateOptions{})
+			Ω(err).Should(BeNil())
+
+			keyRing, err = sut.GetKeyRing(context.Background())
+			Ω(err).Should(BeNil())
+			Ω(keyRing.PrimaryKeyID).Should(Equal("new"))
+		})
+
+		It("should return error when the secret does not exist", func() {
+			_, err := sut.GetKeyRing(context.Background())
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when the secret does not contain the key ring", func() {
+			_, err := clientset.CoreV1().Secrets(namespace).Create(
+				context.Background(),
+				newSecret(map[string][]byte{"other": newKeyRing("old")}),
+				metav1.CreateOptions{})
+			Ω(err).Should(BeNil())
+
+			_, err = sut.GetKeyRing(context.Background())
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
@@ -0,0 +1,59 @@
+// Package keyfile implements an encryption key provider that reads the key ring from a file. The file is read on every
+// request, so rotated keys, e.g. a mounted Secret, are picked up without restarting the service.
+package keyfile
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type keyFileKeyProviderService struct {
+	filePath string
+}
+
+// NewKeyFileKeyProviderService creates new instance of the keyFileKeyProviderService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewKeyFileKeyProviderService(
+	configurationService configuration.ConfigurationContract) (encryption.KeyProviderContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	filePath, err := configurationService.GetEncryptionKeyFilePath()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the encryption key file path", err)
+	}
+
+	service := &keyFileKeyProviderService{
+		filePath: filePath,
+	}
+
+	// Fail fast on a missing or an invalid key ring instead of failing the first read or write of an edge cluster
+	if _, err = service.GetKeyRing(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// GetKeyRing returns the key encryption keys and the identifier of the primary key the new values are encrypted with
+// ctx: Mandatory The reference to the context
+// Returns either the key ring or error if something goes wrong
+func (service *keyFileKeyProviderService) GetKeyRing(ctx context.Context) (encryption.KeyRing, error) {
+	content, err := ioutil.ReadFile(service.filePath)
+	if err != nil {
+		return encryption.KeyRing{}, commonErrors.NewUnknownErrorWithError("failed to read the encryption key file", err)
+	}
+
+	keyRing, err := encryption.ParseKeyRing(content)
+	if err != nil {
+		return encryption.KeyRing{}, commonErrors.NewUnknownErrorWithError("failed to parse the encryption key file", err)
+	}
+
+	return keyRing, nil
+}
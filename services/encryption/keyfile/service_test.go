@@ -0,0 +1,135 @@
+package keyfile_test
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/keyfile"
+	"github.com/golang/mock/gomock"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestKeyFileKeyProviderService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Key File Key Provider Service Tests")
+}
+
+var _ = Describe("Key File Key Provider Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		directory                string
+		filePath                 string
+	)
+
+	writeKeyRing := func(primaryKeyID string, keyIDs ...string) {
+		keys := make([]string, 0, len(keyIDs))
+		for _, keyID := range keyIDs {
+			key := make([]byte, 32)
+			_, err := rand.Read(key)
+			Ω(err).Should(BeNil())
+
+			keys = append(keys, fmt.Sprintf(`"%s": "%s"`, keyID, base64.StdEncoding.EncodeToString(key)))
+		}
+
+		content := fmt.Sprintf(`{"primaryKeyID": "%s", "keys": {%s}}`, primaryKeyID, strings.Join(keys, ", "))
+		Ω(ioutil.WriteFile(filePath, []byte(content), 0600)).Should(BeNil())
+	}
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+
+		var err error
+		directory, err = ioutil.TempDir("", "encryption-keys")
+		Ω(err).Should(BeNil())
+
+		filePath = filepath.Join(directory, "keyring.json")
+		mockConfigurationService.
+			EXPECT().
+			GetEncryptionKeyFilePath().
+			Return(filePath, nil).
+			AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+		_ = os.RemoveAll(directory)
+	})
+
+	Context("user tries to instantiate KeyFileKeyProviderService", func() {
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := keyfile.NewKeyFileKeyProviderService(nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("the key file does not exist", func() {
+			It("should return error", func() {
+				service, err := keyfile.NewKeyFileKeyProviderService(mockConfigurationService)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("the key file does not contain the primary key", func() {
+			It("should return error", func() {
+				writeKeyRing("new", "old")
+
+				service, err := keyfile.NewKeyFileKeyProviderService(mockConfigurationService)
+				Ω(err).Should(HaveOccurred())
+				Ω(service).Should(BeNil())
+			})
+		})
+
+		When("the key file is valid", func() {
+			It("should return the key ring of the file and pick up the rotated keys", func() {
+				writeKeyRing("old", "old")
+
+				service, err := keyfile.NewKeyFileKeyProviderService(mockConfigurationService)
+				Ω(err).Should(BeNil())
+
+				keyRing, err := service.GetKeyRing(context.Background())
+				Ω(err).Should(BeNil())
+				Ω(keyRing.PrimaryKeyID).Should(Equal("old"))
+				Ω(keyRing.Keys).Should(HaveKey("old"))
+
+				writeKeyRing("new", "old", "new")
+
+				keyRing, err = service.GetKeyRing(context.Background())
+				Ω(err).Should(BeNil())
+				Ω(keyRing.PrimaryKeyID).Should(Equal("new"))
+				Ω(keyRing.Keys).Should(HaveLen(2))
+			})
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
@@ -0,0 +1,53 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type keyRingDocument struct {
+	PrimaryKeyID string            `json:"primaryKeyID"`
+	Keys         map[string]string `json:"keys"`
+}
+
+// ParseKeyRing parses the JSON document the key providers read the key ring from, e.g.
+// {"primaryKeyID": "2021-06", "keys": {"2021-01": "<base64 key>", "2021-06": "<base64 key>"}}
+// The keys are base64 encoded and must be KeySize bytes long once decoded.
+// content: Mandatory. The JSON document to parse
+// Returns either the key ring or error if the document is not a valid key ring
+func ParseKeyRing(content []byte) (KeyRing, error) {
+	var document keyRingDocument
+	if err := json.Unmarshal(content, &document); err != nil {
+		return KeyRing{}, fmt.Errorf("failed to parse the key ring: %w", err)
+	}
+
+	if strings.Trim(document.PrimaryKeyID, " ") == "" {
+		return KeyRing{}, fmt.Errorf("the key ring does not define the primary key")
+	}
+
+	keyRing := KeyRing{
+		PrimaryKeyID: document.PrimaryKeyID,
+		Keys:         map[string][]byte{},
+	}
+
+	for keyID, encodedKey := range document.Keys {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return KeyRing{}, fmt.Errorf("failed to decode the key %s: %w", keyID, err)
+		}
+
+		if len(key) != KeySize {
+			return KeyRing{}, fmt.Errorf("the key %s must be %d bytes long, but is %d bytes long", keyID, KeySize, len(key))
+		}
+
+		keyRing.Keys[keyID] = key
+	}
+
+	if _, ok := keyRing.Keys[keyRing.PrimaryKeyID]; !ok {
+		return KeyRing{}, fmt.Errorf("the key ring does not contain the primary key %s", keyRing.PrimaryKeyID)
+	}
+
+	return keyRing, nil
+}
@@ -0,0 +1,71 @@
+package encryption_test
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEncryption(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Encryption Tests")
+}
+
+var _ = Describe("Key Ring Tests", func() {
+	newEncodedKey := func(size int) string {
+		key := make([]byte, size)
+		_, err := rand.Read(key)
+		Ω(err).Should(BeNil())
+
+		return base64.StdEncoding.EncodeToString(key)
+	}
+
+	Context("ParseKeyRing is called", func() {
+		It("should return the primary key identifier and the decoded keys", func() {
+			oldKey := newEncodedKey(encryption.KeySize)
+			newKey := newEncodedKey(encryption.KeySize)
+
+			keyRing, err := encryption.ParseKeyRing([]byte(fmt.Sprintf(
+				`{"primaryKeyID": "new", "keys": {"old": "%s", "new": "%s"}}`,
+				oldKey,
+				newKey)))
+			Ω(err).Should(BeNil())
+			Ω(keyRing.PrimaryKeyID).Should(Equal("new"))
+			Ω(keyRing.Keys).Should(HaveLen(2))
+			Ω(base64.StdEncoding.EncodeToString(keyRing.Keys["old"])).Should(Equal(oldKey))
+			Ω(base64.StdEncoding.EncodeToString(keyRing.Keys["new"])).Should(Equal(newKey))
+		})
+
+		It("should return error when the primary key is not defined", func() {
+			_, err := encryption.ParseKeyRing([]byte(fmt.Sprintf(
+				`{"keys": {"old": "%s"}}`,
+				newEncodedKey(encryption.KeySize))))
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when the primary key is missing from the keys", func() {
+			_, err := encryption.ParseKeyRing([]byte(fmt.Sprintf(
+				`{"primaryKeyID": "new", "keys": {"old": "%s"}}`,
+				newEncodedKey(encryption.KeySize))))
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when a key has the wrong size", func() {
+			_, err := encryption.ParseKeyRing([]byte(fmt.Sprintf(
+				`{"primaryKeyID": "new", "keys": {"new": "%s"}}`,
+				newEncodedKey(16))))
+			Ω(err).Should(HaveOccurred())
+		})
+
+		It("should return error when the document is not valid JSON", func() {
+			_, err := encryption.ParseKeyRing([]byte("primaryKeyID: new"))
+			Ω(err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,20 @@
+// Package encryption implements the envelope encryption of the sensitive fields the edge-cluster service persists
+package encryption
+
+// KeySize is the size in bytes of the key encryption keys and the data keys, which are AES-256 keys
+const KeySize = 32
+
+// KeyRing contains the key encryption keys keyed by their identifiers. The retired keys are kept, so the values
+// encrypted with them can still be decrypted until they are re-encrypted with the primary key.
+type KeyRing struct {
+	PrimaryKeyID string
+	Keys         map[string][]byte
+}
+
+// EncryptedValue contains a value encrypted with its own data key, together with the data key encrypted with the
+// key encryption key whose identifier is recorded
+type EncryptedValue struct {
+	KeyID            string
+	EncryptedDataKey []byte
+	Ciphertext       []byte
+}
@@ -37,4 +37,76 @@ type EndpointCreatorContract interface {
 	// ListEdgeClusterServicesEndpoint creates List Edge Cluster Services endpoint
 	// Returns the List Edge Cluster Services endpoint
 	ListEdgeClusterServicesEndpoint() endpoint.Endpoint
+
+	// WatchEdgeClusterEndpoint creates Watch Edge Cluster endpoint
+	// Returns the Watch Edge Cluster endpoint
+	WatchEdgeClusterEndpoint() endpoint.Endpoint
+
+	// ListSupportedClusterTypesEndpoint creates List Supported Cluster Types endpoint
+	// Returns the List Supported Cluster Types endpoint
+	ListSupportedClusterTypesEndpoint() endpoint.Endpoint
+
+	// InstallHelmReleaseEndpoint creates Install Helm Release endpoint
+	// Returns the Install Helm Release endpoint
+	InstallHelmReleaseEndpoint() endpoint.Endpoint
+
+	// UpgradeHelmReleaseEndpoint creates Upgrade Helm Release endpoint
+	// Returns the Upgrade Helm Release endpoint
+	UpgradeHelmReleaseEndpoint() endpoint.Endpoint
+
+	// RollbackHelmReleaseEndpoint creates Rollback Helm Release endpoint
+	// Returns the Rollback Helm Release endpoint
+	RollbackHelmReleaseEndpoint() endpoint.Endpoint
+
+	// UninstallHelmReleaseEndpoint creates Uninstall Helm Release endpoint
+	// Returns the Uninstall Helm Release endpoint
+	UninstallHelmReleaseEndpoint() endpoint.Endpoint
+
+	// ListHelmReleasesEndpoint creates List Helm Releases endpoint
+	// Returns the List Helm Releases endpoint
+	ListHelmReleasesEndpoint() endpoint.Endpoint
+
+	// AddHelmRepositoryEndpoint creates Add Helm Repository endpoint
+	// Returns the Add Helm Repository endpoint
+	AddHelmRepositoryEndpoint() endpoint.Endpoint
+
+	// RemoveHelmRepositoryEndpoint creates Remove Helm Repository endpoint
+	// Returns the Remove Helm Repository endpoint
+	RemoveHelmRepositoryEndpoint() endpoint.Endpoint
+
+	// ListHelmRepositoriesEndpoint creates List Helm Repositories endpoint
+	// Returns the List Helm Repositories endpoint
+	ListHelmRepositoriesEndpoint() endpoint.Endpoint
+
+	// UpdateHelmRepositoriesEndpoint creates Update Helm Repositories endpoint
+	// Returns the Update Helm Repositories endpoint
+	UpdateHelmRepositoriesEndpoint() endpoint.Endpoint
+
+	// ListOrphanedNamespacesEndpoint creates List Orphaned Namespaces endpoint
+	// Returns the List Orphaned Namespaces endpoint
+	ListOrphanedNamespacesEndpoint() endpoint.Endpoint
+
+	// DeleteOrphanedNamespaceEndpoint creates Delete Orphaned Namespace endpoint
+	// Returns the Delete Orphaned Namespace endpoint
+	DeleteOrphanedNamespaceEndpoint() endpoint.Endpoint
+
+	// AdoptOrphanedNamespaceEndpoint creates Adopt Orphaned Namespace endpoint
+	// Returns the Adopt Orphaned Namespace endpoint
+	AdoptOrphanedNamespaceEndpoint() endpoint.Endpoint
+
+	// IssueKubeconfigEndpoint creates Issue Kubeconfig endpoint
+	// Returns the Issue Kubeconfig endpoint
+	IssueKubeconfigEndpoint() endpoint.Endpoint
+
+	// RevokeKubeconfigEndpoint creates Revoke Kubeconfig endpoint
+	// Returns the Revoke Kubeconfig endpoint
+	RevokeKubeconfigEndpoint() endpoint.Endpoint
+
+	// ListKubeconfigIssuancesEndpoint creates List Kubeconfig Issuances endpoint
+	// Returns the List Kubeconfig Issuances endpoint
+	ListKubeconfigIssuancesEndpoint() endpoint.Endpoint
+
+	// GetEdgeClusterKubeconfigEndpoint creates Get Edge Cluster Kubeconfig endpoint
+	// Returns the Get Edge Cluster Kubeconfig endpoint
+	GetEdgeClusterKubeconfigEndpoint() endpoint.Endpoint
 }
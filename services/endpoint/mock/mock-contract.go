@@ -34,6 +34,34 @@ func (m *MockEndpointCreatorContract) EXPECT() *MockEndpointCreatorContractMockR
 	return m.recorder
 }
 
+// AddHelmRepositoryEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) AddHelmRepositoryEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHelmRepositoryEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// AddHelmRepositoryEndpoint indicates an expected call of AddHelmRepositoryEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) AddHelmRepositoryEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHelmRepositoryEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).AddHelmRepositoryEndpoint))
+}
+
+// AdoptOrphanedNamespaceEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) AdoptOrphanedNamespaceEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptOrphanedNamespaceEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// AdoptOrphanedNamespaceEndpoint indicates an expected call of AdoptOrphanedNamespaceEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) AdoptOrphanedNamespaceEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptOrphanedNamespaceEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).AdoptOrphanedNamespaceEndpoint))
+}
+
 // CreateEdgeClusterEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) CreateEdgeClusterEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -62,6 +90,62 @@ func (mr *MockEndpointCreatorContractMockRecorder) DeleteEdgeClusterEndpoint() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEdgeClusterEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).DeleteEdgeClusterEndpoint))
 }
 
+// DeleteOrphanedNamespaceEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) DeleteOrphanedNamespaceEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrphanedNamespaceEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// DeleteOrphanedNamespaceEndpoint indicates an expected call of DeleteOrphanedNamespaceEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) DeleteOrphanedNamespaceEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrphanedNamespaceEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).DeleteOrphanedNamespaceEndpoint))
+}
+
+// GetEdgeClusterKubeconfigEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) GetEdgeClusterKubeconfigEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEdgeClusterKubeconfigEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// GetEdgeClusterKubeconfigEndpoint indicates an expected call of GetEdgeClusterKubeconfigEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) GetEdgeClusterKubeconfigEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEdgeClusterKubeconfigEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).GetEdgeClusterKubeconfigEndpoint))
+}
+
+// InstallHelmReleaseEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) InstallHelmReleaseEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallHelmReleaseEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// InstallHelmReleaseEndpoint indicates an expected call of InstallHelmReleaseEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) InstallHelmReleaseEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallHelmReleaseEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).InstallHelmReleaseEndpoint))
+}
+
+// IssueKubeconfigEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) IssueKubeconfigEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueKubeconfigEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// IssueKubeconfigEndpoint indicates an expected call of IssueKubeconfigEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) IssueKubeconfigEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueKubeconfigEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).IssueKubeconfigEndpoint))
+}
+
 // ListEdgeClusterNodesEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) ListEdgeClusterNodesEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -118,6 +202,76 @@ func (mr *MockEndpointCreatorContractMockRecorder) ListEdgeClustersEndpoint() *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdgeClustersEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListEdgeClustersEndpoint))
 }
 
+// ListHelmReleasesEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListHelmReleasesEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHelmReleasesEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListHelmReleasesEndpoint indicates an expected call of ListHelmReleasesEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListHelmReleasesEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHelmReleasesEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListHelmReleasesEndpoint))
+}
+
+// ListHelmRepositoriesEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListHelmRepositoriesEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHelmRepositoriesEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListHelmRepositoriesEndpoint indicates an expected call of ListHelmRepositoriesEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListHelmRepositoriesEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHelmRepositoriesEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListHelmRepositoriesEndpoint))
+}
+
+// ListKubeconfigIssuancesEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListKubeconfigIssuancesEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubeconfigIssuancesEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListKubeconfigIssuancesEndpoint indicates an expected call of ListKubeconfigIssuancesEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListKubeconfigIssuancesEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubeconfigIssuancesEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListKubeconfigIssuancesEndpoint))
+}
+
+// ListOrphanedNamespacesEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListOrphanedNamespacesEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrphanedNamespacesEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListOrphanedNamespacesEndpoint indicates an expected call of ListOrphanedNamespacesEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListOrphanedNamespacesEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrphanedNamespacesEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListOrphanedNamespacesEndpoint))
+}
+
+// ListSupportedClusterTypesEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) ListSupportedClusterTypesEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSupportedClusterTypesEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// ListSupportedClusterTypesEndpoint indicates an expected call of ListSupportedClusterTypesEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) ListSupportedClusterTypesEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedClusterTypesEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ListSupportedClusterTypesEndpoint))
+}
+
 // ReadEdgeClusterEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) ReadEdgeClusterEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -132,6 +286,62 @@ func (mr *MockEndpointCreatorContractMockRecorder) ReadEdgeClusterEndpoint() *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEdgeClusterEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).ReadEdgeClusterEndpoint))
 }
 
+// RemoveHelmRepositoryEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RemoveHelmRepositoryEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveHelmRepositoryEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RemoveHelmRepositoryEndpoint indicates an expected call of RemoveHelmRepositoryEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RemoveHelmRepositoryEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveHelmRepositoryEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RemoveHelmRepositoryEndpoint))
+}
+
+// RevokeKubeconfigEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RevokeKubeconfigEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKubeconfigEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RevokeKubeconfigEndpoint indicates an expected call of RevokeKubeconfigEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RevokeKubeconfigEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKubeconfigEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RevokeKubeconfigEndpoint))
+}
+
+// RollbackHelmReleaseEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) RollbackHelmReleaseEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackHelmReleaseEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// RollbackHelmReleaseEndpoint indicates an expected call of RollbackHelmReleaseEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) RollbackHelmReleaseEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackHelmReleaseEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).RollbackHelmReleaseEndpoint))
+}
+
+// UninstallHelmReleaseEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) UninstallHelmReleaseEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallHelmReleaseEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// UninstallHelmReleaseEndpoint indicates an expected call of UninstallHelmReleaseEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) UninstallHelmReleaseEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallHelmReleaseEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UninstallHelmReleaseEndpoint))
+}
+
 // UpdateEdgeClusterEndpoint mocks base method.
 func (m *MockEndpointCreatorContract) UpdateEdgeClusterEndpoint() endpoint.Endpoint {
 	m.ctrl.T.Helper()
@@ -145,3 +355,45 @@ func (mr *MockEndpointCreatorContractMockRecorder) UpdateEdgeClusterEndpoint() *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEdgeClusterEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UpdateEdgeClusterEndpoint))
 }
+
+// UpdateHelmRepositoriesEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) UpdateHelmRepositoriesEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHelmRepositoriesEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// UpdateHelmRepositoriesEndpoint indicates an expected call of UpdateHelmRepositoriesEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) UpdateHelmRepositoriesEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHelmRepositoriesEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UpdateHelmRepositoriesEndpoint))
+}
+
+// UpgradeHelmReleaseEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) UpgradeHelmReleaseEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeHelmReleaseEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// UpgradeHelmReleaseEndpoint indicates an expected call of UpgradeHelmReleaseEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) UpgradeHelmReleaseEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeHelmReleaseEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).UpgradeHelmReleaseEndpoint))
+}
+
+// WatchEdgeClusterEndpoint mocks base method.
+func (m *MockEndpointCreatorContract) WatchEdgeClusterEndpoint() endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEdgeClusterEndpoint")
+	ret0, _ := ret[0].(endpoint.Endpoint)
+	return ret0
+}
+
+// WatchEdgeClusterEndpoint indicates an expected call of WatchEdgeClusterEndpoint.
+func (mr *MockEndpointCreatorContractMockRecorder) WatchEdgeClusterEndpoint() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEdgeClusterEndpoint", reflect.TypeOf((*MockEndpointCreatorContract)(nil).WatchEdgeClusterEndpoint))
+}
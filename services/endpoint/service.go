@@ -267,3 +267,543 @@ func (service *endpointCreatorService) ListEdgeClusterServicesEndpoint() endpoin
 		return service.businessService.ListEdgeClusterServices(ctx, castedRequest)
 	}
 }
+
+// WatchEdgeClusterEndpoint creates Watch Edge Cluster endpoint
+// Returns the Watch Edge Cluster endpoint
+func (service *endpointCreatorService) WatchEdgeClusterEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.WatchEdgeClusterResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.WatchEdgeClusterResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.WatchEdgeClusterRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.WatchEdgeClusterResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.WatchEdgeCluster(ctx, castedRequest)
+	}
+}
+
+// ListSupportedClusterTypesEndpoint creates List Supported Cluster Types endpoint
+// Returns the List Supported Cluster Types endpoint
+func (service *endpointCreatorService) ListSupportedClusterTypesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListSupportedClusterTypesResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListSupportedClusterTypesResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListSupportedClusterTypesRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListSupportedClusterTypesResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListSupportedClusterTypes(ctx, castedRequest)
+	}
+}
+
+// InstallHelmReleaseEndpoint creates Install Helm Release endpoint
+// Returns the Install Helm Release endpoint
+func (service *endpointCreatorService) InstallHelmReleaseEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.InstallHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.InstallHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.InstallHelmReleaseRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.InstallHelmReleaseResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.InstallHelmRelease(ctx, castedRequest)
+	}
+}
+
+// UpgradeHelmReleaseEndpoint creates Upgrade Helm Release endpoint
+// Returns the Upgrade Helm Release endpoint
+func (service *endpointCreatorService) UpgradeHelmReleaseEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.UpgradeHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.UpgradeHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.UpgradeHelmReleaseRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.UpgradeHelmReleaseResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.UpgradeHelmRelease(ctx, castedRequest)
+	}
+}
+
+// RollbackHelmReleaseEndpoint creates Rollback Helm Release endpoint
+// Returns the Rollback Helm Release endpoint
+func (service *endpointCreatorService) RollbackHelmReleaseEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RollbackHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RollbackHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RollbackHelmReleaseRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RollbackHelmReleaseResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RollbackHelmRelease(ctx, castedRequest)
+	}
+}
+
+// UninstallHelmReleaseEndpoint creates Uninstall Helm Release endpoint
+// Returns the Uninstall Helm Release endpoint
+func (service *endpointCreatorService) UninstallHelmReleaseEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.UninstallHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.UninstallHelmReleaseResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.UninstallHelmReleaseRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.UninstallHelmReleaseResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.UninstallHelmRelease(ctx, castedRequest)
+	}
+}
+
+// ListHelmReleasesEndpoint creates List Helm Releases endpoint
+// Returns the List Helm Releases endpoint
+func (service *endpointCreatorService) ListHelmReleasesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListHelmReleasesResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListHelmReleasesResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListHelmReleasesRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListHelmReleasesResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListHelmReleases(ctx, castedRequest)
+	}
+}
+
+// AddHelmRepositoryEndpoint creates Add Helm Repository endpoint
+// Returns the Add Helm Repository endpoint
+func (service *endpointCreatorService) AddHelmRepositoryEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.AddHelmRepositoryResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.AddHelmRepositoryResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.AddHelmRepositoryRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.AddHelmRepositoryResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.AddHelmRepository(ctx, castedRequest)
+	}
+}
+
+// RemoveHelmRepositoryEndpoint creates Remove Helm Repository endpoint
+// Returns the Remove Helm Repository endpoint
+func (service *endpointCreatorService) RemoveHelmRepositoryEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RemoveHelmRepositoryResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RemoveHelmRepositoryResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RemoveHelmRepositoryRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RemoveHelmRepositoryResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RemoveHelmRepository(ctx, castedRequest)
+	}
+}
+
+// ListHelmRepositoriesEndpoint creates List Helm Repositories endpoint
+// Returns the List Helm Repositories endpoint
+func (service *endpointCreatorService) ListHelmRepositoriesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListHelmRepositoriesResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListHelmRepositoriesResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListHelmRepositoriesRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListHelmRepositoriesResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListHelmRepositories(ctx, castedRequest)
+	}
+}
+
+// UpdateHelmRepositoriesEndpoint creates Update Helm Repositories endpoint
+// Returns the Update Helm Repositories endpoint
+func (service *endpointCreatorService) UpdateHelmRepositoriesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.UpdateHelmRepositoriesResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.UpdateHelmRepositoriesResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.UpdateHelmRepositoriesRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.UpdateHelmRepositoriesResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.UpdateHelmRepositories(ctx, castedRequest)
+	}
+}
+
+// ListOrphanedNamespacesEndpoint creates List Orphaned Namespaces endpoint
+// Returns the List Orphaned Namespaces endpoint
+func (service *endpointCreatorService) ListOrphanedNamespacesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListOrphanedNamespacesResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListOrphanedNamespacesResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListOrphanedNamespacesRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListOrphanedNamespacesResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListOrphanedNamespaces(ctx, castedRequest)
+	}
+}
+
+// DeleteOrphanedNamespaceEndpoint creates Delete Orphaned Namespace endpoint
+// Returns the Delete Orphaned Namespace endpoint
+func (service *endpointCreatorService) DeleteOrphanedNamespaceEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.DeleteOrphanedNamespaceResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.DeleteOrphanedNamespaceResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.DeleteOrphanedNamespaceRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.DeleteOrphanedNamespaceResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.DeleteOrphanedNamespace(ctx, castedRequest)
+	}
+}
+
+// AdoptOrphanedNamespaceEndpoint creates Adopt Orphaned Namespace endpoint
+// Returns the Adopt Orphaned Namespace endpoint
+func (service *endpointCreatorService) AdoptOrphanedNamespaceEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.AdoptOrphanedNamespaceResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.AdoptOrphanedNamespaceResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.AdoptOrphanedNamespaceRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.AdoptOrphanedNamespaceResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.AdoptOrphanedNamespace(ctx, castedRequest)
+	}
+}
+
+// IssueKubeconfigEndpoint creates Issue Kubeconfig endpoint
+// Returns the Issue Kubeconfig endpoint
+func (service *endpointCreatorService) IssueKubeconfigEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.IssueKubeconfigResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.IssueKubeconfigResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.IssueKubeconfigRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.IssueKubeconfigResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.IssueKubeconfig(ctx, castedRequest)
+	}
+}
+
+// RevokeKubeconfigEndpoint creates Revoke Kubeconfig endpoint
+// Returns the Revoke Kubeconfig endpoint
+func (service *endpointCreatorService) RevokeKubeconfigEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.RevokeKubeconfigResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.RevokeKubeconfigResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.RevokeKubeconfigRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.RevokeKubeconfigResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.RevokeKubeconfig(ctx, castedRequest)
+	}
+}
+
+// ListKubeconfigIssuancesEndpoint creates List Kubeconfig Issuances endpoint
+// Returns the List Kubeconfig Issuances endpoint
+func (service *endpointCreatorService) ListKubeconfigIssuancesEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.ListKubeconfigIssuancesResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.ListKubeconfigIssuancesResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.ListKubeconfigIssuancesRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.ListKubeconfigIssuancesResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.ListKubeconfigIssuances(ctx, castedRequest)
+	}
+}
+
+// GetEdgeClusterKubeconfigEndpoint creates Get Edge Cluster Kubeconfig endpoint
+// Returns the Get Edge Cluster Kubeconfig endpoint
+func (service *endpointCreatorService) GetEdgeClusterKubeconfigEndpoint() endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		if ctx == nil {
+			return &business.GetEdgeClusterKubeconfigResponse{
+				Err: commonErrors.NewArgumentNilError("ctx", "ctx is required"),
+			}, nil
+		}
+
+		if request == nil {
+			return &business.GetEdgeClusterKubeconfigResponse{
+				Err: commonErrors.NewArgumentNilError("request", "request is required"),
+			}, nil
+		}
+
+		castedRequest := request.(*business.GetEdgeClusterKubeconfigRequest)
+		parsedToken := ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken)
+		castedRequest.UserEmail = parsedToken.Email
+
+		if err := castedRequest.Validate(); err != nil {
+			return &business.GetEdgeClusterKubeconfigResponse{
+				Err: commonErrors.NewArgumentErrorWithError("request", "", err),
+			}, nil
+		}
+
+		return service.businessService.GetEdgeClusterKubeconfig(ctx, castedRequest)
+	}
+}
@@ -143,6 +143,35 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 					})
 				})
 
+				When("endpoint is called with request missing the address of the service exposure", func() {
+					It("should return ArgumentError", func() {
+						request.EdgeCluster.ServiceExposure = models.ServiceExposure{Mode: models.ServiceExposureModeIngress}
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateEdgeClusterResponse)
+						validationErr := request.Validate()
+						Ω(validationErr).ShouldNot(BeNil())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with request exposing a non K3S edge cluster through a node port", func() {
+					It("should return ArgumentError", func() {
+						request.EdgeCluster.ClusterType = models.K0S
+						request.EdgeCluster.ServiceExposure = models.ServiceExposure{Mode: models.ServiceExposureModeNodePort}
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.CreateEdgeClusterResponse)
+						validationErr := request.Validate()
+						Ω(validationErr).ShouldNot(BeNil())
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
 				When("endpoint is called with valid request", func() {
 					It("should call business service CreateEdgeCluster method", func() {
 						mockBusinessService.
@@ -715,6 +744,2000 @@ var _ = Describe("Endpoint Creator Service Tests", func() {
 			})
 		})
 	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("WatchEdgeClusterEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.WatchEdgeClusterEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.WatchEdgeClusterRequest
+				response business.WatchEdgeClusterResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.WatchEdgeClusterEndpoint()
+				request = business.WatchEdgeClusterRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+				}
+
+				response = business.WatchEdgeClusterResponse{
+					Events: make(chan models.EdgeClusterEvent),
+				}
+			})
+
+			Context("WatchEdgeClusterEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchEdgeClusterResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchEdgeClusterResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentNilError", func() {
+						invalidRequest := business.WatchEdgeClusterRequest{
+							EdgeClusterID: "",
+						}
+						returnedResponse, err := endpoint(ctx, &invalidRequest)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchEdgeClusterResponse)
+						validationErr := invalidRequest.Validate()
+						assertArgumentError("request", validationErr.Error(), castedResponse.Err, validationErr)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service WatchEdgeCluster method", func() {
+						mockBusinessService.
+							EXPECT().
+							WatchEdgeCluster(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.WatchEdgeClusterRequest) (*business.WatchEdgeClusterResponse, error) {
+									Ω(mappedRequest.EdgeClusterID).Should(Equal(request.EdgeClusterID))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(response).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.WatchEdgeClusterResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service WatchEdgeCluster returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							WatchEdgeCluster(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service WatchEdgeCluster returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							WatchEdgeCluster(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListSupportedClusterTypesEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListSupportedClusterTypesEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListSupportedClusterTypesRequest
+				response business.ListSupportedClusterTypesResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListSupportedClusterTypesEndpoint()
+				request = business.ListSupportedClusterTypesRequest{
+					UserEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.ListSupportedClusterTypesResponse{
+					ClusterTypes: []models.ClusterTypeDescriptor{
+						{
+							ClusterType:       models.K3S,
+							Name:              cuid.New(),
+							SupportedFeatures: []string{cuid.New()},
+							DefaultCharts:     []string{cuid.New()},
+						},
+					},
+				}
+			})
+
+			Context("ListSupportedClusterTypesEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListSupportedClusterTypesResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListSupportedClusterTypesResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListSupportedClusterTypes method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListSupportedClusterTypes(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.ListSupportedClusterTypesRequest) (*business.ListSupportedClusterTypesResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.ListSupportedClusterTypesResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListSupportedClusterTypes returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListSupportedClusterTypes(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListSupportedClusterTypes returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListSupportedClusterTypes(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("InstallHelmReleaseEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.InstallHelmReleaseEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.InstallHelmReleaseRequest
+				response business.InstallHelmReleaseResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.InstallHelmReleaseEndpoint()
+				request = business.InstallHelmReleaseRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					EdgeClusterID:  cuid.New(),
+					Namespace:      cuid.New(),
+					ReleaseName:    cuid.New(),
+					RepositoryName: cuid.New(),
+					RepositoryURL:  "https://" + cuid.New() + ".com",
+					Chart:          cuid.New(),
+					Set:            []string{cuid.New() + "=" + cuid.New()},
+				}
+
+				response = business.InstallHelmReleaseResponse{
+					Release: models.HelmRelease{Name: cuid.New(), Revision: 1, Status: "deployed"},
+				}
+			})
+
+			Context("InstallHelmReleaseEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.InstallHelmReleaseResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.InstallHelmReleaseResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.Chart = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.InstallHelmReleaseResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service InstallHelmRelease method", func() {
+						mockBusinessService.
+							EXPECT().
+							InstallHelmRelease(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.InstallHelmReleaseRequest) (*business.InstallHelmReleaseResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.InstallHelmReleaseResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service InstallHelmRelease returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							InstallHelmRelease(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service InstallHelmRelease returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							InstallHelmRelease(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("UpgradeHelmReleaseEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.UpgradeHelmReleaseEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.UpgradeHelmReleaseRequest
+				response business.UpgradeHelmReleaseResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.UpgradeHelmReleaseEndpoint()
+				request = business.UpgradeHelmReleaseRequest{
+					UserEmail:      cuid.New() + "@test.com",
+					EdgeClusterID:  cuid.New(),
+					Namespace:      cuid.New(),
+					ReleaseName:    cuid.New(),
+					RepositoryName: cuid.New(),
+					Chart:          cuid.New(),
+				}
+
+				response = business.UpgradeHelmReleaseResponse{
+					Release: models.HelmRelease{Name: cuid.New(), Revision: 2, Status: "deployed"},
+				}
+			})
+
+			Context("UpgradeHelmReleaseEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpgradeHelmReleaseResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpgradeHelmReleaseResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.RepositoryURL = cuid.New()
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpgradeHelmReleaseResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service UpgradeHelmRelease method", func() {
+						mockBusinessService.
+							EXPECT().
+							UpgradeHelmRelease(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.UpgradeHelmReleaseRequest) (*business.UpgradeHelmReleaseResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.UpgradeHelmReleaseResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service UpgradeHelmRelease returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							UpgradeHelmRelease(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service UpgradeHelmRelease returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							UpgradeHelmRelease(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RollbackHelmReleaseEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RollbackHelmReleaseEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RollbackHelmReleaseRequest
+				response business.RollbackHelmReleaseResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RollbackHelmReleaseEndpoint()
+				request = business.RollbackHelmReleaseRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+					Namespace:     cuid.New(),
+					ReleaseName:   cuid.New(),
+					Revision:      1,
+				}
+
+				response = business.RollbackHelmReleaseResponse{
+					Release: models.HelmRelease{Name: cuid.New(), Revision: 3, Status: "deployed"},
+				}
+			})
+
+			Context("RollbackHelmReleaseEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RollbackHelmReleaseResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RollbackHelmReleaseResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.Revision = -1
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RollbackHelmReleaseResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RollbackHelmRelease method", func() {
+						mockBusinessService.
+							EXPECT().
+							RollbackHelmRelease(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.RollbackHelmReleaseRequest) (*business.RollbackHelmReleaseResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.RollbackHelmReleaseResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RollbackHelmRelease returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RollbackHelmRelease(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RollbackHelmRelease returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RollbackHelmRelease(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("UninstallHelmReleaseEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.UninstallHelmReleaseEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.UninstallHelmReleaseRequest
+				response business.UninstallHelmReleaseResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.UninstallHelmReleaseEndpoint()
+				request = business.UninstallHelmReleaseRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+					Namespace:     cuid.New(),
+					ReleaseName:   cuid.New(),
+				}
+
+				response = business.UninstallHelmReleaseResponse{}
+			})
+
+			Context("UninstallHelmReleaseEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UninstallHelmReleaseResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UninstallHelmReleaseResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.ReleaseName = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UninstallHelmReleaseResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service UninstallHelmRelease method", func() {
+						mockBusinessService.
+							EXPECT().
+							UninstallHelmRelease(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.UninstallHelmReleaseRequest) (*business.UninstallHelmReleaseResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.UninstallHelmReleaseResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service UninstallHelmRelease returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							UninstallHelmRelease(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service UninstallHelmRelease returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							UninstallHelmRelease(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListHelmReleasesEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListHelmReleasesEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListHelmReleasesRequest
+				response business.ListHelmReleasesResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListHelmReleasesEndpoint()
+				request = business.ListHelmReleasesRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+				}
+
+				response = business.ListHelmReleasesResponse{
+					Releases: []models.HelmRelease{{Name: cuid.New(), Revision: 1, Status: "deployed"}},
+				}
+			})
+
+			Context("ListHelmReleasesEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmReleasesResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmReleasesResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.EdgeClusterID = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmReleasesResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListHelmReleases method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListHelmReleases(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.ListHelmReleasesRequest) (*business.ListHelmReleasesResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmReleasesResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListHelmReleases returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListHelmReleases(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListHelmReleases returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListHelmReleases(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+	Context("EndpointCreatorService is instantiated", func() {
+		When("AddHelmRepositoryEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.AddHelmRepositoryEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.AddHelmRepositoryRequest
+				response business.AddHelmRepositoryResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.AddHelmRepositoryEndpoint()
+				request = business.AddHelmRepositoryRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Name:      cuid.New(),
+					URL:       "https://" + cuid.New(),
+				}
+
+				response = business.AddHelmRepositoryResponse{
+					Repository: models.HelmRepository{Name: cuid.New(), URL: "https://" + cuid.New()},
+				}
+			})
+
+			Context("AddHelmRepositoryEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddHelmRepositoryResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddHelmRepositoryResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.Name = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AddHelmRepositoryResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service AddHelmRepository method", func() {
+						mockBusinessService.
+							EXPECT().
+							AddHelmRepository(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.AddHelmRepositoryRequest) (*business.AddHelmRepositoryResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.AddHelmRepositoryResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service AddHelmRepository returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							AddHelmRepository(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service AddHelmRepository returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							AddHelmRepository(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RemoveHelmRepositoryEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RemoveHelmRepositoryEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RemoveHelmRepositoryRequest
+				response business.RemoveHelmRepositoryResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RemoveHelmRepositoryEndpoint()
+				request = business.RemoveHelmRepositoryRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Name:      cuid.New(),
+				}
+
+				response = business.RemoveHelmRepositoryResponse{}
+			})
+
+			Context("RemoveHelmRepositoryEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveHelmRepositoryResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveHelmRepositoryResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.Name = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveHelmRepositoryResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RemoveHelmRepository method", func() {
+						mockBusinessService.
+							EXPECT().
+							RemoveHelmRepository(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.RemoveHelmRepositoryRequest) (*business.RemoveHelmRepositoryResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.RemoveHelmRepositoryResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RemoveHelmRepository returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RemoveHelmRepository(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RemoveHelmRepository returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RemoveHelmRepository(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListHelmRepositoriesEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListHelmRepositoriesEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListHelmRepositoriesRequest
+				response business.ListHelmRepositoriesResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListHelmRepositoriesEndpoint()
+				request = business.ListHelmRepositoriesRequest{
+					UserEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.ListHelmRepositoriesResponse{
+					Repositories: []models.HelmRepository{{Name: cuid.New(), URL: "https://" + cuid.New()}},
+				}
+			})
+
+			Context("ListHelmRepositoriesEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmRepositoriesResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmRepositoriesResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListHelmRepositories method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListHelmRepositories(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.ListHelmRepositoriesRequest) (*business.ListHelmRepositoriesResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.ListHelmRepositoriesResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListHelmRepositories returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListHelmRepositories(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListHelmRepositories returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListHelmRepositories(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("UpdateHelmRepositoriesEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.UpdateHelmRepositoriesEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.UpdateHelmRepositoriesRequest
+				response business.UpdateHelmRepositoriesResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.UpdateHelmRepositoriesEndpoint()
+				request = business.UpdateHelmRepositoriesRequest{
+					UserEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.UpdateHelmRepositoriesResponse{
+					Repositories: []models.HelmRepository{{Name: cuid.New(), URL: "https://" + cuid.New()}},
+				}
+			})
+
+			Context("UpdateHelmRepositoriesEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateHelmRepositoriesResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateHelmRepositoriesResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service UpdateHelmRepositories method", func() {
+						mockBusinessService.
+							EXPECT().
+							UpdateHelmRepositories(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.UpdateHelmRepositoriesRequest) (*business.UpdateHelmRepositoriesResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.UpdateHelmRepositoriesResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service UpdateHelmRepositories returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							UpdateHelmRepositories(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service UpdateHelmRepositories returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							UpdateHelmRepositories(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListOrphanedNamespacesEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListOrphanedNamespacesEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListOrphanedNamespacesRequest
+				response business.ListOrphanedNamespacesResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListOrphanedNamespacesEndpoint()
+				request = business.ListOrphanedNamespacesRequest{
+					UserEmail: cuid.New() + "@test.com",
+				}
+
+				response = business.ListOrphanedNamespacesResponse{}
+			})
+
+			Context("ListOrphanedNamespacesEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrphanedNamespacesResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrphanedNamespacesResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListOrphanedNamespaces method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListOrphanedNamespaces(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.ListOrphanedNamespacesRequest) (*business.ListOrphanedNamespacesResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.ListOrphanedNamespacesResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListOrphanedNamespaces returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListOrphanedNamespaces(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListOrphanedNamespaces returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListOrphanedNamespaces(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("DeleteOrphanedNamespaceEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.DeleteOrphanedNamespaceEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.DeleteOrphanedNamespaceRequest
+				response business.DeleteOrphanedNamespaceResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.DeleteOrphanedNamespaceEndpoint()
+				request = business.DeleteOrphanedNamespaceRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Name:      cuid.New(),
+				}
+
+				response = business.DeleteOrphanedNamespaceResponse{}
+			})
+
+			Context("DeleteOrphanedNamespaceEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrphanedNamespaceResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrphanedNamespaceResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.Name = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrphanedNamespaceResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service DeleteOrphanedNamespace method", func() {
+						mockBusinessService.
+							EXPECT().
+							DeleteOrphanedNamespace(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.DeleteOrphanedNamespaceRequest) (*business.DeleteOrphanedNamespaceResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.DeleteOrphanedNamespaceResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service DeleteOrphanedNamespace returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							DeleteOrphanedNamespace(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service DeleteOrphanedNamespace returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							DeleteOrphanedNamespace(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("AdoptOrphanedNamespaceEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.AdoptOrphanedNamespaceEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.AdoptOrphanedNamespaceRequest
+				response business.AdoptOrphanedNamespaceResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.AdoptOrphanedNamespaceEndpoint()
+				request = business.AdoptOrphanedNamespaceRequest{
+					UserEmail: cuid.New() + "@test.com",
+					Name:      cuid.New(),
+				}
+
+				response = business.AdoptOrphanedNamespaceResponse{}
+			})
+
+			Context("AdoptOrphanedNamespaceEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AdoptOrphanedNamespaceResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AdoptOrphanedNamespaceResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.Name = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.AdoptOrphanedNamespaceResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service AdoptOrphanedNamespace method", func() {
+						mockBusinessService.
+							EXPECT().
+							AdoptOrphanedNamespace(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.AdoptOrphanedNamespaceRequest) (*business.AdoptOrphanedNamespaceResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.AdoptOrphanedNamespaceResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service AdoptOrphanedNamespace returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							AdoptOrphanedNamespace(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service AdoptOrphanedNamespace returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							AdoptOrphanedNamespace(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("IssueKubeconfigEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.IssueKubeconfigEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.IssueKubeconfigRequest
+				response business.IssueKubeconfigResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.IssueKubeconfigEndpoint()
+				request = business.IssueKubeconfigRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+					Role:          models.KubeconfigRoleView,
+					Namespace:     cuid.New(),
+					TTL:           time.Hour,
+				}
+
+				response = business.IssueKubeconfigResponse{}
+			})
+
+			Context("IssueKubeconfigEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.IssueKubeconfigResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.IssueKubeconfigResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.TTL = time.Minute
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.IssueKubeconfigResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service IssueKubeconfig method", func() {
+						mockBusinessService.
+							EXPECT().
+							IssueKubeconfig(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.IssueKubeconfigRequest) (*business.IssueKubeconfigResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.IssueKubeconfigResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service IssueKubeconfig returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							IssueKubeconfig(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service IssueKubeconfig returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							IssueKubeconfig(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("RevokeKubeconfigEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.RevokeKubeconfigEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.RevokeKubeconfigRequest
+				response business.RevokeKubeconfigResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.RevokeKubeconfigEndpoint()
+				request = business.RevokeKubeconfigRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+					IssuanceID:    cuid.New(),
+				}
+
+				response = business.RevokeKubeconfigResponse{}
+			})
+
+			Context("RevokeKubeconfigEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeKubeconfigResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeKubeconfigResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.IssuanceID = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeKubeconfigResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service RevokeKubeconfig method", func() {
+						mockBusinessService.
+							EXPECT().
+							RevokeKubeconfig(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.RevokeKubeconfigRequest) (*business.RevokeKubeconfigResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.RevokeKubeconfigResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service RevokeKubeconfig returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							RevokeKubeconfig(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service RevokeKubeconfig returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							RevokeKubeconfig(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("ListKubeconfigIssuancesEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.ListKubeconfigIssuancesEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.ListKubeconfigIssuancesRequest
+				response business.ListKubeconfigIssuancesResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.ListKubeconfigIssuancesEndpoint()
+				request = business.ListKubeconfigIssuancesRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+				}
+
+				response = business.ListKubeconfigIssuancesResponse{}
+			})
+
+			Context("ListKubeconfigIssuancesEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListKubeconfigIssuancesResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListKubeconfigIssuancesResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.EdgeClusterID = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.ListKubeconfigIssuancesResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service ListKubeconfigIssuances method", func() {
+						mockBusinessService.
+							EXPECT().
+							ListKubeconfigIssuances(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.ListKubeconfigIssuancesRequest) (*business.ListKubeconfigIssuancesResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.ListKubeconfigIssuancesResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service ListKubeconfigIssuances returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							ListKubeconfigIssuances(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service ListKubeconfigIssuances returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							ListKubeconfigIssuances(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
+
+	Context("EndpointCreatorService is instantiated", func() {
+		When("GetEdgeClusterKubeconfigEndpoint is called", func() {
+			It("should return valid function", func() {
+				endpoint := sut.GetEdgeClusterKubeconfigEndpoint()
+				Ω(endpoint).ShouldNot(BeNil())
+			})
+
+			var (
+				endpoint gokitendpoint.Endpoint
+				request  business.GetEdgeClusterKubeconfigRequest
+				response business.GetEdgeClusterKubeconfigResponse
+			)
+
+			BeforeEach(func() {
+				endpoint = sut.GetEdgeClusterKubeconfigEndpoint()
+				request = business.GetEdgeClusterKubeconfigRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: cuid.New(),
+				}
+
+				response = business.GetEdgeClusterKubeconfigResponse{}
+			})
+
+			Context("GetEdgeClusterKubeconfigEndpoint function is returned", func() {
+				When("endpoint is called with nil context", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(nil, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.GetEdgeClusterKubeconfigResponse)
+						assertArgumentNilError("ctx", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with nil request", func() {
+					It("should return ArgumentNilError", func() {
+						returnedResponse, err := endpoint(ctx, nil)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.GetEdgeClusterKubeconfigResponse)
+						assertArgumentNilError("request", "", castedResponse.Err)
+					})
+				})
+
+				When("endpoint is called with invalid request", func() {
+					It("should return ArgumentError", func() {
+						request.EdgeClusterID = ""
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).ShouldNot(BeNil())
+						castedResponse := returnedResponse.(*business.GetEdgeClusterKubeconfigResponse)
+						Ω(commonErrors.IsArgumentError(castedResponse.Err)).Should(BeTrue())
+					})
+				})
+
+				When("endpoint is called with valid request", func() {
+					It("should call business service GetEdgeClusterKubeconfig method", func() {
+						mockBusinessService.
+							EXPECT().
+							GetEdgeClusterKubeconfig(ctx, gomock.Any()).
+							DoAndReturn(
+								func(
+									_ context.Context,
+									mappedRequest *business.GetEdgeClusterKubeconfigRequest) (*business.GetEdgeClusterKubeconfigResponse, error) {
+									Ω(mappedRequest.UserEmail).Should(Equal(ctx.Value(models.ContextKeyParsedToken).(models.ParsedToken).Email))
+
+									return &response, nil
+								})
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						castedResponse := returnedResponse.(*business.GetEdgeClusterKubeconfigResponse)
+						Ω(castedResponse.Err).Should(BeNil())
+					})
+				})
+
+				When("business service GetEdgeClusterKubeconfig returns error", func() {
+					It("should return the same error", func() {
+						expectedErr := errors.New(cuid.New())
+						mockBusinessService.
+							EXPECT().
+							GetEdgeClusterKubeconfig(gomock.Any(), gomock.Any()).
+							Return(nil, expectedErr)
+
+						_, err := endpoint(ctx, &request)
+
+						Ω(err).Should(Equal(expectedErr))
+					})
+				})
+
+				When("business service GetEdgeClusterKubeconfig returns response", func() {
+					It("should return the same response", func() {
+						mockBusinessService.
+							EXPECT().
+							GetEdgeClusterKubeconfig(gomock.Any(), gomock.Any()).
+							Return(&response, nil)
+
+						returnedResponse, err := endpoint(ctx, &request)
+
+						Ω(err).Should(BeNil())
+						Ω(returnedResponse).Should(Equal(&response))
+					})
+				})
+			})
+		})
+	})
 })
 
 func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
@@ -0,0 +1,28 @@
+// Package event implements the services that publish the edge cluster provisioning events to the interested subscribers
+package event
+
+import (
+	"context"
+)
+
+// EventBusContract declares the methods to be implemented by the service that delivers the edge cluster
+// provisioning events to the subscribers
+type EventBusContract interface {
+	// Publish delivers the given event to the subscribers of the edge cluster the event belongs to.
+	// Publishing never blocks, events are dropped for subscribers that do not keep up.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request contains the event to publish
+	// Returns either the result of publishing the event or error if something goes wrong.
+	Publish(
+		ctx context.Context,
+		request *PublishRequest) (*PublishResponse, error)
+
+	// Subscribe subscribes to the events of an edge cluster. The subscription ends and the events channel
+	// is closed when the given context is cancelled.
+	// ctx: Mandatory The reference to the context that controls the lifetime of the subscription
+	// request: Mandatory. The request contains the edge cluster to subscribe to
+	// Returns either the channel the events are delivered to or error if something goes wrong.
+	Subscribe(
+		ctx context.Context,
+		request *SubscribeRequest) (*SubscribeResponse, error)
+}
@@ -0,0 +1,89 @@
+// Package memory implements an in-process event bus that delivers the events published by this service instance
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+)
+
+const subscriberBufferSize = 64
+
+type memoryEventBusService struct {
+	lock        sync.RWMutex
+	subscribers map[string]map[chan models.EdgeClusterEvent]struct{}
+}
+
+// NewMemoryEventBusService creates new instance of the memoryEventBusService, setting up all dependencies and returns the instance
+// Returns the new service or error if something goes wrong
+func NewMemoryEventBusService() (event.EventBusContract, error) {
+	return &memoryEventBusService{
+		subscribers: map[string]map[chan models.EdgeClusterEvent]struct{}{},
+	}, nil
+}
+
+// Publish delivers the given event to the subscribers of the edge cluster the event belongs to.
+// Publishing never blocks, events are dropped for subscribers that do not keep up.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request contains the event to publish
+// Returns either the result of publishing the event or error if something goes wrong.
+func (service *memoryEventBusService) Publish(
+	ctx context.Context,
+	request *event.PublishRequest) (*event.PublishResponse, error) {
+	edgeClusterEvent := request.Event
+	if edgeClusterEvent.Timestamp.IsZero() {
+		edgeClusterEvent.Timestamp = time.Now().UTC()
+	}
+
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+
+	for subscriber := range service.subscribers[edgeClusterEvent.EdgeClusterID] {
+		select {
+		case subscriber <- edgeClusterEvent:
+		default:
+		}
+	}
+
+	return &event.PublishResponse{}, nil
+}
+
+// Subscribe subscribes to the events of an edge cluster. The subscription ends and the events channel
+// is closed when the given context is cancelled.
+// ctx: Mandatory The reference to the context that controls the lifetime of the subscription
+// request: Mandatory. The request contains the edge cluster to subscribe to
+// Returns either the channel the events are delivered to or error if something goes wrong.
+func (service *memoryEventBusService) Subscribe(
+	ctx context.Context,
+	request *event.SubscribeRequest) (*event.SubscribeResponse, error) {
+	subscriber := make(chan models.EdgeClusterEvent, subscriberBufferSize)
+
+	service.lock.Lock()
+	if _, ok := service.subscribers[request.EdgeClusterID]; !ok {
+		service.subscribers[request.EdgeClusterID] = map[chan models.EdgeClusterEvent]struct{}{}
+	}
+
+	service.subscribers[request.EdgeClusterID][subscriber] = struct{}{}
+	service.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		service.lock.Lock()
+		defer service.lock.Unlock()
+
+		delete(service.subscribers[request.EdgeClusterID], subscriber)
+		if len(service.subscribers[request.EdgeClusterID]) == 0 {
+			delete(service.subscribers, request.EdgeClusterID)
+		}
+
+		close(subscriber)
+	}()
+
+	return &event.SubscribeResponse{
+		Events: subscriber,
+	}, nil
+}
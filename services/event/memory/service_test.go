@@ -0,0 +1,108 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/event"
+	"github.com/decentralized-cloud/edge-cluster/services/event/memory"
+	"github.com/lucsky/cuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryEventBusService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Memory Event Bus Service Tests")
+}
+
+var _ = Describe("Memory Event Bus Service Tests", func() {
+	var (
+		sut           event.EventBusContract
+		ctx           context.Context
+		cancel        context.CancelFunc
+		edgeClusterID string
+	)
+
+	BeforeEach(func() {
+		sut, _ = memory.NewMemoryEventBusService()
+		ctx, cancel = context.WithCancel(context.Background())
+		edgeClusterID = cuid.New()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	subscribe := func(edgeClusterID string) <-chan models.EdgeClusterEvent {
+		response, err := sut.Subscribe(ctx, &event.SubscribeRequest{EdgeClusterID: edgeClusterID})
+		Ω(err).Should(BeNil())
+
+		return response.Events
+	}
+
+	publish := func(edgeClusterEvent models.EdgeClusterEvent) {
+		_, err := sut.Publish(ctx, &event.PublishRequest{Event: edgeClusterEvent})
+		Ω(err).Should(BeNil())
+	}
+
+	Context("user subscribes to an edge cluster events", func() {
+		When("an event of the edge cluster is published", func() {
+			It("should deliver the event to all the subscribers and set the timestamp", func() {
+				firstSubscriber := subscribe(edgeClusterID)
+				secondSubscriber := subscribe(edgeClusterID)
+
+				publishedEvent := models.EdgeClusterEvent{
+					EdgeClusterID: edgeClusterID,
+					Type:          models.EdgeClusterEventTypeNamespaceCreated,
+					Status:        models.ProvisioningStatusProvisioning,
+					Message:       cuid.New(),
+				}
+				publish(publishedEvent)
+
+				for _, subscriber := range []<-chan models.EdgeClusterEvent{firstSubscriber, secondSubscriber} {
+					var receivedEvent models.EdgeClusterEvent
+					Eventually(subscriber).Should(Receive(&receivedEvent))
+					Ω(receivedEvent.EdgeClusterID).Should(Equal(publishedEvent.EdgeClusterID))
+					Ω(receivedEvent.Type).Should(Equal(publishedEvent.Type))
+					Ω(receivedEvent.Status).Should(Equal(publishedEvent.Status))
+					Ω(receivedEvent.Message).Should(Equal(publishedEvent.Message))
+					Ω(receivedEvent.Timestamp.IsZero()).Should(BeFalse())
+				}
+			})
+		})
+
+		When("an event of another edge cluster is published", func() {
+			It("should not deliver the event", func() {
+				subscriber := subscribe(edgeClusterID)
+
+				publish(models.EdgeClusterEvent{EdgeClusterID: cuid.New()})
+
+				Consistently(subscriber).ShouldNot(Receive())
+			})
+		})
+
+		When("more events are published than the subscriber can buffer", func() {
+			It("should not block the publisher", func() {
+				subscribe(edgeClusterID)
+
+				for i := 0; i < 1000; i++ {
+					publish(models.EdgeClusterEvent{EdgeClusterID: edgeClusterID})
+				}
+			})
+		})
+
+		When("the subscription context is cancelled", func() {
+			It("should close the events channel", func() {
+				subscriber := subscribe(edgeClusterID)
+
+				cancel()
+
+				Eventually(subscriber).Should(BeClosed())
+				publish(models.EdgeClusterEvent{EdgeClusterID: edgeClusterID})
+			})
+		})
+	})
+})
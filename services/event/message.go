@@ -0,0 +1,25 @@
+// Package event implements the services that publish the edge cluster provisioning events to the interested subscribers
+package event
+
+import (
+	"github.com/decentralized-cloud/edge-cluster/models"
+)
+
+// PublishRequest contains the request to publish an edge cluster event
+type PublishRequest struct {
+	Event models.EdgeClusterEvent
+}
+
+// PublishResponse contains the result of publishing an edge cluster event
+type PublishResponse struct {
+}
+
+// SubscribeRequest contains the request to subscribe to the events of an edge cluster
+type SubscribeRequest struct {
+	EdgeClusterID string
+}
+
+// SubscribeResponse contains the result of subscribing to the events of an edge cluster
+type SubscribeResponse struct {
+	Events <-chan models.EdgeClusterEvent
+}
@@ -0,0 +1,72 @@
+// Package job implements the durable job queue used to run the edge cluster provisioning in the background
+package job
+
+import (
+	"context"
+)
+
+// JobQueueContract declares the methods to be implemented by the durable queue that keeps the provisioning jobs
+type JobQueueContract interface {
+	// EnqueueJob adds a new job to the queue.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to add a new job to the queue
+	// Returns either the result of adding a new job to the queue or error if something goes wrong.
+	EnqueueJob(
+		ctx context.Context,
+		request *EnqueueJobRequest) (*EnqueueJobResponse, error)
+
+	// AcquireJob leases the next job that is due to run. Jobs whose lease expired, e.g. because the worker that
+	// acquired them stopped, are due to run again. Jobs of an edge cluster that already has a leased job are skipped.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to lease the next job
+	// Returns either the leased job, nil job if no job is due to run, or error if something goes wrong.
+	AcquireJob(
+		ctx context.Context,
+		request *AcquireJobRequest) (*AcquireJobResponse, error)
+
+	// RenewJobLease extends the lease the worker holds on a job.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to extend the lease on a job
+	// Returns either the result of extending the lease or NotFoundError if the worker no longer holds the lease.
+	RenewJobLease(
+		ctx context.Context,
+		request *RenewJobLeaseRequest) (*RenewJobLeaseResponse, error)
+
+	// CompleteJob marks a leased job as completed.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to mark a leased job as completed
+	// Returns either the result of completing the job or NotFoundError if the worker no longer holds the lease.
+	CompleteJob(
+		ctx context.Context,
+		request *CompleteJobRequest) (*CompleteJobResponse, error)
+
+	// FailJob records the failure of a leased job and either reschedules it or marks it as failed.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to record the failure of a leased job
+	// Returns either the result of recording the failure or NotFoundError if the worker no longer holds the lease.
+	FailJob(
+		ctx context.Context,
+		request *FailJobRequest) (*FailJobResponse, error)
+}
+
+// JobHandlerContract declares the methods to be implemented by the service that executes the leased jobs
+type JobHandlerContract interface {
+	// HandleJob executes the given job.
+	// ctx: Mandatory The reference to the context. It is cancelled if the worker loses the lease or is stopped
+	// request: Mandatory. The request contains the job to execute
+	// Returns either the result of executing the job or error if something goes wrong.
+	HandleJob(
+		ctx context.Context,
+		request *HandleJobRequest) (*HandleJobResponse, error)
+}
+
+// JobWorkerPoolContract declares the methods to be implemented by the pool of workers that process the queued jobs
+type JobWorkerPoolContract interface {
+	// Start starts the workers.
+	// Returns error if something goes wrong.
+	Start() error
+
+	// Stop stops the workers and waits for them to finish.
+	// Returns error if something goes wrong.
+	Stop() error
+}
@@ -0,0 +1 @@
+package job_test
@@ -0,0 +1,36 @@
+// Package job implements the durable job queue used to run the edge cluster provisioning in the background
+package job
+
+import "fmt"
+
+// NonRetryableError indicates that the job failed in a way that retrying it cannot fix, e.g. because the edge cluster
+// details are invalid, so the job is marked as failed without using the remaining attempts
+type NonRetryableError struct {
+	Err error
+}
+
+// Error returns message for the NonRetryableError error type
+// Returns the error nessage
+func (e NonRetryableError) Error() string {
+	return fmt.Sprintf("Job cannot be retried. Error: %v", e.Err)
+}
+
+// Unwrap returns the error that caused the job to fail
+func (e NonRetryableError) Unwrap() error {
+	return e.Err
+}
+
+// IsNonRetryableError indicates whether the error is of type NonRetryableError
+func IsNonRetryableError(err error) bool {
+	_, ok := err.(NonRetryableError)
+
+	return ok
+}
+
+// NewNonRetryableError creates a new NonRetryableError error
+// err: Mandatory. The error that caused the job to fail
+func NewNonRetryableError(err error) error {
+	return NonRetryableError{
+		Err: err,
+	}
+}
@@ -0,0 +1,193 @@
+// Package memory implements an in-memory job queue that is used for local development and tests
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+)
+
+type memoryJobQueueService struct {
+	lock sync.Mutex
+	jobs map[string]*job.Job
+}
+
+// NewMemoryJobQueueService creates new instance of the memoryJobQueueService, setting up all dependencies and returns the instance
+// Returns the new service or error if something goes wrong
+func NewMemoryJobQueueService() (job.JobQueueContract, error) {
+	return &memoryJobQueueService{
+		jobs: map[string]*job.Job{},
+	}, nil
+}
+
+// EnqueueJob adds a new job to the queue.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to add a new job to the queue
+// Returns either the result of adding a new job to the queue or error if something goes wrong.
+func (service *memoryJobQueueService) EnqueueJob(
+	ctx context.Context,
+	request *job.EnqueueJobRequest) (*job.EnqueueJobResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	now := time.Now().UTC()
+	jobID := cuid.New()
+	service.jobs[jobID] = &job.Job{
+		JobID:         jobID,
+		Type:          request.Type,
+		Status:        job.JobStatusPending,
+		EdgeClusterID: request.EdgeClusterID,
+		UserEmail:     request.UserEmail,
+		ClusterType:   request.ClusterType,
+		RunAt:         now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	return &job.EnqueueJobResponse{
+		JobID: jobID,
+	}, nil
+}
+
+// AcquireJob leases the next job that is due to run. Jobs whose lease expired, e.g. because the worker that
+// acquired them stopped, are due to run again. Jobs of an edge cluster that already has a leased job are skipped.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to lease the next job
+// Returns either the leased job, nil job if no job is due to run, or error if something goes wrong.
+func (service *memoryJobQueueService) AcquireJob(
+	ctx context.Context,
+	request *job.AcquireJobRequest) (*job.AcquireJobResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	now := time.Now().UTC()
+	leasedEdgeClusterIDs := map[string]bool{}
+	candidates := []*job.Job{}
+
+	for _, queuedJob := range service.jobs {
+		if isLeased(queuedJob, now) {
+			leasedEdgeClusterIDs[queuedJob.EdgeClusterID] = true
+		} else if isDue(queuedJob, now) {
+			candidates = append(candidates, queuedJob)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].RunAt.Before(candidates[j].RunAt)
+	})
+
+	for _, candidate := range candidates {
+		if leasedEdgeClusterIDs[candidate.EdgeClusterID] {
+			continue
+		}
+
+		candidate.Status = job.JobStatusRunning
+		candidate.Attempts++
+		candidate.LeaseOwner = request.WorkerID
+		candidate.LeaseExpiresAt = now.Add(request.LeaseDuration)
+		candidate.UpdatedAt = now
+		leasedJob := *candidate
+
+		return &job.AcquireJobResponse{
+			Job: &leasedJob,
+		}, nil
+	}
+
+	return &job.AcquireJobResponse{}, nil
+}
+
+// RenewJobLease extends the lease the worker holds on a job.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to extend the lease on a job
+// Returns either the result of extending the lease or NotFoundError if the worker no longer holds the lease.
+func (service *memoryJobQueueService) RenewJobLease(
+	ctx context.Context,
+	request *job.RenewJobLeaseRequest) (*job.RenewJobLeaseResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	leasedJob, err := service.getLeasedJob(request.JobID, request.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	leasedJob.LeaseExpiresAt = now.Add(request.LeaseDuration)
+	leasedJob.UpdatedAt = now
+
+	return &job.RenewJobLeaseResponse{}, nil
+}
+
+// CompleteJob marks a leased job as completed.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to mark a leased job as completed
+// Returns either the result of completing the job or NotFoundError if the worker no longer holds the lease.
+func (service *memoryJobQueueService) CompleteJob(
+	ctx context.Context,
+	request *job.CompleteJobRequest) (*job.CompleteJobResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	leasedJob, err := service.getLeasedJob(request.JobID, request.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	leasedJob.Status = job.JobStatusCompleted
+	leasedJob.LeaseOwner = ""
+	leasedJob.UpdatedAt = time.Now().UTC()
+
+	return &job.CompleteJobResponse{}, nil
+}
+
+// FailJob records the failure of a leased job and either reschedules it or marks it as failed.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to record the failure of a leased job
+// Returns either the result of recording the failure or NotFoundError if the worker no longer holds the lease.
+func (service *memoryJobQueueService) FailJob(
+	ctx context.Context,
+	request *job.FailJobRequest) (*job.FailJobResponse, error) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+
+	leasedJob, err := service.getLeasedJob(request.JobID, request.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.RetryAt == nil {
+		leasedJob.Status = job.JobStatusFailed
+	} else {
+		leasedJob.Status = job.JobStatusPending
+		leasedJob.RunAt = request.RetryAt.UTC()
+	}
+
+	leasedJob.LastErrorMessage = request.ErrorMessage
+	leasedJob.LeaseOwner = ""
+	leasedJob.UpdatedAt = time.Now().UTC()
+
+	return &job.FailJobResponse{}, nil
+}
+
+func (service *memoryJobQueueService) getLeasedJob(jobID, workerID string) (*job.Job, error) {
+	leasedJob, ok := service.jobs[jobID]
+	if !ok || leasedJob.Status != job.JobStatusRunning || leasedJob.LeaseOwner != workerID {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return leasedJob, nil
+}
+
+func isLeased(queuedJob *job.Job, now time.Time) bool {
+	return queuedJob.Status == job.JobStatusRunning && queuedJob.LeaseExpiresAt.After(now)
+}
+
+func isDue(queuedJob *job.Job, now time.Time) bool {
+	return (queuedJob.Status == job.JobStatusPending && !queuedJob.RunAt.After(now)) ||
+		(queuedJob.Status == job.JobStatusRunning && !queuedJob.LeaseExpiresAt.After(now))
+}
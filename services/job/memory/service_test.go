@@ -0,0 +1,171 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	"github.com/decentralized-cloud/edge-cluster/services/job/memory"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMemoryJobQueueService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Memory Job Queue Service Tests")
+}
+
+var _ = Describe("Memory Job Queue Service Tests", func() {
+	var (
+		sut            job.JobQueueContract
+		ctx            context.Context
+		enqueueRequest job.EnqueueJobRequest
+		workerID       string
+	)
+
+	BeforeEach(func() {
+		sut, _ = memory.NewMemoryJobQueueService()
+		ctx = context.Background()
+		workerID = cuid.New()
+		enqueueRequest = job.EnqueueJobRequest{
+			Type:          job.JobTypeCreateProvision,
+			EdgeClusterID: cuid.New(),
+			UserEmail:     cuid.New() + "@test.com",
+			ClusterType:   models.K3S,
+		}
+	})
+
+	acquire := func(workerID string, leaseDuration time.Duration) *job.Job {
+		response, err := sut.AcquireJob(ctx, &job.AcquireJobRequest{
+			WorkerID:      workerID,
+			LeaseDuration: leaseDuration,
+		})
+		Ω(err).Should(BeNil())
+
+		return response.Job
+	}
+
+	Context("job queue is empty", func() {
+		When("user acquires a job", func() {
+			It("should return no job", func() {
+				Ω(acquire(workerID, time.Minute)).Should(BeNil())
+			})
+		})
+	})
+
+	Context("job is enqueued", func() {
+		var jobID string
+
+		BeforeEach(func() {
+			response, err := sut.EnqueueJob(ctx, &enqueueRequest)
+			Ω(err).Should(BeNil())
+			Ω(response.JobID).ShouldNot(BeEmpty())
+			jobID = response.JobID
+		})
+
+		When("user acquires a job", func() {
+			It("should lease the job to the worker", func() {
+				leasedJob := acquire(workerID, time.Minute)
+				Ω(leasedJob).ShouldNot(BeNil())
+				Ω(leasedJob.JobID).Should(Equal(jobID))
+				Ω(leasedJob.Type).Should(Equal(enqueueRequest.Type))
+				Ω(leasedJob.EdgeClusterID).Should(Equal(enqueueRequest.EdgeClusterID))
+				Ω(leasedJob.UserEmail).Should(Equal(enqueueRequest.UserEmail))
+				Ω(leasedJob.ClusterType).Should(Equal(enqueueRequest.ClusterType))
+				Ω(leasedJob.Status).Should(Equal(job.JobStatusRunning))
+				Ω(leasedJob.Attempts).Should(Equal(1))
+				Ω(leasedJob.LeaseOwner).Should(Equal(workerID))
+			})
+
+			It("should not lease the same job twice", func() {
+				Ω(acquire(workerID, time.Minute)).ShouldNot(BeNil())
+				Ω(acquire(cuid.New(), time.Minute)).Should(BeNil())
+			})
+
+			It("should not lease another job of the same edge cluster while the job is leased", func() {
+				_, err := sut.EnqueueJob(ctx, &enqueueRequest)
+				Ω(err).Should(BeNil())
+
+				Ω(acquire(workerID, time.Minute)).ShouldNot(BeNil())
+				Ω(acquire(cuid.New(), time.Minute)).Should(BeNil())
+			})
+
+			It("should lease the job again once the lease expired", func() {
+				Ω(acquire(workerID, time.Millisecond)).ShouldNot(BeNil())
+				time.Sleep(5 * time.Millisecond)
+
+				newWorkerID := cuid.New()
+				leasedJob := acquire(newWorkerID, time.Minute)
+				Ω(leasedJob).ShouldNot(BeNil())
+				Ω(leasedJob.Attempts).Should(Equal(2))
+				Ω(leasedJob.LeaseOwner).Should(Equal(newWorkerID))
+
+				_, err := sut.RenewJobLease(ctx, &job.RenewJobLeaseRequest{JobID: jobID, WorkerID: workerID, LeaseDuration: time.Minute})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+
+		When("user completes the leased job", func() {
+			It("should not lease the job again", func() {
+				Ω(acquire(workerID, time.Millisecond)).ShouldNot(BeNil())
+
+				_, err := sut.CompleteJob(ctx, &job.CompleteJobRequest{JobID: jobID, WorkerID: workerID})
+				Ω(err).Should(BeNil())
+
+				time.Sleep(5 * time.Millisecond)
+				Ω(acquire(workerID, time.Minute)).Should(BeNil())
+			})
+		})
+
+		When("user completes a job that is not leased by the worker", func() {
+			It("should return NotFoundError", func() {
+				Ω(acquire(workerID, time.Minute)).ShouldNot(BeNil())
+
+				_, err := sut.CompleteJob(ctx, &job.CompleteJobRequest{JobID: jobID, WorkerID: cuid.New()})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+
+		When("user fails the leased job with a retry time", func() {
+			It("should lease the job again once the retry time is reached", func() {
+				Ω(acquire(workerID, time.Minute)).ShouldNot(BeNil())
+
+				errorMessage := cuid.New()
+				retryAt := time.Now().Add(20 * time.Millisecond)
+				_, err := sut.FailJob(ctx, &job.FailJobRequest{
+					JobID:        jobID,
+					WorkerID:     workerID,
+					ErrorMessage: errorMessage,
+					RetryAt:      &retryAt,
+				})
+				Ω(err).Should(BeNil())
+				Ω(acquire(workerID, time.Minute)).Should(BeNil())
+
+				time.Sleep(30 * time.Millisecond)
+				leasedJob := acquire(workerID, time.Minute)
+				Ω(leasedJob).ShouldNot(BeNil())
+				Ω(leasedJob.LastErrorMessage).Should(Equal(errorMessage))
+				Ω(leasedJob.Attempts).Should(Equal(2))
+			})
+		})
+
+		When("user fails the leased job without a retry time", func() {
+			It("should not lease the job again", func() {
+				Ω(acquire(workerID, time.Minute)).ShouldNot(BeNil())
+
+				_, err := sut.FailJob(ctx, &job.FailJobRequest{
+					JobID:        jobID,
+					WorkerID:     workerID,
+					ErrorMessage: cuid.New(),
+				})
+				Ω(err).Should(BeNil())
+				Ω(acquire(workerID, time.Minute)).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,124 @@
+// Package job implements the durable job queue used to run the edge cluster provisioning in the background
+package job
+
+import (
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+)
+
+// JobType defines the different type of the jobs
+type JobType int
+
+const (
+	// JobTypeCreateProvision provisions a newly created edge cluster
+	JobTypeCreateProvision JobType = iota
+
+	// JobTypeUpdateProvision updates the provision of an existing edge cluster
+	JobTypeUpdateProvision
+
+	// JobTypeDeleteProvision deletes the provision and the record of an existing edge cluster
+	JobTypeDeleteProvision
+)
+
+// JobStatus defines the different states of a job in the queue
+type JobStatus int
+
+const (
+	// JobStatusPending indicates the job is waiting to be leased by a worker
+	JobStatusPending JobStatus = iota
+
+	// JobStatusRunning indicates the job is leased by a worker
+	JobStatusRunning
+
+	// JobStatusCompleted indicates the job completed successfully
+	JobStatusCompleted
+
+	// JobStatusFailed indicates the job failed and will not be retried
+	JobStatusFailed
+)
+
+// Job defines a queued job
+type Job struct {
+	JobID            string
+	Type             JobType
+	Status           JobStatus
+	EdgeClusterID    string
+	UserEmail        string
+	ClusterType      models.ClusterType
+	Attempts         int
+	LastErrorMessage string
+	RunAt            time.Time
+	LeaseOwner       string
+	LeaseExpiresAt   time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// EnqueueJobRequest contains the request to add a new job to the queue
+type EnqueueJobRequest struct {
+	Type          JobType
+	EdgeClusterID string
+	UserEmail     string
+	ClusterType   models.ClusterType
+}
+
+// EnqueueJobResponse contains the result of adding a new job to the queue
+type EnqueueJobResponse struct {
+	JobID string
+}
+
+// AcquireJobRequest contains the request to lease the next job that is due to run
+type AcquireJobRequest struct {
+	WorkerID      string
+	LeaseDuration time.Duration
+}
+
+// AcquireJobResponse contains the result of leasing the next job that is due to run
+type AcquireJobResponse struct {
+	Job *Job
+}
+
+// RenewJobLeaseRequest contains the request to extend the lease on a job
+type RenewJobLeaseRequest struct {
+	JobID         string
+	WorkerID      string
+	LeaseDuration time.Duration
+}
+
+// RenewJobLeaseResponse contains the result of extending the lease on a job
+type RenewJobLeaseResponse struct {
+}
+
+// CompleteJobRequest contains the request to mark a leased job as completed
+type CompleteJobRequest struct {
+	JobID    string
+	WorkerID string
+}
+
+// CompleteJobResponse contains the result of marking a leased job as completed
+type CompleteJobResponse struct {
+}
+
+// FailJobRequest contains the request to record the failure of a leased job. The job is rescheduled to run at
+// RetryAt, or marked as failed if RetryAt is not provided.
+type FailJobRequest struct {
+	JobID        string
+	WorkerID     string
+	ErrorMessage string
+	RetryAt      *time.Time
+}
+
+// FailJobResponse contains the result of recording the failure of a leased job
+type FailJobResponse struct {
+}
+
+// HandleJobRequest contains the job to execute. FinalAttempt is true if the job is not retried when it fails.
+type HandleJobRequest struct {
+	Job          Job
+	FinalAttempt bool
+}
+
+// HandleJobResponse contains the result of executing a job
+type HandleJobResponse struct {
+}
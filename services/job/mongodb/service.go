@@ -0,0 +1,423 @@
+// Package mongodb implements the MongoDB backed durable job queue
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// lockCollectionSuffix is appended to the name of the job collection to name the collection of the edge cluster
+// locks, whose documents are identified by the edge cluster identifier and record the job that holds the lock
+const lockCollectionSuffix = "-locks"
+
+type queuedJob struct {
+	ID               primitive.ObjectID `bson:"_id,omitempty" json:"_id,omitempty"`
+	Type             job.JobType        `bson:"type" json:"type"`
+	Status           job.JobStatus      `bson:"status" json:"status"`
+	EdgeClusterID    string             `bson:"edgeClusterID" json:"edgeClusterID"`
+	UserEmail        string             `bson:"userEmail" json:"userEmail"`
+	ClusterType      models.ClusterType `bson:"clusterType" json:"clusterType"`
+	Attempts         int                `bson:"attempts" json:"attempts"`
+	LastErrorMessage string             `bson:"lastErrorMessage" json:"lastErrorMessage"`
+	RunAt            time.Time          `bson:"runAt" json:"runAt"`
+	LeaseOwner       string             `bson:"leaseOwner" json:"leaseOwner"`
+	LeaseExpiresAt   time.Time          `bson:"leaseExpiresAt" json:"leaseExpiresAt"`
+	CreatedAt        time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt        time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+type mongodbJobQueueService struct {
+	connectionString       string
+	databaseName           string
+	databaseCollectionName string
+}
+
+// NewMongodbJobQueueService creates new instance of the mongodbJobQueueService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// Returns the new service or error if something goes wrong
+func NewMongodbJobQueueService(
+	configurationService configuration.ConfigurationContract) (job.JobQueueContract, error) {
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	connectionString, err := configurationService.GetDatabaseConnectionString()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get connection string to mongodb", err)
+	}
+
+	databaseName, err := configurationService.GetDatabaseName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database name", err)
+	}
+
+	databaseCollectionName, err := configurationService.GetJobDatabaseCollectionName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the job database collection name", err)
+	}
+
+	return &mongodbJobQueueService{
+		connectionString:       connectionString,
+		databaseName:           databaseName,
+		databaseCollectionName: databaseCollectionName,
+	}, nil
+}
+
+// EnqueueJob adds a new job to the queue.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to add a new job to the queue
+// Returns either the result of adding a new job to the queue or error if something goes wrong.
+func (service *mongodbJobQueueService) EnqueueJob(
+	ctx context.Context,
+	request *job.EnqueueJobRequest) (*job.EnqueueJobResponse, error) {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	now := time.Now().UTC()
+	insertResult, err := collection.InsertOne(ctx, queuedJob{
+		Type:          request.Type,
+		Status:        job.JobStatusPending,
+		EdgeClusterID: request.EdgeClusterID,
+		UserEmail:     request.UserEmail,
+		ClusterType:   request.ClusterType,
+		RunAt:         now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to enqueue job.", err)
+	}
+
+	return &job.EnqueueJobResponse{
+		JobID: insertResult.InsertedID.(primitive.ObjectID).Hex(),
+	}, nil
+}
+
+// AcquireJob leases the next job that is due to run. Jobs whose lease expired, e.g. because the worker that
+// acquired them stopped, are due to run again. Jobs of an edge cluster that already has a leased job are skipped. The
+// edge cluster is locked by upserting its lock document, whose identifier is the edge cluster identifier, so only one
+// worker can lock it even if several workers acquire a job of the same edge cluster at the same time.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to lease the next job
+// Returns either the leased job, nil job if no job is due to run, or error if something goes wrong.
+func (service *mongodbJobQueueService) AcquireJob(
+	ctx context.Context,
+	request *job.AcquireJobRequest) (*job.AcquireJobResponse, error) {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	lockCollection := service.getLockCollection(client)
+	now := time.Now().UTC()
+	leaseExpiresAt := now.Add(request.LeaseDuration)
+	dueFilter := bson.M{
+		"$or": []interface{}{
+			bson.M{"status": job.JobStatusPending, "runAt": bson.M{"$lte": now}},
+			bson.M{"status": job.JobStatusRunning, "leaseExpiresAt": bson.M{"$lte": now}},
+		},
+	}
+
+	cursor, err := collection.Find(ctx, dueFilter, options.Find().SetSort(bson.D{{Key: "runAt", Value: 1}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the jobs that are due to run.", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	lockedEdgeClusterIDs := map[string]bool{}
+	for cursor.Next(ctx) {
+		var candidateJob queuedJob
+		if err = cursor.Decode(&candidateJob); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the job.", err)
+		}
+
+		if lockedEdgeClusterIDs[candidateJob.EdgeClusterID] {
+			continue
+		}
+
+		var locked bool
+		if locked, err = lockEdgeCluster(ctx, lockCollection, candidateJob, request.WorkerID, now, leaseExpiresAt); err != nil {
+			return nil, err
+		}
+
+		if !locked {
+			lockedEdgeClusterIDs[candidateJob.EdgeClusterID] = true
+
+			continue
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"status":         job.JobStatusRunning,
+				"leaseOwner":     request.WorkerID,
+				"leaseExpiresAt": leaseExpiresAt,
+				"updatedAt":      now,
+			},
+			"$inc": bson.M{"attempts": 1},
+		}
+
+		var leasedJob queuedJob
+
+		err = collection.FindOneAndUpdate(
+			ctx,
+			bson.M{"_id": candidateJob.ID, "$or": dueFilter["$or"]},
+			update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&leasedJob)
+		if err == mongo.ErrNoDocuments {
+			// The job is no longer due to run, e.g. because it was completed after it was read
+			if err = unlockEdgeCluster(ctx, lockCollection, candidateJob.ID, request.WorkerID); err != nil {
+				return nil, err
+			}
+
+			continue
+		} else if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to acquire job.", err)
+		}
+
+		mappedJob := mapFromQueuedJob(leasedJob)
+
+		return &job.AcquireJobResponse{
+			Job: &mappedJob,
+		}, nil
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve the jobs that are due to run.", err)
+	}
+
+	return &job.AcquireJobResponse{}, nil
+}
+
+// RenewJobLease extends the lease the worker holds on a job.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to extend the lease on a job
+// Returns either the result of extending the lease or NotFoundError if the worker no longer holds the lease.
+func (service *mongodbJobQueueService) RenewJobLease(
+	ctx context.Context,
+	request *job.RenewJobLeaseRequest) (*job.RenewJobLeaseResponse, error) {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	now := time.Now().UTC()
+	leaseExpiresAt := now.Add(request.LeaseDuration)
+	if err = updateLeasedJob(ctx, collection, request.JobID, request.WorkerID, bson.M{
+		"$set": bson.M{
+			"leaseExpiresAt": leaseExpiresAt,
+			"updatedAt":      now,
+		}}); err != nil {
+		return nil, err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.JobID)
+	response, err := service.getLockCollection(client).UpdateOne(
+		ctx,
+		bson.M{"jobID": ObjectID, "leaseOwner": request.WorkerID},
+		bson.M{"$set": bson.M{"leaseExpiresAt": leaseExpiresAt}})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to renew the edge cluster lock.", err)
+	}
+
+	// The lock expired and was taken by another job of the same edge cluster
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &job.RenewJobLeaseResponse{}, nil
+}
+
+// CompleteJob marks a leased job as completed.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to mark a leased job as completed
+// Returns either the result of completing the job or NotFoundError if the worker no longer holds the lease.
+func (service *mongodbJobQueueService) CompleteJob(
+	ctx context.Context,
+	request *job.CompleteJobRequest) (*job.CompleteJobResponse, error) {
+	if err := service.releaseLeasedJob(ctx, request.JobID, request.WorkerID, bson.M{
+		"$set": bson.M{
+			"status":     job.JobStatusCompleted,
+			"leaseOwner": "",
+			"updatedAt":  time.Now().UTC(),
+		}}); err != nil {
+		return nil, err
+	}
+
+	return &job.CompleteJobResponse{}, nil
+}
+
+// FailJob records the failure of a leased job and either reschedules it or marks it as failed.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to record the failure of a leased job
+// Returns either the result of recording the failure or NotFoundError if the worker no longer holds the lease.
+func (service *mongodbJobQueueService) FailJob(
+	ctx context.Context,
+	request *job.FailJobRequest) (*job.FailJobResponse, error) {
+	newValues := bson.M{
+		"status":           job.JobStatusFailed,
+		"lastErrorMessage": request.ErrorMessage,
+		"leaseOwner":       "",
+		"updatedAt":        time.Now().UTC(),
+	}
+
+	if request.RetryAt != nil {
+		newValues["status"] = job.JobStatusPending
+		newValues["runAt"] = request.RetryAt.UTC()
+	}
+
+	if err := service.releaseLeasedJob(ctx, request.JobID, request.WorkerID, bson.M{"$set": newValues}); err != nil {
+		return nil, err
+	}
+
+	return &job.FailJobResponse{}, nil
+}
+
+func (service *mongodbJobQueueService) releaseLeasedJob(
+	ctx context.Context,
+	jobID string,
+	workerID string,
+	update bson.M) error {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer disconnect(ctx, client)
+
+	if err = updateLeasedJob(ctx, collection, jobID, workerID, update); err != nil {
+		return err
+	}
+
+	ObjectID, _ := primitive.ObjectIDFromHex(jobID)
+
+	return unlockEdgeCluster(ctx, service.getLockCollection(client), ObjectID, workerID)
+}
+
+func (service *mongodbJobQueueService) getLockCollection(client *mongo.Client) *mongo.Collection {
+	return client.Database(service.databaseName).Collection(service.databaseCollectionName + lockCollectionSuffix)
+}
+
+func (service *mongodbJobQueueService) createClientAndCollection(ctx context.Context) (*mongo.Client, *mongo.Collection, error) {
+	clientOptions := options.Client().ApplyURI(service.connectionString)
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, nil, commonErrors.NewUnknownErrorWithError("could not connect to mongodb database.", err)
+	}
+
+	return client, client.Database(service.databaseName).Collection(service.databaseCollectionName), nil
+}
+
+func updateLeasedJob(
+	ctx context.Context,
+	collection *mongo.Collection,
+	jobID string,
+	workerID string,
+	update bson.M) error {
+	ObjectID, _ := primitive.ObjectIDFromHex(jobID)
+	filter := bson.D{
+		{Key: "_id", Value: ObjectID},
+		{Key: "status", Value: job.JobStatusRunning},
+		{Key: "leaseOwner", Value: workerID},
+	}
+
+	response, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to update job.", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return commonErrors.NewNotFoundError()
+	}
+
+	return nil
+}
+
+// lockEdgeCluster locks the edge cluster of the given job for the worker, unless it is locked by another job whose
+// lease has not expired. The lock document is inserted if it does not exist, and the insert fails with a duplicate
+// key error if the filter does not match because the lock is held.
+func lockEdgeCluster(
+	ctx context.Context,
+	lockCollection *mongo.Collection,
+	candidateJob queuedJob,
+	workerID string,
+	now time.Time,
+	leaseExpiresAt time.Time) (bool, error) {
+	filter := bson.M{
+		"_id": candidateJob.EdgeClusterID,
+		"$or": []interface{}{
+			bson.M{"leaseExpiresAt": bson.M{"$lte": now}},
+			bson.M{"jobID": candidateJob.ID},
+		},
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"jobID":          candidateJob.ID,
+			"leaseOwner":     workerID,
+			"leaseExpiresAt": leaseExpiresAt,
+		},
+	}
+
+	_, err := lockCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, commonErrors.NewUnknownErrorWithError("failed to lock the edge cluster.", err)
+	}
+
+	return true, nil
+}
+
+func unlockEdgeCluster(
+	ctx context.Context,
+	lockCollection *mongo.Collection,
+	jobID primitive.ObjectID,
+	workerID string) error {
+	if _, err := lockCollection.DeleteOne(ctx, bson.M{"jobID": jobID, "leaseOwner": workerID}); err != nil {
+		return commonErrors.NewUnknownErrorWithError("failed to unlock the edge cluster.", err)
+	}
+
+	return nil
+}
+
+func disconnect(ctx context.Context, client *mongo.Client) {
+	_ = client.Disconnect(ctx)
+}
+
+func mapFromQueuedJob(from queuedJob) job.Job {
+	return job.Job{
+		JobID:            from.ID.Hex(),
+		Type:             from.Type,
+		Status:           from.Status,
+		EdgeClusterID:    from.EdgeClusterID,
+		UserEmail:        from.UserEmail,
+		ClusterType:      from.ClusterType,
+		Attempts:         from.Attempts,
+		LastErrorMessage: from.LastErrorMessage,
+		RunAt:            from.RunAt,
+		LeaseOwner:       from.LeaseOwner,
+		LeaseExpiresAt:   from.LeaseExpiresAt,
+		CreatedAt:        from.CreatedAt,
+		UpdatedAt:        from.UpdatedAt,
+	}
+}
@@ -0,0 +1,181 @@
+package mongodb_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	"github.com/decentralized-cloud/edge-cluster/services/job/mongodb"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMongodbJobQueueService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mongodb Job Queue Service Tests")
+}
+
+var _ = Describe("Mongodb Job Queue Service Tests", func() {
+	var (
+		mockCtrl       *gomock.Controller
+		sut            job.JobQueueContract
+		ctx            context.Context
+		enqueueRequest job.EnqueueJobRequest
+		workerID       string
+	)
+
+	BeforeEach(func() {
+		connectionString := os.Getenv("DATABASE_CONNECTION_STRING")
+		if strings.Trim(connectionString, " ") == "" {
+			connectionString = "mongodb://mongodb:27017"
+		}
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.
+			EXPECT().
+			GetDatabaseConnectionString().
+			Return(connectionString, nil)
+
+		mockConfigurationService.
+			EXPECT().
+			GetDatabaseName().
+			Return("edge-clusters", nil)
+
+		mockConfigurationService.
+			EXPECT().
+			GetJobDatabaseCollectionName().
+			Return("edge-cluster-jobs", nil)
+
+		sut, _ = mongodb.NewMongodbJobQueueService(mockConfigurationService)
+		ctx = context.Background()
+		workerID = cuid.New()
+		enqueueRequest = job.EnqueueJobRequest{
+			Type:          job.JobTypeCreateProvision,
+			EdgeClusterID: cuid.New(),
+			UserEmail:     cuid.New() + "@test.com",
+			ClusterType:   models.K3S,
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	// acquireJobOfEdgeCluster leases jobs until the one that belongs to the test edge cluster is found, as the
+	// collection is shared with other tests
+	acquireJobOfEdgeCluster := func(edgeClusterID string) *job.Job {
+		for {
+			response, err := sut.AcquireJob(ctx, &job.AcquireJobRequest{
+				WorkerID:      workerID,
+				LeaseDuration: time.Minute,
+			})
+			Ω(err).Should(BeNil())
+
+			if response.Job == nil || response.Job.EdgeClusterID == edgeClusterID {
+				return response.Job
+			}
+
+			_, err = sut.CompleteJob(ctx, &job.CompleteJobRequest{JobID: response.Job.JobID, WorkerID: workerID})
+			Ω(err).Should(BeNil())
+		}
+	}
+
+	Context("user tries to instantiate JobQueueService", func() {
+		When("configuration service is not provided and NewMongodbJobQueueService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := mongodb.NewMongodbJobQueueService(nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("job is enqueued", func() {
+		var jobID string
+
+		BeforeEach(func() {
+			response, err := sut.EnqueueJob(ctx, &enqueueRequest)
+			Ω(err).Should(BeNil())
+			Ω(response.JobID).ShouldNot(BeEmpty())
+			jobID = response.JobID
+		})
+
+		When("user acquires the job", func() {
+			It("should lease the job to the worker", func() {
+				leasedJob := acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID)
+				Ω(leasedJob).ShouldNot(BeNil())
+				Ω(leasedJob.JobID).Should(Equal(jobID))
+				Ω(leasedJob.Type).Should(Equal(enqueueRequest.Type))
+				Ω(leasedJob.UserEmail).Should(Equal(enqueueRequest.UserEmail))
+				Ω(leasedJob.ClusterType).Should(Equal(enqueueRequest.ClusterType))
+				Ω(leasedJob.Status).Should(Equal(job.JobStatusRunning))
+				Ω(leasedJob.Attempts).Should(Equal(1))
+				Ω(leasedJob.LeaseOwner).Should(Equal(workerID))
+			})
+		})
+
+		When("user acquires a job of an edge cluster that already has a leased job", func() {
+			It("should only lease the job once the leased job is completed", func() {
+				response, err := sut.EnqueueJob(ctx, &enqueueRequest)
+				Ω(err).Should(BeNil())
+
+				Ω(acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID).JobID).Should(Equal(jobID))
+				Ω(acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID)).Should(BeNil())
+
+				_, err = sut.CompleteJob(ctx, &job.CompleteJobRequest{JobID: jobID, WorkerID: workerID})
+				Ω(err).Should(BeNil())
+
+				Ω(acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID).JobID).Should(Equal(response.JobID))
+			})
+		})
+
+		When("user renews, completes and fails the leased job", func() {
+			It("should only accept the worker that holds the lease", func() {
+				Ω(acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID)).ShouldNot(BeNil())
+
+				_, err := sut.RenewJobLease(ctx, &job.RenewJobLeaseRequest{JobID: jobID, WorkerID: workerID, LeaseDuration: time.Minute})
+				Ω(err).Should(BeNil())
+
+				_, err = sut.FailJob(ctx, &job.FailJobRequest{JobID: jobID, WorkerID: cuid.New(), ErrorMessage: cuid.New()})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+
+				_, err = sut.CompleteJob(ctx, &job.CompleteJobRequest{JobID: jobID, WorkerID: workerID})
+				Ω(err).Should(BeNil())
+
+				_, err = sut.CompleteJob(ctx, &job.CompleteJobRequest{JobID: jobID, WorkerID: workerID})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+
+		When("user fails the leased job with a retry time", func() {
+			It("should lease the job again", func() {
+				Ω(acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID)).ShouldNot(BeNil())
+
+				errorMessage := cuid.New()
+				retryAt := time.Now()
+				_, err := sut.FailJob(ctx, &job.FailJobRequest{
+					JobID:        jobID,
+					WorkerID:     workerID,
+					ErrorMessage: errorMessage,
+					RetryAt:      &retryAt,
+				})
+				Ω(err).Should(BeNil())
+
+				leasedJob := acquireJobOfEdgeCluster(enqueueRequest.EdgeClusterID)
+				Ω(leasedJob).ShouldNot(BeNil())
+				Ω(leasedJob.Attempts).Should(Equal(2))
+				Ω(leasedJob.LastErrorMessage).Should(Equal(errorMessage))
+			})
+		})
+	})
+})
@@ -0,0 +1,267 @@
+// Package workerpool implements the pool of workers that lease and execute the queued jobs
+package workerpool
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+)
+
+const maxRetryBackoff = 30 * time.Minute
+
+type jobWorkerPoolService struct {
+	logger            *zap.Logger
+	jobQueueService   job.JobQueueContract
+	jobHandlerService job.JobHandlerContract
+	workerCount       int
+	leaseDuration     time.Duration
+	pollInterval      time.Duration
+	maxAttempts       int
+	retryBackoff      time.Duration
+	ctx               context.Context
+	cancel            context.CancelFunc
+	waitGroup         sync.WaitGroup
+}
+
+// NewJobWorkerPoolService creates new instance of the jobWorkerPoolService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// jobQueueService: Mandatory. Reference to the queue that keeps the jobs
+// jobHandlerService: Mandatory. Reference to the service that executes the leased jobs
+// Returns the new service or error if something goes wrong
+func NewJobWorkerPoolService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	jobQueueService job.JobQueueContract,
+	jobHandlerService job.JobHandlerContract) (job.JobWorkerPoolContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if jobQueueService == nil {
+		return nil, commonErrors.NewArgumentNilError("jobQueueService", "jobQueueService is required")
+	}
+
+	if jobHandlerService == nil {
+		return nil, commonErrors.NewArgumentNilError("jobHandlerService", "jobHandlerService is required")
+	}
+
+	workerCount, err := configurationService.GetJobWorkerCount()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the job worker count", err)
+	}
+
+	leaseDuration, err := configurationService.GetJobLeaseDuration()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the job lease duration", err)
+	}
+
+	if leaseDuration <= 0 {
+		return nil, commonErrors.NewArgumentError("leaseDuration", "the job lease duration must be positive")
+	}
+
+	pollInterval, err := configurationService.GetJobPollInterval()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the job poll interval", err)
+	}
+
+	maxAttempts, err := configurationService.GetJobMaxAttempts()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the job max attempts", err)
+	}
+
+	retryBackoff, err := configurationService.GetJobRetryBackoff()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the job retry backoff", err)
+	}
+
+	return &jobWorkerPoolService{
+		logger:            logger,
+		jobQueueService:   jobQueueService,
+		jobHandlerService: jobHandlerService,
+		workerCount:       workerCount,
+		leaseDuration:     leaseDuration,
+		pollInterval:      pollInterval,
+		maxAttempts:       maxAttempts,
+		retryBackoff:      retryBackoff,
+	}, nil
+}
+
+// Start starts the workers. Jobs left behind by a previous run, either pending or with an expired lease,
+// are picked up again by the workers.
+// Returns error if something goes wrong.
+func (service *jobWorkerPoolService) Start() error {
+	service.logger.Info("job worker pool started", zap.Int("workerCount", service.workerCount))
+
+	service.ctx, service.cancel = context.WithCancel(context.Background())
+
+	for i := 0; i < service.workerCount; i++ {
+		service.waitGroup.Add(1)
+
+		go service.runWorker(cuid.New())
+	}
+
+	return nil
+}
+
+// Stop stops the workers and waits for them to finish.
+// Returns error if something goes wrong.
+func (service *jobWorkerPoolService) Stop() error {
+	if service.cancel != nil {
+		service.cancel()
+	}
+
+	service.waitGroup.Wait()
+
+	return nil
+}
+
+func (service *jobWorkerPoolService) runWorker(workerID string) {
+	defer service.waitGroup.Done()
+
+	for {
+		if !service.processNextJob(workerID) {
+			select {
+			case <-service.ctx.Done():
+				return
+			case <-time.After(service.pollInterval):
+			}
+		} else if service.ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// processNextJob leases and executes the next job that is due to run
+// Returns true if a job was processed, otherwise false
+func (service *jobWorkerPoolService) processNextJob(workerID string) bool {
+	response, err := service.jobQueueService.AcquireJob(service.ctx, &job.AcquireJobRequest{
+		WorkerID:      workerID,
+		LeaseDuration: service.leaseDuration,
+	})
+	if err != nil {
+		if service.ctx.Err() == nil {
+			service.logger.Error("failed to acquire job", zap.Error(err))
+		}
+
+		return false
+	}
+
+	if response.Job == nil {
+		return false
+	}
+
+	leasedJob := *response.Job
+	logger := service.logger.With(
+		zap.String("jobID", leasedJob.JobID),
+		zap.Int("jobType", int(leasedJob.Type)),
+		zap.String("edgeClusterID", leasedJob.EdgeClusterID),
+		zap.Int("attempts", leasedJob.Attempts))
+
+	jobCtx, cancelJob := context.WithCancel(service.ctx)
+	leaseRenewed := make(chan struct{})
+
+	go func() {
+		defer close(leaseRenewed)
+
+		service.renewLease(jobCtx, cancelJob, logger, leasedJob.JobID, workerID)
+	}()
+
+	_, err = service.jobHandlerService.HandleJob(jobCtx, &job.HandleJobRequest{
+		Job:          leasedJob,
+		FinalAttempt: leasedJob.Attempts >= service.maxAttempts,
+	})
+
+	cancelJob()
+	<-leaseRenewed
+
+	// The job context is cancelled, the queue is updated with a fresh context
+	ctx := context.Background()
+
+	if err == nil {
+		if _, err = service.jobQueueService.CompleteJob(ctx, &job.CompleteJobRequest{
+			JobID:    leasedJob.JobID,
+			WorkerID: workerID,
+		}); err != nil {
+			logger.Error("failed to mark job as completed", zap.Error(err))
+		}
+
+		return true
+	}
+
+	logger.Error("failed to process job", zap.Error(err))
+
+	failRequest := &job.FailJobRequest{
+		JobID:        leasedJob.JobID,
+		WorkerID:     workerID,
+		ErrorMessage: err.Error(),
+	}
+
+	if service.ctx.Err() != nil {
+		// The worker pool is stopping, the job is resumed as soon as a worker is available again
+		retryAt := time.Now()
+		failRequest.RetryAt = &retryAt
+	} else if leasedJob.Attempts < service.maxAttempts && !job.IsNonRetryableError(err) {
+		retryAt := time.Now().Add(service.getRetryBackoff(leasedJob.Attempts))
+		failRequest.RetryAt = &retryAt
+	}
+
+	if _, err = service.jobQueueService.FailJob(ctx, failRequest); err != nil {
+		logger.Error("failed to record job failure", zap.Error(err))
+	}
+
+	return true
+}
+
+// renewLease keeps extending the lease on the job until the job context is cancelled. If the lease cannot be
+// renewed, the job context is cancelled so the job stops as another worker may have acquired it.
+func (service *jobWorkerPoolService) renewLease(
+	ctx context.Context,
+	cancelJob context.CancelFunc,
+	logger *zap.Logger,
+	jobID string,
+	workerID string) {
+	ticker := time.NewTicker(service.leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := service.jobQueueService.RenewJobLease(ctx, &job.RenewJobLeaseRequest{
+				JobID:         jobID,
+				WorkerID:      workerID,
+				LeaseDuration: service.leaseDuration,
+			}); err != nil {
+				if ctx.Err() == nil {
+					logger.Error("failed to renew job lease, cancelling the job", zap.Error(err))
+					cancelJob()
+				}
+
+				return
+			}
+		}
+	}
+}
+
+// getRetryBackoff returns the delay before the job is retried, doubling the configured backoff after every attempt
+func (service *jobWorkerPoolService) getRetryBackoff(attempts int) time.Duration {
+	backoff := float64(service.retryBackoff) * math.Pow(2, float64(attempts-1))
+	if backoff > float64(maxRetryBackoff) {
+		return maxRetryBackoff
+	}
+
+	return time.Duration(backoff)
+}
@@ -0,0 +1,243 @@
+package workerpool_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/job"
+	"github.com/decentralized-cloud/edge-cluster/services/job/memory"
+	jobMock "github.com/decentralized-cloud/edge-cluster/services/job/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/job/workerpool"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestJobWorkerPoolService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Job Worker Pool Service Tests")
+}
+
+var _ = Describe("Job Worker Pool Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		sut                      job.JobWorkerPoolContract
+		logger                   *zap.Logger
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockJobHandlerService    *jobMock.MockJobHandlerContract
+		jobQueueService          job.JobQueueContract
+		ctx                      context.Context
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockConfigurationService.EXPECT().GetJobWorkerCount().Return(2, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetJobLeaseDuration().Return(time.Minute, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetJobPollInterval().Return(5*time.Millisecond, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetJobMaxAttempts().Return(2, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetJobRetryBackoff().Return(time.Millisecond, nil).AnyTimes()
+
+		mockJobHandlerService = jobMock.NewMockJobHandlerContract(mockCtrl)
+		jobQueueService, _ = memory.NewMemoryJobQueueService()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		sut, _ = workerpool.NewJobWorkerPoolService(logger, mockConfigurationService, jobQueueService, mockJobHandlerService)
+	})
+
+	AfterEach(func() {
+		Ω(sut.Stop()).Should(BeNil())
+		mockCtrl.Finish()
+	})
+
+	enqueueJob := func() string {
+		response, err := jobQueueService.EnqueueJob(ctx, &job.EnqueueJobRequest{
+			Type:          job.JobTypeCreateProvision,
+			EdgeClusterID: cuid.New(),
+			ClusterType:   models.K3S,
+		})
+		Ω(err).Should(BeNil())
+
+		return response.JobID
+	}
+
+	isQueueEmpty := func() bool {
+		response, err := jobQueueService.AcquireJob(ctx, &job.AcquireJobRequest{
+			WorkerID:      cuid.New(),
+			LeaseDuration: time.Minute,
+		})
+		Ω(err).Should(BeNil())
+
+		return response.Job == nil
+	}
+
+	Context("user tries to instantiate JobWorkerPoolService", func() {
+		When("logger is not provided and NewJobWorkerPoolService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := workerpool.NewJobWorkerPoolService(nil, mockConfigurationService, jobQueueService, mockJobHandlerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("logger", "", err)
+			})
+		})
+
+		When("configuration service is not provided and NewJobWorkerPoolService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := workerpool.NewJobWorkerPoolService(logger, nil, jobQueueService, mockJobHandlerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("configurationService", "", err)
+			})
+		})
+
+		When("job queue service is not provided and NewJobWorkerPoolService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := workerpool.NewJobWorkerPoolService(logger, mockConfigurationService, nil, mockJobHandlerService)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("jobQueueService", "", err)
+			})
+		})
+
+		When("job handler service is not provided and NewJobWorkerPoolService is called", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := workerpool.NewJobWorkerPoolService(logger, mockConfigurationService, jobQueueService, nil)
+				Ω(service).Should(BeNil())
+				assertArgumentNilError("jobHandlerService", "", err)
+			})
+		})
+
+		When("the configured job lease duration is not positive and NewJobWorkerPoolService is called", func() {
+			It("should return ArgumentError", func() {
+				configurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+				configurationService.EXPECT().GetJobWorkerCount().Return(2, nil)
+				configurationService.EXPECT().GetJobLeaseDuration().Return(time.Duration(0), nil)
+
+				service, err := workerpool.NewJobWorkerPoolService(logger, configurationService, jobQueueService, mockJobHandlerService)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("jobs are enqueued before the worker pool is started", func() {
+		When("the worker pool is started", func() {
+			It("should execute every job once and complete them", func() {
+				jobIDs := map[string]bool{}
+				for i := 0; i < 5; i++ {
+					jobIDs[enqueueJob()] = true
+				}
+
+				var handledJobs int32
+				mockJobHandlerService.
+					EXPECT().
+					HandleJob(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *job.HandleJobRequest) (*job.HandleJobResponse, error) {
+						Ω(jobIDs).Should(HaveKey(request.Job.JobID))
+						atomic.AddInt32(&handledJobs, 1)
+
+						return &job.HandleJobResponse{}, nil
+					}).
+					Times(5)
+
+				Ω(sut.Start()).Should(BeNil())
+				Eventually(func() int32 { return atomic.LoadInt32(&handledJobs) }).Should(Equal(int32(5)))
+				Eventually(isQueueEmpty).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the job handler fails", func() {
+		When("the worker pool is started", func() {
+			It("should retry the job until the maximum number of attempts is reached", func() {
+				jobID := enqueueJob()
+
+				var attempts int32
+				mockJobHandlerService.
+					EXPECT().
+					HandleJob(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, request *job.HandleJobRequest) (*job.HandleJobResponse, error) {
+						Ω(request.Job.JobID).Should(Equal(jobID))
+						Ω(request.Job.Attempts).Should(Equal(int(atomic.AddInt32(&attempts, 1))))
+						Ω(request.FinalAttempt).Should(Equal(request.Job.Attempts == 2))
+
+						return nil, errors.New(cuid.New())
+					}).
+					Times(2)
+
+				Ω(sut.Start()).Should(BeNil())
+				Eventually(func() int32 { return atomic.LoadInt32(&attempts) }).Should(Equal(int32(2)))
+				Consistently(isQueueEmpty, 50*time.Millisecond).Should(BeTrue())
+			})
+		})
+
+		When("the job handler returns NonRetryableError", func() {
+			It("should not retry the job", func() {
+				enqueueJob()
+
+				var attempts int32
+				mockJobHandlerService.
+					EXPECT().
+					HandleJob(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ *job.HandleJobRequest) (*job.HandleJobResponse, error) {
+						atomic.AddInt32(&attempts, 1)
+
+						return nil, job.NewNonRetryableError(errors.New(cuid.New()))
+					})
+
+				Ω(sut.Start()).Should(BeNil())
+				Eventually(func() int32 { return atomic.LoadInt32(&attempts) }).Should(Equal(int32(1)))
+				Consistently(isQueueEmpty, 50*time.Millisecond).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the worker pool is stopped while a job is running", func() {
+		It("should cancel the job and leave it in the queue", func() {
+			enqueueJob()
+
+			started := make(chan struct{})
+			mockJobHandlerService.
+				EXPECT().
+				HandleJob(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ *job.HandleJobRequest) (*job.HandleJobResponse, error) {
+					close(started)
+					<-ctx.Done()
+
+					return nil, ctx.Err()
+				})
+
+			Ω(sut.Start()).Should(BeNil())
+			Eventually(started).Should(BeClosed())
+			Ω(sut.Stop()).Should(BeNil())
+			Ω(isQueueEmpty()).Should(BeFalse())
+		})
+	})
+})
+
+func assertArgumentNilError(expectedArgumentName, expectedMessage string, err error) {
+	Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+
+	var argumentNilErr commonErrors.ArgumentNilError
+	_ = errors.As(err, &argumentNilErr)
+
+	if expectedArgumentName != "" {
+		Ω(argumentNilErr.ArgumentName).Should(Equal(expectedArgumentName))
+	}
+
+	if expectedMessage != "" {
+		Ω(strings.Contains(argumentNilErr.Error(), expectedMessage)).Should(BeTrue())
+	}
+}
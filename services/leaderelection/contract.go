@@ -0,0 +1,30 @@
+// Package leaderelection implements the election of the replica of the service that runs the background jobs
+package leaderelection
+
+// LeaderElectionContract declares the methods to be implemented by the service that elects the leader among the
+// replicas of the service and runs the leader services only while this replica is the leader
+type LeaderElectionContract interface {
+	// Start the leader election service.
+	// Returns error if something goes wrong.
+	Start() error
+
+	// Stop the leader election service, stops the leader services if this replica is the leader and gives up the
+	// leadership.
+	// Returns error if something goes wrong.
+	Stop() error
+
+	// GetStatus returns the current leadership status of this replica
+	// Returns the current leadership status of this replica
+	GetStatus() LeadershipStatus
+}
+
+// LeaderServiceContract declares the methods to be implemented by a service that must only run on the leader replica
+type LeaderServiceContract interface {
+	// Start the service once this replica becomes the leader. The service can be started again after it is stopped.
+	// Returns error if something goes wrong.
+	Start() error
+
+	// Stop the service once this replica loses the leadership.
+	// Returns error if something goes wrong.
+	Stop() error
+}
@@ -0,0 +1 @@
+package leaderelection_test
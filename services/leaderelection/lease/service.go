@@ -0,0 +1,296 @@
+// Package lease implements the leader election using a Kubernetes lease on the host cluster
+package lease
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/leaderelection"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sLeaderElection "k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var (
+	isLeader = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "edge_cluster_leader_election_is_leader",
+		Help: "Whether this replica is the leader that runs the background jobs (1) or not (0)",
+	})
+
+	leadershipTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "edge_cluster_leader_election_transitions_total",
+		Help: "The number of times this replica acquired or lost the leadership, by transition (acquired or lost)",
+	}, []string{"transition"})
+)
+
+type leaseLeaderElectionService struct {
+	logger         *zap.Logger
+	enabled        bool
+	elector        *k8sLeaderElection.LeaderElector
+	leaderServices []leaderelection.LeaderServiceContract
+	status         leaderelection.LeadershipStatus
+	statusLock     sync.Mutex
+	leadingLock    sync.Mutex
+	leading        bool
+	lifecycleLock  sync.Mutex
+	cancel         context.CancelFunc
+	done           chan struct{}
+}
+
+// NewLeaseLeaderElectionService creates new instance of the leaseLeaderElectionService, setting up all dependencies and returns the instance
+// logger: Mandatory. Reference to the logger service
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// clientset: Mandatory. Reference to the client of the host cluster that keeps the lease
+// leaderServices: Mandatory. The services to run only while this replica is the leader
+// Returns the new service or error if something goes wrong
+func NewLeaseLeaderElectionService(
+	logger *zap.Logger,
+	configurationService configuration.ConfigurationContract,
+	clientset kubernetes.Interface,
+	leaderServices []leaderelection.LeaderServiceContract) (leaderelection.LeaderElectionContract, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if configurationService == nil {
+		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
+	}
+
+	if clientset == nil {
+		return nil, commonErrors.NewArgumentNilError("clientset", "clientset is required")
+	}
+
+	for _, leaderService := range leaderServices {
+		if leaderService == nil {
+			return nil, commonErrors.NewArgumentNilError("leaderServices", "leaderServices must not contain nil service")
+		}
+	}
+
+	enabled, err := configurationService.GetLeaderElectionEnabled()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get whether the leader election is enabled", err)
+	}
+
+	identity, err := configurationService.GetLeaderElectionIdentity()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the leader election identity", err)
+	}
+
+	service := &leaseLeaderElectionService{
+		logger:         logger,
+		enabled:        enabled,
+		leaderServices: leaderServices,
+		status: leaderelection.LeadershipStatus{
+			Enabled:  enabled,
+			Identity: identity,
+		},
+	}
+
+	if !enabled {
+		return service, nil
+	}
+
+	namespace, err := configurationService.GetLeaderElectionNamespace()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the leader election namespace", err)
+	}
+
+	leaseName, err := configurationService.GetLeaderElectionLeaseName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the leader election lease name", err)
+	}
+
+	leaseDuration, err := configurationService.GetLeaderElectionLeaseDuration()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the leader election lease duration", err)
+	}
+
+	renewDeadline, err := configurationService.GetLeaderElectionRenewDeadline()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the leader election renew deadline", err)
+	}
+
+	retryPeriod, err := configurationService.GetLeaderElectionRetryPeriod()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the leader election retry period", err)
+	}
+
+	service.status.LeaseName = leaseName
+	service.status.LeaseNamespace = namespace
+
+	if service.elector, err = k8sLeaderElection.NewLeaderElector(k8sLeaderElection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      leaseName,
+				Namespace: namespace,
+			},
+			Client:     clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration:   leaseDuration,
+		RenewDeadline:   renewDeadline,
+		RetryPeriod:     retryPeriod,
+		ReleaseOnCancel: true,
+		Name:            leaseName,
+		Callbacks: k8sLeaderElection.LeaderCallbacks{
+			OnStartedLeading: service.onStartedLeading,
+			OnStoppedLeading: service.onStoppedLeading,
+			OnNewLeader:      service.onNewLeader,
+		},
+	}); err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("configurationService", "invalid leader election configuration", err)
+	}
+
+	return service, nil
+}
+
+// Start the leader election service. If the leader election is disabled, this replica becomes the leader
+// immediately, otherwise it keeps trying to acquire the lease until the service is stopped.
+// Returns error if something goes wrong.
+func (service *leaseLeaderElectionService) Start() error {
+	service.lifecycleLock.Lock()
+	defer service.lifecycleLock.Unlock()
+
+	if service.done != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	service.cancel = cancel
+	service.done = make(chan struct{})
+
+	if !service.enabled {
+		service.logger.Info("leader election is disabled, running the leader services on this replica")
+		service.onStartedLeading(ctx)
+		close(service.done)
+
+		return nil
+	}
+
+	service.logger.Info(
+		"leader election service started",
+		zap.String("identity", service.status.Identity),
+		zap.String("lease", service.status.LeaseNamespace+"/"+service.status.LeaseName))
+
+	go func() {
+		defer close(service.done)
+
+		// Run returns once this replica loses the leadership, so it has to run again to take part in the next election
+		for ctx.Err() == nil {
+			service.elector.Run(ctx)
+		}
+	}()
+
+	return nil
+}
+
+// Stop the leader election service, stops the leader services if this replica is the leader and gives up the
+// leadership.
+// Returns error if something goes wrong.
+func (service *leaseLeaderElectionService) Stop() error {
+	service.lifecycleLock.Lock()
+	defer service.lifecycleLock.Unlock()
+
+	if service.done == nil {
+		return nil
+	}
+
+	service.cancel()
+	<-service.done
+
+	if !service.enabled {
+		service.onStoppedLeading()
+	}
+
+	service.cancel = nil
+	service.done = nil
+
+	return nil
+}
+
+// GetStatus returns the current leadership status of this replica
+// Returns the current leadership status of this replica
+func (service *leaseLeaderElectionService) GetStatus() leaderelection.LeadershipStatus {
+	service.statusLock.Lock()
+	defer service.statusLock.Unlock()
+
+	return service.status
+}
+
+// onStartedLeading starts the leader services once this replica acquires the lease. The elector calls it on a
+// separate goroutine, so the leadership might already be lost by the time it runs.
+func (service *leaseLeaderElectionService) onStartedLeading(ctx context.Context) {
+	service.leadingLock.Lock()
+	defer service.leadingLock.Unlock()
+
+	if service.leading || ctx.Err() != nil {
+		return
+	}
+
+	service.leading = true
+	service.setLeader(true)
+	isLeader.Set(1)
+	leadershipTransitions.WithLabelValues("acquired").Inc()
+	service.logger.Info("acquired the leadership, starting the leader services")
+
+	for _, leaderService := range service.leaderServices {
+		if err := leaderService.Start(); err != nil {
+			service.logger.Error("failed to start the leader service", zap.Error(err))
+		}
+	}
+}
+
+// onStoppedLeading stops the leader services once this replica loses the lease. The elector calls it whenever the
+// election stops, even if this replica never acquired the lease.
+func (service *leaseLeaderElectionService) onStoppedLeading() {
+	service.leadingLock.Lock()
+	defer service.leadingLock.Unlock()
+
+	if !service.leading {
+		return
+	}
+
+	service.logger.Info("lost the leadership, stopping the leader services")
+
+	for _, leaderService := range service.leaderServices {
+		if err := leaderService.Stop(); err != nil {
+			service.logger.Error("failed to stop the leader service", zap.Error(err))
+		}
+	}
+
+	service.leading = false
+	service.setLeader(false)
+	isLeader.Set(0)
+	leadershipTransitions.WithLabelValues("lost").Inc()
+}
+
+// onNewLeader records the identity of the replica that holds the lease
+func (service *leaseLeaderElectionService) onNewLeader(identity string) {
+	service.statusLock.Lock()
+	service.status.LeaderIdentity = identity
+	service.statusLock.Unlock()
+
+	service.logger.Info("observed a new leader", zap.String("leader", identity))
+}
+
+// setLeader records whether this replica is the leader
+func (service *leaseLeaderElectionService) setLeader(leader bool) {
+	service.statusLock.Lock()
+	defer service.statusLock.Unlock()
+
+	service.status.IsLeader = leader
+	service.status.LastTransitionTime = time.Now()
+
+	if leader {
+		service.status.LeaderIdentity = service.status.Identity
+	} else if service.status.LeaderIdentity == service.status.Identity {
+		service.status.LeaderIdentity = ""
+	}
+}
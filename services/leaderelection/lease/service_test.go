@@ -0,0 +1,219 @@
+package lease_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/leaderelection"
+	"github.com/decentralized-cloud/edge-cluster/services/leaderelection/lease"
+	leaderElectionMock "github.com/decentralized-cloud/edge-cluster/services/leaderelection/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLeaseLeaderElectionService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lease Leader Election Service Tests")
+}
+
+var _ = Describe("Lease Leader Election Service Tests", func() {
+	var (
+		mockCtrl                 *gomock.Controller
+		mockConfigurationService *configurationMock.MockConfigurationContract
+		mockLeaderService        *leaderElectionMock.MockLeaderServiceContract
+		logger                   *zap.Logger
+		clientset                *fake.Clientset
+		enabled                  bool
+		identity                 string
+		namespace                string
+		leaseName                string
+	)
+
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockConfigurationService = configurationMock.NewMockConfigurationContract(mockCtrl)
+		mockLeaderService = leaderElectionMock.NewMockLeaderServiceContract(mockCtrl)
+		clientset = fake.NewSimpleClientset()
+
+		var err error
+		logger, err = zap.NewProduction()
+		Ω(err).Should(BeNil())
+
+		enabled = true
+		identity = cuid.New()
+		namespace = cuid.New()
+		leaseName = cuid.New()
+
+		mockConfigurationService.
+			EXPECT().
+			GetLeaderElectionEnabled().
+			DoAndReturn(func() (bool, error) { return enabled, nil }).
+			AnyTimes()
+		mockConfigurationService.EXPECT().GetLeaderElectionIdentity().Return(identity, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetLeaderElectionNamespace().Return(namespace, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetLeaderElectionLeaseName().Return(leaseName, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetLeaderElectionLeaseDuration().Return(time.Second, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetLeaderElectionRenewDeadline().Return(500*time.Millisecond, nil).AnyTimes()
+		mockConfigurationService.EXPECT().GetLeaderElectionRetryPeriod().Return(100*time.Millisecond, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	newService := func() leaderelection.LeaderElectionContract {
+		service, err := lease.NewLeaseLeaderElectionService(
+			logger,
+			mockConfigurationService,
+			clientset,
+			[]leaderelection.LeaderServiceContract{mockLeaderService})
+		Ω(err).Should(BeNil())
+
+		return service
+	}
+
+	Context("user tries to instantiate LeaseLeaderElectionService", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := lease.NewLeaseLeaderElectionService(nil, mockConfigurationService, clientset, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("configuration service is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := lease.NewLeaseLeaderElectionService(logger, nil, clientset, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("clientset is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := lease.NewLeaseLeaderElectionService(logger, mockConfigurationService, nil, nil)
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("leader services contain nil service", func() {
+			It("should return ArgumentNilError", func() {
+				service, err := lease.NewLeaseLeaderElectionService(
+					logger,
+					mockConfigurationService,
+					clientset,
+					[]leaderelection.LeaderServiceContract{nil})
+				Ω(service).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the leader election is disabled", func() {
+		When("the service is started and stopped", func() {
+			It("should run the leader services without acquiring the lease", func() {
+				enabled = false
+				sut := newService()
+
+				mockLeaderService.EXPECT().Start().Return(nil)
+				Ω(sut.Start()).Should(BeNil())
+
+				status := sut.GetStatus()
+				Ω(status.Enabled).Should(BeFalse())
+				Ω(status.IsLeader).Should(BeTrue())
+				Ω(status.LeaderIdentity).Should(Equal(identity))
+
+				mockLeaderService.EXPECT().Stop().Return(nil)
+				Ω(sut.Stop()).Should(BeNil())
+				Ω(sut.GetStatus().IsLeader).Should(BeFalse())
+
+				leases, err := clientset.CoordinationV1().Leases(namespace).List(context.Background(), metav1.ListOptions{})
+				Ω(err).Should(BeNil())
+				Ω(leases.Items).Should(BeEmpty())
+			})
+		})
+	})
+
+	Context("the leader election is enabled", func() {
+		When("no other replica holds the lease", func() {
+			It("should acquire the lease, run the leader services and release the lease once stopped", func() {
+				sut := newService()
+
+				started := make(chan struct{})
+				mockLeaderService.EXPECT().Start().DoAndReturn(func() error {
+					close(started)
+
+					return nil
+				})
+				Ω(sut.Start()).Should(BeNil())
+
+				Eventually(started, 5*time.Second).Should(BeClosed())
+
+				status := sut.GetStatus()
+				Ω(status.Enabled).Should(BeTrue())
+				Ω(status.IsLeader).Should(BeTrue())
+				Ω(status.LeaderIdentity).Should(Equal(identity))
+				Ω(status.LeaseName).Should(Equal(leaseName))
+				Ω(status.LeaseNamespace).Should(Equal(namespace))
+
+				leaseRecord, err := clientset.CoordinationV1().Leases(namespace).Get(context.Background(), leaseName, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(*leaseRecord.Spec.HolderIdentity).Should(Equal(identity))
+
+				mockLeaderService.EXPECT().Stop().Return(nil)
+				Ω(sut.Stop()).Should(BeNil())
+				Ω(sut.GetStatus().IsLeader).Should(BeFalse())
+
+				leaseRecord, err = clientset.CoordinationV1().Leases(namespace).Get(context.Background(), leaseName, metav1.GetOptions{})
+				Ω(err).Should(BeNil())
+				Ω(*leaseRecord.Spec.HolderIdentity).Should(BeEmpty())
+			})
+		})
+
+		When("another replica holds the lease", func() {
+			It("should not run the leader services", func() {
+				leader := cuid.New()
+				leaseDurationSeconds := int32(60)
+				now := metav1.NewMicroTime(time.Now())
+
+				_, err := clientset.CoordinationV1().Leases(namespace).Create(
+					context.Background(),
+					&coordinationv1.Lease{
+						ObjectMeta: metav1.ObjectMeta{Name: leaseName, Namespace: namespace},
+						Spec: coordinationv1.LeaseSpec{
+							HolderIdentity:       &leader,
+							LeaseDurationSeconds: &leaseDurationSeconds,
+							AcquireTime:          &now,
+							RenewTime:            &now,
+						},
+					},
+					metav1.CreateOptions{})
+				Ω(err).Should(BeNil())
+
+				sut := newService()
+				Ω(sut.Start()).Should(BeNil())
+
+				Eventually(func() string {
+					return sut.GetStatus().LeaderIdentity
+				}, 5*time.Second).Should(Equal(leader))
+
+				Consistently(func() bool {
+					return sut.GetStatus().IsLeader
+				}, 500*time.Millisecond).Should(BeFalse())
+
+				Ω(sut.Stop()).Should(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,15 @@
+// Package leaderelection implements the election of the replica of the service that runs the background jobs
+package leaderelection
+
+import "time"
+
+// LeadershipStatus contains the leadership status of this replica
+type LeadershipStatus struct {
+	Enabled            bool
+	Identity           string
+	IsLeader           bool
+	LeaderIdentity     string
+	LeaseName          string
+	LeaseNamespace     string
+	LastTransitionTime time.Time
+}
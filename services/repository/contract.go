@@ -45,4 +45,95 @@ type RepositoryContract interface {
 	ListEdgeClusters(
 		ctx context.Context,
 		request *ListEdgeClustersRequest) (*ListEdgeClustersResponse, error)
+
+	// UpdateProvisioningState updates the provisioning state of an existing edge cluster
+	// context: Mandatory The reference to the context
+	// request: Mandatory. The request to update the provisioning state of an esiting edge cluster
+	// Returns either the result of updating the provisioning state of an existing edge cluster or error if something goes wrong.
+	UpdateProvisioningState(
+		ctx context.Context,
+		request *UpdateProvisioningStateRequest) (*UpdateProvisioningStateResponse, error)
+
+	// ListAllEdgeClusters returns the edge clusters of all the users. It is used by the background jobs that
+	// maintain the provisioned edge clusters and must not be exposed to the users. An edge cluster that cannot be
+	// read does not fail the listing, it is reported as a failure instead.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the edge clusters of all the users
+	// Returns either the edge clusters of all the users or error if something goes wrong.
+	ListAllEdgeClusters(
+		ctx context.Context,
+		request *ListAllEdgeClustersRequest) (*ListAllEdgeClustersResponse, error)
+
+	// AdoptEdgeCluster adds an edge cluster whose provision still exists back to the repository using its original
+	// identifier. The edge cluster is stored as ready as its provision is already running.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to adopt an edge cluster
+	// Returns either the result of adopting the edge cluster or error if something goes wrong.
+	AdoptEdgeCluster(
+		ctx context.Context,
+		request *AdoptEdgeClusterRequest) (*AdoptEdgeClusterResponse, error)
+
+	// EncryptEdgeClusters encrypts the sensitive fields of the next batch of edge clusters that are either stored in
+	// plain text or encrypted with a key other than the primary key. It is used by the background job that migrates
+	// the plain text edge clusters and re-encrypts the edge clusters once the keys are rotated, and must not be exposed
+	// to the users.
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to encrypt the next batch of edge clusters
+	// Returns either the result of encrypting the batch of edge clusters or error if something goes wrong.
+	EncryptEdgeClusters(
+		ctx context.Context,
+		request *EncryptEdgeClustersRequest) (*EncryptEdgeClustersResponse, error)
+
+	// CreateKubeconfigIssuance tracks a new kubeconfig issued to a user of an edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to track a new issued kubeconfig
+	// Returns either the unique identifier of the issuance or error if something goes wrong.
+	CreateKubeconfigIssuance(
+		ctx context.Context,
+		request *CreateKubeconfigIssuanceRequest) (*CreateKubeconfigIssuanceResponse, error)
+
+	// ReadKubeconfigIssuance reads an existing kubeconfig issued to the user for the edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to read an existing issued kubeconfig
+	// Returns either the issuance or error if something goes wrong, including NotFoundError if the kubeconfig was not
+	// issued to the user for the edge cluster.
+	ReadKubeconfigIssuance(
+		ctx context.Context,
+		request *ReadKubeconfigIssuanceRequest) (*ReadKubeconfigIssuanceResponse, error)
+
+	// ListKubeconfigIssuances lists the kubeconfigs issued to the user for the edge cluster
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the issued kubeconfigs
+	// Returns either the issuances ordered by their issue time or error if something goes wrong.
+	ListKubeconfigIssuances(
+		ctx context.Context,
+		request *ListKubeconfigIssuancesRequest) (*ListKubeconfigIssuancesResponse, error)
+
+	// UpdateKubeconfigIssuanceExpiry records the time an existing kubeconfig issued to the user for the edge cluster
+	// expires at, as the token of the kubeconfig can expire earlier or later than requested
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to record the expiry time of an issued kubeconfig
+	// Returns either the updated issuance or error if something goes wrong, including NotFoundError if the kubeconfig
+	// was not issued to the user for the edge cluster.
+	UpdateKubeconfigIssuanceExpiry(
+		ctx context.Context,
+		request *UpdateKubeconfigIssuanceExpiryRequest) (*UpdateKubeconfigIssuanceExpiryResponse, error)
+
+	// ListExpiredKubeconfigIssuances lists the kubeconfigs issued to all the users of all the edge clusters that
+	// expired and are not revoked yet
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to list the expired issued kubeconfigs
+	// Returns either the expired issuances ordered by their expiry time or error if something goes wrong.
+	ListExpiredKubeconfigIssuances(
+		ctx context.Context,
+		request *ListExpiredKubeconfigIssuancesRequest) (*ListExpiredKubeconfigIssuancesResponse, error)
+
+	// RevokeKubeconfigIssuance records an existing kubeconfig issued to the user for the edge cluster as revoked
+	// ctx: Mandatory The reference to the context
+	// request: Mandatory. The request to record an issued kubeconfig as revoked
+	// Returns either the revoked issuance or error if something goes wrong, including NotFoundError if the kubeconfig
+	// was not issued to the user for the edge cluster.
+	RevokeKubeconfigIssuance(
+		ctx context.Context,
+		request *RevokeKubeconfigIssuanceRequest) (*RevokeKubeconfigIssuanceResponse, error)
 }
@@ -2,6 +2,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/decentralized-cloud/edge-cluster/models"
 	"github.com/micro-business/go-core/common"
 )
@@ -27,7 +29,8 @@ type ReadEdgeClusterRequest struct {
 
 // ReadEdgeClusterResponse contains the result of reading an existing edge cluster
 type ReadEdgeClusterResponse struct {
-	EdgeCluster models.EdgeCluster
+	EdgeCluster       models.EdgeCluster
+	ProvisioningState models.ProvisioningState
 }
 
 // UpdateEdgeClusterRequest contains the request to update an existing edge cluster
@@ -69,3 +72,148 @@ type ListEdgeClustersResponse struct {
 	TotalCount      int64
 	EdgeClusters    []models.EdgeClusterWithCursor
 }
+
+// UpdateProvisioningStateRequest contains the request to update the provisioning state of an existing edge cluster
+type UpdateProvisioningStateRequest struct {
+	EdgeClusterID    string
+	Status           models.ProvisioningStatus
+	LastErrorMessage string
+}
+
+// UpdateProvisioningStateResponse contains the result of updating the provisioning state of an existing edge cluster
+type UpdateProvisioningStateResponse struct {
+}
+
+// ListAllEdgeClustersRequest contains the request to list the edge clusters of all the users
+type ListAllEdgeClustersRequest struct {
+}
+
+// ListAllEdgeClustersResponse contains the edge clusters of all the users. The edge clusters that failed to be read,
+// e.g. because their cluster secret cannot be decrypted, are left out of EdgeClusters and reported in Failures, as
+// they still exist in the repository.
+type ListAllEdgeClustersResponse struct {
+	EdgeClusters []EdgeClusterRecord
+	Failures     []ListEdgeClusterFailure
+}
+
+// ListEdgeClusterFailure contains the error an edge cluster failed to be read with
+type ListEdgeClusterFailure struct {
+	EdgeClusterID string
+	Err           error
+}
+
+// EdgeClusterRecord contains a stored edge cluster together with the user it belongs to
+type EdgeClusterRecord struct {
+	EdgeClusterID     string
+	UserEmail         string
+	EdgeCluster       models.EdgeCluster
+	ProvisioningState models.ProvisioningState
+}
+
+// AdoptEdgeClusterRequest contains the request to add an edge cluster whose provision still exists back to the repository
+type AdoptEdgeClusterRequest struct {
+	EdgeClusterID string
+	UserEmail     string
+	EdgeCluster   models.EdgeCluster
+}
+
+// AdoptEdgeClusterResponse contains the result of adding an edge cluster back to the repository
+type AdoptEdgeClusterResponse struct {
+	EdgeCluster models.EdgeCluster
+	Cursor      string
+}
+
+// EncryptEdgeClustersRequest contains the request to encrypt the next batch of edge clusters that are either stored in
+// plain text or encrypted with a key other than the primary key
+type EncryptEdgeClustersRequest struct {
+	BatchSize int
+	After     string
+}
+
+// EncryptEdgeClustersResponse contains the result of encrypting a batch of edge clusters. Cursor is passed as After of
+// the next request to continue with the next batch.
+type EncryptEdgeClustersResponse struct {
+	EncryptedCount int
+	Failures       []EncryptEdgeClusterFailure
+	Cursor         string
+	HasMore        bool
+}
+
+// EncryptEdgeClusterFailure contains the error an edge cluster failed to be encrypted with
+type EncryptEdgeClusterFailure struct {
+	EdgeClusterID string
+	Err           error
+}
+
+// CreateKubeconfigIssuanceRequest contains the request to track a new kubeconfig issued to a user of an edge cluster
+type CreateKubeconfigIssuanceRequest struct {
+	Issuance models.KubeconfigIssuance
+}
+
+// CreateKubeconfigIssuanceResponse contains the result of tracking a new issued kubeconfig
+type CreateKubeconfigIssuanceResponse struct {
+	IssuanceID string
+}
+
+// ReadKubeconfigIssuanceRequest contains the request to read an existing kubeconfig issued to a user of an edge cluster
+type ReadKubeconfigIssuanceRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	IssuanceID    string
+}
+
+// ReadKubeconfigIssuanceResponse contains the result of reading an existing issued kubeconfig
+type ReadKubeconfigIssuanceResponse struct {
+	Issuance models.KubeconfigIssuance
+}
+
+// ListKubeconfigIssuancesRequest contains the request to list the kubeconfigs issued to a user of an edge cluster
+type ListKubeconfigIssuancesRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+}
+
+// ListKubeconfigIssuancesResponse contains the result of listing the issued kubeconfigs
+type ListKubeconfigIssuancesResponse struct {
+	Issuances []models.KubeconfigIssuanceWithID
+}
+
+// UpdateKubeconfigIssuanceExpiryRequest contains the request to record the time an existing kubeconfig issued to a
+// user of an edge cluster expires at
+type UpdateKubeconfigIssuanceExpiryRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	IssuanceID    string
+	ExpiresAt     time.Time
+}
+
+// UpdateKubeconfigIssuanceExpiryResponse contains the result of recording the expiry time of an issued kubeconfig
+type UpdateKubeconfigIssuanceExpiryResponse struct {
+	Issuance models.KubeconfigIssuance
+}
+
+// ListExpiredKubeconfigIssuancesRequest contains the request to list the issued kubeconfigs that expired and are not
+// revoked yet
+type ListExpiredKubeconfigIssuancesRequest struct {
+	// ExpiredBefore is the time the listed kubeconfigs expired before
+	ExpiredBefore time.Time
+}
+
+// ListExpiredKubeconfigIssuancesResponse contains the result of listing the expired issued kubeconfigs
+type ListExpiredKubeconfigIssuancesResponse struct {
+	Issuances []models.KubeconfigIssuanceWithID
+}
+
+// RevokeKubeconfigIssuanceRequest contains the request to record an existing kubeconfig issued to a user of an edge
+// cluster as revoked
+type RevokeKubeconfigIssuanceRequest struct {
+	UserEmail     string
+	EdgeClusterID string
+	IssuanceID    string
+	RevokedAt     time.Time
+}
+
+// RevokeKubeconfigIssuanceResponse contains the result of recording an issued kubeconfig as revoked
+type RevokeKubeconfigIssuanceResponse struct {
+	Issuance models.KubeconfigIssuance
+}
@@ -35,6 +35,21 @@ func (m *MockRepositoryContract) EXPECT() *MockRepositoryContractMockRecorder {
 	return m.recorder
 }
 
+// AdoptEdgeCluster mocks base method.
+func (m *MockRepositoryContract) AdoptEdgeCluster(ctx context.Context, request *repository.AdoptEdgeClusterRequest) (*repository.AdoptEdgeClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptEdgeCluster", ctx, request)
+	ret0, _ := ret[0].(*repository.AdoptEdgeClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdoptEdgeCluster indicates an expected call of AdoptEdgeCluster.
+func (mr *MockRepositoryContractMockRecorder) AdoptEdgeCluster(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptEdgeCluster", reflect.TypeOf((*MockRepositoryContract)(nil).AdoptEdgeCluster), ctx, request)
+}
+
 // CreateEdgeCluster mocks base method.
 func (m *MockRepositoryContract) CreateEdgeCluster(ctx context.Context, request *repository.CreateEdgeClusterRequest) (*repository.CreateEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +65,21 @@ func (mr *MockRepositoryContractMockRecorder) CreateEdgeCluster(ctx, request int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEdgeCluster", reflect.TypeOf((*MockRepositoryContract)(nil).CreateEdgeCluster), ctx, request)
 }
 
+// CreateKubeconfigIssuance mocks base method.
+func (m *MockRepositoryContract) CreateKubeconfigIssuance(ctx context.Context, request *repository.CreateKubeconfigIssuanceRequest) (*repository.CreateKubeconfigIssuanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKubeconfigIssuance", ctx, request)
+	ret0, _ := ret[0].(*repository.CreateKubeconfigIssuanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKubeconfigIssuance indicates an expected call of CreateKubeconfigIssuance.
+func (mr *MockRepositoryContractMockRecorder) CreateKubeconfigIssuance(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKubeconfigIssuance", reflect.TypeOf((*MockRepositoryContract)(nil).CreateKubeconfigIssuance), ctx, request)
+}
+
 // DeleteEdgeCluster mocks base method.
 func (m *MockRepositoryContract) DeleteEdgeCluster(ctx context.Context, request *repository.DeleteEdgeClusterRequest) (*repository.DeleteEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +95,36 @@ func (mr *MockRepositoryContractMockRecorder) DeleteEdgeCluster(ctx, request int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEdgeCluster", reflect.TypeOf((*MockRepositoryContract)(nil).DeleteEdgeCluster), ctx, request)
 }
 
+// EncryptEdgeClusters mocks base method.
+func (m *MockRepositoryContract) EncryptEdgeClusters(ctx context.Context, request *repository.EncryptEdgeClustersRequest) (*repository.EncryptEdgeClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EncryptEdgeClusters", ctx, request)
+	ret0, _ := ret[0].(*repository.EncryptEdgeClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EncryptEdgeClusters indicates an expected call of EncryptEdgeClusters.
+func (mr *MockRepositoryContractMockRecorder) EncryptEdgeClusters(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EncryptEdgeClusters", reflect.TypeOf((*MockRepositoryContract)(nil).EncryptEdgeClusters), ctx, request)
+}
+
+// ListAllEdgeClusters mocks base method.
+func (m *MockRepositoryContract) ListAllEdgeClusters(ctx context.Context, request *repository.ListAllEdgeClustersRequest) (*repository.ListAllEdgeClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllEdgeClusters", ctx, request)
+	ret0, _ := ret[0].(*repository.ListAllEdgeClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllEdgeClusters indicates an expected call of ListAllEdgeClusters.
+func (mr *MockRepositoryContractMockRecorder) ListAllEdgeClusters(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllEdgeClusters", reflect.TypeOf((*MockRepositoryContract)(nil).ListAllEdgeClusters), ctx, request)
+}
+
 // ListEdgeClusters mocks base method.
 func (m *MockRepositoryContract) ListEdgeClusters(ctx context.Context, request *repository.ListEdgeClustersRequest) (*repository.ListEdgeClustersResponse, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +140,36 @@ func (mr *MockRepositoryContractMockRecorder) ListEdgeClusters(ctx, request inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEdgeClusters", reflect.TypeOf((*MockRepositoryContract)(nil).ListEdgeClusters), ctx, request)
 }
 
+// ListExpiredKubeconfigIssuances mocks base method.
+func (m *MockRepositoryContract) ListExpiredKubeconfigIssuances(ctx context.Context, request *repository.ListExpiredKubeconfigIssuancesRequest) (*repository.ListExpiredKubeconfigIssuancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredKubeconfigIssuances", ctx, request)
+	ret0, _ := ret[0].(*repository.ListExpiredKubeconfigIssuancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredKubeconfigIssuances indicates an expected call of ListExpiredKubeconfigIssuances.
+func (mr *MockRepositoryContractMockRecorder) ListExpiredKubeconfigIssuances(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredKubeconfigIssuances", reflect.TypeOf((*MockRepositoryContract)(nil).ListExpiredKubeconfigIssuances), ctx, request)
+}
+
+// ListKubeconfigIssuances mocks base method.
+func (m *MockRepositoryContract) ListKubeconfigIssuances(ctx context.Context, request *repository.ListKubeconfigIssuancesRequest) (*repository.ListKubeconfigIssuancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubeconfigIssuances", ctx, request)
+	ret0, _ := ret[0].(*repository.ListKubeconfigIssuancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubeconfigIssuances indicates an expected call of ListKubeconfigIssuances.
+func (mr *MockRepositoryContractMockRecorder) ListKubeconfigIssuances(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubeconfigIssuances", reflect.TypeOf((*MockRepositoryContract)(nil).ListKubeconfigIssuances), ctx, request)
+}
+
 // ReadEdgeCluster mocks base method.
 func (m *MockRepositoryContract) ReadEdgeCluster(ctx context.Context, request *repository.ReadEdgeClusterRequest) (*repository.ReadEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +185,36 @@ func (mr *MockRepositoryContractMockRecorder) ReadEdgeCluster(ctx, request inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEdgeCluster", reflect.TypeOf((*MockRepositoryContract)(nil).ReadEdgeCluster), ctx, request)
 }
 
+// ReadKubeconfigIssuance mocks base method.
+func (m *MockRepositoryContract) ReadKubeconfigIssuance(ctx context.Context, request *repository.ReadKubeconfigIssuanceRequest) (*repository.ReadKubeconfigIssuanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadKubeconfigIssuance", ctx, request)
+	ret0, _ := ret[0].(*repository.ReadKubeconfigIssuanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadKubeconfigIssuance indicates an expected call of ReadKubeconfigIssuance.
+func (mr *MockRepositoryContractMockRecorder) ReadKubeconfigIssuance(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadKubeconfigIssuance", reflect.TypeOf((*MockRepositoryContract)(nil).ReadKubeconfigIssuance), ctx, request)
+}
+
+// RevokeKubeconfigIssuance mocks base method.
+func (m *MockRepositoryContract) RevokeKubeconfigIssuance(ctx context.Context, request *repository.RevokeKubeconfigIssuanceRequest) (*repository.RevokeKubeconfigIssuanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeKubeconfigIssuance", ctx, request)
+	ret0, _ := ret[0].(*repository.RevokeKubeconfigIssuanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeKubeconfigIssuance indicates an expected call of RevokeKubeconfigIssuance.
+func (mr *MockRepositoryContractMockRecorder) RevokeKubeconfigIssuance(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeKubeconfigIssuance", reflect.TypeOf((*MockRepositoryContract)(nil).RevokeKubeconfigIssuance), ctx, request)
+}
+
 // UpdateEdgeCluster mocks base method.
 func (m *MockRepositoryContract) UpdateEdgeCluster(ctx context.Context, request *repository.UpdateEdgeClusterRequest) (*repository.UpdateEdgeClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,3 +229,33 @@ func (mr *MockRepositoryContractMockRecorder) UpdateEdgeCluster(ctx, request int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEdgeCluster", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateEdgeCluster), ctx, request)
 }
+
+// UpdateKubeconfigIssuanceExpiry mocks base method.
+func (m *MockRepositoryContract) UpdateKubeconfigIssuanceExpiry(ctx context.Context, request *repository.UpdateKubeconfigIssuanceExpiryRequest) (*repository.UpdateKubeconfigIssuanceExpiryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKubeconfigIssuanceExpiry", ctx, request)
+	ret0, _ := ret[0].(*repository.UpdateKubeconfigIssuanceExpiryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKubeconfigIssuanceExpiry indicates an expected call of UpdateKubeconfigIssuanceExpiry.
+func (mr *MockRepositoryContractMockRecorder) UpdateKubeconfigIssuanceExpiry(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKubeconfigIssuanceExpiry", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateKubeconfigIssuanceExpiry), ctx, request)
+}
+
+// UpdateProvisioningState mocks base method.
+func (m *MockRepositoryContract) UpdateProvisioningState(ctx context.Context, request *repository.UpdateProvisioningStateRequest) (*repository.UpdateProvisioningStateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProvisioningState", ctx, request)
+	ret0, _ := ret[0].(*repository.UpdateProvisioningStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProvisioningState indicates an expected call of UpdateProvisioningState.
+func (mr *MockRepositoryContractMockRecorder) UpdateProvisioningState(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProvisioningState", reflect.TypeOf((*MockRepositoryContract)(nil).UpdateProvisioningState), ctx, request)
+}
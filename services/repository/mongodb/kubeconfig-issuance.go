@@ -0,0 +1,304 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/decentralized-cloud/edge-cluster/services/repository"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type kubeconfigIssuance struct {
+	ID            primitive.ObjectID    `bson:"_id,omitempty" json:"-"`
+	EdgeClusterID string                `bson:"edgeClusterID" json:"edgeClusterID"`
+	UserEmail     string                `bson:"userEmail" json:"userEmail"`
+	Role          models.KubeconfigRole `bson:"role" json:"role"`
+	Namespace     string                `bson:"namespace" json:"namespace"`
+	IssuedAt      time.Time             `bson:"issuedAt" json:"issuedAt"`
+	ExpiresAt     time.Time             `bson:"expiresAt" json:"expiresAt"`
+	RevokedAt     *time.Time            `bson:"revokedAt,omitempty" json:"revokedAt,omitempty"`
+}
+
+// CreateKubeconfigIssuance tracks a new kubeconfig issued to a user of an edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to track a new issued kubeconfig
+// Returns either the unique identifier of the issuance or error if something goes wrong.
+func (service *mongodbRepositoryService) CreateKubeconfigIssuance(
+	ctx context.Context,
+	request *repository.CreateKubeconfigIssuanceRequest) (*repository.CreateKubeconfigIssuanceResponse, error) {
+	client, collection, err := service.createClientAndKubeconfigIssuanceCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	insertResult, err := collection.InsertOne(ctx, mapToInternalKubeconfigIssuance(request.Issuance))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to create kubeconfig issuance.", err)
+	}
+
+	return &repository.CreateKubeconfigIssuanceResponse{
+		IssuanceID: insertResult.InsertedID.(primitive.ObjectID).Hex(),
+	}, nil
+}
+
+// ReadKubeconfigIssuance reads an existing kubeconfig issued to the user for the edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to read an existing issued kubeconfig
+// Returns either the issuance or error if something goes wrong, including NotFoundError if the kubeconfig was not
+// issued to the user for the edge cluster.
+func (service *mongodbRepositoryService) ReadKubeconfigIssuance(
+	ctx context.Context,
+	request *repository.ReadKubeconfigIssuanceRequest) (*repository.ReadKubeconfigIssuanceResponse, error) {
+	client, collection, err := service.createClientAndKubeconfigIssuanceCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	var issuance kubeconfigIssuance
+
+	err = collection.FindOne(
+		ctx,
+		getKubeconfigIssuanceFilter(request.IssuanceID, request.UserEmail, request.EdgeClusterID)).Decode(&issuance)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve kubeconfig issuance", err)
+	}
+
+	return &repository.ReadKubeconfigIssuanceResponse{
+		Issuance: mapFromInternalKubeconfigIssuance(issuance),
+	}, nil
+}
+
+// ListKubeconfigIssuances lists the kubeconfigs issued to the user for the edge cluster
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the issued kubeconfigs
+// Returns either the issuances ordered by their issue time or error if something goes wrong.
+func (service *mongodbRepositoryService) ListKubeconfigIssuances(
+	ctx context.Context,
+	request *repository.ListKubeconfigIssuancesRequest) (*repository.ListKubeconfigIssuancesResponse, error) {
+	client, collection, err := service.createClientAndKubeconfigIssuanceCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.D{{Key: "userEmail", Value: request.UserEmail}, {Key: "edgeClusterID", Value: request.EdgeClusterID}},
+		options.Find().SetSort(bson.D{{Key: "issuedAt", Value: 1}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to call the Find function on the collection.", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	issuances := []models.KubeconfigIssuanceWithID{}
+	for cursor.Next(ctx) {
+		var issuance kubeconfigIssuance
+		if err = cursor.Decode(&issuance); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the kubeconfig issuance", err)
+		}
+
+		issuances = append(issuances, models.KubeconfigIssuanceWithID{
+			IssuanceID: issuance.ID.Hex(),
+			Issuance:   mapFromInternalKubeconfigIssuance(issuance),
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to iterate over the kubeconfig issuances", err)
+	}
+
+	return &repository.ListKubeconfigIssuancesResponse{
+		Issuances: issuances,
+	}, nil
+}
+
+// RevokeKubeconfigIssuance records an existing kubeconfig issued to the user for the edge cluster as revoked
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to record an issued kubeconfig as revoked
+// Returns either the revoked issuance or error if something goes wrong, including NotFoundError if the kubeconfig
+// was not issued to the user for the edge cluster.
+func (service *mongodbRepositoryService) RevokeKubeconfigIssuance(
+	ctx context.Context,
+	request *repository.RevokeKubeconfigIssuanceRequest) (*repository.RevokeKubeconfigIssuanceResponse, error) {
+	client, collection, err := service.createClientAndKubeconfigIssuanceCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	revokedAt := request.RevokedAt.UTC()
+	var issuance kubeconfigIssuance
+
+	err = collection.FindOneAndUpdate(
+		ctx,
+		getKubeconfigIssuanceFilter(request.IssuanceID, request.UserEmail, request.EdgeClusterID),
+		bson.M{"$set": bson.M{"revokedAt": revokedAt}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&issuance)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to revoke kubeconfig issuance.", err)
+	}
+
+	return &repository.RevokeKubeconfigIssuanceResponse{
+		Issuance: mapFromInternalKubeconfigIssuance(issuance),
+	}, nil
+}
+
+// UpdateKubeconfigIssuanceExpiry records the time an existing kubeconfig issued to the user for the edge cluster
+// expires at, as the token of the kubeconfig can expire earlier or later than requested
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to record the expiry time of an issued kubeconfig
+// Returns either the updated issuance or error if something goes wrong, including NotFoundError if the kubeconfig
+// was not issued to the user for the edge cluster.
+func (service *mongodbRepositoryService) UpdateKubeconfigIssuanceExpiry(
+	ctx context.Context,
+	request *repository.UpdateKubeconfigIssuanceExpiryRequest) (*repository.UpdateKubeconfigIssuanceExpiryResponse, error) {
+	client, collection, err := service.createClientAndKubeconfigIssuanceCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	var issuance kubeconfigIssuance
+
+	err = collection.FindOneAndUpdate(
+		ctx,
+		getKubeconfigIssuanceFilter(request.IssuanceID, request.UserEmail, request.EdgeClusterID),
+		bson.M{"$set": bson.M{"expiresAt": request.ExpiresAt.UTC()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&issuance)
+	if err == mongo.ErrNoDocuments {
+		return nil, commonErrors.NewNotFoundError()
+	} else if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update the expiry of kubeconfig issuance.", err)
+	}
+
+	return &repository.UpdateKubeconfigIssuanceExpiryResponse{
+		Issuance: mapFromInternalKubeconfigIssuance(issuance),
+	}, nil
+}
+
+// ListExpiredKubeconfigIssuances lists the kubeconfigs issued to all the users of all the edge clusters that
+// expired and are not revoked yet
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the expired issued kubeconfigs
+// Returns either the expired issuances ordered by their expiry time or error if something goes wrong.
+func (service *mongodbRepositoryService) ListExpiredKubeconfigIssuances(
+	ctx context.Context,
+	request *repository.ListExpiredKubeconfigIssuancesRequest) (*repository.ListExpiredKubeconfigIssuancesResponse, error) {
+	client, collection, err := service.createClientAndKubeconfigIssuanceCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	cursor, err := collection.Find(
+		ctx,
+		bson.D{
+			{Key: "expiresAt", Value: bson.M{"$lt": request.ExpiredBefore.UTC()}},
+			{Key: "revokedAt", Value: bson.M{"$exists": false}},
+		},
+		options.Find().SetSort(bson.D{{Key: "expiresAt", Value: 1}}))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to call the Find function on the collection.", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	issuances := []models.KubeconfigIssuanceWithID{}
+	for cursor.Next(ctx) {
+		var issuance kubeconfigIssuance
+		if err = cursor.Decode(&issuance); err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the kubeconfig issuance", err)
+		}
+
+		issuances = append(issuances, models.KubeconfigIssuanceWithID{
+			IssuanceID: issuance.ID.Hex(),
+			Issuance:   mapFromInternalKubeconfigIssuance(issuance),
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to iterate over the kubeconfig issuances", err)
+	}
+
+	return &repository.ListExpiredKubeconfigIssuancesResponse{
+		Issuances: issuances,
+	}, nil
+}
+
+func (service *mongodbRepositoryService) createClientAndKubeconfigIssuanceCollection(
+	ctx context.Context) (*mongo.Client, *mongo.Collection, error) {
+	clientOptions := options.Client().ApplyURI(service.connectionString)
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, nil, commonErrors.NewUnknownErrorWithError("could not connect to mongodb database.", err)
+	}
+
+	return client, client.Database(service.databaseName).Collection(service.kubeconfigIssuanceDatabaseCollectionName), nil
+}
+
+func getKubeconfigIssuanceFilter(issuanceID, userEmail, edgeClusterID string) bson.D {
+	ObjectID, _ := primitive.ObjectIDFromHex(issuanceID)
+
+	return bson.D{
+		{Key: "_id", Value: ObjectID},
+		{Key: "userEmail", Value: userEmail},
+		{Key: "edgeClusterID", Value: edgeClusterID},
+	}
+}
+
+func mapToInternalKubeconfigIssuance(from models.KubeconfigIssuance) kubeconfigIssuance {
+	to := kubeconfigIssuance{
+		EdgeClusterID: from.EdgeClusterID,
+		UserEmail:     from.UserEmail,
+		Role:          from.Role,
+		Namespace:     from.Namespace,
+		IssuedAt:      from.IssuedAt.UTC(),
+		ExpiresAt:     from.ExpiresAt.UTC(),
+	}
+
+	if !from.RevokedAt.IsZero() {
+		revokedAt := from.RevokedAt.UTC()
+		to.RevokedAt = &revokedAt
+	}
+
+	return to
+}
+
+func mapFromInternalKubeconfigIssuance(from kubeconfigIssuance) models.KubeconfigIssuance {
+	to := models.KubeconfigIssuance{
+		EdgeClusterID: from.EdgeClusterID,
+		UserEmail:     from.UserEmail,
+		Role:          from.Role,
+		Namespace:     from.Namespace,
+		IssuedAt:      from.IssuedAt,
+		ExpiresAt:     from.ExpiresAt,
+	}
+
+	if from.RevokedAt != nil {
+		to.RevokedAt = *from.RevokedAt
+	}
+
+	return to
+}
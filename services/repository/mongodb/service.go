@@ -4,9 +4,11 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/decentralized-cloud/edge-cluster/models"
 	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
 	"github.com/decentralized-cloud/edge-cluster/services/repository"
 	"github.com/micro-business/go-core/common"
 	commonErrors "github.com/micro-business/go-core/system/errors"
@@ -17,23 +19,46 @@ import (
 )
 
 type edgeCluster struct {
-	UserEmail     string             `bson:"userEmail" json:"userEmail"`
-	ProjectID     string             `bson:"projectID" json:"projectID"`
-	Name          string             `bson:"name" json:"name"`
-	ClusterSecret string             `bson:"clusterSecret" json:"clusterSecret"`
-	ClusterType   models.ClusterType `bson:"clusterType" json:"clusterType"`
+	ID                     primitive.ObjectID     `bson:"_id,omitempty" json:"-"`
+	UserEmail              string                 `bson:"userEmail" json:"userEmail"`
+	ProjectID              string                 `bson:"projectID" json:"projectID"`
+	Name                   string                 `bson:"name" json:"name"`
+	ClusterSecret          string                 `bson:"clusterSecret,omitempty" json:"clusterSecret,omitempty"`
+	EncryptedClusterSecret *encryptedValue        `bson:"encryptedClusterSecret,omitempty" json:"encryptedClusterSecret,omitempty"`
+	ClusterType            models.ClusterType     `bson:"clusterType" json:"clusterType"`
+	ServiceExposure        models.ServiceExposure `bson:"serviceExposure" json:"serviceExposure"`
+	ProvisioningState      provisioningState      `bson:"provisioningState" json:"provisioningState"`
+}
+
+type encryptedValue struct {
+	KeyID            string `bson:"keyID" json:"keyID"`
+	EncryptedDataKey []byte `bson:"encryptedDataKey" json:"encryptedDataKey"`
+	Ciphertext       []byte `bson:"ciphertext" json:"ciphertext"`
+}
+
+type provisioningState struct {
+	Status           models.ProvisioningStatus `bson:"status" json:"status"`
+	LastErrorMessage string                    `bson:"lastErrorMessage" json:"lastErrorMessage"`
+	CreatedAt        time.Time                 `bson:"createdAt" json:"createdAt"`
+	UpdatedAt        time.Time                 `bson:"updatedAt" json:"updatedAt"`
 }
 
 type mongodbRepositoryService struct {
-	connectionString       string
-	databaseName           string
-	databaseCollectionName string
+	connectionString                         string
+	databaseName                             string
+	databaseCollectionName                   string
+	kubeconfigIssuanceDatabaseCollectionName string
+	encryptionService                        encryption.EncryptionContract
 }
 
 // NewMongodbRepositoryService creates new instance of the mongodbRepositoryService, setting up all dependencies and returns the instance
+// configurationService: Mandatory. Reference to the service that provides required configurations
+// encryptionService: Optional. Reference to the service that encrypts the sensitive fields. The sensitive fields are
+// stored in plain text if not provided.
 // Returns the new service or error if something goes wrong
 func NewMongodbRepositoryService(
-	configurationService configuration.ConfigurationContract) (repository.RepositoryContract, error) {
+	configurationService configuration.ConfigurationContract,
+	encryptionService encryption.EncryptionContract) (repository.RepositoryContract, error) {
 	if configurationService == nil {
 		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
 	}
@@ -53,10 +78,17 @@ func NewMongodbRepositoryService(
 		return nil, commonErrors.NewUnknownErrorWithError("failed to get the database collection name", err)
 	}
 
+	kubeconfigIssuanceDatabaseCollectionName, err := configurationService.GetKubeconfigIssuanceDatabaseCollectionName()
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to get the kubeconfig issuance database collection name", err)
+	}
+
 	return &mongodbRepositoryService{
-		connectionString:       connectionString,
-		databaseName:           databaseName,
-		databaseCollectionName: databaseCollectionName,
+		connectionString:                         connectionString,
+		databaseName:                             databaseName,
+		databaseCollectionName:                   databaseCollectionName,
+		kubeconfigIssuanceDatabaseCollectionName: kubeconfigIssuanceDatabaseCollectionName,
+		encryptionService:                        encryptionService,
 	}, nil
 }
 
@@ -74,7 +106,19 @@ func (service *mongodbRepositoryService) CreateEdgeCluster(
 
 	defer disconnect(ctx, client)
 
-	insertResult, err := collection.InsertOne(ctx, mapToInternalEdgeCluster(request.UserEmail, request.EdgeCluster))
+	now := time.Now().UTC()
+	internalEdgeCluster, err := service.mapToInternalEdgeCluster(ctx, request.UserEmail, request.EdgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	internalEdgeCluster.ProvisioningState = provisioningState{
+		Status:    models.ProvisioningStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	insertResult, err := collection.InsertOne(ctx, internalEdgeCluster)
 	if err != nil {
 		return nil, commonErrors.NewUnknownErrorWithError("failed to create edge cluster.", err)
 	}
@@ -113,8 +157,14 @@ func (service *mongodbRepositoryService) ReadEdgeCluster(
 		return nil, commonErrors.NewUnknownErrorWithError("failed to retrieve edge cluster", err)
 	}
 
+	mappedEdgeCluster, err := service.mapFromInternalEdgeCluster(ctx, edgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
 	return &repository.ReadEdgeClusterResponse{
-		EdgeCluster: mapFromInternalEdgeCluster(edgeCluster),
+		EdgeCluster:       mappedEdgeCluster,
+		ProvisioningState: mapFromInternalProvisioningState(edgeCluster.ProvisioningState),
 	}, nil
 }
 
@@ -135,12 +185,15 @@ func (service *mongodbRepositoryService) UpdateEdgeCluster(
 	ObjectID, _ := primitive.ObjectIDFromHex(request.EdgeClusterID)
 	filter := bson.D{{Key: "_id", Value: ObjectID}, {Key: "userEmail", Value: request.UserEmail}}
 
-	newEdgeCluster := bson.M{
-		"$set": bson.M{
-			"name":          request.EdgeCluster.Name,
-			"projectID":     request.EdgeCluster.ProjectID,
-			"clusterSecret": request.EdgeCluster.ClusterSecret,
-		}}
+	newEdgeCluster, err := service.getClusterSecretUpdate(ctx, request.EdgeCluster.ClusterSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	newEdgeCluster["$set"]["name"] = request.EdgeCluster.Name
+	newEdgeCluster["$set"]["projectID"] = request.EdgeCluster.ProjectID
+	newEdgeCluster["$set"]["serviceExposure"] = request.EdgeCluster.ServiceExposure
+
 	response, err := collection.UpdateOne(ctx, filter, newEdgeCluster)
 	if err != nil {
 		return nil, commonErrors.NewUnknownErrorWithError("failed to update edge cluster.", err)
@@ -324,11 +377,17 @@ func (service *mongodbRepositoryService) ListEdgeClusters(
 			return nil, commonErrors.NewUnknownErrorWithError("could not load the data.", err)
 		}
 
+		mappedEdgeCluster, err := service.mapFromInternalEdgeCluster(ctx, edgeCluster)
+		if err != nil {
+			return nil, err
+		}
+
 		edgeClusterID := edgeClusterBson["_id"].(primitive.ObjectID).Hex()
 		edgeClusterWithCursor := models.EdgeClusterWithCursor{
-			EdgeClusterID: edgeClusterID,
-			EdgeCluster:   mapFromInternalEdgeCluster(edgeCluster),
-			Cursor:        edgeClusterID,
+			EdgeClusterID:     edgeClusterID,
+			EdgeCluster:       mappedEdgeCluster,
+			Cursor:            edgeClusterID,
+			ProvisioningState: mapFromInternalProvisioningState(edgeCluster.ProvisioningState),
 		}
 
 		edgeClusters = append(edgeClusters, edgeClusterWithCursor)
@@ -350,6 +409,265 @@ func (service *mongodbRepositoryService) ListEdgeClusters(
 	return response, nil
 }
 
+// UpdateProvisioningState updates the provisioning state of an existing edge cluster
+// context: Optional The reference to the context
+// request: Mandatory. The request to update the provisioning state of an existing edge cluster
+// Returns either the result of updating the provisioning state of an existing edge cluster or error if something goes wrong.
+func (service *mongodbRepositoryService) UpdateProvisioningState(
+	ctx context.Context,
+	request *repository.UpdateProvisioningStateRequest) (*repository.UpdateProvisioningStateResponse, error) {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	ObjectID, _ := primitive.ObjectIDFromHex(request.EdgeClusterID)
+	filter := bson.D{{Key: "_id", Value: ObjectID}}
+
+	newProvisioningState := bson.M{
+		"$set": bson.M{
+			"provisioningState.status":           request.Status,
+			"provisioningState.lastErrorMessage": request.LastErrorMessage,
+			"provisioningState.updatedAt":        time.Now().UTC(),
+		}}
+	response, err := collection.UpdateOne(ctx, filter, newProvisioningState)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to update edge cluster provisioning state.", err)
+	}
+
+	if response.MatchedCount == 0 {
+		return nil, commonErrors.NewNotFoundError()
+	}
+
+	return &repository.UpdateProvisioningStateResponse{}, nil
+}
+
+// ListAllEdgeClusters returns the edge clusters of all the users. It is used by the background jobs that
+// maintain the provisioned edge clusters and must not be exposed to the users. The edge clusters whose cluster secret
+// cannot be decrypted are reported as failures instead of failing the listing.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to list the edge clusters of all the users
+// Returns either the edge clusters of all the users or error if something goes wrong.
+func (service *mongodbRepositoryService) ListAllEdgeClusters(
+	ctx context.Context,
+	request *repository.ListAllEdgeClustersRequest) (*repository.ListAllEdgeClustersResponse, error) {
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	cursor, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to call the Find function on the collection.", err)
+	}
+
+	response := &repository.ListAllEdgeClustersResponse{
+		EdgeClusters: []repository.EdgeClusterRecord{},
+	}
+
+	for cursor.Next(ctx) {
+		var edgeCluster edgeCluster
+		var edgeClusterBson bson.M
+
+		err := cursor.Decode(&edgeCluster)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("failed to decode the edge cluster", err)
+		}
+
+		err = cursor.Decode(&edgeClusterBson)
+		if err != nil {
+			return nil, commonErrors.NewUnknownErrorWithError("could not load the data.", err)
+		}
+
+		edgeClusterID := edgeClusterBson["_id"].(primitive.ObjectID).Hex()
+
+		// A single edge cluster whose cluster secret cannot be decrypted must not stop the background jobs from
+		// maintaining the rest of the edge clusters
+		mappedEdgeCluster, err := service.mapFromInternalEdgeCluster(ctx, edgeCluster)
+		if err != nil {
+			response.Failures = append(response.Failures, repository.ListEdgeClusterFailure{
+				EdgeClusterID: edgeClusterID,
+				Err:           err,
+			})
+
+			continue
+		}
+
+		response.EdgeClusters = append(response.EdgeClusters, repository.EdgeClusterRecord{
+			EdgeClusterID:     edgeClusterID,
+			UserEmail:         edgeCluster.UserEmail,
+			EdgeCluster:       mappedEdgeCluster,
+			ProvisioningState: mapFromInternalProvisioningState(edgeCluster.ProvisioningState),
+		})
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to iterate over the edge clusters", err)
+	}
+
+	return response, nil
+}
+
+// AdoptEdgeCluster adds an edge cluster whose provision still exists back to the repository using its original
+// identifier. The edge cluster is stored as ready as its provision is already running.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to adopt an edge cluster
+// Returns either the result of adopting the edge cluster or error if something goes wrong.
+func (service *mongodbRepositoryService) AdoptEdgeCluster(
+	ctx context.Context,
+	request *repository.AdoptEdgeClusterRequest) (*repository.AdoptEdgeClusterResponse, error) {
+	objectID, err := primitive.ObjectIDFromHex(request.EdgeClusterID)
+	if err != nil {
+		return nil, commonErrors.NewArgumentErrorWithError("request", "the edge cluster identifier is not valid", err)
+	}
+
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	now := time.Now().UTC()
+	internalEdgeCluster, err := service.mapToInternalEdgeCluster(ctx, request.UserEmail, request.EdgeCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	internalEdgeCluster.ID = objectID
+	internalEdgeCluster.ProvisioningState = provisioningState{
+		Status:    models.ProvisioningStatusReady,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if _, err = collection.InsertOne(ctx, internalEdgeCluster); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, commonErrors.NewAlreadyExistsError()
+		}
+
+		return nil, commonErrors.NewUnknownErrorWithError("failed to adopt edge cluster.", err)
+	}
+
+	return &repository.AdoptEdgeClusterResponse{
+		EdgeCluster: request.EdgeCluster,
+		Cursor:      request.EdgeClusterID,
+	}, nil
+}
+
+// EncryptEdgeClusters encrypts the sensitive fields of the next batch of edge clusters that are either stored in plain
+// text or encrypted with a key other than the primary key. It is used by the background job that migrates the plain
+// text edge clusters and re-encrypts the edge clusters once the keys are rotated, and must not be exposed to the users.
+// ctx: Mandatory The reference to the context
+// request: Mandatory. The request to encrypt the next batch of edge clusters
+// Returns either the result of encrypting the batch of edge clusters or error if something goes wrong.
+func (service *mongodbRepositoryService) EncryptEdgeClusters(
+	ctx context.Context,
+	request *repository.EncryptEdgeClustersRequest) (*repository.EncryptEdgeClustersResponse, error) {
+	if service.encryptionService == nil {
+		return nil, commonErrors.NewUnknownError("the edge clusters cannot be encrypted as no encryption key provider is configured")
+	}
+
+	primaryKeyID, err := service.encryptionService.GetPrimaryKeyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Matches the plain text edge clusters too, as they do not have the key identifier at all
+	filter := bson.M{"encryptedClusterSecret.keyID": bson.M{"$ne": primaryKeyID}}
+	if request.After != "" {
+		objectID, err := primitive.ObjectIDFromHex(request.After)
+		if err != nil {
+			return nil, commonErrors.NewArgumentErrorWithError("request", "the cursor is not valid", err)
+		}
+
+		filter["_id"] = bson.M{"$gt": objectID}
+	}
+
+	client, collection, err := service.createClientAndCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer disconnect(ctx, client)
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+	if request.BatchSize > 0 {
+		findOptions.SetLimit(int64(request.BatchSize))
+	}
+
+	cursor, err := collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to call the Find function on the collection.", err)
+	}
+
+	var edgeClusters []edgeCluster
+	if err = cursor.All(ctx, &edgeClusters); err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to decode the edge clusters", err)
+	}
+
+	response := &repository.EncryptEdgeClustersResponse{
+		Cursor:  request.After,
+		HasMore: request.BatchSize > 0 && len(edgeClusters) == request.BatchSize,
+	}
+
+	for _, edgeCluster := range edgeClusters {
+		edgeClusterID := edgeCluster.ID.Hex()
+		response.Cursor = edgeClusterID
+
+		encrypted, err := service.encryptEdgeCluster(ctx, collection, edgeCluster)
+		if err != nil {
+			response.Failures = append(response.Failures, repository.EncryptEdgeClusterFailure{
+				EdgeClusterID: edgeClusterID,
+				Err:           err,
+			})
+
+			continue
+		}
+
+		if encrypted {
+			response.EncryptedCount++
+		}
+	}
+
+	return response, nil
+}
+
+func (service *mongodbRepositoryService) encryptEdgeCluster(
+	ctx context.Context,
+	collection *mongo.Collection,
+	edgeCluster edgeCluster) (bool, error) {
+	clusterSecret, err := service.decryptClusterSecret(ctx, edgeCluster)
+	if err != nil {
+		return false, err
+	}
+
+	update, err := service.getClusterSecretUpdate(ctx, clusterSecret)
+	if err != nil {
+		return false, err
+	}
+
+	// Only replaces the value that was read, so a concurrent update of the edge cluster, which is encrypted with the
+	// primary key anyway, is not overwritten with the old value
+	filter := bson.M{"_id": edgeCluster.ID}
+	if edgeCluster.EncryptedClusterSecret != nil {
+		filter["encryptedClusterSecret.ciphertext"] = edgeCluster.EncryptedClusterSecret.Ciphertext
+	} else {
+		filter["encryptedClusterSecret"] = bson.M{"$exists": false}
+	}
+
+	response, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, commonErrors.NewUnknownErrorWithError("failed to encrypt edge cluster.", err)
+	}
+
+	return response.ModifiedCount > 0, nil
+}
+
 func (service *mongodbRepositoryService) createClientAndCollection(ctx context.Context) (*mongo.Client, *mongo.Collection, error) {
 	clientOptions := options.Client().ApplyURI(service.connectionString)
 	client, err := mongo.Connect(ctx, clientOptions)
@@ -364,21 +682,119 @@ func disconnect(ctx context.Context, client *mongo.Client) {
 	_ = client.Disconnect(ctx)
 }
 
-func mapToInternalEdgeCluster(email string, from models.EdgeCluster) edgeCluster {
-	return edgeCluster{
-		UserEmail:     email,
-		ProjectID:     from.ProjectID,
-		Name:          from.Name,
-		ClusterSecret: from.ClusterSecret,
-		ClusterType:   from.ClusterType,
+func (service *mongodbRepositoryService) mapToInternalEdgeCluster(
+	ctx context.Context,
+	email string,
+	from models.EdgeCluster) (edgeCluster, error) {
+	to := edgeCluster{
+		UserEmail:       email,
+		ProjectID:       from.ProjectID,
+		Name:            from.Name,
+		ClusterType:     from.ClusterType,
+		ServiceExposure: from.ServiceExposure,
 	}
+
+	if service.encryptionService == nil {
+		to.ClusterSecret = from.ClusterSecret
+
+		return to, nil
+	}
+
+	encryptedClusterSecret, err := service.encryptClusterSecret(ctx, from.ClusterSecret)
+	if err != nil {
+		return edgeCluster{}, err
+	}
+
+	to.EncryptedClusterSecret = encryptedClusterSecret
+
+	return to, nil
 }
 
-func mapFromInternalEdgeCluster(from edgeCluster) models.EdgeCluster {
+func (service *mongodbRepositoryService) mapFromInternalEdgeCluster(
+	ctx context.Context,
+	from edgeCluster) (models.EdgeCluster, error) {
+	clusterSecret, err := service.decryptClusterSecret(ctx, from)
+	if err != nil {
+		return models.EdgeCluster{}, err
+	}
+
 	return models.EdgeCluster{
-		ProjectID:     from.ProjectID,
-		Name:          from.Name,
-		ClusterSecret: from.ClusterSecret,
-		ClusterType:   from.ClusterType,
+		ProjectID:       from.ProjectID,
+		Name:            from.Name,
+		ClusterSecret:   clusterSecret,
+		ClusterType:     from.ClusterType,
+		ServiceExposure: from.ServiceExposure,
+	}, nil
+}
+
+// getClusterSecretUpdate returns the update that stores the given cluster secret either encrypted or in plain text,
+// and removes the other representation of the cluster secret
+func (service *mongodbRepositoryService) getClusterSecretUpdate(
+	ctx context.Context,
+	clusterSecret string) (map[string]bson.M, error) {
+	if service.encryptionService == nil {
+		return map[string]bson.M{
+			"$set":   {"clusterSecret": clusterSecret},
+			"$unset": {"encryptedClusterSecret": ""},
+		}, nil
+	}
+
+	encryptedClusterSecret, err := service.encryptClusterSecret(ctx, clusterSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]bson.M{
+		"$set":   {"encryptedClusterSecret": encryptedClusterSecret},
+		"$unset": {"clusterSecret": ""},
+	}, nil
+}
+
+func (service *mongodbRepositoryService) encryptClusterSecret(
+	ctx context.Context,
+	clusterSecret string) (*encryptedValue, error) {
+	value, err := service.encryptionService.Encrypt(ctx, []byte(clusterSecret))
+	if err != nil {
+		return nil, commonErrors.NewUnknownErrorWithError("failed to encrypt the cluster secret", err)
+	}
+
+	return &encryptedValue{
+		KeyID:            value.KeyID,
+		EncryptedDataKey: value.EncryptedDataKey,
+		Ciphertext:       value.Ciphertext,
+	}, nil
+}
+
+// decryptClusterSecret returns the cluster secret of the given edge cluster, which is stored in plain text if the edge
+// cluster was stored before the encryption was configured and is not migrated yet
+func (service *mongodbRepositoryService) decryptClusterSecret(
+	ctx context.Context,
+	from edgeCluster) (string, error) {
+	if from.EncryptedClusterSecret == nil {
+		return from.ClusterSecret, nil
+	}
+
+	if service.encryptionService == nil {
+		return "", commonErrors.NewUnknownError("the cluster secret is encrypted but no encryption key provider is configured")
+	}
+
+	clusterSecret, err := service.encryptionService.Decrypt(ctx, encryption.EncryptedValue{
+		KeyID:            from.EncryptedClusterSecret.KeyID,
+		EncryptedDataKey: from.EncryptedClusterSecret.EncryptedDataKey,
+		Ciphertext:       from.EncryptedClusterSecret.Ciphertext,
+	})
+	if err != nil {
+		return "", commonErrors.NewUnknownErrorWithError("failed to decrypt the cluster secret", err)
+	}
+
+	return string(clusterSecret), nil
+}
+
+func mapFromInternalProvisioningState(from provisioningState) models.ProvisioningState {
+	return models.ProvisioningState{
+		Status:           from.Status,
+		LastErrorMessage: from.LastErrorMessage,
+		CreatedAt:        from.CreatedAt,
+		UpdatedAt:        from.UpdatedAt,
 	}
 }
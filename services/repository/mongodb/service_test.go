@@ -2,20 +2,26 @@ package mongodb_test
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/decentralized-cloud/edge-cluster/models"
 	configurationMock "github.com/decentralized-cloud/edge-cluster/services/configuration/mock"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption"
+	"github.com/decentralized-cloud/edge-cluster/services/encryption/envelope"
+	encryptionMock "github.com/decentralized-cloud/edge-cluster/services/encryption/mock"
 	"github.com/decentralized-cloud/edge-cluster/services/repository"
 	"github.com/decentralized-cloud/edge-cluster/services/repository/mongodb"
 	"github.com/golang/mock/gomock"
 	"github.com/lucsky/cuid"
 	"github.com/micro-business/go-core/common"
 	commonErrors "github.com/micro-business/go-core/system/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -28,14 +34,15 @@ func TestMongodbRepositoryService(t *testing.T) {
 
 var _ = Describe("Mongodb Repository Service Tests", func() {
 	var (
-		mockCtrl      *gomock.Controller
-		sut           repository.RepositoryContract
-		ctx           context.Context
-		createRequest repository.CreateEdgeClusterRequest
+		mockCtrl         *gomock.Controller
+		sut              repository.RepositoryContract
+		ctx              context.Context
+		createRequest    repository.CreateEdgeClusterRequest
+		connectionString string
 	)
 
 	BeforeEach(func() {
-		connectionString := os.Getenv("DATABASE_CONNECTION_STRING")
+		connectionString = os.Getenv("DATABASE_CONNECTION_STRING")
 		if strings.Trim(connectionString, " ") == "" {
 			connectionString = "mongodb://mongodb:27017"
 		}
@@ -57,7 +64,12 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 			GetDatabaseCollectionName().
 			Return("edge-clusters", nil)
 
-		sut, _ = mongodb.NewMongodbRepositoryService(mockConfigurationService)
+		mockConfigurationService.
+			EXPECT().
+			GetKubeconfigIssuanceDatabaseCollectionName().
+			Return("edge-cluster-kubeconfig-issuances", nil)
+
+		sut, _ = mongodb.NewMongodbRepositoryService(mockConfigurationService, nil)
 		ctx = context.Background()
 		createRequest = repository.CreateEdgeClusterRequest{
 			UserEmail: cuid.New() + "@test.com",
@@ -66,6 +78,10 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 				Name:          cuid.New(),
 				ClusterSecret: cuid.New(),
 				ClusterType:   models.K3S,
+				ServiceExposure: models.ServiceExposure{
+					Mode:    models.ServiceExposureModeIngress,
+					Address: cuid.New() + ".example.com",
+				},
 			},
 		}
 	})
@@ -93,7 +109,12 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 					GetDatabaseCollectionName().
 					Return(cuid.New(), nil)
 
-				service, err := mongodb.NewMongodbRepositoryService(mockConfigurationService)
+				mockConfigurationService.
+					EXPECT().
+					GetKubeconfigIssuanceDatabaseCollectionName().
+					Return(cuid.New(), nil)
+
+				service, err := mongodb.NewMongodbRepositoryService(mockConfigurationService, nil)
 				Ω(err).Should(BeNil())
 				Ω(service).ShouldNot(BeNil())
 			})
@@ -129,6 +150,26 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 				response, err := sut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{UserEmail: createRequest.UserEmail, EdgeClusterID: edgeClusterID})
 				Ω(err).Should(BeNil())
 				assertEdgeCluster(response.EdgeCluster, createRequest.EdgeCluster)
+				Ω(response.ProvisioningState.Status).Should(Equal(models.ProvisioningStatusPending))
+				Ω(response.ProvisioningState.CreatedAt.IsZero()).Should(BeFalse())
+			})
+		})
+
+		When("the provisioning state of the edge cluster is updated", func() {
+			It("should update the edge cluster's provisioning state", func() {
+				lastErrorMessage := cuid.New()
+				_, err := sut.UpdateProvisioningState(ctx, &repository.UpdateProvisioningStateRequest{
+					EdgeClusterID:    edgeClusterID,
+					Status:           models.ProvisioningStatusFailed,
+					LastErrorMessage: lastErrorMessage,
+				})
+				Ω(err).Should(BeNil())
+
+				response, err := sut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{UserEmail: createRequest.UserEmail, EdgeClusterID: edgeClusterID})
+				Ω(err).Should(BeNil())
+				Ω(response.ProvisioningState.Status).Should(Equal(models.ProvisioningStatusFailed))
+				Ω(response.ProvisioningState.LastErrorMessage).Should(Equal(lastErrorMessage))
+				Ω(response.ProvisioningState.UpdatedAt.Before(response.ProvisioningState.CreatedAt)).Should(BeFalse())
 			})
 		})
 
@@ -166,6 +207,25 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 				Ω(response).Should(BeNil())
 			})
 		})
+
+		When("the edge clusters of all the users are listed", func() {
+			It("should return the edge cluster together with the user it belongs to", func() {
+				response, err := sut.ListAllEdgeClusters(ctx, &repository.ListAllEdgeClustersRequest{})
+				Ω(err).Should(BeNil())
+
+				var found *repository.EdgeClusterRecord
+				for index, edgeCluster := range response.EdgeClusters {
+					if edgeCluster.EdgeClusterID == edgeClusterID {
+						found = &response.EdgeClusters[index]
+					}
+				}
+
+				Ω(found).ShouldNot(BeNil())
+				Ω(found.UserEmail).Should(Equal(createRequest.UserEmail))
+				assertEdgeCluster(found.EdgeCluster, createRequest.EdgeCluster)
+				Ω(found.ProvisioningState.Status).Should(Equal(models.ProvisioningStatusPending))
+			})
+		})
 	})
 
 	Context("edge cluster does not exist", func() {
@@ -209,6 +269,19 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 			})
 		})
 
+		When("the provisioning state of the edge cluster is updated", func() {
+			It("should return NotFoundError", func() {
+				response, err := sut.UpdateProvisioningState(ctx, &repository.UpdateProvisioningStateRequest{
+					EdgeClusterID: edgeClusterID,
+					Status:        models.ProvisioningStatusReady,
+				})
+				Ω(err).Should(HaveOccurred())
+				Ω(response).Should(BeNil())
+
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+
 		When("user tries to delete the edge cluster", func() {
 			It("should return NotFoundError", func() {
 				response, err := sut.DeleteEdgeCluster(ctx, &repository.DeleteEdgeClusterRequest{UserEmail: createRequest.UserEmail, EdgeClusterID: edgeClusterID})
@@ -475,6 +548,386 @@ var _ = Describe("Mongodb Repository Service Tests", func() {
 
 	})
 
+	Context("edge cluster whose provision still exists is adopted", func() {
+		When("the edge cluster does not exist in the repository", func() {
+			It("should store the edge cluster as ready with its original identifier", func() {
+				edgeClusterID := primitive.NewObjectID().Hex()
+
+				response, err := sut.AdoptEdgeCluster(ctx, &repository.AdoptEdgeClusterRequest{
+					EdgeClusterID: edgeClusterID,
+					UserEmail:     createRequest.UserEmail,
+					EdgeCluster:   createRequest.EdgeCluster,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Cursor).Should(Equal(edgeClusterID))
+
+				readResponse, err := sut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+					UserEmail:     createRequest.UserEmail,
+					EdgeClusterID: edgeClusterID,
+				})
+				Ω(err).Should(BeNil())
+				assertEdgeCluster(readResponse.EdgeCluster, createRequest.EdgeCluster)
+				Ω(readResponse.ProvisioningState.Status).Should(Equal(models.ProvisioningStatusReady))
+			})
+		})
+
+		When("the edge cluster already exists in the repository", func() {
+			It("should return AlreadyExistsError", func() {
+				createResponse, err := sut.CreateEdgeCluster(ctx, &createRequest)
+				Ω(err).Should(BeNil())
+
+				response, err := sut.AdoptEdgeCluster(ctx, &repository.AdoptEdgeClusterRequest{
+					EdgeClusterID: createResponse.EdgeClusterID,
+					UserEmail:     createRequest.UserEmail,
+					EdgeCluster:   createRequest.EdgeCluster,
+				})
+				Ω(response).Should(BeNil())
+				Ω(commonErrors.IsAlreadyExistsError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the sensitive fields are encrypted", func() {
+		var (
+			mockKeyProviderService *encryptionMock.MockKeyProviderContract
+			plainTextSut           repository.RepositoryContract
+			encryptedSut           repository.RepositoryContract
+			keyRing                encryption.KeyRing
+		)
+
+		newKey := func() []byte {
+			key := make([]byte, encryption.KeySize)
+			_, err := rand.Read(key)
+			Ω(err).Should(BeNil())
+
+			return key
+		}
+
+		BeforeEach(func() {
+			// Every test uses its own collection, so encrypting the edge clusters does not affect the other tests
+			collectionName := "edge-clusters-" + cuid.New()
+			mockConfigurationService := configurationMock.NewMockConfigurationContract(mockCtrl)
+			mockConfigurationService.
+				EXPECT().
+				GetDatabaseConnectionString().
+				Return(connectionString, nil).
+				AnyTimes()
+
+			mockConfigurationService.
+				EXPECT().
+				GetDatabaseName().
+				Return("edge-clusters", nil).
+				AnyTimes()
+
+			mockConfigurationService.
+				EXPECT().
+				GetDatabaseCollectionName().
+				Return(collectionName, nil).
+				AnyTimes()
+
+			mockConfigurationService.
+				EXPECT().
+				GetKubeconfigIssuanceDatabaseCollectionName().
+				Return("edge-cluster-kubeconfig-issuances", nil).
+				AnyTimes()
+
+			mockConfigurationService.
+				EXPECT().
+				GetEncryptionKeyRefreshInterval().
+				Return(time.Duration(0), nil)
+
+			keyRing = encryption.KeyRing{
+				PrimaryKeyID: "old",
+				Keys:         map[string][]byte{"old": newKey()},
+			}
+
+			mockKeyProviderService = encryptionMock.NewMockKeyProviderContract(mockCtrl)
+			mockKeyProviderService.
+				EXPECT().
+				GetKeyRing(gomock.Any()).
+				DoAndReturn(func(_ context.Context) (encryption.KeyRing, error) { return keyRing, nil }).
+				AnyTimes()
+
+			encryptionService, err := envelope.NewEnvelopeEncryptionService(mockConfigurationService, mockKeyProviderService)
+			Ω(err).Should(BeNil())
+
+			plainTextSut, err = mongodb.NewMongodbRepositoryService(mockConfigurationService, nil)
+			Ω(err).Should(BeNil())
+
+			encryptedSut, err = mongodb.NewMongodbRepositoryService(mockConfigurationService, encryptionService)
+			Ω(err).Should(BeNil())
+		})
+
+		When("an edge cluster is created", func() {
+			It("should store the cluster secret encrypted and decrypt it when the edge cluster is read", func() {
+				response, err := encryptedSut.CreateEdgeCluster(ctx, &createRequest)
+				Ω(err).Should(BeNil())
+
+				readResponse, err := encryptedSut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+					UserEmail:     createRequest.UserEmail,
+					EdgeClusterID: response.EdgeClusterID,
+				})
+				Ω(err).Should(BeNil())
+				assertEdgeCluster(readResponse.EdgeCluster, createRequest.EdgeCluster)
+
+				_, err = plainTextSut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+					UserEmail:     createRequest.UserEmail,
+					EdgeClusterID: response.EdgeClusterID,
+				})
+				Ω(err).Should(HaveOccurred())
+			})
+		})
+
+		When("the edge clusters of all the users are listed and one of them cannot be decrypted", func() {
+			It("should report the edge cluster as a failure and return the rest of the edge clusters", func() {
+				plainTextResponse, err := plainTextSut.CreateEdgeCluster(ctx, &createRequest)
+				Ω(err).Should(BeNil())
+
+				encryptedResponse, err := encryptedSut.CreateEdgeCluster(ctx, &createRequest)
+				Ω(err).Should(BeNil())
+
+				response, err := plainTextSut.ListAllEdgeClusters(ctx, &repository.ListAllEdgeClustersRequest{})
+				Ω(err).Should(BeNil())
+
+				edgeClusterIDs := []string{}
+				for _, edgeCluster := range response.EdgeClusters {
+					edgeClusterIDs = append(edgeClusterIDs, edgeCluster.EdgeClusterID)
+				}
+
+				Ω(edgeClusterIDs).Should(ContainElement(plainTextResponse.EdgeClusterID))
+				Ω(edgeClusterIDs).ShouldNot(ContainElement(encryptedResponse.EdgeClusterID))
+
+				var failure *repository.ListEdgeClusterFailure
+				for index := range response.Failures {
+					if response.Failures[index].EdgeClusterID == encryptedResponse.EdgeClusterID {
+						failure = &response.Failures[index]
+					}
+				}
+
+				Ω(failure).ShouldNot(BeNil())
+				Ω(failure.Err).Should(HaveOccurred())
+			})
+		})
+
+		When("the edge clusters stored in plain text are encrypted", func() {
+			It("should encrypt the edge clusters in batches and keep reading them", func() {
+				edgeClusterIDs := []string{}
+				for i := 0; i < 3; i++ {
+					response, err := plainTextSut.CreateEdgeCluster(ctx, &createRequest)
+					Ω(err).Should(BeNil())
+
+					edgeClusterIDs = append(edgeClusterIDs, response.EdgeClusterID)
+				}
+
+				response, err := encryptedSut.EncryptEdgeClusters(ctx, &repository.EncryptEdgeClustersRequest{BatchSize: 2})
+				Ω(err).Should(BeNil())
+				Ω(response.EncryptedCount).Should(Equal(2))
+				Ω(response.Failures).Should(BeEmpty())
+				Ω(response.Cursor).Should(Equal(edgeClusterIDs[1]))
+				Ω(response.HasMore).Should(BeTrue())
+
+				response, err = encryptedSut.EncryptEdgeClusters(ctx, &repository.EncryptEdgeClustersRequest{
+					BatchSize: 2,
+					After:     response.Cursor,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.EncryptedCount).Should(Equal(1))
+				Ω(response.HasMore).Should(BeFalse())
+
+				for _, edgeClusterID := range edgeClusterIDs {
+					readResponse, err := encryptedSut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+						UserEmail:     createRequest.UserEmail,
+						EdgeClusterID: edgeClusterID,
+					})
+					Ω(err).Should(BeNil())
+					assertEdgeCluster(readResponse.EdgeCluster, createRequest.EdgeCluster)
+				}
+			})
+		})
+
+		When("the keys are rotated", func() {
+			It("should only re-encrypt the edge clusters encrypted with the retired key", func() {
+				createResponse, err := encryptedSut.CreateEdgeCluster(ctx, &createRequest)
+				Ω(err).Should(BeNil())
+
+				response, err := encryptedSut.EncryptEdgeClusters(ctx, &repository.EncryptEdgeClustersRequest{BatchSize: 10})
+				Ω(err).Should(BeNil())
+				Ω(response.EncryptedCount).Should(Equal(0))
+
+				keyRing = encryption.KeyRing{
+					PrimaryKeyID: "new",
+					Keys:         map[string][]byte{"old": keyRing.Keys["old"], "new": newKey()},
+				}
+
+				response, err = encryptedSut.EncryptEdgeClusters(ctx, &repository.EncryptEdgeClustersRequest{BatchSize: 10})
+				Ω(err).Should(BeNil())
+				Ω(response.EncryptedCount).Should(Equal(1))
+				Ω(response.Cursor).Should(Equal(createResponse.EdgeClusterID))
+
+				// The retired key is no longer needed once every edge cluster is re-encrypted
+				delete(keyRing.Keys, "old")
+
+				readResponse, err := encryptedSut.ReadEdgeCluster(ctx, &repository.ReadEdgeClusterRequest{
+					UserEmail:     createRequest.UserEmail,
+					EdgeClusterID: createResponse.EdgeClusterID,
+				})
+				Ω(err).Should(BeNil())
+				assertEdgeCluster(readResponse.EdgeCluster, createRequest.EdgeCluster)
+			})
+		})
+	})
+
+	Context("kubeconfig is issued to the user of an edge cluster", func() {
+		var (
+			issuance   models.KubeconfigIssuance
+			issuanceID string
+		)
+
+		BeforeEach(func() {
+			issuedAt := time.Now().UTC().Truncate(time.Millisecond)
+			issuance = models.KubeconfigIssuance{
+				EdgeClusterID: cuid.New(),
+				UserEmail:     createRequest.UserEmail,
+				Role:          models.KubeconfigRoleEdit,
+				Namespace:     cuid.New(),
+				IssuedAt:      issuedAt,
+				ExpiresAt:     issuedAt.Add(time.Hour),
+			}
+
+			response, err := sut.CreateKubeconfigIssuance(ctx, &repository.CreateKubeconfigIssuanceRequest{Issuance: issuance})
+			Ω(err).Should(BeNil())
+			issuanceID = response.IssuanceID
+		})
+
+		When("user reads the issuance", func() {
+			It("should return the issuance", func() {
+				response, err := sut.ReadKubeconfigIssuance(ctx, &repository.ReadKubeconfigIssuanceRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Issuance).Should(Equal(issuance))
+			})
+
+			It("should return NotFoundError when the kubeconfig was issued to another user", func() {
+				_, err := sut.ReadKubeconfigIssuance(ctx, &repository.ReadKubeconfigIssuanceRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+				})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+
+		When("user lists the issuances of the edge cluster", func() {
+			It("should return the issuances in the order they were issued", func() {
+				secondIssuance := issuance
+				secondIssuance.IssuedAt = issuance.IssuedAt.Add(time.Minute)
+				secondIssuance.Role = models.KubeconfigRoleView
+
+				createResponse, err := sut.CreateKubeconfigIssuance(
+					ctx,
+					&repository.CreateKubeconfigIssuanceRequest{Issuance: secondIssuance})
+				Ω(err).Should(BeNil())
+
+				response, err := sut.ListKubeconfigIssuances(ctx, &repository.ListKubeconfigIssuancesRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: issuance.EdgeClusterID,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Issuances).Should(Equal([]models.KubeconfigIssuanceWithID{
+					{IssuanceID: issuanceID, Issuance: issuance},
+					{IssuanceID: createResponse.IssuanceID, Issuance: secondIssuance},
+				}))
+			})
+		})
+
+		When("the expiry of the issuance is updated", func() {
+			It("should record the new expiry time", func() {
+				expiresAt := issuance.IssuedAt.Add(30 * time.Minute)
+				response, err := sut.UpdateKubeconfigIssuanceExpiry(ctx, &repository.UpdateKubeconfigIssuanceExpiryRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+					ExpiresAt:     expiresAt,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Issuance.ExpiresAt).Should(Equal(expiresAt))
+			})
+
+			It("should return NotFoundError when the kubeconfig was issued to another user", func() {
+				_, err := sut.UpdateKubeconfigIssuanceExpiry(ctx, &repository.UpdateKubeconfigIssuanceExpiryRequest{
+					UserEmail:     cuid.New() + "@test.com",
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+					ExpiresAt:     time.Now(),
+				})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the expired issuances are listed", func() {
+			It("should return the issuance once it expired until it is revoked", func() {
+				listExpired := func(expiredBefore time.Time) []models.KubeconfigIssuanceWithID {
+					response, err := sut.ListExpiredKubeconfigIssuances(
+						ctx,
+						&repository.ListExpiredKubeconfigIssuancesRequest{ExpiredBefore: expiredBefore})
+					Ω(err).Should(BeNil())
+
+					return response.Issuances
+				}
+
+				expected := models.KubeconfigIssuanceWithID{IssuanceID: issuanceID, Issuance: issuance}
+				Ω(listExpired(issuance.ExpiresAt)).ShouldNot(ContainElement(expected))
+				Ω(listExpired(issuance.ExpiresAt.Add(time.Minute))).Should(ContainElement(expected))
+
+				_, err := sut.RevokeKubeconfigIssuance(ctx, &repository.RevokeKubeconfigIssuanceRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+					RevokedAt:     time.Now(),
+				})
+				Ω(err).Should(BeNil())
+
+				for _, expiredIssuance := range listExpired(issuance.ExpiresAt.Add(time.Minute)) {
+					Ω(expiredIssuance.IssuanceID).ShouldNot(Equal(issuanceID))
+				}
+			})
+		})
+
+		When("user revokes the issuance", func() {
+			It("should record the issuance as revoked", func() {
+				revokedAt := time.Now().UTC().Truncate(time.Millisecond)
+				response, err := sut.RevokeKubeconfigIssuance(ctx, &repository.RevokeKubeconfigIssuanceRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+					RevokedAt:     revokedAt,
+				})
+				Ω(err).Should(BeNil())
+				Ω(response.Issuance.RevokedAt).Should(Equal(revokedAt))
+
+				readResponse, err := sut.ReadKubeconfigIssuance(ctx, &repository.ReadKubeconfigIssuanceRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: issuance.EdgeClusterID,
+					IssuanceID:    issuanceID,
+				})
+				Ω(err).Should(BeNil())
+				Ω(readResponse.Issuance.RevokedAt).Should(Equal(revokedAt))
+			})
+
+			It("should return NotFoundError when the kubeconfig was issued for another edge cluster", func() {
+				_, err := sut.RevokeKubeconfigIssuance(ctx, &repository.RevokeKubeconfigIssuanceRequest{
+					UserEmail:     issuance.UserEmail,
+					EdgeClusterID: cuid.New(),
+					IssuanceID:    issuanceID,
+					RevokedAt:     time.Now(),
+				})
+				Ω(commonErrors.IsNotFoundError(err)).Should(BeTrue())
+			})
+		})
+	})
 })
 
 func assertEdgeCluster(edgeCluster, expectedEdgeCluster models.EdgeCluster) {
@@ -483,4 +936,5 @@ func assertEdgeCluster(edgeCluster, expectedEdgeCluster models.EdgeCluster) {
 	Ω(edgeCluster.ProjectID).Should(Equal(expectedEdgeCluster.ProjectID))
 	Ω(edgeCluster.ClusterSecret).Should(Equal(expectedEdgeCluster.ClusterSecret))
 	Ω(edgeCluster.ClusterType).Should(Equal(expectedEdgeCluster.ClusterType))
+	Ω(edgeCluster.ServiceExposure).Should(Equal(expectedEdgeCluster.ServiceExposure))
 }
@@ -94,9 +94,10 @@ func encodeReadEdgeClusterResponse(
 		}
 
 		return &edgeClusterGRPCContract.ReadEdgeClusterResponse{
-			Error:           edgeClusterGRPCContract.Error_NO_ERROR,
-			EdgeCluster:     edgeCluster,
-			ProvisionDetail: mapFromProvisionDetails(castedResponse.ProvisionDetails),
+			Error:             edgeClusterGRPCContract.Error_NO_ERROR,
+			EdgeCluster:       edgeCluster,
+			ProvisionDetail:   mapFromProvisionDetails(castedResponse.ProvisionDetails),
+			ProvisioningState: mapFromProvisioningState(castedResponse.ProvisioningState),
 		}, nil
 	}
 
@@ -261,10 +262,11 @@ func encodeListEdgeClustersResponse(
 				mappedEdgeCluster, _ := mapFromEdgeCluster(edgeCluster.EdgeCluster)
 
 				return &edgeClusterGRPCContract.EdgeClusterWithCursor{
-					EdgeClusterID:   edgeCluster.EdgeClusterID,
-					EdgeCluster:     mappedEdgeCluster,
-					Cursor:          edgeCluster.Cursor,
-					ProvisionDetail: mapFromProvisionDetails(edgeCluster.ProvisionDetails),
+					EdgeClusterID:     edgeCluster.EdgeClusterID,
+					EdgeCluster:       mappedEdgeCluster,
+					Cursor:            edgeCluster.Cursor,
+					ProvisionDetail:   mapFromProvisionDetails(edgeCluster.ProvisionDetails),
+					ProvisioningState: mapFromProvisioningState(edgeCluster.ProvisioningState),
 				}
 			}).([]*edgeClusterGRPCContract.EdgeClusterWithCursor),
 		}, nil
@@ -387,6 +389,644 @@ func encodeListEdgeClusterServicesResponse(
 	}, nil
 }
 
+// decodeWatchEdgeClusterRequest decodes WatchEdgeCluster request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeWatchEdgeClusterRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.WatchEdgeClusterRequest)
+
+	return &business.WatchEdgeClusterRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+	}, nil
+}
+
+// encodeWatchEdgeClusterResponse returns the WatchEdgeCluster business response as is, as the events are
+// mapped to GRPC objects one by one while they are streamed to the client
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the response or error if something goes wrong
+func encodeWatchEdgeClusterResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	return response.(*business.WatchEdgeClusterResponse), nil
+}
+
+// decodeListSupportedClusterTypesRequest decodes ListSupportedClusterTypes request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListSupportedClusterTypesRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.ListSupportedClusterTypesRequest{}, nil
+}
+
+// encodeListSupportedClusterTypesResponse encodes ListSupportedClusterTypes response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListSupportedClusterTypesResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListSupportedClusterTypesResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.ListSupportedClusterTypesResponse{
+			Error:        edgeClusterGRPCContract.Error_NO_ERROR,
+			ClusterTypes: mapFromClusterTypeDescriptors(castedResponse.ClusterTypes),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.ListSupportedClusterTypesResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeInstallHelmReleaseRequest decodes InstallHelmRelease request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeInstallHelmReleaseRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.InstallHelmReleaseRequest)
+
+	return &business.InstallHelmReleaseRequest{
+		EdgeClusterID:    castedRequest.EdgeClusterID,
+		Namespace:        castedRequest.Namespace,
+		ReleaseName:      castedRequest.ReleaseName,
+		RepositoryName:   castedRequest.RepositoryName,
+		RepositoryURL:    castedRequest.RepositoryURL,
+		Chart:            castedRequest.Chart,
+		Version:          castedRequest.Version,
+		Values:           castedRequest.Values,
+		Set:              castedRequest.Set,
+		SetString:        castedRequest.SetString,
+		SetFile:          castedRequest.SetFile,
+		Atomic:           castedRequest.Atomic,
+		Wait:             castedRequest.Wait,
+		Timeout:          castedRequest.Timeout.AsDuration(),
+		DependencyUpdate: castedRequest.DependencyUpdate,
+	}, nil
+}
+
+// encodeInstallHelmReleaseResponse encodes InstallHelmRelease response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeInstallHelmReleaseResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.InstallHelmReleaseResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.InstallHelmReleaseResponse{
+			Error:   edgeClusterGRPCContract.Error_NO_ERROR,
+			Release: mapFromHelmRelease(castedResponse.Release),
+			History: mapFromHelmReleases(castedResponse.History),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.InstallHelmReleaseResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeUpgradeHelmReleaseRequest decodes UpgradeHelmRelease request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeUpgradeHelmReleaseRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.UpgradeHelmReleaseRequest)
+
+	return &business.UpgradeHelmReleaseRequest{
+		EdgeClusterID:    castedRequest.EdgeClusterID,
+		Namespace:        castedRequest.Namespace,
+		ReleaseName:      castedRequest.ReleaseName,
+		RepositoryName:   castedRequest.RepositoryName,
+		RepositoryURL:    castedRequest.RepositoryURL,
+		Chart:            castedRequest.Chart,
+		Version:          castedRequest.Version,
+		Values:           castedRequest.Values,
+		Set:              castedRequest.Set,
+		SetString:        castedRequest.SetString,
+		SetFile:          castedRequest.SetFile,
+		Atomic:           castedRequest.Atomic,
+		Wait:             castedRequest.Wait,
+		Timeout:          castedRequest.Timeout.AsDuration(),
+		DependencyUpdate: castedRequest.DependencyUpdate,
+	}, nil
+}
+
+// encodeUpgradeHelmReleaseResponse encodes UpgradeHelmRelease response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeUpgradeHelmReleaseResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.UpgradeHelmReleaseResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.UpgradeHelmReleaseResponse{
+			Error:   edgeClusterGRPCContract.Error_NO_ERROR,
+			Release: mapFromHelmRelease(castedResponse.Release),
+			History: mapFromHelmReleases(castedResponse.History),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.UpgradeHelmReleaseResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRollbackHelmReleaseRequest decodes RollbackHelmRelease request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRollbackHelmReleaseRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.RollbackHelmReleaseRequest)
+
+	return &business.RollbackHelmReleaseRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+		Namespace:     castedRequest.Namespace,
+		ReleaseName:   castedRequest.ReleaseName,
+		Revision:      int(castedRequest.Revision),
+	}, nil
+}
+
+// encodeRollbackHelmReleaseResponse encodes RollbackHelmRelease response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRollbackHelmReleaseResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RollbackHelmReleaseResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.RollbackHelmReleaseResponse{
+			Error:   edgeClusterGRPCContract.Error_NO_ERROR,
+			Release: mapFromHelmRelease(castedResponse.Release),
+			History: mapFromHelmReleases(castedResponse.History),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.RollbackHelmReleaseResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeUninstallHelmReleaseRequest decodes UninstallHelmRelease request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeUninstallHelmReleaseRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.UninstallHelmReleaseRequest)
+
+	return &business.UninstallHelmReleaseRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+		Namespace:     castedRequest.Namespace,
+		ReleaseName:   castedRequest.ReleaseName,
+		DisableHooks:  castedRequest.DisableHooks,
+		Timeout:       castedRequest.Timeout.AsDuration(),
+	}, nil
+}
+
+// encodeUninstallHelmReleaseResponse encodes UninstallHelmRelease response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeUninstallHelmReleaseResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.UninstallHelmReleaseResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.UninstallHelmReleaseResponse{
+			Error: edgeClusterGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.UninstallHelmReleaseResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListHelmReleasesRequest decodes ListHelmReleases request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListHelmReleasesRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.ListHelmReleasesRequest)
+
+	return &business.ListHelmReleasesRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+		Namespace:     castedRequest.Namespace,
+	}, nil
+}
+
+// encodeListHelmReleasesResponse encodes ListHelmReleases response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListHelmReleasesResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListHelmReleasesResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.ListHelmReleasesResponse{
+			Error:    edgeClusterGRPCContract.Error_NO_ERROR,
+			Releases: mapFromHelmReleases(castedResponse.Releases),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.ListHelmReleasesResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeAddHelmRepositoryRequest decodes AddHelmRepository request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeAddHelmRepositoryRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.AddHelmRepositoryRequest)
+
+	return &business.AddHelmRepositoryRequest{
+		Name: castedRequest.Name,
+		URL:  castedRequest.Url,
+	}, nil
+}
+
+// encodeAddHelmRepositoryResponse encodes AddHelmRepository response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeAddHelmRepositoryResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.AddHelmRepositoryResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.AddHelmRepositoryResponse{
+			Error:      edgeClusterGRPCContract.Error_NO_ERROR,
+			Repository: mapFromHelmRepository(castedResponse.Repository),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.AddHelmRepositoryResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRemoveHelmRepositoryRequest decodes RemoveHelmRepository request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRemoveHelmRepositoryRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.RemoveHelmRepositoryRequest)
+
+	return &business.RemoveHelmRepositoryRequest{
+		Name: castedRequest.Name,
+	}, nil
+}
+
+// encodeRemoveHelmRepositoryResponse encodes RemoveHelmRepository response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRemoveHelmRepositoryResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RemoveHelmRepositoryResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.RemoveHelmRepositoryResponse{
+			Error: edgeClusterGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.RemoveHelmRepositoryResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListHelmRepositoriesRequest decodes ListHelmRepositories request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListHelmRepositoriesRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.ListHelmRepositoriesRequest{}, nil
+}
+
+// encodeListHelmRepositoriesResponse encodes ListHelmRepositories response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListHelmRepositoriesResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListHelmRepositoriesResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.ListHelmRepositoriesResponse{
+			Error:        edgeClusterGRPCContract.Error_NO_ERROR,
+			Repositories: mapFromHelmRepositories(castedResponse.Repositories),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.ListHelmRepositoriesResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeUpdateHelmRepositoriesRequest decodes UpdateHelmRepositories request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeUpdateHelmRepositoriesRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.UpdateHelmRepositoriesRequest{}, nil
+}
+
+// encodeUpdateHelmRepositoriesResponse encodes UpdateHelmRepositories response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeUpdateHelmRepositoriesResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.UpdateHelmRepositoriesResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.UpdateHelmRepositoriesResponse{
+			Error:        edgeClusterGRPCContract.Error_NO_ERROR,
+			Repositories: mapFromHelmRepositories(castedResponse.Repositories),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.UpdateHelmRepositoriesResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListOrphanedNamespacesRequest decodes ListOrphanedNamespaces request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListOrphanedNamespacesRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	return &business.ListOrphanedNamespacesRequest{}, nil
+}
+
+// encodeListOrphanedNamespacesResponse encodes ListOrphanedNamespaces response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListOrphanedNamespacesResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListOrphanedNamespacesResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.ListOrphanedNamespacesResponse{
+			Error:      edgeClusterGRPCContract.Error_NO_ERROR,
+			Namespaces: mapFromOrphanedNamespaces(castedResponse.Namespaces),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.ListOrphanedNamespacesResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeDeleteOrphanedNamespaceRequest decodes DeleteOrphanedNamespace request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeDeleteOrphanedNamespaceRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.DeleteOrphanedNamespaceRequest)
+
+	return &business.DeleteOrphanedNamespaceRequest{
+		Name: castedRequest.Name,
+	}, nil
+}
+
+// encodeDeleteOrphanedNamespaceResponse encodes DeleteOrphanedNamespace response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeDeleteOrphanedNamespaceResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.DeleteOrphanedNamespaceResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.DeleteOrphanedNamespaceResponse{
+			Error: edgeClusterGRPCContract.Error_NO_ERROR,
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.DeleteOrphanedNamespaceResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeAdoptOrphanedNamespaceRequest decodes AdoptOrphanedNamespace request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeAdoptOrphanedNamespaceRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.AdoptOrphanedNamespaceRequest)
+
+	return &business.AdoptOrphanedNamespaceRequest{
+		Name: castedRequest.Name,
+	}, nil
+}
+
+// encodeAdoptOrphanedNamespaceResponse encodes AdoptOrphanedNamespace response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeAdoptOrphanedNamespaceResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.AdoptOrphanedNamespaceResponse)
+
+	if castedResponse.Err == nil {
+		edgeCluster, err := mapFromEdgeCluster(castedResponse.EdgeCluster)
+		if err != nil {
+			return nil, err
+		}
+
+		return &edgeClusterGRPCContract.AdoptOrphanedNamespaceResponse{
+			Error:         edgeClusterGRPCContract.Error_NO_ERROR,
+			EdgeClusterID: castedResponse.EdgeClusterID,
+			EdgeCluster:   edgeCluster,
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.AdoptOrphanedNamespaceResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeIssueKubeconfigRequest decodes IssueKubeconfig request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeIssueKubeconfigRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.IssueKubeconfigRequest)
+
+	return &business.IssueKubeconfigRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+		Role:          models.KubeconfigRole(castedRequest.Role),
+		Namespace:     castedRequest.Namespace,
+		TTL:           castedRequest.Ttl.AsDuration(),
+	}, nil
+}
+
+// encodeIssueKubeconfigResponse encodes IssueKubeconfig response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeIssueKubeconfigResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.IssueKubeconfigResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.IssueKubeconfigResponse{
+			Error:             edgeClusterGRPCContract.Error_NO_ERROR,
+			Issuance:          mapFromKubeconfigIssuance(castedResponse.IssuanceID, castedResponse.Issuance),
+			KubeconfigContent: castedResponse.KubeconfigContent,
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.IssueKubeconfigResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeRevokeKubeconfigRequest decodes RevokeKubeconfig request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeRevokeKubeconfigRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.RevokeKubeconfigRequest)
+
+	return &business.RevokeKubeconfigRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+		IssuanceID:    castedRequest.IssuanceID,
+	}, nil
+}
+
+// encodeRevokeKubeconfigResponse encodes RevokeKubeconfig response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeRevokeKubeconfigResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.RevokeKubeconfigResponse)
+
+	if castedResponse.Err == nil {
+		return &edgeClusterGRPCContract.RevokeKubeconfigResponse{
+			Error:    edgeClusterGRPCContract.Error_NO_ERROR,
+			Issuance: mapFromKubeconfigIssuance(castedResponse.IssuanceID, castedResponse.Issuance),
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.RevokeKubeconfigResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
+// decodeListKubeconfigIssuancesRequest decodes ListKubeconfigIssuances request message from GRPC object to business object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the GRPC request
+// Returns either the decoded request or error if something goes wrong
+func decodeListKubeconfigIssuancesRequest(
+	ctx context.Context,
+	request interface{}) (interface{}, error) {
+	castedRequest := request.(*edgeClusterGRPCContract.ListKubeconfigIssuancesRequest)
+
+	return &business.ListKubeconfigIssuancesRequest{
+		EdgeClusterID: castedRequest.EdgeClusterID,
+	}, nil
+}
+
+// encodeListKubeconfigIssuancesResponse encodes ListKubeconfigIssuances response from business object to GRPC object
+// context: Optional The reference to the context
+// request: Mandatory. The reference to the business response
+// Returns either the decoded response or error if something goes wrong
+func encodeListKubeconfigIssuancesResponse(
+	ctx context.Context,
+	response interface{}) (interface{}, error) {
+	castedResponse := response.(*business.ListKubeconfigIssuancesResponse)
+
+	if castedResponse.Err == nil {
+		issuances := make([]*edgeClusterGRPCContract.KubeconfigIssuance, 0, len(castedResponse.Issuances))
+		for _, issuance := range castedResponse.Issuances {
+			issuances = append(issuances, mapFromKubeconfigIssuance(issuance.IssuanceID, issuance.Issuance))
+		}
+
+		return &edgeClusterGRPCContract.ListKubeconfigIssuancesResponse{
+			Error:     edgeClusterGRPCContract.Error_NO_ERROR,
+			Issuances: issuances,
+		}, nil
+	}
+
+	return &edgeClusterGRPCContract.ListKubeconfigIssuancesResponse{
+		Error:        mapError(castedResponse.Err),
+		ErrorMessage: castedResponse.Err.Error(),
+	}, nil
+}
+
 func mapError(err error) edgeClusterGRPCContract.Error {
 	if commonErrors.IsUnknownError(err) {
 		return edgeClusterGRPCContract.Error_UNKNOWN
@@ -404,15 +1044,24 @@ func mapError(err error) edgeClusterGRPCContract.Error {
 		return edgeClusterGRPCContract.Error_BAD_REQUEST
 	}
 
+	if business.IsPermissionDeniedError(err) {
+		return edgeClusterGRPCContract.Error_PERMISSION_DENIED
+	}
+
 	return edgeClusterGRPCContract.Error_UNKNOWN
 }
 
 func mapToEdgeCluster(grpcEdgeCluster *edgeClusterGRPCContract.EdgeCluster) (edgeCluster models.EdgeCluster, err error) {
 	var clusterType models.ClusterType
 
-	if grpcEdgeCluster.ClusterType == edgeClusterGRPCContract.ClusterType_K3S {
+	switch grpcEdgeCluster.ClusterType {
+	case edgeClusterGRPCContract.ClusterType_K3S:
 		clusterType = models.K3S
-	} else {
+	case edgeClusterGRPCContract.ClusterType_K0S:
+		clusterType = models.K0S
+	case edgeClusterGRPCContract.ClusterType_VCLUSTER:
+		clusterType = models.VCluster
+	default:
 		err = fmt.Errorf("cluster type is not supported: %v", grpcEdgeCluster.ClusterType)
 
 		return
@@ -425,15 +1074,28 @@ func mapToEdgeCluster(grpcEdgeCluster *edgeClusterGRPCContract.EdgeCluster) (edg
 		ClusterType:   clusterType,
 	}
 
+	// The edge clusters are exposed through a load balancer unless requested otherwise
+	if grpcEdgeCluster.ServiceExposure != nil {
+		edgeCluster.ServiceExposure = models.ServiceExposure{
+			Mode:    models.ServiceExposureMode(grpcEdgeCluster.ServiceExposure.Mode),
+			Address: grpcEdgeCluster.ServiceExposure.Address,
+		}
+	}
+
 	return
 }
 
 func mapFromEdgeCluster(edgeCluster models.EdgeCluster) (grpcEdgeCluster *edgeClusterGRPCContract.EdgeCluster, err error) {
 	var clusterType edgeClusterGRPCContract.ClusterType
 
-	if edgeCluster.ClusterType == models.K3S {
+	switch edgeCluster.ClusterType {
+	case models.K3S:
 		clusterType = edgeClusterGRPCContract.ClusterType_K3S
-	} else {
+	case models.K0S:
+		clusterType = edgeClusterGRPCContract.ClusterType_K0S
+	case models.VCluster:
+		clusterType = edgeClusterGRPCContract.ClusterType_VCLUSTER
+	default:
 		err = fmt.Errorf("cluster type is not supported: %v", edgeCluster.ClusterType)
 
 		return
@@ -444,11 +1106,44 @@ func mapFromEdgeCluster(edgeCluster models.EdgeCluster) (grpcEdgeCluster *edgeCl
 		Name:          edgeCluster.Name,
 		ClusterSecret: edgeCluster.ClusterSecret,
 		ClusterType:   clusterType,
+		ServiceExposure: &edgeClusterGRPCContract.ServiceExposure{
+			Mode:    edgeClusterGRPCContract.ServiceExposureMode(edgeCluster.ServiceExposure.Mode),
+			Address: edgeCluster.ServiceExposure.Address,
+		},
 	}
 
 	return
 }
 
+func mapFromProvisioningState(provisioningState models.ProvisioningState) *edgeClusterGRPCContract.ProvisioningState {
+	return &edgeClusterGRPCContract.ProvisioningState{
+		Status:           edgeClusterGRPCContract.ProvisioningStatus(provisioningState.Status),
+		LastErrorMessage: provisioningState.LastErrorMessage,
+		CreatedAt:        &timestamppb.Timestamp{Seconds: provisioningState.CreatedAt.Unix()},
+		UpdatedAt:        &timestamppb.Timestamp{Seconds: provisioningState.UpdatedAt.Unix()},
+	}
+}
+
+func mapFromClusterTypeDescriptors(descriptors []models.ClusterTypeDescriptor) []*edgeClusterGRPCContract.ClusterTypeDescriptor {
+	return funk.Map(descriptors, func(descriptor models.ClusterTypeDescriptor) *edgeClusterGRPCContract.ClusterTypeDescriptor {
+		return &edgeClusterGRPCContract.ClusterTypeDescriptor{
+			ClusterType:       edgeClusterGRPCContract.ClusterType(descriptor.ClusterType),
+			Name:              descriptor.Name,
+			SupportedFeatures: descriptor.SupportedFeatures,
+			DefaultCharts:     descriptor.DefaultCharts,
+		}
+	}).([]*edgeClusterGRPCContract.ClusterTypeDescriptor)
+}
+
+func mapFromEdgeClusterEvent(edgeClusterEvent models.EdgeClusterEvent) *edgeClusterGRPCContract.EdgeClusterEvent {
+	return &edgeClusterGRPCContract.EdgeClusterEvent{
+		Type:      edgeClusterGRPCContract.EdgeClusterEventType(edgeClusterEvent.Type),
+		Status:    edgeClusterGRPCContract.ProvisioningStatus(edgeClusterEvent.Status),
+		Message:   edgeClusterEvent.Message,
+		Timestamp: &timestamppb.Timestamp{Seconds: edgeClusterEvent.Timestamp.Unix()},
+	}
+}
+
 func mapFromNodeStatus(nodes []models.EdgeClusterNode) []*edgeClusterGRPCContract.EdgeClusterNode {
 	return funk.Map(nodes, func(node models.EdgeClusterNode) *edgeClusterGRPCContract.EdgeClusterNode {
 		conditions := funk.Map(node.Node.Status.Conditions, func(condition v1.NodeCondition) *edgeClusterGRPCContract.NodeCondition {
@@ -614,3 +1309,100 @@ func mapFromProvisionDetails(from models.ProvisionDetails) (mappedValues *edgeCl
 
 	return &provisionDetails
 }
+
+func mapFromHelmRelease(release models.HelmRelease) *edgeClusterGRPCContract.HelmRelease {
+	return &edgeClusterGRPCContract.HelmRelease{
+		Name:          release.Name,
+		Namespace:     release.Namespace,
+		Revision:      int32(release.Revision),
+		Status:        release.Status,
+		Description:   release.Description,
+		ChartName:     release.ChartName,
+		ChartVersion:  release.ChartVersion,
+		AppVersion:    release.AppVersion,
+		FirstDeployed: &timestamppb.Timestamp{Seconds: release.FirstDeployed.Unix()},
+		LastDeployed:  &timestamppb.Timestamp{Seconds: release.LastDeployed.Unix()},
+	}
+}
+
+func mapFromHelmReleases(releases []models.HelmRelease) []*edgeClusterGRPCContract.HelmRelease {
+	mappedReleases := make([]*edgeClusterGRPCContract.HelmRelease, 0, len(releases))
+	for _, release := range releases {
+		mappedReleases = append(mappedReleases, mapFromHelmRelease(release))
+	}
+
+	return mappedReleases
+}
+
+func mapFromHelmRepository(repository models.HelmRepository) *edgeClusterGRPCContract.HelmRepository {
+	mappedRepository := &edgeClusterGRPCContract.HelmRepository{
+		Name: repository.Name,
+		Url:  repository.URL,
+	}
+
+	if !repository.LastIndexRefreshTime.IsZero() {
+		mappedRepository.LastIndexRefreshTime = &timestamppb.Timestamp{Seconds: repository.LastIndexRefreshTime.Unix()}
+	}
+
+	return mappedRepository
+}
+
+func mapFromHelmRepositories(repositories []models.HelmRepository) []*edgeClusterGRPCContract.HelmRepository {
+	mappedRepositories := make([]*edgeClusterGRPCContract.HelmRepository, 0, len(repositories))
+	for _, repository := range repositories {
+		mappedRepositories = append(mappedRepositories, mapFromHelmRepository(repository))
+	}
+
+	return mappedRepositories
+}
+
+func mapFromOrphanedNamespace(namespace models.OrphanedNamespace) *edgeClusterGRPCContract.OrphanedNamespace {
+	mappedNamespace := &edgeClusterGRPCContract.OrphanedNamespace{
+		Name:      namespace.Name,
+		CreatedAt: &timestamppb.Timestamp{Seconds: namespace.CreationTime.Unix()},
+		Adoptable: namespace.Adoptable,
+	}
+
+	if ownership := namespace.Ownership; ownership != nil {
+		mappedNamespace.EdgeClusterID = ownership.EdgeClusterID
+		mappedNamespace.UserEmail = ownership.UserEmail
+		mappedNamespace.ServiceVersion = ownership.ServiceVersion
+
+		if edgeCluster, err := mapFromEdgeCluster(models.EdgeCluster{
+			ProjectID:   ownership.ProjectID,
+			Name:        ownership.EdgeClusterName,
+			ClusterType: ownership.ClusterType,
+		}); err == nil {
+			mappedNamespace.EdgeCluster = edgeCluster
+		}
+	}
+
+	return mappedNamespace
+}
+
+func mapFromOrphanedNamespaces(namespaces []models.OrphanedNamespace) []*edgeClusterGRPCContract.OrphanedNamespace {
+	mappedNamespaces := make([]*edgeClusterGRPCContract.OrphanedNamespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		mappedNamespaces = append(mappedNamespaces, mapFromOrphanedNamespace(namespace))
+	}
+
+	return mappedNamespaces
+}
+
+func mapFromKubeconfigIssuance(issuanceID string, issuance models.KubeconfigIssuance) *edgeClusterGRPCContract.KubeconfigIssuance {
+	mappedIssuance := &edgeClusterGRPCContract.KubeconfigIssuance{
+		IssuanceID:    issuanceID,
+		EdgeClusterID: issuance.EdgeClusterID,
+		Role:          edgeClusterGRPCContract.KubeconfigRole(issuance.Role),
+		Namespace:     issuance.Namespace,
+		IssuedAt:      &timestamppb.Timestamp{Seconds: issuance.IssuedAt.Unix()},
+		ExpiresAt:     &timestamppb.Timestamp{Seconds: issuance.ExpiresAt.Unix()},
+		Revoked:       !issuance.RevokedAt.IsZero(),
+	}
+
+	if mappedIssuance.Revoked {
+		mappedIssuance.RevokedAt = &timestamppb.Timestamp{Seconds: issuance.RevokedAt.Unix()}
+	}
+
+	return mappedIssuance
+}
@@ -7,6 +7,7 @@ import (
 	"net"
 
 	edgeClusterGRPCContract "github.com/decentralized-cloud/edge-cluster/contract/grpc/go"
+	"github.com/decentralized-cloud/edge-cluster/services/business"
 	"github.com/decentralized-cloud/edge-cluster/services/configuration"
 	"github.com/decentralized-cloud/edge-cluster/services/endpoint"
 	"github.com/decentralized-cloud/edge-cluster/services/transport"
@@ -18,19 +19,36 @@ import (
 )
 
 type transportService struct {
-	logger                         *zap.Logger
-	configurationService           configuration.ConfigurationContract
-	endpointCreatorService         endpoint.EndpointCreatorContract
-	middlewareProviderService      middleware.MiddlewareProviderContract
-	jwksURL                        string
-	createEdgeClusterHandler       gokitgrpc.Handler
-	readEdgeClusterHandler         gokitgrpc.Handler
-	updateEdgeClusterHandler       gokitgrpc.Handler
-	deleteEdgeClusterHandler       gokitgrpc.Handler
-	ListEdgeClustersHandler        gokitgrpc.Handler
-	listEdgeClusterNodesHandler    gokitgrpc.Handler
-	listEdgeClusterPodsHandler     gokitgrpc.Handler
-	listEdgeClusterServicesHandler gokitgrpc.Handler
+	logger                           *zap.Logger
+	configurationService             configuration.ConfigurationContract
+	endpointCreatorService           endpoint.EndpointCreatorContract
+	middlewareProviderService        middleware.MiddlewareProviderContract
+	jwksURL                          string
+	createEdgeClusterHandler         gokitgrpc.Handler
+	readEdgeClusterHandler           gokitgrpc.Handler
+	updateEdgeClusterHandler         gokitgrpc.Handler
+	deleteEdgeClusterHandler         gokitgrpc.Handler
+	ListEdgeClustersHandler          gokitgrpc.Handler
+	listEdgeClusterNodesHandler      gokitgrpc.Handler
+	listEdgeClusterPodsHandler       gokitgrpc.Handler
+	listEdgeClusterServicesHandler   gokitgrpc.Handler
+	watchEdgeClusterHandler          gokitgrpc.Handler
+	listSupportedClusterTypesHandler gokitgrpc.Handler
+	installHelmReleaseHandler        gokitgrpc.Handler
+	upgradeHelmReleaseHandler        gokitgrpc.Handler
+	rollbackHelmReleaseHandler       gokitgrpc.Handler
+	uninstallHelmReleaseHandler      gokitgrpc.Handler
+	listHelmReleasesHandler          gokitgrpc.Handler
+	addHelmRepositoryHandler         gokitgrpc.Handler
+	removeHelmRepositoryHandler      gokitgrpc.Handler
+	listHelmRepositoriesHandler      gokitgrpc.Handler
+	updateHelmRepositoriesHandler    gokitgrpc.Handler
+	listOrphanedNamespacesHandler    gokitgrpc.Handler
+	deleteOrphanedNamespaceHandler   gokitgrpc.Handler
+	adoptOrphanedNamespaceHandler    gokitgrpc.Handler
+	issueKubeconfigHandler           gokitgrpc.Handler
+	revokeKubeconfigHandler          gokitgrpc.Handler
+	listKubeconfigIssuancesHandler   gokitgrpc.Handler
 }
 
 var Live bool
@@ -197,6 +215,158 @@ func (service *transportService) setupHandlers() {
 		encodeListEdgeClusterServicesResponse,
 	)
 
+	endpoint = service.endpointCreatorService.WatchEdgeClusterEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("WatchEdgeCluster")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.watchEdgeClusterHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeWatchEdgeClusterRequest,
+		encodeWatchEdgeClusterResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListSupportedClusterTypesEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListSupportedClusterTypes")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listSupportedClusterTypesHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListSupportedClusterTypesRequest,
+		encodeListSupportedClusterTypesResponse,
+	)
+
+	endpoint = service.endpointCreatorService.InstallHelmReleaseEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("InstallHelmRelease")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.installHelmReleaseHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeInstallHelmReleaseRequest,
+		encodeInstallHelmReleaseResponse,
+	)
+
+	endpoint = service.endpointCreatorService.UpgradeHelmReleaseEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("UpgradeHelmRelease")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.upgradeHelmReleaseHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeUpgradeHelmReleaseRequest,
+		encodeUpgradeHelmReleaseResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RollbackHelmReleaseEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RollbackHelmRelease")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.rollbackHelmReleaseHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRollbackHelmReleaseRequest,
+		encodeRollbackHelmReleaseResponse,
+	)
+
+	endpoint = service.endpointCreatorService.UninstallHelmReleaseEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("UninstallHelmRelease")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.uninstallHelmReleaseHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeUninstallHelmReleaseRequest,
+		encodeUninstallHelmReleaseResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListHelmReleasesEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListHelmReleases")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listHelmReleasesHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListHelmReleasesRequest,
+		encodeListHelmReleasesResponse,
+	)
+
+	endpoint = service.endpointCreatorService.AddHelmRepositoryEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("AddHelmRepository")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.addHelmRepositoryHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeAddHelmRepositoryRequest,
+		encodeAddHelmRepositoryResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RemoveHelmRepositoryEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RemoveHelmRepository")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.removeHelmRepositoryHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRemoveHelmRepositoryRequest,
+		encodeRemoveHelmRepositoryResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListHelmRepositoriesEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListHelmRepositories")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listHelmRepositoriesHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListHelmRepositoriesRequest,
+		encodeListHelmRepositoriesResponse,
+	)
+
+	endpoint = service.endpointCreatorService.UpdateHelmRepositoriesEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("UpdateHelmRepositories")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.updateHelmRepositoriesHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeUpdateHelmRepositoriesRequest,
+		encodeUpdateHelmRepositoriesResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListOrphanedNamespacesEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListOrphanedNamespaces")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listOrphanedNamespacesHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListOrphanedNamespacesRequest,
+		encodeListOrphanedNamespacesResponse,
+	)
+
+	endpoint = service.endpointCreatorService.DeleteOrphanedNamespaceEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("DeleteOrphanedNamespace")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.deleteOrphanedNamespaceHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeDeleteOrphanedNamespaceRequest,
+		encodeDeleteOrphanedNamespaceResponse,
+	)
+
+	endpoint = service.endpointCreatorService.AdoptOrphanedNamespaceEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("AdoptOrphanedNamespace")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.adoptOrphanedNamespaceHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeAdoptOrphanedNamespaceRequest,
+		encodeAdoptOrphanedNamespaceResponse,
+	)
+
+	endpoint = service.endpointCreatorService.IssueKubeconfigEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("IssueKubeconfig")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.issueKubeconfigHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeIssueKubeconfigRequest,
+		encodeIssueKubeconfigResponse,
+	)
+
+	endpoint = service.endpointCreatorService.RevokeKubeconfigEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("RevokeKubeconfig")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.revokeKubeconfigHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeRevokeKubeconfigRequest,
+		encodeRevokeKubeconfigResponse,
+	)
+
+	endpoint = service.endpointCreatorService.ListKubeconfigIssuancesEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("ListKubeconfigIssuances")(endpoint)
+	endpoint = service.createAuthMiddleware()(endpoint)
+	service.listKubeconfigIssuancesHandler = gokitgrpc.NewServer(
+		endpoint,
+		decodeListKubeconfigIssuancesRequest,
+		encodeListKubeconfigIssuancesResponse,
+	)
 }
 
 // CreateEdgeCluster creates a new edgeCluster
@@ -321,3 +491,276 @@ func (service *transportService) ListEdgeClusterServices(
 
 	return response.(*edgeClusterGRPCContract.ListEdgeClusterServicesResponse), nil
 }
+
+// ListSupportedClusterTypes returns the edge cluster types supported by this deployment
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the supported edge cluster types
+// Returns the descriptors of the supported edge cluster types
+func (service *transportService) ListSupportedClusterTypes(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.ListSupportedClusterTypesRequest) (*edgeClusterGRPCContract.ListSupportedClusterTypesResponse, error) {
+	_, response, err := service.listSupportedClusterTypesHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.ListSupportedClusterTypesResponse), nil
+}
+
+// InstallHelmRelease installs a new helm release on an existing edge cluster
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to install a new helm release
+// Returns the result of installing the helm release
+func (service *transportService) InstallHelmRelease(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.InstallHelmReleaseRequest) (*edgeClusterGRPCContract.InstallHelmReleaseResponse, error) {
+	_, response, err := service.installHelmReleaseHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.InstallHelmReleaseResponse), nil
+}
+
+// UpgradeHelmRelease upgrades an existing helm release on an existing edge cluster
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to upgrade an existing helm release
+// Returns the result of upgrading the helm release
+func (service *transportService) UpgradeHelmRelease(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.UpgradeHelmReleaseRequest) (*edgeClusterGRPCContract.UpgradeHelmReleaseResponse, error) {
+	_, response, err := service.upgradeHelmReleaseHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.UpgradeHelmReleaseResponse), nil
+}
+
+// RollbackHelmRelease rolls an existing helm release on an existing edge cluster back to one of its previous revisions
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to roll back an existing helm release
+// Returns the result of rolling back the helm release
+func (service *transportService) RollbackHelmRelease(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.RollbackHelmReleaseRequest) (*edgeClusterGRPCContract.RollbackHelmReleaseResponse, error) {
+	_, response, err := service.rollbackHelmReleaseHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.RollbackHelmReleaseResponse), nil
+}
+
+// UninstallHelmRelease uninstalls an existing helm release from an existing edge cluster
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to uninstall an existing helm release
+// Returns the result of uninstalling the helm release
+func (service *transportService) UninstallHelmRelease(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.UninstallHelmReleaseRequest) (*edgeClusterGRPCContract.UninstallHelmReleaseResponse, error) {
+	_, response, err := service.uninstallHelmReleaseHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.UninstallHelmReleaseResponse), nil
+}
+
+// ListHelmReleases lists the helm releases installed on an existing edge cluster
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the helm releases
+// Returns the latest revision of the helm releases
+func (service *transportService) ListHelmReleases(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.ListHelmReleasesRequest) (*edgeClusterGRPCContract.ListHelmReleasesResponse, error) {
+	_, response, err := service.listHelmReleasesHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.ListHelmReleasesResponse), nil
+}
+
+// AddHelmRepository registers a helm chart repository or an OCI registry the charts can be installed from. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to add a helm repository
+// Returns the result of adding the helm repository
+func (service *transportService) AddHelmRepository(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.AddHelmRepositoryRequest) (*edgeClusterGRPCContract.AddHelmRepositoryResponse, error) {
+	_, response, err := service.addHelmRepositoryHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.AddHelmRepositoryResponse), nil
+}
+
+// RemoveHelmRepository removes a registered helm chart repository or OCI registry. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to remove a helm repository
+// Returns the result of removing the helm repository
+func (service *transportService) RemoveHelmRepository(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.RemoveHelmRepositoryRequest) (*edgeClusterGRPCContract.RemoveHelmRepositoryResponse, error) {
+	_, response, err := service.removeHelmRepositoryHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.RemoveHelmRepositoryResponse), nil
+}
+
+// ListHelmRepositories lists the registered helm chart repositories and OCI registries. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the helm repositories
+// Returns the helm repositories with their last index refresh time
+func (service *transportService) ListHelmRepositories(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.ListHelmRepositoriesRequest) (*edgeClusterGRPCContract.ListHelmRepositoriesResponse, error) {
+	_, response, err := service.listHelmRepositoriesHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.ListHelmRepositoriesResponse), nil
+}
+
+// UpdateHelmRepositories downloads the latest index of the registered helm chart repositories. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to update the helm repositories
+// Returns the updated helm repositories
+func (service *transportService) UpdateHelmRepositories(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.UpdateHelmRepositoriesRequest) (*edgeClusterGRPCContract.UpdateHelmRepositoriesResponse, error) {
+	_, response, err := service.updateHelmRepositoriesHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.UpdateHelmRepositoriesResponse), nil
+}
+
+// ListOrphanedNamespaces lists the namespaces that host an edge cluster that no longer exists. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the orphaned namespaces
+// Returns the orphaned namespaces
+func (service *transportService) ListOrphanedNamespaces(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.ListOrphanedNamespacesRequest) (*edgeClusterGRPCContract.ListOrphanedNamespacesResponse, error) {
+	_, response, err := service.listOrphanedNamespacesHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.ListOrphanedNamespacesResponse), nil
+}
+
+// DeleteOrphanedNamespace deletes a namespace that hosts an edge cluster that no longer exists. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to delete an orphaned namespace
+// Returns the result of deleting the orphaned namespace
+func (service *transportService) DeleteOrphanedNamespace(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.DeleteOrphanedNamespaceRequest) (*edgeClusterGRPCContract.DeleteOrphanedNamespaceResponse, error) {
+	_, response, err := service.deleteOrphanedNamespaceHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.DeleteOrphanedNamespaceResponse), nil
+}
+
+// AdoptOrphanedNamespace adds the edge cluster hosted by an orphaned namespace back to the repository. Admin only.
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to adopt an orphaned namespace
+// Returns the adopted edge cluster
+func (service *transportService) AdoptOrphanedNamespace(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.AdoptOrphanedNamespaceRequest) (*edgeClusterGRPCContract.AdoptOrphanedNamespaceResponse, error) {
+	_, response, err := service.adoptOrphanedNamespaceHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.AdoptOrphanedNamespaceResponse), nil
+}
+
+// IssueKubeconfig issues a kubeconfig granting a role on an existing edge cluster that expires after the
+// requested time
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to issue a kubeconfig
+// Returns the issued kubeconfig
+func (service *transportService) IssueKubeconfig(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.IssueKubeconfigRequest) (*edgeClusterGRPCContract.IssueKubeconfigResponse, error) {
+	_, response, err := service.issueKubeconfigHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.IssueKubeconfigResponse), nil
+}
+
+// RevokeKubeconfig revokes a kubeconfig issued for an existing edge cluster
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to revoke an issued kubeconfig
+// Returns the revoked kubeconfig
+func (service *transportService) RevokeKubeconfig(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.RevokeKubeconfigRequest) (*edgeClusterGRPCContract.RevokeKubeconfigResponse, error) {
+	_, response, err := service.revokeKubeconfigHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.RevokeKubeconfigResponse), nil
+}
+
+// ListKubeconfigIssuances lists the kubeconfigs issued for an existing edge cluster
+// context: Mandatory. The reference to the context
+// request: Mandatory. The request to list the issued kubeconfigs
+// Returns the issued kubeconfigs
+func (service *transportService) ListKubeconfigIssuances(
+	ctx context.Context,
+	request *edgeClusterGRPCContract.ListKubeconfigIssuancesRequest) (*edgeClusterGRPCContract.ListKubeconfigIssuancesResponse, error) {
+	_, response, err := service.listKubeconfigIssuancesHandler.ServeGRPC(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.(*edgeClusterGRPCContract.ListKubeconfigIssuancesResponse), nil
+}
+
+// WatchEdgeCluster streams the provisioning events of an existing edge cluster until the client disconnects
+// request: Mandatory. The request to watch an existing edge cluster
+// stream: Mandatory. The stream the provisioning events are sent to
+// Returns error if something goes wrong
+func (service *transportService) WatchEdgeCluster(
+	request *edgeClusterGRPCContract.WatchEdgeClusterRequest,
+	stream edgeClusterGRPCContract.Service_WatchEdgeClusterServer) error {
+	_, response, err := service.watchEdgeClusterHandler.ServeGRPC(stream.Context(), request)
+	if err != nil {
+		return err
+	}
+
+	castedResponse := response.(*business.WatchEdgeClusterResponse)
+	if castedResponse.Err != nil {
+		return stream.Send(&edgeClusterGRPCContract.WatchEdgeClusterResponse{
+			Error:        mapError(castedResponse.Err),
+			ErrorMessage: castedResponse.Err.Error(),
+		})
+	}
+
+	for edgeClusterEvent := range castedResponse.Events {
+		if err = stream.Send(&edgeClusterGRPCContract.WatchEdgeClusterResponse{
+			Error: edgeClusterGRPCContract.Error_NO_ERROR,
+			Event: mapFromEdgeClusterEvent(edgeClusterEvent),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"context"
+
+	"github.com/decentralized-cloud/edge-cluster/models"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/micro-business/go-core/jwt/fasthttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createAuthMiddleware verifies the bearer token of the request and stores the parsed token in the context. The
+// middleware must be called with the fasthttp request context, as the token is read from the request headers.
+func (service *transportService) createAuthMiddleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			token, err := fasthttp.ParseAndVerifyToken(ctx, service.jwksURL, true)
+			if err != nil {
+				return nil, err
+			}
+
+			email, ok := token.PrivateClaims()["email"].(string)
+			if !ok {
+				return nil, status.Errorf(codes.Unauthenticated, "the token does not contain the email claim")
+			}
+
+			parsedToken := models.ParsedToken{Email: email}
+			ctx = context.WithValue(ctx, models.ContextKeyParsedToken, parsedToken)
+
+			return next(ctx, request)
+		}
+	}
+}
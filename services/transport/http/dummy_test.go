@@ -1 +0,0 @@
-package http_test
@@ -0,0 +1,240 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	gokitendpoint "github.com/go-kit/kit/endpoint"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/savsgio/atreugo/v11"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// KubernetesProxyPath is the route of the proxy to the Kubernetes API of the edge clusters. The path after the k8s
+// segment is forwarded to the Kubernetes API, e.g. /clusters/{id}/k8s/api/v1/namespaces is forwarded to
+// /api/v1/namespaces.
+const KubernetesProxyPath = "/clusters/{id}/k8s/{path:*}"
+
+// edgeClusterUnreachableMessage is the message the errors of reaching the edge cluster are reported with, the details
+// of the errors are only logged as they can contain the address and the credentials of the edge cluster
+const edgeClusterUnreachableMessage = "failed to reach the edge cluster"
+
+// hopByHopHeaders are the headers that only apply to a single connection, so they are not forwarded by the proxy
+var hopByHopHeaders = map[string]bool{
+	"Connection":          true,
+	"Keep-Alive":          true,
+	"Proxy-Authenticate":  true,
+	"Proxy-Authorization": true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+type kubernetesProxy struct {
+	logger                           *zap.Logger
+	getEdgeClusterKubeconfigEndpoint gokitendpoint.Endpoint
+}
+
+// NewKubernetesProxyHandler creates the handler that forwards the requests of the users to the Kubernetes API of
+// their edge clusters, to be served on KubernetesProxyPath
+// logger: Mandatory. Reference to the logger service
+// getEdgeClusterKubeconfigEndpoint: Mandatory. The endpoint that authenticates the user and returns the kubeconfig of the edge cluster
+// Returns the new handler or error if something goes wrong
+func NewKubernetesProxyHandler(
+	logger *zap.Logger,
+	getEdgeClusterKubeconfigEndpoint gokitendpoint.Endpoint) (atreugo.View, error) {
+	if logger == nil {
+		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
+	}
+
+	if getEdgeClusterKubeconfigEndpoint == nil {
+		return nil, commonErrors.NewArgumentNilError("getEdgeClusterKubeconfigEndpoint", "getEdgeClusterKubeconfigEndpoint is required")
+	}
+
+	proxy := &kubernetesProxy{
+		logger:                           logger,
+		getEdgeClusterKubeconfigEndpoint: getEdgeClusterKubeconfigEndpoint,
+	}
+
+	return proxy.handle, nil
+}
+
+// handle forwards the request of the user to the Kubernetes API of the edge cluster using the kubeconfig of the edge
+// cluster, so the users can reach the Kubernetes API of their edge clusters without it being exposed publicly. The
+// user is authenticated with the same bearer token the gRPC API accepts and can only reach the edge clusters the user
+// owns. The response is streamed back, so watch requests keep working. The requests that upgrade the connection, e.g.
+// exec, attach and port-forward, are not supported.
+func (proxy *kubernetesProxy) handle(ctx *atreugo.RequestCtx) error {
+	if ctx.Request.Header.ConnectionUpgrade() {
+		return writeKubernetesStatus(
+			ctx,
+			http.StatusNotImplemented,
+			metav1.StatusReasonMethodNotAllowed,
+			"the requests that upgrade the connection are not supported by the proxy")
+	}
+
+	edgeClusterID, _ := ctx.UserValue("id").(string)
+	response, err := proxy.getEdgeClusterKubeconfigEndpoint(
+		ctx.RequestCtx,
+		&business.GetEdgeClusterKubeconfigRequest{EdgeClusterID: edgeClusterID})
+	if err != nil {
+		return proxy.writeEndpointError(ctx, edgeClusterID, err)
+	}
+
+	castedResponse := response.(*business.GetEdgeClusterKubeconfigResponse)
+	if castedResponse.Err != nil {
+		return proxy.writeBusinessError(ctx, edgeClusterID, castedResponse.Err)
+	}
+
+	if castedResponse.KubeconfigContent == "" {
+		return writeKubernetesStatus(
+			ctx,
+			http.StatusServiceUnavailable,
+			metav1.StatusReasonServiceUnavailable,
+			"the edge cluster is not provisioned yet")
+	}
+
+	return proxy.forward(ctx, edgeClusterID, castedResponse.KubeconfigContent)
+}
+
+func (proxy *kubernetesProxy) forward(ctx *atreugo.RequestCtx, edgeClusterID string, kubeconfig string) error {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return proxy.writeUnreachableError(ctx, edgeClusterID, "failed to parse the edge cluster kubeconfig", err)
+	}
+
+	// The transports are cached by client-go, so the connections to the edge cluster are reused across the requests
+	roundTripper, err := rest.TransportFor(restConfig)
+	if err != nil {
+		return proxy.writeUnreachableError(ctx, edgeClusterID, "failed to create the edge cluster transport", err)
+	}
+
+	upstreamURL, err := url.Parse(restConfig.Host)
+	if err != nil {
+		return proxy.writeUnreachableError(ctx, edgeClusterID, "failed to parse the edge cluster address", err)
+	}
+
+	path, _ := ctx.UserValue("path").(string)
+	upstreamURL.Path = strings.TrimSuffix(upstreamURL.Path, "/") + "/" + path
+	upstreamURL.RawQuery = string(ctx.URI().QueryString())
+
+	var body io.Reader = http.NoBody
+	if requestBody := ctx.Request.Body(); len(requestBody) > 0 {
+		body = bytes.NewReader(requestBody)
+	}
+
+	request, err := http.NewRequestWithContext(ctx.RequestCtx, string(ctx.Method()), upstreamURL.String(), body)
+	if err != nil {
+		proxy.logger.Warn("failed to create the request to the edge cluster", zap.Error(err), zap.String("edgeClusterID", edgeClusterID))
+
+		return writeKubernetesStatus(ctx, http.StatusBadRequest, metav1.StatusReasonBadRequest, "the request cannot be forwarded to the edge cluster")
+	}
+
+	ctx.Request.Header.VisitAll(func(key, value []byte) {
+		name := http.CanonicalHeaderKey(string(key))
+		if hopByHopHeaders[name] || name == "Authorization" || name == "Host" || name == "Content-Length" {
+			return
+		}
+
+		request.Header.Add(name, string(value))
+	})
+
+	response, err := roundTripper.RoundTrip(request)
+	if err != nil {
+		return proxy.writeUnreachableError(ctx, edgeClusterID, "failed to forward the request to the edge cluster", err)
+	}
+
+	ctx.Response.SetStatusCode(response.StatusCode)
+	for name, values := range response.Header {
+		if hopByHopHeaders[name] || name == "Content-Length" {
+			continue
+		}
+
+		for _, value := range values {
+			ctx.Response.Header.Add(name, value)
+		}
+	}
+
+	// The response body is closed by fasthttp once it is written or the client disconnects, which also ends the
+	// watch requests on the edge cluster
+	bodySize := -1
+	if response.ContentLength >= 0 {
+		bodySize = int(response.ContentLength)
+	}
+
+	ctx.Response.SetBodyStream(response.Body, bodySize)
+
+	return nil
+}
+
+// writeEndpointError writes the error the endpoint failed with. Only the errors of authenticating the user are
+// reported as unauthorized, the rest are reported the same way as the errors of the business service.
+func (proxy *kubernetesProxy) writeEndpointError(ctx *atreugo.RequestCtx, edgeClusterID string, err error) error {
+	if argumentNilErr, ok := err.(commonErrors.ArgumentNilError); ok && argumentNilErr.ArgumentName == "authorizationToken" {
+		return writeKubernetesStatus(ctx, http.StatusUnauthorized, metav1.StatusReasonUnauthorized, "authorization token is not provided")
+	}
+
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return writeKubernetesStatus(ctx, http.StatusUnauthorized, metav1.StatusReasonUnauthorized, "the user is not authenticated")
+	case codes.PermissionDenied:
+		return writeKubernetesStatus(ctx, http.StatusForbidden, metav1.StatusReasonForbidden, "the user is not allowed to reach the edge cluster")
+	default:
+		return proxy.writeBusinessError(ctx, edgeClusterID, err)
+	}
+}
+
+// writeBusinessError writes the error the business service failed with. The edge clusters the user does not own are
+// reported as not found, and the rest of the errors are only logged and reported as a bad gateway, as the edge
+// cluster is not reachable through the proxy then.
+func (proxy *kubernetesProxy) writeBusinessError(ctx *atreugo.RequestCtx, edgeClusterID string, err error) error {
+	switch {
+	case commonErrors.IsNotFoundError(err):
+		return writeKubernetesStatus(ctx, http.StatusNotFound, metav1.StatusReasonNotFound, "edge cluster not found")
+	case commonErrors.IsArgumentNilError(err), commonErrors.IsArgumentError(err):
+		return writeKubernetesStatus(ctx, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+	case business.IsPermissionDeniedError(err):
+		return writeKubernetesStatus(ctx, http.StatusForbidden, metav1.StatusReasonForbidden, "the user is not allowed to reach the edge cluster")
+	default:
+		return proxy.writeUnreachableError(ctx, edgeClusterID, "failed to retrieve the edge cluster kubeconfig", err)
+	}
+}
+
+// writeUnreachableError logs the error of reaching the edge cluster and reports it as a bad gateway
+func (proxy *kubernetesProxy) writeUnreachableError(ctx *atreugo.RequestCtx, edgeClusterID string, message string, err error) error {
+	proxy.logger.Error(message, zap.Error(err), zap.String("edgeClusterID", edgeClusterID))
+
+	return writeKubernetesStatus(ctx, http.StatusBadGateway, metav1.StatusReasonServiceUnavailable, edgeClusterUnreachableMessage)
+}
+
+// writeKubernetesStatus writes the error as a Kubernetes Status, so the Kubernetes clients report the errors of the
+// proxy the same way as the errors of the Kubernetes API
+func writeKubernetesStatus(ctx *atreugo.RequestCtx, statusCode int, reason metav1.StatusReason, message string) error {
+	body, err := json.Marshal(metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  message,
+		Reason:   reason,
+		Code:     int32(statusCode),
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Response.SetStatusCode(statusCode)
+	ctx.Response.Header.SetContentType("application/json")
+	ctx.Response.SetBody(body)
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/decentralized-cloud/edge-cluster/services/business"
+	transportHttp "github.com/decentralized-cloud/edge-cluster/services/transport/http"
+	"github.com/lucsky/cuid"
+	commonErrors "github.com/micro-business/go-core/system/errors"
+	"github.com/savsgio/atreugo/v11"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestHttpTransport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "HTTP Transport Tests")
+}
+
+var _ = Describe("Kubernetes Proxy Tests", func() {
+	const edgeClusterToken = "edge-cluster-token"
+
+	var (
+		upstream         *httptest.Server
+		upstreamRequest  *http.Request
+		upstreamBody     string
+		listener         net.Listener
+		proxyURL         string
+		edgeClusterID    string
+		requestedID      string
+		endpointResponse interface{}
+		endpointErr      error
+	)
+
+	newKubeconfig := func(server string) string {
+		return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: edge-cluster
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+contexts:
+- name: edge-cluster
+  context:
+    cluster: edge-cluster
+    user: edge-cluster
+current-context: edge-cluster
+users:
+- name: edge-cluster
+  user:
+    token: %s
+`, server, edgeClusterToken)
+	}
+
+	sendRequest := func(method, path string, body string, headers map[string]string) (*http.Response, string) {
+		request, err := http.NewRequest(method, proxyURL+path, strings.NewReader(body))
+		Ω(err).Should(BeNil())
+
+		for name, value := range headers {
+			request.Header.Set(name, value)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		Ω(err).Should(BeNil())
+
+		defer response.Body.Close()
+
+		responseBody, err := ioutil.ReadAll(response.Body)
+		Ω(err).Should(BeNil())
+
+		return response, string(responseBody)
+	}
+
+	readStatus := func(body string) metav1.Status {
+		var kubernetesStatus metav1.Status
+		Ω(json.Unmarshal([]byte(body), &kubernetesStatus)).Should(BeNil())
+
+		return kubernetesStatus
+	}
+
+	BeforeEach(func() {
+		upstreamRequest = nil
+		upstreamBody = ""
+		upstream = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			upstreamRequest = r
+			upstreamBody = string(body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Upstream", "edge-cluster")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"kind":"Namespace"}`))
+		}))
+
+		edgeClusterID = cuid.New()
+		requestedID = ""
+		endpointErr = nil
+		endpointResponse = &business.GetEdgeClusterKubeconfigResponse{KubeconfigContent: newKubeconfig(upstream.URL)}
+
+		handler, err := transportHttp.NewKubernetesProxyHandler(
+			zap.NewNop(),
+			func(ctx context.Context, request interface{}) (interface{}, error) {
+				requestedID = request.(*business.GetEdgeClusterKubeconfigRequest).EdgeClusterID
+
+				return endpointResponse, endpointErr
+			})
+		Ω(err).Should(BeNil())
+
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Ω(err).Should(BeNil())
+
+		server := atreugo.New(atreugo.Config{})
+		server.ANY(transportHttp.KubernetesProxyPath, handler)
+
+		go func() {
+			_ = server.Serve(listener)
+		}()
+
+		proxyURL = "http://" + listener.Addr().String() + "/clusters/" + edgeClusterID + "/k8s"
+	})
+
+	AfterEach(func() {
+		_ = listener.Close()
+		upstream.Close()
+	})
+
+	Context("user tries to instantiate the Kubernetes proxy handler", func() {
+		When("logger is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				handler, err := transportHttp.NewKubernetesProxyHandler(nil, func(context.Context, interface{}) (interface{}, error) {
+					return nil, nil
+				})
+				Ω(handler).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+
+		When("the kubeconfig endpoint is not provided", func() {
+			It("should return ArgumentNilError", func() {
+				handler, err := transportHttp.NewKubernetesProxyHandler(zap.NewNop(), nil)
+				Ω(handler).Should(BeNil())
+				Ω(commonErrors.IsArgumentNilError(err)).Should(BeTrue())
+			})
+		})
+	})
+
+	Context("the request is forwarded to the edge cluster", func() {
+		It("should forward the path and the query string and stream back the response", func() {
+			response, body := sendRequest(
+				http.MethodPost,
+				"/api/v1/namespaces?dryRun=All&fieldManager=kubectl",
+				`{"kind":"Namespace"}`,
+				map[string]string{"Content-Type": "application/json"})
+
+			Ω(requestedID).Should(Equal(edgeClusterID))
+			Ω(response.StatusCode).Should(Equal(http.StatusCreated))
+			Ω(response.Header.Get("X-Upstream")).Should(Equal("edge-cluster"))
+			Ω(body).Should(Equal(`{"kind":"Namespace"}`))
+
+			Ω(upstreamRequest).ShouldNot(BeNil())
+			Ω(upstreamRequest.Method).Should(Equal(http.MethodPost))
+			Ω(upstreamRequest.URL.Path).Should(Equal("/api/v1/namespaces"))
+			Ω(upstreamRequest.URL.RawQuery).Should(Equal("dryRun=All&fieldManager=kubectl"))
+			Ω(upstreamRequest.Header.Get("Content-Type")).Should(Equal("application/json"))
+			Ω(upstreamBody).Should(Equal(`{"kind":"Namespace"}`))
+			Ω(upstreamRequest.ContentLength).Should(Equal(int64(len(upstreamBody))))
+		})
+
+		It("should forward the request for the root of the Kubernetes API", func() {
+			response, _ := sendRequest(http.MethodGet, "/", "", nil)
+
+			Ω(response.StatusCode).Should(Equal(http.StatusCreated))
+			Ω(upstreamRequest.URL.Path).Should(Equal("/"))
+		})
+
+		It("should authenticate with the kubeconfig of the edge cluster and drop the connection specific headers", func() {
+			response, _ := sendRequest(http.MethodGet, "/api", "", map[string]string{
+				"Authorization":       "Bearer " + cuid.New(),
+				"Proxy-Authorization": "Basic " + cuid.New(),
+				"Keep-Alive":          "timeout=5",
+				"Te":                  "trailers",
+				"Accept":              "application/json",
+			})
+
+			Ω(response.StatusCode).Should(Equal(http.StatusCreated))
+			Ω(upstreamRequest).ShouldNot(BeNil())
+			Ω(upstreamRequest.Header.Get("Authorization")).Should(Equal("Bearer " + edgeClusterToken))
+			Ω(upstreamRequest.Host).Should(Equal(upstream.Listener.Addr().String()))
+			Ω(upstreamRequest.Header.Get("Accept")).Should(Equal("application/json"))
+			Ω(upstreamRequest.Header).ShouldNot(HaveKey("Proxy-Authorization"))
+			Ω(upstreamRequest.Header).ShouldNot(HaveKey("Keep-Alive"))
+			Ω(upstreamRequest.Header).ShouldNot(HaveKey("Te"))
+		})
+
+		When("the request upgrades the connection", func() {
+			It("should return 501 without reaching the edge cluster", func() {
+				response, body := sendRequest(http.MethodPost, "/api/v1/namespaces/default/pods/x/exec", "", map[string]string{
+					"Connection": "Upgrade",
+					"Upgrade":    "SPDY/3.1",
+				})
+
+				Ω(response.StatusCode).Should(Equal(http.StatusNotImplemented))
+				Ω(readStatus(body).Code).Should(Equal(int32(http.StatusNotImplemented)))
+				Ω(requestedID).Should(BeEmpty())
+				Ω(upstreamRequest).Should(BeNil())
+			})
+		})
+
+		When("the edge cluster is not reachable", func() {
+			It("should return 502 without the details of the error", func() {
+				upstream.Close()
+
+				response, body := sendRequest(http.MethodGet, "/api", "", nil)
+
+				Ω(response.StatusCode).Should(Equal(http.StatusBadGateway))
+				Ω(body).ShouldNot(ContainSubstring(upstream.Listener.Addr().String()))
+				Ω(readStatus(body).Message).Should(Equal("failed to reach the edge cluster"))
+			})
+		})
+	})
+
+	Context("the kubeconfig of the edge cluster cannot be retrieved", func() {
+		When("the authorization token is not provided", func() {
+			It("should return 401", func() {
+				endpointErr = commonErrors.NewArgumentNilError("authorizationToken", "authorizationToken is required")
+
+				response, body := sendRequest(http.MethodGet, "/api", "", nil)
+				Ω(response.StatusCode).Should(Equal(http.StatusUnauthorized))
+				Ω(readStatus(body).Reason).Should(Equal(metav1.StatusReasonUnauthorized))
+			})
+		})
+
+		When("the authorization token is not valid", func() {
+			It("should return 401", func() {
+				endpointErr = status.Errorf(codes.Unauthenticated, "Failed to parse and validate the received token")
+
+				response, _ := sendRequest(http.MethodGet, "/api", "", nil)
+				Ω(response.StatusCode).Should(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		When("the edge cluster does not exist or is owned by another user", func() {
+			It("should return 404", func() {
+				endpointResponse = &business.GetEdgeClusterKubeconfigResponse{Err: commonErrors.NewNotFoundError()}
+
+				response, body := sendRequest(http.MethodGet, "/api", "", nil)
+				Ω(response.StatusCode).Should(Equal(http.StatusNotFound))
+				Ω(readStatus(body).Reason).Should(Equal(metav1.StatusReasonNotFound))
+			})
+		})
+
+		When("the request is not valid", func() {
+			It("should return 400", func() {
+				endpointResponse = &business.GetEdgeClusterKubeconfigResponse{
+					Err: commonErrors.NewArgumentError("request", "edge cluster ID is required"),
+				}
+
+				response, _ := sendRequest(http.MethodGet, "/api", "", nil)
+				Ω(response.StatusCode).Should(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("retrieving the kubeconfig fails", func() {
+			It("should return 502 without the details of the error", func() {
+				internalDetails := cuid.New()
+				endpointResponse = &business.GetEdgeClusterKubeconfigResponse{
+					Err: commonErrors.NewUnknownError(internalDetails),
+				}
+
+				response, body := sendRequest(http.MethodGet, "/api", "", nil)
+				Ω(response.StatusCode).Should(Equal(http.StatusBadGateway))
+				Ω(body).ShouldNot(ContainSubstring(internalDetails))
+			})
+		})
+
+		When("the edge cluster is not provisioned yet", func() {
+			It("should return 503", func() {
+				endpointResponse = &business.GetEdgeClusterKubeconfigResponse{}
+
+				response, _ := sendRequest(http.MethodGet, "/api", "", nil)
+				Ω(response.StatusCode).Should(Equal(http.StatusServiceUnavailable))
+			})
+		})
+	})
+})
@@ -2,12 +2,19 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/decentralized-cloud/edge-cluster/services/configuration"
+	"github.com/decentralized-cloud/edge-cluster/services/cron"
+	"github.com/decentralized-cloud/edge-cluster/services/endpoint"
+	"github.com/decentralized-cloud/edge-cluster/services/leaderelection"
 	"github.com/decentralized-cloud/edge-cluster/services/transport"
 	"github.com/decentralized-cloud/edge-cluster/services/transport/grpc"
+	gokitendpoint "github.com/go-kit/kit/endpoint"
+	"github.com/micro-business/go-core/gokit/middleware"
 	commonErrors "github.com/micro-business/go-core/system/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/savsgio/atreugo/v11"
@@ -15,17 +22,81 @@ import (
 )
 
 type transportService struct {
-	logger               *zap.Logger
-	configurationService configuration.ConfigurationContract
+	logger                    *zap.Logger
+	configurationService      configuration.ConfigurationContract
+	schedulerService          cron.SchedulerContract
+	helmChartRefreshJob       cron.HelmChartRefreshJobContract
+	leaderElection            leaderelection.LeaderElectionContract
+	endpointCreatorService    endpoint.EndpointCreatorContract
+	middlewareProviderService middleware.MiddlewareProviderContract
+	jwksURL                   string
+
+	getEdgeClusterKubeconfigEndpoint gokitendpoint.Endpoint
+}
+
+// readinessStatus is the body of the readiness check response
+type readinessStatus struct {
+	Ready            bool                    `json:"ready"`
+	LeaderElection   leaderElectionStatus    `json:"leaderElection"`
+	HelmRepositories *helmRepositoriesStatus `json:"helmRepositories,omitempty"`
+	CronJobs         []cronJobStatus         `json:"cronJobs"`
+}
+
+// leaderElectionStatus is the leadership status of this replica. Only the leader runs the background jobs.
+type leaderElectionStatus struct {
+	Enabled            bool       `json:"enabled"`
+	Identity           string     `json:"identity"`
+	IsLeader           bool       `json:"isLeader"`
+	Leader             string     `json:"leader,omitempty"`
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// helmRepositoriesStatus is the result of the last update of the helm chart repositories
+type helmRepositoriesStatus struct {
+	LastUpdate   time.Time              `json:"lastUpdate"`
+	Duration     string                 `json:"duration"`
+	Repositories []helmRepositoryStatus `json:"repositories"`
+}
+
+// helmRepositoryStatus is the result of downloading the latest index of a helm chart repository
+type helmRepositoryStatus struct {
+	Name     string `json:"name"`
+	URL      string `json:"url"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// cronJobStatus is the status of the last run of a background job
+type cronJobStatus struct {
+	Name            string     `json:"name"`
+	Schedule        string     `json:"schedule"`
+	Running         bool       `json:"running"`
+	LastRun         *time.Time `json:"lastRun,omitempty"`
+	LastDuration    string     `json:"lastDuration,omitempty"`
+	LastError       string     `json:"lastError,omitempty"`
+	LastSuccess     *time.Time `json:"lastSuccess,omitempty"`
+	RunCount        int        `json:"runCount"`
+	FailureCount    int        `json:"failureCount"`
+	SkippedRunCount int        `json:"skippedRunCount"`
 }
 
 // NewTransportService creates new instance of the transportService, setting up all dependencies and returns the instance
 // logger: Mandatory. Reference to the logger service
 // configurationService: Mandatory. Reference to the service that provides required configurations
+// schedulerService: Mandatory. Reference to the service that runs the background jobs
+// helmChartRefreshJob: Mandatory. Reference to the background job that keeps the helm chart repositories updated
+// leaderElection: Mandatory. Reference to the service that elects the replica that runs the background jobs
+// endpointCreatorService: Mandatory. Reference to the service that creates go-kit compatible endpoints
+// middlewareProviderService: Mandatory. Reference to the service that provides different go-kit middlewares
 // Returns the new service or error if something goes wrong
 func NewTransportService(
 	logger *zap.Logger,
-	configurationService configuration.ConfigurationContract) (transport.TransportContract, error) {
+	configurationService configuration.ConfigurationContract,
+	schedulerService cron.SchedulerContract,
+	helmChartRefreshJob cron.HelmChartRefreshJobContract,
+	leaderElection leaderelection.LeaderElectionContract,
+	endpointCreatorService endpoint.EndpointCreatorContract,
+	middlewareProviderService middleware.MiddlewareProviderContract) (transport.TransportContract, error) {
 	if logger == nil {
 		return nil, commonErrors.NewArgumentNilError("logger", "logger is required")
 	}
@@ -34,17 +105,54 @@ func NewTransportService(
 		return nil, commonErrors.NewArgumentNilError("configurationService", "configurationService is required")
 	}
 
+	if schedulerService == nil {
+		return nil, commonErrors.NewArgumentNilError("schedulerService", "schedulerService is required")
+	}
+
+	if helmChartRefreshJob == nil {
+		return nil, commonErrors.NewArgumentNilError("helmChartRefreshJob", "helmChartRefreshJob is required")
+	}
+
+	if leaderElection == nil {
+		return nil, commonErrors.NewArgumentNilError("leaderElection", "leaderElection is required")
+	}
+
+	if endpointCreatorService == nil {
+		return nil, commonErrors.NewArgumentNilError("endpointCreatorService", "endpointCreatorService is required")
+	}
+
+	if middlewareProviderService == nil {
+		return nil, commonErrors.NewArgumentNilError("middlewareProviderService", "middlewareProviderService is required")
+	}
+
+	jwksURL, err := configurationService.GetJwksURL()
+	if err != nil {
+		return nil, err
+	}
+
 	return &transportService{
-		logger:               logger,
-		configurationService: configurationService,
+		logger:                    logger,
+		configurationService:      configurationService,
+		schedulerService:          schedulerService,
+		helmChartRefreshJob:       helmChartRefreshJob,
+		leaderElection:            leaderElection,
+		endpointCreatorService:    endpointCreatorService,
+		middlewareProviderService: middlewareProviderService,
+		jwksURL:                   jwksURL,
 	}, nil
 }
 
 // Start starts the GraphQL transport service
 // Returns error if something goes wrong
 func (service *transportService) Start() error {
+	service.setupHandlers()
+
+	kubernetesProxyHandler, err := NewKubernetesProxyHandler(service.logger, service.getEdgeClusterKubeconfigEndpoint)
+	if err != nil {
+		return err
+	}
+
 	config := atreugo.Config{GracefulShutdown: true}
-	var err error
 
 	host, err := service.configurationService.GetHttpHost()
 	if err != nil {
@@ -62,6 +170,7 @@ func (service *transportService) Start() error {
 	server.Path("GET", "/live", service.livenessCheckHandler)
 	server.Path("GET", "/ready", service.readinessCheckHandler)
 	server.NetHTTPPath("GET", "/metrics", promhttp.Handler())
+	server.ANY(KubernetesProxyPath, kubernetesProxyHandler)
 	service.logger.Info("HTTP service started", zap.String("address", config.Addr))
 
 	return server.ListenAndServe()
@@ -73,6 +182,12 @@ func (service *transportService) Stop() error {
 	return nil
 }
 
+func (service *transportService) setupHandlers() {
+	endpoint := service.endpointCreatorService.GetEdgeClusterKubeconfigEndpoint()
+	endpoint = service.middlewareProviderService.CreateLoggingMiddleware("GetEdgeClusterKubeconfig")(endpoint)
+	service.getEdgeClusterKubeconfigEndpoint = service.createAuthMiddleware()(endpoint)
+}
+
 func (service *transportService) livenessCheckHandler(ctx *atreugo.RequestCtx) error {
 	if grpc.Live {
 		ctx.Response.SetStatusCode(http.StatusOK)
@@ -83,12 +198,101 @@ func (service *transportService) livenessCheckHandler(ctx *atreugo.RequestCtx) e
 	return nil
 }
 
+// readinessCheckHandler reports whether the service is ready to accept requests, together with the result of the
+// last update of the helm chart repositories, the last run of the background jobs and the leadership of this replica.
+// A failing chart repository or background job does not make the service unready, as the cached indexes are still
+// used to install the charts. The replicas that are not the leader are ready too, as they still serve the requests.
 func (service *transportService) readinessCheckHandler(ctx *atreugo.RequestCtx) error {
-	if grpc.Ready {
-		ctx.Response.SetStatusCode(http.StatusOK)
-	} else {
-		ctx.Response.SetStatusCode(http.StatusServiceUnavailable)
+	status := readinessStatus{
+		Ready:          grpc.Ready,
+		LeaderElection: getLeaderElectionStatus(service.leaderElection.GetStatus()),
+		CronJobs:       getCronJobStatuses(service.schedulerService.ListJobStatuses()),
+	}
+
+	if report, ok := service.helmChartRefreshJob.GetLastUpdateReport(); ok {
+		status.HelmRepositories = &helmRepositoriesStatus{
+			LastUpdate:   report.StartTime,
+			Duration:     report.Duration.String(),
+			Repositories: []helmRepositoryStatus{},
+		}
+
+		for _, result := range report.Repositories {
+			repositoryStatus := helmRepositoryStatus{
+				Name:     result.Name,
+				URL:      result.URL,
+				Duration: result.Duration.String(),
+			}
+
+			if result.Err != nil {
+				repositoryStatus.Error = result.Err.Error()
+			}
+
+			status.HelmRepositories.Repositories = append(status.HelmRepositories.Repositories, repositoryStatus)
+		}
+	}
+
+	statusCode := http.StatusOK
+	if !status.Ready {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	body, err := json.Marshal(status)
+	if err != nil {
+		return err
 	}
 
+	ctx.Response.SetStatusCode(statusCode)
+	ctx.Response.Header.SetContentType("application/json")
+	ctx.Response.SetBody(body)
+
 	return nil
 }
+
+func getLeaderElectionStatus(leadershipStatus leaderelection.LeadershipStatus) leaderElectionStatus {
+	status := leaderElectionStatus{
+		Enabled:  leadershipStatus.Enabled,
+		Identity: leadershipStatus.Identity,
+		IsLeader: leadershipStatus.IsLeader,
+		Leader:   leadershipStatus.LeaderIdentity,
+	}
+
+	if !leadershipStatus.LastTransitionTime.IsZero() {
+		lastTransitionTime := leadershipStatus.LastTransitionTime
+		status.LastTransitionTime = &lastTransitionTime
+	}
+
+	return status
+}
+
+func getCronJobStatuses(jobStatuses []cron.JobStatus) []cronJobStatus {
+	statuses := make([]cronJobStatus, 0, len(jobStatuses))
+	for _, jobStatus := range jobStatuses {
+		status := cronJobStatus{
+			Name:            jobStatus.Name,
+			Schedule:        jobStatus.Schedule,
+			Running:         jobStatus.Running,
+			LastError:       jobStatus.LastErrorMessage,
+			RunCount:        jobStatus.RunCount,
+			FailureCount:    jobStatus.FailureCount,
+			SkippedRunCount: jobStatus.SkipCount,
+		}
+
+		if !jobStatus.LastStartTime.IsZero() {
+			lastRun := jobStatus.LastStartTime
+			status.LastRun = &lastRun
+		}
+
+		if jobStatus.RunCount > 0 {
+			status.LastDuration = jobStatus.LastDuration.String()
+		}
+
+		if !jobStatus.LastSuccessTime.IsZero() {
+			lastSuccess := jobStatus.LastSuccessTime
+			status.LastSuccess = &lastSuccess
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}